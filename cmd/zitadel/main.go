@@ -13,6 +13,8 @@ import (
 	"github.com/getsentry/sentry-go"
 	"github.com/rs/cors"
 
+	"github.com/caos/zitadel/internal/actions"
+	"github.com/caos/zitadel/internal/actions/repository/execution"
 	admin_es "github.com/caos/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/caos/zitadel/internal/api"
 	"github.com/caos/zitadel/internal/api/assets"
@@ -21,6 +23,8 @@ import (
 	"github.com/caos/zitadel/internal/api/grpc/auth"
 	"github.com/caos/zitadel/internal/api/grpc/management"
 	"github.com/caos/zitadel/internal/api/oidc"
+	"github.com/caos/zitadel/internal/api/saml"
+	"github.com/caos/zitadel/internal/api/scim"
 	auth_es "github.com/caos/zitadel/internal/auth/repository/eventsourcing"
 	"github.com/caos/zitadel/internal/authz"
 	authz_repo "github.com/caos/zitadel/internal/authz/repository"
@@ -29,6 +33,7 @@ import (
 	sd "github.com/caos/zitadel/internal/config/systemdefaults"
 	"github.com/caos/zitadel/internal/config/types"
 	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/expiration"
 	"github.com/caos/zitadel/internal/id"
 	"github.com/caos/zitadel/internal/notification"
 	"github.com/caos/zitadel/internal/query"
@@ -41,6 +46,7 @@ import (
 	"github.com/caos/zitadel/internal/ui"
 	"github.com/caos/zitadel/internal/ui/console"
 	"github.com/caos/zitadel/internal/ui/login"
+	"github.com/caos/zitadel/internal/webhook"
 	"github.com/caos/zitadel/openapi"
 )
 
@@ -68,6 +74,10 @@ type Config struct {
 	UI  ui.Config
 
 	Notification notification.Config
+	Webhooks     webhook.Config
+	Expiration   expiration.Config
+
+	ActionExecutions execution.Config
 }
 
 type setupConfig struct {
@@ -86,10 +96,14 @@ var (
 	managementEnabled   = flag.Bool("management", true, "enable management api")
 	authEnabled         = flag.Bool("auth", true, "enable auth api")
 	oidcEnabled         = flag.Bool("oidc", true, "enable oidc api")
+	samlEnabled         = flag.Bool("saml", true, "enable saml api")
 	assetsEnabled       = flag.Bool("assets", true, "enable assets api")
+	scimEnabled         = flag.Bool("scim", true, "enable scim api")
 	loginEnabled        = flag.Bool("login", true, "enable login ui")
 	consoleEnabled      = flag.Bool("console", true, "enable console ui")
 	notificationEnabled = flag.Bool("notification", true, "enable notification handler")
+	webhooksEnabled     = flag.Bool("webhooks", true, "enable webhook delivery")
+	expirationEnabled   = flag.Bool("expiration", true, "enable expiration of user grants and memberships and password expiry notifications")
 	localDevMode        = flag.Bool("localDevMode", false, "enable local development specific configs")
 )
 
@@ -159,6 +173,14 @@ func startZitadel(configPaths []string) {
 	queries, err := query.StartQueries(ctx, esQueries, conf.Projections, conf.SystemDefaults, keyChan, conf.InternalAuthZ.RolePermissionMappings)
 	logging.Log("MAIN-WpeJY").OnError(err).Fatal("cannot start queries")
 
+	executions, err := execution.Start(conf.EventstoreBase, conf.ActionExecutions)
+	logging.Log("MAIN-Ax7st").OnError(err).Fatal("cannot start action executions store")
+	actionsDeps := actions.Dependencies{
+		HTTPConfig: queries,
+		Libraries:  queries,
+		Recorder:   executions,
+	}
+
 	authZRepo, err := authz.Start(conf.AuthZ, conf.SystemDefaults, queries)
 	logging.Log("MAIN-s9KOw").OnError(err).Fatal("error starting authz repo")
 
@@ -168,13 +190,13 @@ func startZitadel(configPaths []string) {
 	store, err := conf.AssetStorage.Config.NewStorage()
 	logging.Log("ZITAD-Bfhe2").OnError(err).Fatal("Unable to start asset storage")
 
-	commands, err := command.StartCommands(esCommands, conf.SystemDefaults, conf.InternalAuthZ, store, authZRepo)
+	commands, err := command.StartCommands(esCommands, conf.SystemDefaults, conf.InternalAuthZ, store, authZRepo, queries, actionsDeps)
 	if err != nil {
 		logging.Log("ZITAD-bmNiJ").OnError(err).Fatal("cannot start commands")
 	}
 
 	var authRepo *auth_es.EsRepository
-	if *authEnabled || *oidcEnabled || *loginEnabled {
+	if *authEnabled || *oidcEnabled || *samlEnabled || *loginEnabled {
 		authRepo, err = auth_es.Start(conf.Auth, conf.SystemDefaults, commands, queries)
 		logging.Log("MAIN-9oRw6").OnError(err).Fatal("error starting auth repo")
 	}
@@ -188,21 +210,32 @@ func startZitadel(configPaths []string) {
 	}
 
 	verifier := internal_authz.Start(&repo)
-	startAPI(ctx, conf, verifier, authZRepo, authRepo, commands, queries, store, esQueries, conf.Projections.CRDB, keyChan)
-	startUI(ctx, conf, authRepo, commands, queries, store)
+	startAPI(ctx, conf, verifier, authZRepo, authRepo, commands, queries, store, esQueries, conf.Projections.CRDB, keyChan, actionsDeps)
+	startUI(ctx, conf, authRepo, commands, queries, store, actionsDeps)
 
 	if *notificationEnabled {
 		notification.Start(ctx, conf.Notification, conf.SystemDefaults, commands, queries, store != nil)
 	}
 
+	if *webhooksEnabled {
+		err = webhook.Start(ctx, conf.Webhooks, conf.Projections, conf.SystemDefaults, esQueries, queries)
+		logging.Log("MAIN-Wq8sn").OnError(err).Fatal("cannot start webhook delivery")
+	}
+
+	if *expirationEnabled {
+		expirationClient, err := conf.Projections.CRDB.Start()
+		logging.Log("MAIN-Ex8lc").OnError(err).Fatal("cannot start client for expiration lock")
+		expiration.Start(ctx, conf.Expiration, expirationClient, commands, queries, executions)
+	}
+
 	<-ctx.Done()
 	logging.Log("MAIN-s8d2h").Info("stopping zitadel")
 }
 
-func startUI(ctx context.Context, conf *Config, authRepo *auth_es.EsRepository, command *command.Commands, query *query.Queries, staticStorage static.Storage) {
+func startUI(ctx context.Context, conf *Config, authRepo *auth_es.EsRepository, command *command.Commands, query *query.Queries, staticStorage static.Storage, actionsDeps actions.Dependencies) {
 	uis := ui.Create(conf.UI)
 	if *loginEnabled {
-		login, prefix := login.Start(conf.UI.Login, command, query, authRepo, staticStorage, conf.SystemDefaults, *localDevMode)
+		login, prefix := login.Start(conf.UI.Login, command, query, authRepo, staticStorage, conf.SystemDefaults, actionsDeps, *localDevMode)
 		uis.RegisterHandler(prefix, login.Handler())
 	}
 	if *consoleEnabled {
@@ -213,7 +246,7 @@ func startUI(ctx context.Context, conf *Config, authRepo *auth_es.EsRepository,
 	uis.Start(ctx)
 }
 
-func startAPI(ctx context.Context, conf *Config, verifier *internal_authz.TokenVerifier, authZRepo authz_repo.Repository, authRepo *auth_es.EsRepository, command *command.Commands, query *query.Queries, static static.Storage, es *eventstore.Eventstore, projections types.SQL, keyChan <-chan interface{}) {
+func startAPI(ctx context.Context, conf *Config, verifier *internal_authz.TokenVerifier, authZRepo authz_repo.Repository, authRepo *auth_es.EsRepository, command *command.Commands, query *query.Queries, static static.Storage, es *eventstore.Eventstore, projections types.SQL, keyChan <-chan interface{}, actionsDeps actions.Dependencies) {
 	repo, err := admin_es.Start(ctx, conf.Admin, conf.SystemDefaults, command, static, *localDevMode)
 	logging.Log("API-D42tq").OnError(err).Fatal("error starting auth repo")
 
@@ -229,13 +262,20 @@ func startAPI(ctx context.Context, conf *Config, verifier *internal_authz.TokenV
 		apis.RegisterServer(ctx, auth.CreateServer(command, query, authRepo, conf.SystemDefaults, conf.API.Domain+"/assets/v1/"))
 	}
 	if *oidcEnabled {
-		op := oidc.NewProvider(ctx, conf.API.OIDC, command, query, authRepo, conf.SystemDefaults.KeyConfig, *localDevMode, es, projections, keyChan, conf.API.Domain+"/assets/v1/")
+		op := oidc.NewProvider(ctx, conf.API.OIDC, command, query, authRepo, conf.SystemDefaults.KeyConfig, *localDevMode, es, projections, keyChan, conf.API.Domain+"/assets/v1/", actionsDeps)
 		apis.RegisterHandler("/oauth/v2", op.HttpHandler())
 	}
+	if *samlEnabled {
+		idp := saml.NewProvider(ctx, conf.API.SAML, query, authRepo, conf.SystemDefaults.KeyConfig, *localDevMode)
+		apis.RegisterHandler("/saml/v2", idp.HttpHandler())
+	}
 	if *assetsEnabled {
 		assetsHandler := assets.NewHandler(command, verifier, conf.InternalAuthZ, id.SonyFlakeGenerator, static, query)
 		apis.RegisterHandler("/assets/v1", assetsHandler)
 	}
+	if *scimEnabled {
+		apis.RegisterHandler(scim.HandlerPrefix, scim.NewHandler(command, query, verifier, conf.InternalAuthZ, conf.API.Domain+scim.HandlerPrefix))
+	}
 
 	openAPIHandler, err := openapi.Start()
 	logging.Log("ZITAD-8pRk1").OnError(err).Fatal("Unable to start openapi handler")
@@ -254,7 +294,7 @@ func startSetup(configPaths []string) {
 	es, err := eventstore.Start(conf.Eventstore)
 	logging.Log("MAIN-Ddt3").OnError(err).Fatal("cannot start eventstore")
 
-	commands, err := command.StartCommands(es, conf.SystemDefaults, conf.InternalAuthZ, nil, nil)
+	commands, err := command.StartCommands(es, conf.SystemDefaults, conf.InternalAuthZ, nil, nil, nil, actions.Dependencies{})
 	logging.Log("MAIN-dsjrr").OnError(err).Fatal("cannot start command side")
 
 	err = setup.Execute(ctx, conf.SetUp, conf.SystemDefaults.IamID, commands)
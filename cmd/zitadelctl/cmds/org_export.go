@@ -0,0 +1,136 @@
+package cmds
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+type adminAPIFlags struct {
+	address  string
+	token    string
+	insecure bool
+}
+
+func (f *adminAPIFlags) register(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVar(&f.address, "address", "api.zitadel.ch:443", "Address of the ZITADEL API")
+	flags.StringVar(&f.token, "token", "", "Access token of a user with the IAM_OWNER role")
+	flags.BoolVar(&f.insecure, "insecure", false, "Connect to the API without TLS")
+}
+
+func (f *adminAPIFlags) client(ctx context.Context) (admin.AdminServiceClient, context.Context, func() error, error) {
+	transport := grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	if f.insecure {
+		transport = grpc.WithInsecure()
+	}
+	conn, err := grpc.DialContext(ctx, f.address, transport)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+f.token)
+	return admin.NewAdminServiceClient(conn), ctx, conn.Close, nil
+}
+
+func ExportOrgCommand(getRv GetRootValues) *cobra.Command {
+	var (
+		orgID string
+		file  string
+		api   = new(adminAPIFlags)
+		cmd   = &cobra.Command{
+			Use:     "export-org",
+			Short:   "Export an organisation as json",
+			Long:    "Export an organisation with its policies, users, projects, grants and actions as json.\nSecrets of identity providers and applications are not exported",
+			Example: `zitadelctl export-org --address api.zitadel.ch:443 --token $TOKEN --org 69629023906488334 --file org.json`,
+		}
+	)
+
+	api.register(cmd)
+	flags := cmd.Flags()
+	flags.StringVar(&orgID, "org", "", "ID of the organisation to export")
+	flags.StringVar(&file, "file", "", "Path to the file the export is written to, if empty the export is written to stdout")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
+		rv := getRv("export-org", map[string]interface{}{"org": orgID}, "")
+		defer func() {
+			err = rv.ErrFunc(err)
+		}()
+
+		client, ctx, closeConn, err := api.client(rv.Ctx)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		resp, err := client.ExportOrg(ctx, &admin.ExportOrgRequest{OrgId: orgID})
+		if err != nil {
+			return err
+		}
+		if file == "" {
+			_, err = os.Stdout.Write([]byte(resp.Data))
+			return err
+		}
+		return ioutil.WriteFile(file, []byte(resp.Data), 0600)
+	}
+	return cmd
+}
+
+func ImportOrgsCommand(getRv GetRootValues) *cobra.Command {
+	var (
+		file string
+		api  = new(adminAPIFlags)
+		cmd  = &cobra.Command{
+			Use:     "import-orgs",
+			Short:   "Import organisations from an export",
+			Long:    "Import organisations from an export created with export-org.\nObjects which could not be imported and new client secrets of confidential applications are printed to stdout",
+			Example: `zitadelctl import-orgs --address api.zitadel.ch:443 --token $TOKEN --file org.json`,
+		}
+	)
+
+	api.register(cmd)
+	flags := cmd.Flags()
+	flags.StringVar(&file, "file", "", "Path to the file containing the export")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) (err error) {
+		rv := getRv("import-orgs", map[string]interface{}{"file": file}, "")
+		defer func() {
+			err = rv.ErrFunc(err)
+		}()
+
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		client, ctx, closeConn, err := api.client(rv.Ctx)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+
+		resp, err := client.ImportOrgs(ctx, &admin.ImportOrgsRequest{Data: string(data)})
+		if err != nil {
+			return err
+		}
+		for _, result := range resp.Results {
+			fmt.Printf("org %s imported\n", result.OrgId)
+			for _, importErr := range result.Errors {
+				fmt.Printf("  failed to import %s %s: %s\n", importErr.Type, importErr.Id, importErr.Message)
+			}
+			for _, secret := range result.ClientSecrets {
+				fmt.Printf("  new secret of app %s (client id %s): %s\n", secret.AppId, secret.ClientId, secret.ClientSecret)
+			}
+		}
+		return nil
+	}
+	return cmd
+}
@@ -39,6 +39,8 @@ func main() {
 		cmds.StartDatabase(rootValues),
 		cmds.ConfigCommand(rootValues, githubClientID, githubClientSecret),
 		cmds.TeardownCommand(rootValues),
+		cmds.ExportOrgCommand(rootValues),
+		cmds.ImportOrgsCommand(rootValues),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -111,7 +111,12 @@ export class MfaTableComponent implements OnInit {
     if (this.componentType === LoginMethodComponentType.MultiFactor) {
       selection = [MultiFactorType.MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION];
     } else if (this.componentType === LoginMethodComponentType.SecondFactor) {
-      selection = [SecondFactorType.SECOND_FACTOR_TYPE_U2F, SecondFactorType.SECOND_FACTOR_TYPE_OTP];
+      selection = [
+        SecondFactorType.SECOND_FACTOR_TYPE_U2F,
+        SecondFactorType.SECOND_FACTOR_TYPE_OTP,
+        SecondFactorType.SECOND_FACTOR_TYPE_OTP_EMAIL,
+        SecondFactorType.SECOND_FACTOR_TYPE_OTP_SMS,
+      ];
     }
 
     this.mfas.forEach(mfa => {
@@ -23,7 +23,13 @@ export class ActionsComponent {
 
   public typeControl: FormControl = new FormControl(FlowType.FLOW_TYPE_EXTERNAL_AUTHENTICATION);
 
-  public typesForSelection: FlowType[] = [FlowType.FLOW_TYPE_EXTERNAL_AUTHENTICATION];
+  public typesForSelection: FlowType[] = [
+    FlowType.FLOW_TYPE_EXTERNAL_AUTHENTICATION,
+    FlowType.FLOW_TYPE_CUSTOMISE_TOKEN,
+    FlowType.FLOW_TYPE_REGISTRATION,
+    FlowType.FLOW_TYPE_USER_CREATION,
+    FlowType.FLOW_TYPE_USER_GRANT,
+  ];
 
   public selection: Action.AsObject[] = [];
   public InfoSectionType: any = InfoSectionType;
@@ -16,11 +16,21 @@ export class AddFlowDialogComponent {
     public actions: Action.AsObject[] = [];
     public typesForSelection: FlowType[] = [
       FlowType.FLOW_TYPE_EXTERNAL_AUTHENTICATION,
+      FlowType.FLOW_TYPE_CUSTOMISE_TOKEN,
+      FlowType.FLOW_TYPE_REGISTRATION,
+      FlowType.FLOW_TYPE_USER_CREATION,
+      FlowType.FLOW_TYPE_USER_GRANT,
     ];
     public triggerTypesForSelection: TriggerType[] = [
       TriggerType.TRIGGER_TYPE_POST_AUTHENTICATION,
       TriggerType.TRIGGER_TYPE_POST_CREATION,
-      TriggerType.TRIGGER_TYPE_PRE_CREATION,      
+      TriggerType.TRIGGER_TYPE_PRE_CREATION,
+      TriggerType.TRIGGER_TYPE_PRE_USERINFO_CREATION,
+      TriggerType.TRIGGER_TYPE_PRE_ACCESS_TOKEN_CREATION,
+      TriggerType.TRIGGER_TYPE_PRE_CHANGE,
+      TriggerType.TRIGGER_TYPE_POST_CHANGE,
+      TriggerType.TRIGGER_TYPE_PRE_REMOVAL,
+      TriggerType.TRIGGER_TYPE_POST_REMOVAL,
     ];
     
     public form!: FormGroup;
@@ -44,6 +44,7 @@ module.exports = {
         "guides/basics/get-started",
         "guides/basics/organizations",
         "guides/basics/projects",
+        "guides/basics/export-import",
       ],
     },
     {
@@ -90,7 +91,11 @@ module.exports = {
       type: "category",
       label: "Customization",
       collapsed: false,
-      items: ["guides/customization/branding", "guides/customization/texts"],
+      items: [
+        "guides/customization/branding",
+        "guides/customization/texts",
+        "guides/customization/webhooks",
+      ],
     },
 
     {
@@ -170,6 +175,7 @@ module.exports = {
             "apis/proto/policy",
             "apis/proto/auth_n_key",
             "apis/proto/change",
+            "apis/proto/event",
             "apis/proto/idp",
             "apis/proto/member",
             "apis/proto/metadata",
@@ -177,6 +183,8 @@ module.exports = {
             "apis/proto/text",
             "apis/proto/object",
             "apis/proto/options",
+            "apis/proto/webhook",
+            "apis/proto/settings",
           ],
         },
         {
@@ -185,6 +193,7 @@ module.exports = {
           collapsed: true,
           items: ["apis/assets/assets"],
         },
+        "apis/scim",
       ],
     },
     {
@@ -1,6 +1,7 @@
 package actions
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -14,26 +15,70 @@ var ErrHalt = errors.New("interrupt")
 
 type jsAction func(*Context, *API) error
 
+const (
+	maxLogLines = 100
+	//maxLogLineLength is the maximum count of characters of a captured log line
+	maxLogLineLength = 1000
+)
+
 func Run(ctx *Context, api *API, script, name string, timeout time.Duration, allowedToFail bool) error {
-	if timeout <= 0 || timeout > 20 {
+	_, err := run(ctx, api, script, name, timeout, allowedToFail, nil, nil)
+	return err
+}
+
+type runResult struct {
+	//logs is the captured console output
+	logs []string
+	//err is the error of the script, even if the action is allowed to fail
+	err      error
+	timedOut bool
+}
+
+func (r *runResult) print(s string) {
+	logging.Log("ACTIONS-dfgg2").Debug(s)
+	if len(r.logs) < maxLogLines {
+		r.logs = append(r.logs, truncateLogLine(s))
+	}
+}
+
+//truncateLogLine cuts the line after maxLogLineLength characters
+func truncateLogLine(s string) string {
+	if len(s) <= maxLogLineLength {
+		return s
+	}
+	runes := []rune(s)
+	if len(runes) <= maxLogLineLength {
+		return s
+	}
+	return string(runes[:maxLogLineLength]) + "…"
+}
+
+func run(ctx *Context, api *API, script, name string, timeout time.Duration, allowedToFail bool, libraries Libraries, httpConfig HTTPConfigProvider) (*runResult, error) {
+	if timeout <= 0 || timeout > 20*time.Second {
 		timeout = 20 * time.Second
 	}
 	prepareTimeout := timeout
-	if prepareTimeout > 5 {
+	if prepareTimeout > 5*time.Second {
 		prepareTimeout = 5 * time.Second
 	}
-	vm, err := prepareRun(script, prepareTimeout)
+	runCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result := new(runResult)
+	vm, err := prepareRun(runCtx, script, prepareTimeout, result.print, libraries, httpConfig)
 	if err != nil {
-		return err
+		result.err = err
+		return result, err
 	}
 	var fn jsAction
 	jsFn := vm.Get(name)
 	if jsFn == nil {
-		return errors.New("function not found")
+		result.err = errors.New("function not found")
+		return result, result.err
 	}
 	err = vm.ExportTo(jsFn, &fn)
 	if err != nil {
-		return err
+		result.err = err
+		return result, err
 	}
 	t := setInterrupt(vm, timeout)
 	defer func() {
@@ -43,7 +88,7 @@ func Run(ctx *Context, api *API, script, name string, timeout time.Duration, all
 	go func() {
 		defer func() {
 			r := recover()
-			if r != nil && !allowedToFail {
+			if r != nil {
 				err, ok := r.(error)
 				if !ok {
 					e, ok := r.(string)
@@ -55,32 +100,35 @@ func Run(ctx *Context, api *API, script, name string, timeout time.Duration, all
 				return
 			}
 		}()
-		err = fn(ctx, api)
-		if err != nil && !allowedToFail {
-			errCh <- err
-			return
-		}
-		errCh <- nil
+		errCh <- fn(ctx, api)
 	}()
-	return <-errCh
+	result.err = <-errCh
+	var interrupted *goja.InterruptedError
+	result.timedOut = errors.As(result.err, &interrupted) || errors.Is(runCtx.Err(), context.DeadlineExceeded)
+	if allowedToFail {
+		return result, nil
+	}
+	return result, result.err
 }
 
-func newRuntime() *goja.Runtime {
+//newRuntime creates the vm with the native modules and the libraries
+//requests of the http module are cancelled as soon as ctx is done
+func newRuntime(ctx context.Context, print func(string), libraries Libraries, httpConfig HTTPConfigProvider) *goja.Runtime {
 	vm := goja.New()
 
-	printer := console.PrinterFunc(func(s string) {
-		logging.Log("ACTIONS-dfgg2").Debug(s)
-	})
+	printer := console.PrinterFunc(print)
 	registry := new(require.Registry)
 	registry.Enable(vm)
 	registry.RegisterNativeModule("console", console.RequireWithPrinter(printer))
+	registry.RegisterNativeModule(httpModuleName, newHTTPModule(ctx, httpConfig).require)
+	libraries.register(registry)
 	console.Enable(vm)
 
 	return vm
 }
 
-func prepareRun(script string, timeout time.Duration) (*goja.Runtime, error) {
-	vm := newRuntime()
+func prepareRun(ctx context.Context, script string, timeout time.Duration, print func(string), libraries Libraries, httpConfig HTTPConfigProvider) (*goja.Runtime, error) {
+	vm := newRuntime(ctx, print, libraries, httpConfig)
 	t := setInterrupt(vm, timeout)
 	defer func() {
 		t.Stop()
@@ -94,7 +142,7 @@ func prepareRun(script string, timeout time.Duration) (*goja.Runtime, error) {
 				return
 			}
 		}()
-		_, err := vm.RunString(script)
+		_, err := vm.RunString(transformImports(script))
 		if err != nil {
 			errCh <- err
 			return
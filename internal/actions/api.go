@@ -103,3 +103,44 @@ func (a *API) SetUserGrants(usergrants *[]UserGrant) *API {
 	a.set("userGrants", usergrants)
 	return a
 }
+
+func (a *API) SetClaims(claims map[string]interface{}) *API {
+	a.set("setClaim", func(key string, value interface{}) {
+		claims[key] = value
+	})
+	a.set("removeClaim", func(key string) {
+		delete(claims, key)
+	})
+	a.set("renameClaim", func(oldKey, newKey string) {
+		value, ok := claims[oldKey]
+		if !ok {
+			return
+		}
+		delete(claims, oldKey)
+		claims[newKey] = value
+	})
+	return a
+}
+
+func (a *API) SetUserGrant(grant *domain.UserGrant) *API {
+	a.set("setRoles", func(roles []string) {
+		grant.RoleKeys = roles
+	})
+	a.set("addRole", func(role string) {
+		for _, key := range grant.RoleKeys {
+			if key == role {
+				return
+			}
+		}
+		grant.RoleKeys = append(grant.RoleKeys, role)
+	})
+	a.set("removeRole", func(role string) {
+		for i, key := range grant.RoleKeys {
+			if key == role {
+				grant.RoleKeys = append(grant.RoleKeys[:i], grant.RoleKeys[i+1:]...)
+				return
+			}
+		}
+	})
+	return a
+}
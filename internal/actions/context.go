@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 
 	"github.com/caos/oidc/pkg/oidc"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
 )
 
 type Context map[string]interface{}
@@ -34,3 +37,43 @@ func (c *Context) SetToken(t *oidc.Tokens) *Context {
 	}
 	return c
 }
+
+func (c *Context) SetClaims(claims map[string]interface{}) *Context {
+	c.set("getClaim", func(claim string) interface{} { return claims[claim] })
+	c.set("claimsJSON", func() (string, error) {
+		c, err := json.Marshal(claims)
+		if err != nil {
+			return "", err
+		}
+		return string(c), nil
+	})
+	return c
+}
+
+func (c *Context) SetUser(user *query.User) *Context {
+	c.set("user", user)
+	return c
+}
+
+func (c *Context) SetUserGrants(grants []*query.UserGrant) *Context {
+	c.set("userGrants", grants)
+	return c
+}
+
+func (c *Context) SetUserMetadata(metadata []*query.UserMetadata) *Context {
+	c.set("metadata", metadata)
+	return c
+}
+
+//SetHuman provides the human without the password
+func (c *Context) SetHuman(human *domain.Human) *Context {
+	h := *human
+	h.Password = nil
+	c.set("human", &h)
+	return c
+}
+
+func (c *Context) SetUserGrant(grant *domain.UserGrant) *Context {
+	c.set("userGrant", grant)
+	return c
+}
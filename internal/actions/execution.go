@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/caos/logging"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/telemetry/metrics"
+)
+
+const (
+	ExecutionCounter                    = "zitadel.action_executions"
+	ExecutionCounterDescription         = "Executed actions"
+	ExecutionDurationCounter            = "zitadel.action_execution_duration_milliseconds"
+	ExecutionDurationCounterDescription = "Duration of the executed actions in milliseconds"
+	FlowTypeLabel                       = "flow_type"
+	TriggerTypeLabel                    = "trigger_type"
+	OutcomeLabel                        = "outcome"
+)
+
+type ExecutionRecorder interface {
+	RecordActionExecution(ctx context.Context, execution *domain.ActionExecution) error
+}
+
+//Dependencies are the services RunAction loads the IAM wide configuration and the libraries from
+//and records the executions with
+type Dependencies struct {
+	HTTPConfig HTTPConfigProvider
+	Libraries  LibraryProvider
+	Recorder   ExecutionRecorder
+}
+
+//RunAction runs the action on the trigger of the flow
+//the execution is recorded, even if the action is allowed to fail
+func RunAction(ctx context.Context, deps Dependencies, actionCtx *Context, api *API, action *query.Action, flowType domain.FlowType, triggerType domain.TriggerType) error {
+	start := time.Now()
+	result, err := runWithLibraries(ctx, deps, actionCtx, api, action)
+	execution := &domain.ActionExecution{
+		ActionID:      action.ID,
+		ActionName:    action.Name,
+		ResourceOwner: action.ResourceOwner,
+		FlowType:      flowType,
+		TriggerType:   triggerType,
+		CreationDate:  start,
+		Duration:      time.Since(start),
+		Outcome:       executionOutcome(result),
+		Logs:          result.logs,
+	}
+	if result.err != nil {
+		execution.Error = truncateLogLine(result.err.Error())
+	}
+	recordExecution(ctx, deps.Recorder, execution)
+	return err
+}
+
+func runWithLibraries(ctx context.Context, deps Dependencies, actionCtx *Context, api *API, action *query.Action) (*runResult, error) {
+	libraries, err := resolveProvidedLibraries(ctx, deps.Libraries, action.Script, action.ResourceOwner)
+	if err != nil {
+		result := &runResult{err: err}
+		if action.AllowedToFail {
+			return result, nil
+		}
+		return result, err
+	}
+	return run(actionCtx, api, action.Script, action.Name, action.Timeout, action.AllowedToFail, libraries, deps.HTTPConfig)
+}
+
+//Test runs the script without recording the execution
+//the returned execution contains the outcome and the captured console output
+func Test(actionCtx *Context, api *API, script, name string, timeout time.Duration, libraries Libraries, httpConfig HTTPConfigProvider) (*domain.ActionExecution, error) {
+	start := time.Now()
+	result, err := run(actionCtx, api, script, name, timeout, false, libraries, httpConfig)
+	execution := &domain.ActionExecution{
+		ActionName:   name,
+		CreationDate: start,
+		Duration:     time.Since(start),
+		Outcome:      executionOutcome(result),
+		Logs:         result.logs,
+	}
+	if err != nil {
+		execution.Error = truncateLogLine(err.Error())
+	}
+	return execution, err
+}
+
+func executionOutcome(result *runResult) domain.ActionExecutionOutcome {
+	switch {
+	case result.timedOut:
+		return domain.ActionExecutionOutcomeTimedOut
+	case result.err != nil:
+		return domain.ActionExecutionOutcomeFailed
+	default:
+		return domain.ActionExecutionOutcomeSucceeded
+	}
+}
+
+func recordExecution(ctx context.Context, recorder ExecutionRecorder, execution *domain.ActionExecution) {
+	labels := map[string]attribute.Value{
+		FlowTypeLabel:    attribute.IntValue(int(execution.FlowType)),
+		TriggerTypeLabel: attribute.IntValue(int(execution.TriggerType)),
+		OutcomeLabel:     attribute.IntValue(int(execution.Outcome)),
+	}
+	metrics.RegisterCounter(ExecutionCounter, ExecutionCounterDescription)
+	metrics.AddCount(ctx, ExecutionCounter, 1, labels)
+	metrics.RegisterCounter(ExecutionDurationCounter, ExecutionDurationCounterDescription)
+	metrics.AddCount(ctx, ExecutionDurationCounter, execution.Duration.Milliseconds(), labels)
+
+	if recorder == nil {
+		return
+	}
+	err := recorder.RecordActionExecution(ctx, execution)
+	logging.LogWithFields("ACTIONS-Ex3rc", "actionID", execution.ActionID).OnError(err).Warn("unable to record action execution")
+}
@@ -0,0 +1,246 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type mockExecutionRecorder struct {
+	executions []*domain.ActionExecution
+	err        error
+}
+
+func (m *mockExecutionRecorder) RecordActionExecution(_ context.Context, execution *domain.ActionExecution) error {
+	m.executions = append(m.executions, execution)
+	return m.err
+}
+
+type mockLibraryProvider map[string]string
+
+func (m mockLibraryProvider) ActionLibraryScript(_ context.Context, _ string, requirement domain.ActionLibraryRequirement) (string, error) {
+	script, ok := m[requirement.Module()]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return script, nil
+}
+
+func TestTest(t *testing.T) {
+	type args struct {
+		script  string
+		timeout time.Duration
+	}
+	type res struct {
+		outcome domain.ActionExecutionOutcome
+		logs    []string
+		wantErr bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "succeeded, logs captured",
+			args: args{
+				script: `function action(ctx, api) {
+	console.log("hello");
+	console.log(ctx.getClaim("email"));
+}`,
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+				logs:    []string{"hello", "gigi@zitadel.ch"},
+			},
+		},
+		{
+			name: "script throws, failed",
+			args: args{
+				script: `function action(ctx, api) {
+	console.log("before");
+	throw "failed";
+}`,
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeFailed,
+				logs:    []string{"before"},
+				wantErr: true,
+			},
+		},
+		{
+			name: "long log line, truncated",
+			args: args{
+				script: `function action(ctx, api) {
+	console.log("ä".repeat(1500));
+}`,
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+				logs:    []string{strings.Repeat("ä", maxLogLineLength) + "…"},
+			},
+		},
+		{
+			name: "function not found, failed",
+			args: args{
+				script: `function other(ctx, api) {}`,
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeFailed,
+				wantErr: true,
+			},
+		},
+		{
+			name: "endless loop, timed out",
+			args: args{
+				script: `function action(ctx, api) {
+	while (true) {}
+}`,
+				timeout: 100 * time.Millisecond,
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeTimedOut,
+				wantErr: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := map[string]interface{}{"email": "gigi@zitadel.ch"}
+			execution, err := Test((&Context{}).SetClaims(claims), (&API{}).SetClaims(claims), tt.args.script, "action", tt.args.timeout, nil, nil)
+			if tt.res.wantErr {
+				assert.Error(t, err)
+				assert.NotEmpty(t, execution.Error)
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, execution.Error)
+			}
+			assert.Equal(t, tt.res.outcome, execution.Outcome)
+			assert.Equal(t, tt.res.logs, execution.Logs)
+		})
+	}
+}
+
+func TestRunAction_recordsExecution(t *testing.T) {
+	type args struct {
+		action *query.Action
+	}
+	type res struct {
+		outcome domain.ActionExecutionOutcome
+		wantErr bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "succeeded",
+			args: args{
+				action: &query.Action{
+					ID:            "action1",
+					ResourceOwner: "org1",
+					Name:          "action",
+					Script:        `function action(ctx, api) { console.log("ok"); }`,
+					Timeout:       time.Second,
+				},
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+			},
+		},
+		{
+			name: "failed",
+			args: args{
+				action: &query.Action{
+					ID:            "action1",
+					ResourceOwner: "org1",
+					Name:          "action",
+					Script:        `function action(ctx, api) { throw "failed"; }`,
+					Timeout:       time.Second,
+				},
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeFailed,
+				wantErr: true,
+			},
+		},
+		{
+			name: "library imported, recorded as succeeded",
+			args: args{
+				action: &query.Action{
+					ID:            "action1",
+					ResourceOwner: "org1",
+					Name:          "action",
+					Script:        `import {check} from "zitadel/libraries/helpers"; function action(ctx, api) { check(); }`,
+					Timeout:       time.Second,
+				},
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeSucceeded,
+			},
+		},
+		{
+			name: "library not found, recorded as failed",
+			args: args{
+				action: &query.Action{
+					ID:            "action1",
+					ResourceOwner: "org1",
+					Name:          "action",
+					Script:        `import {check} from "zitadel/libraries/unknown"; function action(ctx, api) { check(); }`,
+					Timeout:       time.Second,
+				},
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeFailed,
+				wantErr: true,
+			},
+		},
+		{
+			name: "failed but allowed to fail, recorded as failed",
+			args: args{
+				action: &query.Action{
+					ID:            "action1",
+					ResourceOwner: "org1",
+					Name:          "action",
+					Script:        `function action(ctx, api) { throw "failed"; }`,
+					Timeout:       time.Second,
+					AllowedToFail: true,
+				},
+			},
+			res: res{
+				outcome: domain.ActionExecutionOutcomeFailed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &mockExecutionRecorder{err: errors.New("unavailable")}
+			libraries := mockLibraryProvider{
+				"zitadel/libraries/helpers": `exports.check = function() {}`,
+			}
+
+			err := RunAction(context.Background(), Dependencies{Libraries: libraries, Recorder: recorder}, &Context{}, &API{}, tt.args.action, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostAuthentication)
+			if tt.res.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if assert.Len(t, recorder.executions, 1) {
+				execution := recorder.executions[0]
+				assert.Equal(t, "action1", execution.ActionID)
+				assert.Equal(t, "org1", execution.ResourceOwner)
+				assert.Equal(t, domain.FlowTypeExternalAuthentication, execution.FlowType)
+				assert.Equal(t, domain.TriggerTypePostAuthentication, execution.TriggerType)
+				assert.Equal(t, tt.res.outcome, execution.Outcome)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dop251/goja"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+)
+
+const (
+	httpModuleName             = "zitadel/http"
+	defaultHTTPMaxResponseSize = 1 << 20
+)
+
+var (
+	ErrHostNotAllowed       = errors.New("host is not allowed")
+	ErrResponseSizeExceeded = errors.New("response size exceeded")
+)
+
+type HTTPConfig struct {
+	//AllowList of the hosts the actions are allowed to call
+	//an entry starting with `*.` allows all subdomains, no entry denies all requests
+	AllowList []string
+	//MaxResponseSize in bytes, defaults to 1MB
+	MaxResponseSize int64
+}
+
+//HTTPConfigProvider returns the IAM wide configuration of the http module
+type HTTPConfigProvider interface {
+	ActionsHTTPConfig(ctx context.Context) (*query.ActionsHTTPConfig, error)
+}
+
+//loadHTTPConfig loads the configuration from the provider
+//all requests are denied if no provider is passed or no configuration is set
+func loadHTTPConfig(ctx context.Context, provider HTTPConfigProvider) (HTTPConfig, error) {
+	if provider == nil {
+		return HTTPConfig{}, nil
+	}
+	config, err := provider.ActionsHTTPConfig(ctx)
+	if caos_errs.IsNotFound(err) {
+		return HTTPConfig{}, nil
+	}
+	if err != nil {
+		return HTTPConfig{}, err
+	}
+	return HTTPConfig{
+		AllowList:       config.AllowList,
+		MaxResponseSize: config.MaxResponseSize,
+	}, nil
+}
+
+func (c HTTPConfig) isAllowed(host string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range c.AllowList {
+		allowed = strings.ToLower(allowed)
+		if strings.HasPrefix(allowed, "*.") {
+			if strings.HasSuffix(host, allowed[1:]) {
+				return true
+			}
+			continue
+		}
+		if host == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (c HTTPConfig) maxResponseSize() int64 {
+	if c.MaxResponseSize <= 0 {
+		return defaultHTTPMaxResponseSize
+	}
+	return c.MaxResponseSize
+}
+
+type httpModule struct {
+	ctx      context.Context
+	provider HTTPConfigProvider
+	config   HTTPConfig
+	client   *http.Client
+}
+
+//newHTTPModule creates the `zitadel/http` module
+//the configuration is loaded as soon as the module is required
+//all requests are cancelled as soon as ctx is done
+func newHTTPModule(ctx context.Context, provider HTTPConfigProvider) *httpModule {
+	m := &httpModule{
+		ctx:      ctx,
+		provider: provider,
+	}
+	m.client = &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if !m.config.isAllowed(req.URL.Hostname()) {
+				return ErrHostNotAllowed
+			}
+			return nil
+		},
+	}
+	return m
+}
+
+func (m *httpModule) require(vm *goja.Runtime, module *goja.Object) {
+	config, err := loadHTTPConfig(m.ctx, m.provider)
+	if err != nil {
+		panic(vm.NewGoError(err))
+	}
+	m.config = config
+	exports := module.Get("exports").(*goja.Object)
+	err = exports.Set("fetch", func(call goja.FunctionCall) goja.Value {
+		response, err := m.fetch(call.Argument(0).String(), call.Argument(1).Export())
+		if err != nil {
+			panic(vm.NewGoError(err))
+		}
+		return response.toValue(vm)
+	})
+	if err != nil {
+		panic(vm.NewGoError(err))
+	}
+}
+
+type httpResponse struct {
+	status  int
+	headers map[string]string
+	body    []byte
+}
+
+func (r *httpResponse) toValue(vm *goja.Runtime) goja.Value {
+	response := vm.NewObject()
+	_ = response.Set("status", r.status)
+	_ = response.Set("headers", r.headers)
+	_ = response.Set("body", string(r.body))
+	_ = response.Set("text", func() string {
+		return string(r.body)
+	})
+	_ = response.Set("json", func() (interface{}, error) {
+		var body interface{}
+		err := json.Unmarshal(r.body, &body)
+		return body, err
+	})
+	return response
+}
+
+//fetch executes the request described by the fetch-style options
+//(`method`, `headers` and `body`, where objects are sent as json)
+func (m *httpModule) fetch(rawURL string, options interface{}) (*httpResponse, error) {
+	req, err := m.newRequest(rawURL, options)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	maxSize := m.config.maxResponseSize()
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxSize {
+		return nil, ErrResponseSizeExceeded
+	}
+	headers := make(map[string]string, len(res.Header))
+	for key := range res.Header {
+		headers[key] = res.Header.Get(key)
+	}
+	return &httpResponse{
+		status:  res.StatusCode,
+		headers: headers,
+		body:    body,
+	}, nil
+}
+
+func (m *httpModule) newRequest(rawURL string, options interface{}) (*http.Request, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("scheme %q is not supported", u.Scheme)
+	}
+	if !m.config.isAllowed(u.Hostname()) {
+		return nil, ErrHostNotAllowed
+	}
+	opts, _ := options.(map[string]interface{})
+	method := http.MethodGet
+	if reqMethod, ok := opts["method"].(string); ok && reqMethod != "" {
+		method = strings.ToUpper(reqMethod)
+	}
+	body, isJSON, err := requestBody(opts["body"])
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(m.ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if isJSON {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if headers, ok := opts["headers"].(map[string]interface{}); ok {
+		for key, value := range headers {
+			req.Header.Set(key, fmt.Sprint(value))
+		}
+	}
+	return req, nil
+}
+
+func requestBody(body interface{}) (_ io.Reader, isJSON bool, err error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, false, nil
+	case string:
+		return strings.NewReader(b), false, nil
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, false, err
+		}
+		return bytes.NewReader(data), true, nil
+	}
+}
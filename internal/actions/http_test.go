@@ -0,0 +1,279 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+)
+
+func TestHTTPConfig_isAllowed(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowList []string
+		host      string
+		want      bool
+	}{
+		{
+			name:      "empty allow list, not allowed",
+			allowList: nil,
+			host:      "zitadel.ch",
+			want:      false,
+		},
+		{
+			name:      "exact host, allowed",
+			allowList: []string{"zitadel.ch"},
+			host:      "zitadel.ch",
+			want:      true,
+		},
+		{
+			name:      "exact host case insensitive, allowed",
+			allowList: []string{"Zitadel.ch"},
+			host:      "ZITADEL.ch",
+			want:      true,
+		},
+		{
+			name:      "subdomain without wildcard, not allowed",
+			allowList: []string{"zitadel.ch"},
+			host:      "api.zitadel.ch",
+			want:      false,
+		},
+		{
+			name:      "subdomain with wildcard, allowed",
+			allowList: []string{"*.zitadel.ch"},
+			host:      "api.zitadel.ch",
+			want:      true,
+		},
+		{
+			name:      "suffix of other domain with wildcard, not allowed",
+			allowList: []string{"*.zitadel.ch"},
+			host:      "evilzitadel.ch",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := HTTPConfig{AllowList: tt.allowList}
+			assert.Equal(t, tt.want, config.isAllowed(tt.host))
+		})
+	}
+}
+
+type mockHTTPConfig struct {
+	config *query.ActionsHTTPConfig
+	err    error
+}
+
+func (m *mockHTTPConfig) ActionsHTTPConfig(context.Context) (*query.ActionsHTTPConfig, error) {
+	return m.config, m.err
+}
+
+func Test_loadHTTPConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider HTTPConfigProvider
+		want     HTTPConfig
+		wantErr  bool
+	}{
+		{
+			name:     "no provider, deny all",
+			provider: nil,
+			want:     HTTPConfig{},
+		},
+		{
+			name: "config not set, deny all",
+			provider: &mockHTTPConfig{
+				err: caos_errs.ThrowNotFound(nil, "id", "not found"),
+			},
+			want: HTTPConfig{},
+		},
+		{
+			name: "query failed, error",
+			provider: &mockHTTPConfig{
+				err: caos_errs.ThrowInternal(nil, "id", "internal"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "config set, ok",
+			provider: &mockHTTPConfig{
+				config: &query.ActionsHTTPConfig{
+					AllowList:       []string{"zitadel.ch"},
+					MaxResponseSize: 1024,
+				},
+			},
+			want: HTTPConfig{
+				AllowList:       []string{"zitadel.ch"},
+				MaxResponseSize: 1024,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadHTTPConfig(context.Background(), tt.provider)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRun_httpModule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/echo":
+			body := make(map[string]interface{})
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"method": r.Method,
+				"header": r.Header.Get("X-Test"),
+				"body":   body,
+			})
+		case "/large":
+			_, _ = w.Write([]byte(strings.Repeat("a", 100)))
+		case "/slow":
+			time.Sleep(2 * time.Second)
+		case "/redirect":
+			http.Redirect(w, r, "http://zitadel.ch", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		config  HTTPConfig
+		script  string
+		timeout time.Duration
+	}
+	type res struct {
+		claims  map[string]interface{}
+		wantErr bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "host not allowed, error",
+			args: args{
+				config: HTTPConfig{},
+				script: `function action(ctx, api) {
+	require("zitadel/http").fetch("` + server.URL + `/echo");
+}`,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "unsupported scheme, error",
+			args: args{
+				config: HTTPConfig{AllowList: []string{serverURL.Hostname()}},
+				script: `function action(ctx, api) {
+	require("zitadel/http").fetch("file:///etc/passwd");
+}`,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "response too large, error",
+			args: args{
+				config: HTTPConfig{AllowList: []string{serverURL.Hostname()}, MaxResponseSize: 10},
+				script: `function action(ctx, api) {
+	require("zitadel/http").fetch("` + server.URL + `/large");
+}`,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "redirect to host not allowed, error",
+			args: args{
+				config: HTTPConfig{AllowList: []string{serverURL.Hostname()}},
+				script: `function action(ctx, api) {
+	require("zitadel/http").fetch("` + server.URL + `/redirect");
+}`,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "request exceeds action timeout, error",
+			args: args{
+				config: HTTPConfig{AllowList: []string{serverURL.Hostname()}},
+				script: `function action(ctx, api) {
+	require("zitadel/http").fetch("` + server.URL + `/slow");
+}`,
+				timeout: 200 * time.Millisecond,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "post json, ok",
+			args: args{
+				config: HTTPConfig{AllowList: []string{serverURL.Hostname()}},
+				script: `function action(ctx, api) {
+	let res = require("zitadel/http").fetch("` + server.URL + `/echo", {
+		method: "post",
+		headers: {"X-Test": "header"},
+		body: {"key": "value"},
+	});
+	let body = res.json();
+	api.setClaim("status", res.status);
+	api.setClaim("method", body.method);
+	api.setClaim("header", body.header);
+	api.setClaim("key", body.body.key);
+}`,
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"status": int64(200),
+					"method": "POST",
+					"header": "header",
+					"key":    "value",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := make(map[string]interface{})
+			httpConfig := &mockHTTPConfig{
+				config: &query.ActionsHTTPConfig{
+					AllowList:       tt.args.config.AllowList,
+					MaxResponseSize: tt.args.config.MaxResponseSize,
+				},
+			}
+			_, err := run(&Context{}, (&API{}).SetClaims(claims), tt.args.script, "action", tt.args.timeout, false, nil, httpConfig)
+			if tt.res.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.claims, claims)
+		})
+	}
+}
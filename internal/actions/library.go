@@ -0,0 +1,107 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/require"
+
+	"github.com/caos/zitadel/internal/domain"
+)
+
+const validationTimeout = 5 * time.Second
+
+//Libraries maps the module names (see domain.ActionLibraryRequirement.Module) to the scripts of the libraries
+type Libraries map[string]string
+
+//LibraryProvider returns the script of the required library version of the resource owner
+type LibraryProvider interface {
+	ActionLibraryScript(ctx context.Context, resourceOwner string, requirement domain.ActionLibraryRequirement) (string, error)
+}
+
+//ResolveLibraries loads the libraries required by the script
+//including the libraries required by the loaded libraries
+func ResolveLibraries(script string, lookup func(domain.ActionLibraryRequirement) (string, error)) (Libraries, error) {
+	libraries := make(Libraries)
+	requirements := domain.ActionLibraryRequirements(script)
+	for len(requirements) > 0 {
+		requirement := requirements[0]
+		requirements = requirements[1:]
+		if _, ok := libraries[requirement.Module()]; ok {
+			continue
+		}
+		libraryScript, err := lookup(requirement)
+		if err != nil {
+			return nil, err
+		}
+		libraries[requirement.Module()] = libraryScript
+		requirements = append(requirements, domain.ActionLibraryRequirements(libraryScript)...)
+	}
+	return libraries, nil
+}
+
+func resolveProvidedLibraries(ctx context.Context, provider LibraryProvider, script, resourceOwner string) (Libraries, error) {
+	if provider == nil {
+		return nil, nil
+	}
+	return ResolveLibraries(script, func(requirement domain.ActionLibraryRequirement) (string, error) {
+		return provider.ActionLibraryScript(ctx, resourceOwner, requirement)
+	})
+}
+
+func (l Libraries) register(registry *require.Registry) {
+	for module, script := range l {
+		registry.RegisterNativeModule(module, libraryLoader(module, script))
+	}
+}
+
+//libraryLoader evaluates the library like a CommonJS module
+//the library exports its functions through `module.exports` or `exports`
+//and is allowed to import other modules with ES module imports
+func libraryLoader(module, script string) require.ModuleLoader {
+	return func(vm *goja.Runtime, moduleObj *goja.Object) {
+		program, err := goja.Compile(module, "(function(exports, require, module) {"+transformImports(script)+"\n})", false)
+		if err != nil {
+			panic(vm.NewGoError(fmt.Errorf("library %s: %w", module, err)))
+		}
+		value, err := vm.RunProgram(program)
+		if err != nil {
+			panic(vm.NewGoError(fmt.Errorf("library %s: %w", module, err)))
+		}
+		wrapper, ok := goja.AssertFunction(value)
+		if !ok {
+			panic(vm.NewGoError(fmt.Errorf("library %s: not a function", module)))
+		}
+		exports := moduleObj.Get("exports")
+		_, err = wrapper(exports, exports, vm.Get("require"), moduleObj)
+		if err != nil {
+			panic(vm.NewGoError(fmt.Errorf("library %s: %w", module, err)))
+		}
+	}
+}
+
+//Validate evaluates the script and all the libraries
+//it fails if the script or one of the libraries can't be compiled or throws while loading
+func Validate(script string, libraries Libraries) error {
+	ctx, cancel := context.WithTimeout(context.Background(), validationTimeout)
+	defer cancel()
+	vm, err := prepareRun(ctx, script, validationTimeout, func(string) {}, libraries, nil)
+	if err != nil {
+		return err
+	}
+	requireFn, ok := goja.AssertFunction(vm.Get("require"))
+	if !ok {
+		return errors.New("require not found")
+	}
+	t := setInterrupt(vm, validationTimeout)
+	defer t.Stop()
+	for module := range libraries {
+		if _, err = requireFn(goja.Undefined(), vm.ToValue(module)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
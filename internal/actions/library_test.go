@@ -0,0 +1,232 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+)
+
+func TestResolveLibraries(t *testing.T) {
+	scripts := map[domain.ActionLibraryRequirement]string{
+		{Name: "helpers"}:             `exports.upper = function(s) { return require("zitadel/libraries/strings@1").upper(s); }`,
+		{Name: "strings", Version: 1}: `exports.upper = function(s) { return s.toUpperCase(); }`,
+	}
+	lookup := func(requirement domain.ActionLibraryRequirement) (string, error) {
+		script, ok := scripts[requirement]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		return script, nil
+	}
+	type res struct {
+		libraries Libraries
+		wantErr   bool
+	}
+	tests := []struct {
+		name   string
+		script string
+		res    res
+	}{
+		{
+			name:   "no requirements, empty",
+			script: `function action(ctx, api) {}`,
+			res: res{
+				libraries: Libraries{},
+			},
+		},
+		{
+			name:   "library not found, error",
+			script: `let l = require("zitadel/libraries/unknown");`,
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name:   "transitive requirements, ok",
+			script: `let helpers = require("zitadel/libraries/helpers");`,
+			res: res{
+				libraries: Libraries{
+					"zitadel/libraries/helpers":   scripts[domain.ActionLibraryRequirement{Name: "helpers"}],
+					"zitadel/libraries/strings@1": scripts[domain.ActionLibraryRequirement{Name: "strings", Version: 1}],
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			libraries, err := ResolveLibraries(tt.script, lookup)
+			if tt.res.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.libraries, libraries)
+		})
+	}
+}
+
+func TestRun_libraries(t *testing.T) {
+	type args struct {
+		script    string
+		libraries Libraries
+	}
+	type res struct {
+		claims  map[string]interface{}
+		wantErr bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "library not provided, error",
+			args: args{
+				script: `function action(ctx, api) {
+	require("zitadel/libraries/helpers");
+}`,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "library throws, error",
+			args: args{
+				script: `function action(ctx, api) {
+	require("zitadel/libraries/helpers");
+}`,
+				libraries: Libraries{
+					"zitadel/libraries/helpers": `throw "broken";`,
+				},
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+		{
+			name: "latest and pinned versions, ok",
+			args: args{
+				script: `let helpers = require("zitadel/libraries/helpers");
+function action(ctx, api) {
+	api.setClaim("latest", helpers.version);
+	api.setClaim("pinned", require("zitadel/libraries/helpers@1").version);
+}`,
+				libraries: Libraries{
+					"zitadel/libraries/helpers":   `exports.version = 2;`,
+					"zitadel/libraries/helpers@1": `module.exports = {version: 1};`,
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"latest": int64(2),
+					"pinned": int64(1),
+				},
+			},
+		},
+		{
+			name: "library requires library, ok",
+			args: args{
+				script: `function action(ctx, api) {
+	api.setClaim("name", require("zitadel/libraries/helpers").upper("gigi"));
+}`,
+				libraries: Libraries{
+					"zitadel/libraries/helpers":   `exports.upper = function(s) { return require("zitadel/libraries/strings@1").upper(s); }`,
+					"zitadel/libraries/strings@1": `exports.upper = function(s) { return s.toUpperCase(); }`,
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"name": "GIGI",
+				},
+			},
+		},
+		{
+			name: "es module imports, ok",
+			args: args{
+				script: `import helpers from "zitadel/libraries/helpers";
+import {upper as toUpper} from "zitadel/libraries/strings@1";
+function action(ctx, api) {
+	api.setClaim("name", helpers.upper("gigi"));
+	api.setClaim("direct", toUpper("zitadel"));
+}`,
+				libraries: Libraries{
+					"zitadel/libraries/helpers": `import * as strings from "zitadel/libraries/strings@1";
+exports.upper = function(s) { return strings.upper(s); }`,
+					"zitadel/libraries/strings@1": `exports.upper = function(s) { return s.toUpperCase(); }`,
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"name":   "GIGI",
+					"direct": "ZITADEL",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := make(map[string]interface{})
+			_, err := run(&Context{}, (&API{}).SetClaims(claims), tt.args.script, "action", time.Second, false, tt.args.libraries, nil)
+			if tt.res.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.claims, claims)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	type args struct {
+		script    string
+		libraries Libraries
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			args: args{
+				script: `function action(ctx, api) { require("zitadel/libraries/helpers").upper("a"); }`,
+				libraries: Libraries{
+					"zitadel/libraries/helpers": `exports.upper = function(s) { return s.toUpperCase(); }`,
+				},
+			},
+		},
+		{
+			name: "syntax error in script, error",
+			args: args{
+				script: `function action(ctx, api) {`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "syntax error in library, error",
+			args: args{
+				script: `function action(ctx, api) { require("zitadel/libraries/helpers").upper("a"); }`,
+				libraries: Libraries{
+					"zitadel/libraries/helpers": `exports.upper = function(s) {`,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.args.script, tt.args.libraries)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"regexp"
+	"strings"
+)
+
+//importRegex matches the static ES module imports at the beginning of a line
+//e.g. `import "m"`, `import x from "m"`, `import * as x from "m"`, `import {a, b as c} from "m"` and `import x, {a} from "m"`
+var importRegex = regexp.MustCompile(`(?m)^([ \t]*)import[ \t]+(?:([\w$]+)?[ \t]*,?[ \t]*(\*[ \t]*as[ \t]+[\w$]+|\{[^}]*\})?[ \t]*from[ \t]*)?["']([^"'\n]+)["'][ \t]*;?`)
+
+var (
+	namespaceImportRegex = regexp.MustCompile(`^\*[ \t]*as[ \t]+([\w$]+)$`)
+	namedImportRegex     = regexp.MustCompile(`^([\w$]+)(?:\s+as\s+([\w$]+))?$`)
+)
+
+//transformImports rewrites the static ES module imports of the script to calls of `require`
+//because the runtime only supports CommonJS modules
+//the default import is the `module.exports` of the required module
+//the rewritten script keeps the line numbers of the original script
+func transformImports(script string) string {
+	return importRegex.ReplaceAllStringFunc(script, func(statement string) string {
+		match := importRegex.FindStringSubmatch(statement)
+		transformed, ok := importToRequire(match[2], match[3], match[4])
+		if !ok {
+			return statement
+		}
+		return match[1] + transformed + strings.Repeat("\n", strings.Count(statement, "\n"))
+	})
+}
+
+func importToRequire(defaultBinding, bindings, module string) (string, bool) {
+	required := `require("` + module + `")`
+	if defaultBinding == "" && bindings == "" {
+		return required + ";", true
+	}
+	declarations := make([]string, 0, 2)
+	if defaultBinding != "" {
+		declarations = append(declarations, "const "+defaultBinding+" = "+required+";")
+	}
+	if bindings == "" {
+		return strings.Join(declarations, " "), true
+	}
+	if namespace := namespaceImportRegex.FindStringSubmatch(bindings); namespace != nil {
+		return strings.Join(append(declarations, "const "+namespace[1]+" = "+required+";"), " "), true
+	}
+	names := strings.Split(strings.TrimSpace(bindings[1:len(bindings)-1]), ",")
+	properties := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		named := namedImportRegex.FindStringSubmatch(name)
+		if named == nil {
+			return "", false
+		}
+		if named[2] == "" {
+			properties = append(properties, named[1])
+			continue
+		}
+		properties = append(properties, named[1]+": "+named[2])
+	}
+	return strings.Join(append(declarations, "const {"+strings.Join(properties, ", ")+"} = "+required+";"), " "), true
+}
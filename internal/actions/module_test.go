@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"testing"
+)
+
+func Test_transformImports(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{
+			name:   "no imports, unchanged",
+			script: `let helpers = require("zitadel/libraries/helpers");`,
+			want:   `let helpers = require("zitadel/libraries/helpers");`,
+		},
+		{
+			name:   "side effect import",
+			script: `import "zitadel/libraries/polyfills";`,
+			want:   `require("zitadel/libraries/polyfills");`,
+		},
+		{
+			name:   "default import",
+			script: `import helpers from "zitadel/libraries/helpers"`,
+			want:   `const helpers = require("zitadel/libraries/helpers");`,
+		},
+		{
+			name:   "namespace import",
+			script: `import * as helpers from 'zitadel/libraries/helpers@2';`,
+			want:   `const helpers = require("zitadel/libraries/helpers@2");`,
+		},
+		{
+			name:   "named imports",
+			script: `import {upper, lower as toLower} from "zitadel/libraries/strings";`,
+			want:   `const {upper, lower: toLower} = require("zitadel/libraries/strings");`,
+		},
+		{
+			name:   "default and named imports",
+			script: `import http, {fetch} from "zitadel/http";`,
+			want:   `const http = require("zitadel/http"); const {fetch} = require("zitadel/http");`,
+		},
+		{
+			name: "multiline named imports, lines kept",
+			script: `import {
+	upper,
+	lower,
+} from "zitadel/libraries/strings";
+function action(ctx, api) {}`,
+			want: `const {upper, lower} = require("zitadel/libraries/strings");
+
+
+
+function action(ctx, api) {}`,
+		},
+		{
+			name: "indented imports",
+			script: `	import helpers from "zitadel/libraries/helpers";
+	import {upper} from "zitadel/libraries/strings";`,
+			want: `	const helpers = require("zitadel/libraries/helpers");
+	const {upper} = require("zitadel/libraries/strings");`,
+		},
+		{
+			name:   "import in string, unchanged",
+			script: `let s = 'import x from "y"';`,
+			want:   `let s = 'import x from "y"';`,
+		},
+		{
+			name:   "invalid named import, unchanged",
+			script: `import {a-b} from "zitadel/libraries/strings";`,
+			want:   `import {a-b} from "zitadel/libraries/strings";`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transformImports(tt.script); got != tt.want {
+				t.Errorf("transformImports() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
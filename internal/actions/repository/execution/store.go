@@ -0,0 +1,109 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/config/types"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const (
+	Table            = "zitadel.action_executions"
+	ActionIDCol      = "action_id"
+	ActionNameCol    = "action_name"
+	ResourceOwnerCol = "resource_owner"
+	FlowTypeCol      = "flow_type"
+	TriggerTypeCol   = "trigger_type"
+	CreationDateCol  = "creation_date"
+	DurationCol      = "duration"
+	OutcomeCol       = "outcome"
+	ErrorCol         = "error"
+	LogsCol          = "logs"
+)
+
+type Config struct {
+	Connection types.SQLUser
+	//Retention of the executions, older executions are removed by the expiration job
+	//the executions are kept forever if not set
+	Retention types.Duration
+}
+
+//Store keeps the executions of the actions
+//executions are not event sourced, they are written directly into their table
+type Store struct {
+	client    *sql.DB
+	retention time.Duration
+}
+
+func Start(base types.SQLBase, conf Config) (*Store, error) {
+	client, err := conf.Connection.Start(base)
+	if err != nil {
+		return nil, caos_errs.ThrowPreconditionFailed(err, "EXECS-Ex8db", "unable to open database connection")
+	}
+	return &Store{
+		client:    client,
+		retention: conf.Retention.Duration,
+	}, nil
+}
+
+//RecordActionExecution stores the execution of an action
+func (s *Store) RecordActionExecution(ctx context.Context, execution *domain.ActionExecution) error {
+	stmt, args, err := sq.Insert(Table).
+		Columns(
+			ActionIDCol,
+			ActionNameCol,
+			ResourceOwnerCol,
+			FlowTypeCol,
+			TriggerTypeCol,
+			CreationDateCol,
+			DurationCol,
+			OutcomeCol,
+			ErrorCol,
+			LogsCol,
+		).
+		Values(
+			execution.ActionID,
+			execution.ActionName,
+			execution.ResourceOwner,
+			execution.FlowType,
+			execution.TriggerType,
+			execution.CreationDate,
+			execution.Duration,
+			execution.Outcome,
+			execution.Error,
+			pq.StringArray(execution.Logs),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "EXECS-Ex3ls", "Errors.Query.SQLStatement")
+	}
+	if _, err = s.client.ExecContext(ctx, stmt, args...); err != nil {
+		return caos_errs.ThrowInternal(err, "EXECS-Ex9wq", "Errors.Internal")
+	}
+	return nil
+}
+
+//RemoveExpiredActionExecutions removes the executions which are older than the retention
+func (s *Store) RemoveExpiredActionExecutions(ctx context.Context, now time.Time) error {
+	if s.retention <= 0 {
+		return nil
+	}
+	stmt, args, err := sq.Delete(Table).
+		Where(sq.Lt{CreationDateCol: now.Add(-s.retention)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return caos_errs.ThrowInternal(err, "EXECS-Ex4rs", "Errors.Query.SQLStatement")
+	}
+	if _, err = s.client.ExecContext(ctx, stmt, args...); err != nil {
+		return caos_errs.ThrowInternal(err, "EXECS-Ex6rd", "Errors.Internal")
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+)
+
+func TestStore_RecordActionExecution(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		execution *domain.ActionExecution
+		dbErr     error
+		wantErr   bool
+	}{
+		{
+			name: "recorded",
+			execution: &domain.ActionExecution{
+				ActionID:      "action-id",
+				ActionName:    "action",
+				ResourceOwner: "org-id",
+				FlowType:      domain.FlowTypeExternalAuthentication,
+				TriggerType:   domain.TriggerTypePostAuthentication,
+				CreationDate:  now,
+				Duration:      time.Second,
+				Outcome:       domain.ActionExecutionOutcomeSucceeded,
+				Logs:          []string{"log"},
+			},
+		},
+		{
+			name: "db error",
+			execution: &domain.ActionExecution{
+				ActionID: "action-id",
+			},
+			dbErr:   sql.ErrConnDone,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("unable to create mock db: %v", err)
+			}
+			exec := mock.ExpectExec(regexp.QuoteMeta("INSERT INTO zitadel.action_executions (action_id,action_name,resource_owner,flow_type,trigger_type,creation_date,duration,outcome,error,logs) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)")).
+				WithArgs(
+					tt.execution.ActionID,
+					tt.execution.ActionName,
+					tt.execution.ResourceOwner,
+					tt.execution.FlowType,
+					tt.execution.TriggerType,
+					tt.execution.CreationDate,
+					tt.execution.Duration,
+					tt.execution.Outcome,
+					tt.execution.Error,
+					pq.StringArray(tt.execution.Logs),
+				)
+			if tt.dbErr != nil {
+				exec.WillReturnError(tt.dbErr)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			s := &Store{client: db}
+			err = s.RecordActionExecution(context.Background(), tt.execution)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStore_RemoveExpiredActionExecutions(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		retention  time.Duration
+		expectExec bool
+	}{
+		{
+			name:       "no retention, nothing removed",
+			retention:  0,
+			expectExec: false,
+		},
+		{
+			name:       "retention, expired removed",
+			retention:  24 * time.Hour,
+			expectExec: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("unable to create mock db: %v", err)
+			}
+			if tt.expectExec {
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM zitadel.action_executions WHERE creation_date < $1")).
+					WithArgs(now.Add(-tt.retention)).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			}
+
+			s := &Store{client: db, retention: tt.retention}
+			err = s.RemoveExpiredActionExecutions(context.Background(), now)
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
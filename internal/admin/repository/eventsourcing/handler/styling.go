@@ -129,6 +129,8 @@ func (m *Styling) processLabelPolicy(event *es_models.Event) (err error) {
 			return err
 		}
 		err = m.generateStylingFile(policy)
+	case model.OrgRemoved:
+		return m.view.DeleteStyling(event.AggregateID, event)
 	default:
 		return m.view.ProcessedStylingSequence(event)
 	}
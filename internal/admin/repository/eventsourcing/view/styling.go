@@ -23,6 +23,14 @@ func (v *View) PutStyling(policy *model.LabelPolicyView, event *models.Event) er
 	return v.ProcessedStylingSequence(event)
 }
 
+func (v *View) DeleteStyling(aggregateID string, event *models.Event) error {
+	err := view.DeleteStyling(v.Db, stylingTyble, aggregateID)
+	if err != nil {
+		return err
+	}
+	return v.ProcessedStylingSequence(event)
+}
+
 func (v *View) GetLatestStylingSequence() (*global_view.CurrentSequence, error) {
 	return v.latestSequence(stylingTyble)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/caos/zitadel/internal/api/grpc/server"
 	http_util "github.com/caos/zitadel/internal/api/http"
 	"github.com/caos/zitadel/internal/api/oidc"
+	"github.com/caos/zitadel/internal/api/saml"
 	auth_es "github.com/caos/zitadel/internal/auth/repository/eventsourcing"
 	authz_repo "github.com/caos/zitadel/internal/authz/repository"
 	"github.com/caos/zitadel/internal/config/systemdefaults"
@@ -31,6 +32,7 @@ import (
 type Config struct {
 	GRPC   grpc_util.Config
 	OIDC   oidc.OPHandlerConfig
+	SAML   saml.Config
 	Domain string
 }
 
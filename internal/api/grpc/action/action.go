@@ -6,12 +6,21 @@ import (
 	"github.com/caos/zitadel/internal/query"
 	action_pb "github.com/caos/zitadel/pkg/grpc/action"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func FlowTypeToDomain(flowType action_pb.FlowType) domain.FlowType {
 	switch flowType {
 	case action_pb.FlowType_FLOW_TYPE_EXTERNAL_AUTHENTICATION:
 		return domain.FlowTypeExternalAuthentication
+	case action_pb.FlowType_FLOW_TYPE_CUSTOMISE_TOKEN:
+		return domain.FlowTypeCustomiseToken
+	case action_pb.FlowType_FLOW_TYPE_REGISTRATION:
+		return domain.FlowTypeRegistration
+	case action_pb.FlowType_FLOW_TYPE_USER_CREATION:
+		return domain.FlowTypeUserCreation
+	case action_pb.FlowType_FLOW_TYPE_USER_GRANT:
+		return domain.FlowTypeUserGrant
 	default:
 		return domain.FlowTypeUnspecified
 	}
@@ -25,6 +34,18 @@ func TriggerTypeToDomain(triggerType action_pb.TriggerType) domain.TriggerType {
 		return domain.TriggerTypePreCreation
 	case action_pb.TriggerType_TRIGGER_TYPE_POST_CREATION:
 		return domain.TriggerTypePostCreation
+	case action_pb.TriggerType_TRIGGER_TYPE_PRE_USERINFO_CREATION:
+		return domain.TriggerTypePreUserinfoCreation
+	case action_pb.TriggerType_TRIGGER_TYPE_PRE_ACCESS_TOKEN_CREATION:
+		return domain.TriggerTypePreAccessTokenCreation
+	case action_pb.TriggerType_TRIGGER_TYPE_PRE_CHANGE:
+		return domain.TriggerTypePreChange
+	case action_pb.TriggerType_TRIGGER_TYPE_POST_CHANGE:
+		return domain.TriggerTypePostChange
+	case action_pb.TriggerType_TRIGGER_TYPE_PRE_REMOVAL:
+		return domain.TriggerTypePreRemoval
+	case action_pb.TriggerType_TRIGGER_TYPE_POST_REMOVAL:
+		return domain.TriggerTypePostRemoval
 	default:
 		return domain.TriggerTypeUnspecified
 	}
@@ -50,6 +71,14 @@ func FlowTypeToPb(flowType domain.FlowType) action_pb.FlowType {
 	switch flowType {
 	case domain.FlowTypeExternalAuthentication:
 		return action_pb.FlowType_FLOW_TYPE_EXTERNAL_AUTHENTICATION
+	case domain.FlowTypeCustomiseToken:
+		return action_pb.FlowType_FLOW_TYPE_CUSTOMISE_TOKEN
+	case domain.FlowTypeRegistration:
+		return action_pb.FlowType_FLOW_TYPE_REGISTRATION
+	case domain.FlowTypeUserCreation:
+		return action_pb.FlowType_FLOW_TYPE_USER_CREATION
+	case domain.FlowTypeUserGrant:
+		return action_pb.FlowType_FLOW_TYPE_USER_GRANT
 	default:
 		return action_pb.FlowType_FLOW_TYPE_UNSPECIFIED
 	}
@@ -63,6 +92,18 @@ func TriggerTypeToPb(triggerType domain.TriggerType) action_pb.TriggerType {
 		return action_pb.TriggerType_TRIGGER_TYPE_PRE_CREATION
 	case domain.TriggerTypePostCreation:
 		return action_pb.TriggerType_TRIGGER_TYPE_POST_CREATION
+	case domain.TriggerTypePreUserinfoCreation:
+		return action_pb.TriggerType_TRIGGER_TYPE_PRE_USERINFO_CREATION
+	case domain.TriggerTypePreAccessTokenCreation:
+		return action_pb.TriggerType_TRIGGER_TYPE_PRE_ACCESS_TOKEN_CREATION
+	case domain.TriggerTypePreChange:
+		return action_pb.TriggerType_TRIGGER_TYPE_PRE_CHANGE
+	case domain.TriggerTypePostChange:
+		return action_pb.TriggerType_TRIGGER_TYPE_POST_CHANGE
+	case domain.TriggerTypePreRemoval:
+		return action_pb.TriggerType_TRIGGER_TYPE_PRE_REMOVAL
+	case domain.TriggerTypePostRemoval:
+		return action_pb.TriggerType_TRIGGER_TYPE_POST_REMOVAL
 	default:
 		return action_pb.TriggerType_TRIGGER_TYPE_UNSPECIFIED
 	}
@@ -124,3 +165,76 @@ func ActionStateToDomain(state action_pb.ActionState) domain.ActionState {
 		return domain.ActionStateUnspecified
 	}
 }
+
+func ActionExecutionsToPb(executions []*query.ActionExecution) []*action_pb.ActionExecution {
+	list := make([]*action_pb.ActionExecution, len(executions))
+	for i, execution := range executions {
+		list[i] = &action_pb.ActionExecution{
+			ActionId:     execution.ActionID,
+			ActionName:   execution.ActionName,
+			FlowType:     FlowTypeToPb(execution.FlowType),
+			TriggerType:  TriggerTypeToPb(execution.TriggerType),
+			CreationDate: timestamppb.New(execution.CreationDate),
+			Duration:     durationpb.New(execution.Duration),
+			Outcome:      ActionExecutionOutcomeToPb(execution.Outcome),
+			Error:        execution.Error,
+			Logs:         execution.Logs,
+		}
+	}
+	return list
+}
+
+func TestExecutionToPb(execution *domain.ActionExecution) *action_pb.ActionExecution {
+	return &action_pb.ActionExecution{
+		ActionName:   execution.ActionName,
+		CreationDate: timestamppb.New(execution.CreationDate),
+		Duration:     durationpb.New(execution.Duration),
+		Outcome:      ActionExecutionOutcomeToPb(execution.Outcome),
+		Error:        execution.Error,
+		Logs:         execution.Logs,
+	}
+}
+
+func ActionExecutionOutcomeToPb(outcome domain.ActionExecutionOutcome) action_pb.ActionExecutionOutcome {
+	switch outcome {
+	case domain.ActionExecutionOutcomeSucceeded:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_SUCCEEDED
+	case domain.ActionExecutionOutcomeFailed:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_FAILED
+	case domain.ActionExecutionOutcomeTimedOut:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_TIMED_OUT
+	default:
+		return action_pb.ActionExecutionOutcome_ACTION_EXECUTION_OUTCOME_UNSPECIFIED
+	}
+}
+
+func ActionLibrariesToPb(libraries []*query.ActionLibrary) []*action_pb.ActionLibrary {
+	list := make([]*action_pb.ActionLibrary, len(libraries))
+	for i, library := range libraries {
+		list[i] = ActionLibraryToPb(library)
+	}
+	return list
+}
+
+func ActionLibraryToPb(library *query.ActionLibrary) *action_pb.ActionLibrary {
+	return &action_pb.ActionLibrary{
+		Id:      library.ID,
+		Details: object_grpc.ChangeToDetailsPb(library.Sequence, library.ChangeDate, library.ResourceOwner),
+		Name:    library.Name,
+		Version: library.Version,
+		Script:  library.Script,
+	}
+}
+
+func ActionLibraryDependenciesToPb(dependencies []*query.ActionLibraryDependency) []*action_pb.ActionLibraryDependency {
+	list := make([]*action_pb.ActionLibraryDependency, len(dependencies))
+	for i, dependency := range dependencies {
+		list[i] = &action_pb.ActionLibraryDependency{
+			ActionId:   dependency.ActionID,
+			ActionName: dependency.ActionName,
+			Version:    dependency.Version,
+			Pinned:     dependency.Pinned,
+		}
+	}
+	return list
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	"github.com/caos/zitadel/internal/domain"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetActionsHTTPConfig(ctx context.Context, req *admin_pb.GetActionsHTTPConfigRequest) (*admin_pb.GetActionsHTTPConfigResponse, error) {
+	config, err := s.query.ActionsHTTPConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetActionsHTTPConfigResponse{
+		Details:         object.ChangeToDetailsPb(config.Sequence, config.ChangeDate, config.ResourceOwner),
+		AllowList:       config.AllowList,
+		MaxResponseSize: config.MaxResponseSize,
+	}, nil
+}
+
+func (s *Server) SetActionsHTTPConfig(ctx context.Context, req *admin_pb.SetActionsHTTPConfigRequest) (*admin_pb.SetActionsHTTPConfigResponse, error) {
+	details, err := s.command.SetActionsHTTPConfig(ctx, &domain.ActionsHTTPConfig{
+		AllowList:       req.AllowList,
+		MaxResponseSize: req.MaxResponseSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetActionsHTTPConfigResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
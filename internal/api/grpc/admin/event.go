@@ -0,0 +1,16 @@
+package admin
+
+import (
+	event_grpc "github.com/caos/zitadel/internal/api/grpc/event"
+	"github.com/caos/zitadel/internal/query"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) StreamEvents(req *admin_pb.StreamEventsRequest, stream admin_pb.AdminService_StreamEventsServer) error {
+	streamQuery := event_grpc.StreamEventsToQuery(req.Sequence, req.AggregateTypes, req.EventTypes, req.BatchSize, req.ResourceOwner)
+	return s.query.StreamEvents(stream.Context(), streamQuery, event_grpc.PollInterval, func(event *query.StreamedEvent) error {
+		return stream.Send(&admin_pb.StreamEventsResponse{
+			Event: event_grpc.EventToPb(event),
+		})
+	})
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ExportOrg(ctx context.Context, req *admin_pb.ExportOrgRequest) (*admin_pb.ExportOrgResponse, error) {
+	org, err := s.query.ExportOrg(ctx, req.OrgId, s.iamDomain)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(&domain.OrgExport{
+		Version: domain.OrgExportVersion,
+		Orgs:    []*domain.ExportedOrg{org},
+	})
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ADMIN-Md92k", "Errors.Internal")
+	}
+	return &admin_pb.ExportOrgResponse{
+		Data: string(data),
+	}, nil
+}
+
+func (s *Server) ImportOrgs(ctx context.Context, req *admin_pb.ImportOrgsRequest) (*admin_pb.ImportOrgsResponse, error) {
+	export := new(domain.OrgExport)
+	if err := json.Unmarshal([]byte(req.Data), export); err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "ADMIN-Ks93m", "Errors.Import.Invalid")
+	}
+	results, err := s.command.ImportOrgs(ctx, export, authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ImportOrgsResponse{
+		Results: importResultsToPb(results),
+	}, nil
+}
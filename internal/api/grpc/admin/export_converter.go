@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"github.com/caos/zitadel/internal/domain"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func importResultsToPb(results []*domain.OrgImportResult) []*admin_pb.ImportOrgResult {
+	r := make([]*admin_pb.ImportOrgResult, len(results))
+	for i, result := range results {
+		r[i] = &admin_pb.ImportOrgResult{
+			OrgId:         result.OrgID,
+			Errors:        importErrorsToPb(result.Errors),
+			ClientSecrets: importedClientSecretsToPb(result.ClientSecrets),
+		}
+	}
+	return r
+}
+
+func importErrorsToPb(importErrors []*domain.ImportError) []*admin_pb.ImportError {
+	e := make([]*admin_pb.ImportError, len(importErrors))
+	for i, importErr := range importErrors {
+		e[i] = &admin_pb.ImportError{
+			Type:    importErr.Type,
+			Id:      importErr.ID,
+			Message: importErr.Message,
+		}
+	}
+	return e
+}
+
+func importedClientSecretsToPb(secrets []*domain.ImportedClientSecret) []*admin_pb.ImportedClientSecret {
+	s := make([]*admin_pb.ImportedClientSecret, len(secrets))
+	for i, secret := range secrets {
+		s[i] = &admin_pb.ImportedClientSecret{
+			ProjectId:    secret.ProjectID,
+			AppId:        secret.AppID,
+			ClientId:     secret.ClientID,
+			ClientSecret: secret.ClientSecret,
+		}
+	}
+	return s
+}
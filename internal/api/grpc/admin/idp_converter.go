@@ -50,6 +50,60 @@ func addJWTIDPRequestToDomainJWTIDPConfig(req *admin_pb.AddJWTIDPRequest) *domai
 	}
 }
 
+func addSAMLIDPRequestToDomain(req *admin_pb.AddSAMLIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		SAMLConfig:   addSAMLIDPRequestToDomainSAMLIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeSAML,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addSAMLIDPRequestToDomainSAMLIDPConfig(req *admin_pb.AddSAMLIDPRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		MetadataURL:          req.MetadataUrl,
+		Metadata:             req.Metadata,
+		Binding:              idp_grpc.SAMLBindingToDomain(req.Binding),
+		WithSignedRequest:    req.WithSignedRequest,
+		NameIDFormat:         idp_grpc.SAMLNameIDFormatToDomain(req.NameIdFormat),
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
+func addLDAPIDPRequestToDomain(req *admin_pb.AddLDAPIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		LDAPConfig:   addLDAPIDPRequestToDomainLDAPIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeLDAP,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addLDAPIDPRequestToDomainLDAPIDPConfig(req *admin_pb.AddLDAPIDPRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		URL:                  req.Url,
+		StartTLS:             req.StartTls,
+		BindDN:               req.BindDn,
+		BindPasswordString:   req.BindPassword,
+		BaseDN:               req.BaseDn,
+		UserObjectClass:      req.UserObjectClass,
+		IDAttribute:          req.IdAttribute,
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
 func updateIDPToDomain(req *admin_pb.UpdateIDPRequest) *domain.IDPConfig {
 	return &domain.IDPConfig{
 		IDPConfigID:  req.IdpId,
@@ -81,6 +135,42 @@ func updateJWTConfigToDomain(req *admin_pb.UpdateIDPJWTConfigRequest) *domain.JW
 	}
 }
 
+func updateSAMLConfigToDomain(req *admin_pb.UpdateIDPSAMLConfigRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		IDPConfigID:          req.IdpId,
+		MetadataURL:          req.MetadataUrl,
+		Metadata:             req.Metadata,
+		Binding:              idp_grpc.SAMLBindingToDomain(req.Binding),
+		WithSignedRequest:    req.WithSignedRequest,
+		NameIDFormat:         idp_grpc.SAMLNameIDFormatToDomain(req.NameIdFormat),
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
+func updateLDAPConfigToDomain(req *admin_pb.UpdateIDPLDAPConfigRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		IDPConfigID:          req.IdpId,
+		URL:                  req.Url,
+		StartTLS:             req.StartTls,
+		BindDN:               req.BindDn,
+		BindPasswordString:   req.BindPassword,
+		BaseDN:               req.BaseDn,
+		UserObjectClass:      req.UserObjectClass,
+		IDAttribute:          req.IdAttribute,
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
 func listIDPsToModel(req *admin_pb.ListIDPsRequest) (*query.IDPSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	queries, err := idpQueriesToModel(req.Queries)
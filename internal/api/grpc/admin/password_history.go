@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/caos/zitadel/internal/api/grpc/policy"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetPasswordHistoryPolicy(ctx context.Context, req *admin_pb.GetPasswordHistoryPolicyRequest) (*admin_pb.GetPasswordHistoryPolicyResponse, error) {
+	policy, err := s.query.DefaultPasswordHistoryPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetPasswordHistoryPolicyResponse{
+		Policy: policy_grpc.ModelPasswordHistoryPolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) UpdatePasswordHistoryPolicy(ctx context.Context, req *admin_pb.UpdatePasswordHistoryPolicyRequest) (*admin_pb.UpdatePasswordHistoryPolicyResponse, error) {
+	result, err := s.command.ChangeDefaultPasswordHistoryPolicy(ctx, UpdatePasswordHistoryPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdatePasswordHistoryPolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
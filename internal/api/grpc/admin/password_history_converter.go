@@ -0,0 +1,12 @@
+package admin
+
+import (
+	"github.com/caos/zitadel/internal/domain"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func UpdatePasswordHistoryPolicyToDomain(policy *admin_pb.UpdatePasswordHistoryPolicyRequest) *domain.PasswordHistoryPolicy {
+	return &domain.PasswordHistoryPolicy{
+		HistoryCount: uint64(policy.HistoryCount),
+	}
+}
@@ -0,0 +1,164 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListSMSProviders(ctx context.Context, req *admin_pb.ListSMSProvidersRequest) (*admin_pb.ListSMSProvidersResponse, error) {
+	queries, err := listSMSConfigsToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMSConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListSMSProvidersResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.Timestamp),
+		Result:  SMSConfigsToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetSMSProvider(ctx context.Context, req *admin_pb.GetSMSProviderRequest) (*admin_pb.GetSMSProviderResponse, error) {
+	result, err := s.query.SMSProviderConfigByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetSMSProviderResponse{
+		Config: SMSConfigToPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderTwilio(ctx context.Context, req *admin_pb.AddSMSProviderTwilioRequest) (*admin_pb.AddSMSProviderTwilioResponse, error) {
+	id, details, err := s.command.AddSMSConfigTwilio(ctx, AddSMSConfigTwilioToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderTwilioResponse{
+		Details: object.DomainToAddDetailsPb(details),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderTwilio(ctx context.Context, req *admin_pb.UpdateSMSProviderTwilioRequest) (*admin_pb.UpdateSMSProviderTwilioResponse, error) {
+	details, err := s.command.ChangeSMSConfigTwilio(ctx, req.Id, UpdateSMSConfigTwilioToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderTwilioResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderTwilioToken(ctx context.Context, req *admin_pb.UpdateSMSProviderTwilioTokenRequest) (*admin_pb.UpdateSMSProviderTwilioTokenResponse, error) {
+	details, err := s.command.ChangeSMSConfigTwilioToken(ctx, req.Id, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderTwilioTokenResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderVonage(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) (*admin_pb.AddSMSProviderVonageResponse, error) {
+	id, details, err := s.command.AddSMSConfigVonage(ctx, AddSMSConfigVonageToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderVonageResponse{
+		Details: object.DomainToAddDetailsPb(details),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonage(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) (*admin_pb.UpdateSMSProviderVonageResponse, error) {
+	details, err := s.command.ChangeSMSConfigVonage(ctx, req.Id, UpdateSMSConfigVonageToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonageSecret(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageSecretRequest) (*admin_pb.UpdateSMSProviderVonageSecretResponse, error) {
+	details, err := s.command.ChangeSMSConfigVonageSecret(ctx, req.Id, req.ApiSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageSecretResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderHTTP(ctx context.Context, req *admin_pb.AddSMSProviderHTTPRequest) (*admin_pb.AddSMSProviderHTTPResponse, error) {
+	id, details, err := s.command.AddSMSConfigHTTP(ctx, AddSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderHTTPResponse{
+		Details: object.DomainToAddDetailsPb(details),
+		Id:      id,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPRequest) (*admin_pb.UpdateSMSProviderHTTPResponse, error) {
+	details, err := s.command.ChangeSMSConfigHTTP(ctx, req.Id, UpdateSMSConfigHTTPToConfig(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTPAuthorization(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPAuthorizationRequest) (*admin_pb.UpdateSMSProviderHTTPAuthorizationResponse, error) {
+	details, err := s.command.ChangeSMSConfigHTTPAuthorization(ctx, req.Id, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPAuthorizationResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
+	details, err := s.command.ActivateSMSConfig(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ActivateSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DeactivateSMSProvider(ctx context.Context, req *admin_pb.DeactivateSMSProviderRequest) (*admin_pb.DeactivateSMSProviderResponse, error) {
+	details, err := s.command.DeactivateSMSConfig(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.DeactivateSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveSMSProvider(ctx context.Context, req *admin_pb.RemoveSMSProviderRequest) (*admin_pb.RemoveSMSProviderResponse, error) {
+	details, err := s.command.RemoveSMSConfig(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveSMSProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) TestSMSProvider(ctx context.Context, req *admin_pb.TestSMSProviderRequest) (*admin_pb.TestSMSProviderResponse, error) {
+	err := s.command.TestSMSConfig(ctx, req.Id, req.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.TestSMSProviderResponse{}, nil
+}
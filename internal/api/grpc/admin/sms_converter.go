@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+	settings_pb "github.com/caos/zitadel/pkg/grpc/settings"
+)
+
+func listSMSConfigsToModel(req *admin_pb.ListSMSProvidersRequest) (*query.SMSConfigsSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMSConfigResourceOwnerSearchQuery(domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.SMSConfigsSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	}, nil
+}
+
+func SMSConfigsToPb(configs []*query.SMSConfig) []*settings_pb.SMSProvider {
+	c := make([]*settings_pb.SMSProvider, len(configs))
+	for i, config := range configs {
+		c[i] = SMSConfigToPb(config)
+	}
+	return c
+}
+
+func SMSConfigToPb(config *query.SMSConfig) *settings_pb.SMSProvider {
+	provider := &settings_pb.SMSProvider{
+		Details: object.ToViewDetailsPb(config.Sequence, config.CreationDate, config.ChangeDate, config.ResourceOwner),
+		Id:      config.ID,
+		State:   smsStateToPb(config.State),
+	}
+	if config.TwilioConfig != nil {
+		provider.Config = &settings_pb.SMSProvider_Twilio{
+			Twilio: TwilioConfigToPb(config.TwilioConfig),
+		}
+	}
+	if config.VonageConfig != nil {
+		provider.Config = &settings_pb.SMSProvider_Vonage{
+			Vonage: VonageConfigToPb(config.VonageConfig),
+		}
+	}
+	if config.HTTPConfig != nil {
+		provider.Config = &settings_pb.SMSProvider_Http{
+			Http: HTTPConfigToPb(config.HTTPConfig),
+		}
+	}
+	return provider
+}
+
+func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.TwilioConfig {
+	return &settings_pb.TwilioConfig{
+		Sid:          twilio.SID,
+		SenderNumber: twilio.SenderNumber,
+	}
+}
+
+func VonageConfigToPb(vonage *query.Vonage) *settings_pb.VonageConfig {
+	return &settings_pb.VonageConfig{
+		ApiKey:       vonage.APIKey,
+		SenderNumber: vonage.SenderNumber,
+	}
+}
+
+func HTTPConfigToPb(http *query.HTTP) *settings_pb.HTTPConfig {
+	return &settings_pb.HTTPConfig{
+		Endpoint:     http.Endpoint,
+		Method:       http.Method,
+		ContentType:  http.ContentType,
+		BodyTemplate: http.BodyTemplate,
+		SenderNumber: http.SenderNumber,
+	}
+}
+
+func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
+	switch state {
+	case domain.SMSConfigStateActive:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_ACTIVE
+	case domain.SMSConfigStateInactive:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_INACTIVE
+	default:
+		return settings_pb.SMSProviderConfigState_SMS_PROVIDER_CONFIG_STATE_UNSPECIFIED
+	}
+}
+
+func AddSMSConfigTwilioToConfig(req *admin_pb.AddSMSProviderTwilioRequest) *domain.SMSConfigTwilio {
+	return &domain.SMSConfigTwilio{
+		SID:          req.Sid,
+		SenderNumber: req.SenderNumber,
+		Token:        req.Token,
+	}
+}
+
+func UpdateSMSConfigTwilioToConfig(req *admin_pb.UpdateSMSProviderTwilioRequest) *domain.SMSConfigTwilio {
+	return &domain.SMSConfigTwilio{
+		SID:          req.Sid,
+		SenderNumber: req.SenderNumber,
+	}
+}
+
+func AddSMSConfigVonageToConfig(req *admin_pb.AddSMSProviderVonageRequest) *domain.SMSConfigVonage {
+	return &domain.SMSConfigVonage{
+		APIKey:       req.ApiKey,
+		APISecret:    req.ApiSecret,
+		SenderNumber: req.SenderNumber,
+	}
+}
+
+func UpdateSMSConfigVonageToConfig(req *admin_pb.UpdateSMSProviderVonageRequest) *domain.SMSConfigVonage {
+	return &domain.SMSConfigVonage{
+		APIKey:       req.ApiKey,
+		SenderNumber: req.SenderNumber,
+	}
+}
+
+func AddSMSConfigHTTPToConfig(req *admin_pb.AddSMSProviderHTTPRequest) *domain.SMSConfigHTTP {
+	return &domain.SMSConfigHTTP{
+		Endpoint:      req.Endpoint,
+		Method:        req.Method,
+		ContentType:   req.ContentType,
+		BodyTemplate:  req.BodyTemplate,
+		SenderNumber:  req.SenderNumber,
+		Authorization: req.Authorization,
+	}
+}
+
+func UpdateSMSConfigHTTPToConfig(req *admin_pb.UpdateSMSProviderHTTPRequest) *domain.SMSConfigHTTP {
+	return &domain.SMSConfigHTTP{
+		Endpoint:     req.Endpoint,
+		Method:       req.Method,
+		ContentType:  req.ContentType,
+		BodyTemplate: req.BodyTemplate,
+		SenderNumber: req.SenderNumber,
+	}
+}
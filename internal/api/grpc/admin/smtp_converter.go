@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+	settings_pb "github.com/caos/zitadel/pkg/grpc/settings"
+)
+
+func AddSMTPToConfig(req *admin_pb.AddSMTPConfigRequest) *domain.SMTPConfig {
+	return &domain.SMTPConfig{
+		TLS:           req.Tls,
+		SenderAddress: req.SenderAddress,
+		SenderName:    req.SenderName,
+		Host:          req.Host,
+		User:          req.User,
+		Password:      req.Password,
+	}
+}
+
+func UpdateSMTPToConfig(req *admin_pb.UpdateSMTPConfigRequest) *domain.SMTPConfig {
+	return &domain.SMTPConfig{
+		TLS:           req.Tls,
+		SenderAddress: req.SenderAddress,
+		SenderName:    req.SenderName,
+		Host:          req.Host,
+		User:          req.User,
+	}
+}
+
+func SMTPConfigToPb(smtp *query.SMTPConfig) *settings_pb.SMTPConfig {
+	return &settings_pb.SMTPConfig{
+		Tls:           smtp.TLS,
+		SenderAddress: smtp.SenderAddress,
+		SenderName:    smtp.SenderName,
+		Host:          smtp.Host,
+		User:          smtp.User,
+		Details:       object.ToViewDetailsPb(smtp.Sequence, smtp.CreationDate, smtp.ChangeDate, smtp.ResourceOwner),
+	}
+}
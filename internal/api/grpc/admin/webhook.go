@@ -0,0 +1,138 @@
+package admin
+
+import (
+	"context"
+
+	obj_grpc "github.com/caos/zitadel/internal/api/grpc/object"
+	webhook_grpc "github.com/caos/zitadel/internal/api/grpc/webhook"
+	"github.com/caos/zitadel/internal/domain"
+	admin_pb "github.com/caos/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListWebhooks(ctx context.Context, req *admin_pb.ListWebhooksRequest) (*admin_pb.ListWebhooksResponse, error) {
+	query, err := webhook_grpc.ListWebhooksToQuery(domain.IAMID, req.Query, req.SortingColumn, req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.query.SearchWebhooks(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListWebhooksResponse{
+		Details:       obj_grpc.ToListDetails(webhooks.Count, webhooks.Sequence, webhooks.Timestamp),
+		SortingColumn: req.SortingColumn,
+		Result:        webhook_grpc.WebhooksToPb(webhooks.Webhooks),
+	}, nil
+}
+
+func (s *Server) GetWebhook(ctx context.Context, req *admin_pb.GetWebhookRequest) (*admin_pb.GetWebhookResponse, error) {
+	webhook, err := s.query.GetWebhookByID(ctx, req.Id, domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetWebhookResponse{
+		Webhook: webhook_grpc.WebhookToPb(webhook),
+	}, nil
+}
+
+func (s *Server) CreateWebhook(ctx context.Context, req *admin_pb.CreateWebhookRequest) (*admin_pb.CreateWebhookResponse, error) {
+	id, signingKey, details, err := s.command.AddWebhook(ctx, webhook_grpc.WebhookToDomain("", req.Name, req.Url, req.EventTypes), domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.CreateWebhookResponse{
+		Id:         id,
+		SigningKey: signingKey,
+		Details: obj_grpc.AddToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateWebhook(ctx context.Context, req *admin_pb.UpdateWebhookRequest) (*admin_pb.UpdateWebhookResponse, error) {
+	details, err := s.command.ChangeWebhook(ctx, webhook_grpc.WebhookToDomain(req.Id, req.Name, req.Url, req.EventTypes), domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) RegenerateWebhookSigningKey(ctx context.Context, req *admin_pb.RegenerateWebhookSigningKeyRequest) (*admin_pb.RegenerateWebhookSigningKeyResponse, error) {
+	signingKey, details, err := s.command.RegenerateWebhookSigningKey(ctx, req.Id, domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RegenerateWebhookSigningKeyResponse{
+		SigningKey: signingKey,
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) DeactivateWebhook(ctx context.Context, req *admin_pb.DeactivateWebhookRequest) (*admin_pb.DeactivateWebhookResponse, error) {
+	details, err := s.command.DeactivateWebhook(ctx, req.Id, domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.DeactivateWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ReactivateWebhook(ctx context.Context, req *admin_pb.ReactivateWebhookRequest) (*admin_pb.ReactivateWebhookResponse, error) {
+	details, err := s.command.ReactivateWebhook(ctx, req.Id, domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ReactivateWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *admin_pb.DeleteWebhookRequest) (*admin_pb.DeleteWebhookResponse, error) {
+	details, err := s.command.RemoveWebhook(ctx, req.Id, domain.IAMID)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.DeleteWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ListWebhookFailedDeliveries(ctx context.Context, req *admin_pb.ListWebhookFailedDeliveriesRequest) (*admin_pb.ListWebhookFailedDeliveriesResponse, error) {
+	query, err := webhook_grpc.ListFailedDeliveriesToQuery(req.Id, domain.IAMID, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	failedDeliveries, err := s.query.SearchWebhookFailedDeliveries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListWebhookFailedDeliveriesResponse{
+		Details: obj_grpc.ToListDetails(failedDeliveries.Count, failedDeliveries.Sequence, failedDeliveries.Timestamp),
+		Result:  webhook_grpc.FailedDeliveriesToPb(failedDeliveries.FailedDeliveries),
+	}, nil
+}
@@ -113,3 +113,30 @@ func (s *Server) RemoveMyAuthFactorU2F(ctx context.Context, req *auth_pb.RemoveM
 		Details: object.DomainToChangeDetailsPb(objectDetails),
 	}, nil
 }
+
+func (s *Server) AddMyAuthFactorRecoveryCodes(ctx context.Context, _ *auth_pb.AddMyAuthFactorRecoveryCodesRequest) (*auth_pb.AddMyAuthFactorRecoveryCodesResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	codes, err := s.command.AddHumanRecoveryCodes(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.AddMyAuthFactorRecoveryCodesResponse{
+		Codes: codes.Codes,
+		Details: object.AddToDetailsPb(
+			codes.Sequence,
+			codes.ChangeDate,
+			codes.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) RemoveMyAuthFactorRecoveryCodes(ctx context.Context, _ *auth_pb.RemoveMyAuthFactorRecoveryCodesRequest) (*auth_pb.RemoveMyAuthFactorRecoveryCodesResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	objectDetails, err := s.command.HumanRemoveRecoveryCodes(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RemoveMyAuthFactorRecoveryCodesResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
@@ -2,9 +2,11 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/api/authz"
 	"github.com/caos/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/caos/zitadel/internal/api/grpc/user"
 	"github.com/caos/zitadel/internal/query"
 	auth_pb "github.com/caos/zitadel/pkg/grpc/auth"
 )
@@ -15,6 +17,10 @@ func ListMyUserGrantsRequestToQuery(ctx context.Context, req *auth_pb.ListMyUser
 	if err != nil {
 		return nil, err
 	}
+	notExpiredQuery, err := query.NewUserGrantNotExpiredQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	return &query.UserGrantsQueries{
 		SearchRequest: query.SearchRequest{
 			Offset: offset,
@@ -23,6 +29,7 @@ func ListMyUserGrantsRequestToQuery(ctx context.Context, req *auth_pb.ListMyUser
 		},
 		Queries: []query.SearchQuery{
 			userGrantUserID,
+			notExpiredQuery,
 		},
 	}, nil
 }
@@ -37,11 +44,12 @@ func UserGrantsToPb(grants []*query.UserGrant) []*auth_pb.UserGrant {
 
 func UserGrantToPb(grant *query.UserGrant) *auth_pb.UserGrant {
 	return &auth_pb.UserGrant{
-		GrantId:   grant.ID,
-		OrgId:     grant.ResourceOwner,
-		OrgName:   grant.OrgName,
-		ProjectId: grant.ProjectID,
-		UserId:    grant.UserID,
-		Roles:     grant.Roles,
+		GrantId:        grant.ID,
+		OrgId:          grant.ResourceOwner,
+		OrgName:        grant.OrgName,
+		ProjectId:      grant.ProjectID,
+		UserId:         grant.UserID,
+		Roles:          grant.Roles,
+		ExpirationDate: user_grpc.ExpirationDateToPb(grant.ExpirationDate),
 	}
 }
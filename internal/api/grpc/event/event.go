@@ -0,0 +1,38 @@
+package event
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/caos/zitadel/internal/query"
+	event_pb "github.com/caos/zitadel/pkg/grpc/event"
+)
+
+//PollInterval defines how long the stream waits for new events
+const PollInterval = time.Second
+
+func StreamEventsToQuery(sequence uint64, aggregateTypes, eventTypes []string, batchSize uint32, resourceOwner string) *query.EventStreamQuery {
+	return &query.EventStreamQuery{
+		Sequence:       sequence,
+		AggregateTypes: aggregateTypes,
+		EventTypes:     eventTypes,
+		ResourceOwner:  resourceOwner,
+		BatchSize:      uint64(batchSize),
+	}
+}
+
+func EventToPb(event *query.StreamedEvent) *event_pb.Event {
+	return &event_pb.Event{
+		Sequence:         event.Sequence,
+		CreationDate:     timestamppb.New(event.CreationDate),
+		Type:             event.Type,
+		AggregateType:    event.AggregateType,
+		AggregateId:      event.AggregateID,
+		AggregateVersion: event.AggregateVersion,
+		ResourceOwner:    event.ResourceOwner,
+		EditorService:    event.EditorService,
+		EditorUserId:     event.EditorUser,
+		Payload:          event.Payload,
+	}
+}
@@ -88,9 +88,11 @@ func IDPTypeToPb(idpType domain.IDPConfigType) idp_pb.IDPType {
 	case domain.IDPConfigTypeOIDC:
 		return idp_pb.IDPType_IDP_TYPE_OIDC
 	case domain.IDPConfigTypeSAML:
-		return idp_pb.IDPType_IDP_TYPE_UNSPECIFIED
+		return idp_pb.IDPType_IDP_TYPE_SAML
 	case domain.IDPConfigTypeJWT:
 		return idp_pb.IDPType_IDP_TYPE_JWT
+	case domain.IDPConfigTypeLDAP:
+		return idp_pb.IDPType_IDP_TYPE_LDAP
 	default:
 		return idp_pb.IDPType_IDP_TYPE_UNSPECIFIED
 	}
@@ -157,6 +159,16 @@ func ModelIDPViewToConfigPb(config *query.IDP) idp_pb.IDPConfig {
 			},
 		}
 	}
+	if config.SAMLIDP != nil {
+		return &idp_pb.IDP_SamlConfig{
+			SamlConfig: SAMLIDPToPb(config.SAMLIDP),
+		}
+	}
+	if config.LDAPIDP != nil {
+		return &idp_pb.IDP_LdapConfig{
+			LdapConfig: LDAPIDPToPb(config.LDAPIDP),
+		}
+	}
 	return &idp_pb.IDP_JwtConfig{
 		JwtConfig: &idp_pb.JWTConfig{
 			JwtEndpoint:  config.Endpoint,
@@ -179,6 +191,16 @@ func IDPViewToConfigPb(config *query.IDP) idp_pb.IDPConfig {
 			},
 		}
 	}
+	if config.SAMLIDP != nil {
+		return &idp_pb.IDP_SamlConfig{
+			SamlConfig: SAMLIDPToPb(config.SAMLIDP),
+		}
+	}
+	if config.LDAPIDP != nil {
+		return &idp_pb.IDP_LdapConfig{
+			LdapConfig: LDAPIDPToPb(config.LDAPIDP),
+		}
+	}
 	return &idp_pb.IDP_JwtConfig{
 		JwtConfig: &idp_pb.JWTConfig{
 			JwtEndpoint:  config.JWTIDP.Endpoint,
@@ -188,6 +210,89 @@ func IDPViewToConfigPb(config *query.IDP) idp_pb.IDPConfig {
 	}
 }
 
+func SAMLIDPToPb(config *query.SAMLIDP) *idp_pb.SAMLConfig {
+	return &idp_pb.SAMLConfig{
+		MetadataUrl:          config.MetadataURL,
+		Metadata:             config.Metadata,
+		Binding:              SAMLBindingToPb(config.Binding),
+		WithSignedRequest:    config.WithSignedRequest,
+		NameIdFormat:         SAMLNameIDFormatToPb(config.NameIDFormat),
+		UsernameAttribute:    config.UsernameAttribute,
+		DisplayNameAttribute: config.DisplayNameAttribute,
+		FirstNameAttribute:   config.FirstNameAttribute,
+		LastNameAttribute:    config.LastNameAttribute,
+		EmailAttribute:       config.EmailAttribute,
+		PhoneAttribute:       config.PhoneAttribute,
+		Certificate:          config.Certificate,
+	}
+}
+
+// LDAPIDPToPb maps the ldap config without the bind password
+func LDAPIDPToPb(config *query.LDAPIDP) *idp_pb.LDAPConfig {
+	return &idp_pb.LDAPConfig{
+		Url:                  config.URL,
+		StartTls:             config.StartTLS,
+		BindDn:               config.BindDN,
+		BaseDn:               config.BaseDN,
+		UserObjectClass:      config.UserObjectClass,
+		IdAttribute:          config.IDAttribute,
+		UsernameAttribute:    config.UsernameAttribute,
+		DisplayNameAttribute: config.DisplayNameAttribute,
+		FirstNameAttribute:   config.FirstNameAttribute,
+		LastNameAttribute:    config.LastNameAttribute,
+		EmailAttribute:       config.EmailAttribute,
+		PhoneAttribute:       config.PhoneAttribute,
+	}
+}
+
+func SAMLBindingToPb(binding domain.SAMLBinding) idp_pb.SAMLBinding {
+	switch binding {
+	case domain.SAMLBindingRedirect:
+		return idp_pb.SAMLBinding_SAML_BINDING_REDIRECT
+	case domain.SAMLBindingPost:
+		return idp_pb.SAMLBinding_SAML_BINDING_POST
+	default:
+		return idp_pb.SAMLBinding_SAML_BINDING_UNSPECIFIED
+	}
+}
+
+func SAMLBindingToDomain(binding idp_pb.SAMLBinding) domain.SAMLBinding {
+	switch binding {
+	case idp_pb.SAMLBinding_SAML_BINDING_REDIRECT:
+		return domain.SAMLBindingRedirect
+	case idp_pb.SAMLBinding_SAML_BINDING_POST:
+		return domain.SAMLBindingPost
+	default:
+		return domain.SAMLBindingUnspecified
+	}
+}
+
+func SAMLNameIDFormatToPb(format domain.SAMLNameIDFormat) idp_pb.SAMLNameIDFormat {
+	switch format {
+	case domain.SAMLNameIDFormatEmailAddress:
+		return idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS
+	case domain.SAMLNameIDFormatPersistent:
+		return idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT
+	case domain.SAMLNameIDFormatTransient:
+		return idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT
+	default:
+		return idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED
+	}
+}
+
+func SAMLNameIDFormatToDomain(format idp_pb.SAMLNameIDFormat) domain.SAMLNameIDFormat {
+	switch format {
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		return domain.SAMLNameIDFormatEmailAddress
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT:
+		return domain.SAMLNameIDFormatPersistent
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT:
+		return domain.SAMLNameIDFormatTransient
+	default:
+		return domain.SAMLNameIDFormatUnspecified
+	}
+}
+
 func FieldNameToModel(fieldName idp_pb.IDPFieldName) query.Column {
 	switch fieldName {
 	// case admin.IdpSearchKey_IDPSEARCHKEY_IDP_CONFIG_ID: //TODO: not implemented in proto
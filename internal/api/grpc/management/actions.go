@@ -3,9 +3,14 @@ package management
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/caos/zitadel/internal/actions"
 	"github.com/caos/zitadel/internal/api/authz"
 	action_grpc "github.com/caos/zitadel/internal/api/grpc/action"
 	obj_grpc "github.com/caos/zitadel/internal/api/grpc/object"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
 	mgmt_pb "github.com/caos/zitadel/pkg/grpc/management"
 )
 
@@ -96,3 +101,116 @@ func (s *Server) DeleteAction(ctx context.Context, req *mgmt_pb.DeleteActionRequ
 	_, err = s.command.DeleteAction(ctx, req.Id, authz.GetCtxData(ctx).OrgID, flowTypes...)
 	return &mgmt_pb.DeleteActionResponse{}, err
 }
+
+func (s *Server) ListActionExecutions(ctx context.Context, req *mgmt_pb.ListActionExecutionsRequest) (*mgmt_pb.ListActionExecutionsResponse, error) {
+	query, err := listActionExecutionsToQuery(req.Id, authz.GetCtxData(ctx).OrgID, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := s.query.SearchActionExecutions(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListActionExecutionsResponse{
+		Details: obj_grpc.ToListDetails(executions.Count, executions.Sequence, executions.Timestamp),
+		Result:  action_grpc.ActionExecutionsToPb(executions.Executions),
+	}, nil
+}
+
+//TestAction returns the outcome of the script in the response
+//a failing script is therefore not returned as error
+func (s *Server) TestAction(ctx context.Context, req *mgmt_pb.TestActionRequest) (*mgmt_pb.TestActionResponse, error) {
+	claims := req.Claims.AsMap()
+	actionCtx := actions.Context(req.Context.AsMap())
+	actionCtx.SetClaims(claims)
+	orgID := authz.GetCtxData(ctx).OrgID
+	libraries, err := actions.ResolveLibraries(req.Script, func(requirement domain.ActionLibraryRequirement) (string, error) {
+		return s.query.ActionLibraryScript(ctx, orgID, requirement)
+	})
+	if err != nil {
+		return nil, err
+	}
+	execution, _ := actions.Test(&actionCtx, (&actions.API{}).SetClaims(claims), req.Script, req.Name, req.Timeout.AsDuration(), libraries, s.query)
+	resultClaims, err := structpb.NewStruct(claims)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "MANAG-Ac3tz", "Errors.Internal")
+	}
+	return &mgmt_pb.TestActionResponse{
+		Execution: action_grpc.TestExecutionToPb(execution),
+		Claims:    resultClaims,
+	}, nil
+}
+
+func (s *Server) ListActionLibraries(ctx context.Context, req *mgmt_pb.ListActionLibrariesRequest) (*mgmt_pb.ListActionLibrariesResponse, error) {
+	query, err := listActionLibrariesToQuery(authz.GetCtxData(ctx).OrgID, req)
+	if err != nil {
+		return nil, err
+	}
+	libraries, err := s.query.SearchActionLibraries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListActionLibrariesResponse{
+		Details: obj_grpc.ToListDetails(libraries.Count, libraries.Sequence, libraries.Timestamp),
+		Result:  action_grpc.ActionLibrariesToPb(libraries.Libraries),
+	}, nil
+}
+
+func (s *Server) GetActionLibrary(ctx context.Context, req *mgmt_pb.GetActionLibraryRequest) (*mgmt_pb.GetActionLibraryResponse, error) {
+	library, err := s.query.GetActionLibraryByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetActionLibraryResponse{
+		Library: action_grpc.ActionLibraryToPb(library),
+	}, nil
+}
+
+func (s *Server) CreateActionLibrary(ctx context.Context, req *mgmt_pb.CreateActionLibraryRequest) (*mgmt_pb.CreateActionLibraryResponse, error) {
+	id, details, err := s.command.AddActionLibrary(ctx, createActionLibraryRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CreateActionLibraryResponse{
+		Id: id,
+		Details: obj_grpc.AddToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateActionLibrary(ctx context.Context, req *mgmt_pb.UpdateActionLibraryRequest) (*mgmt_pb.UpdateActionLibraryResponse, error) {
+	details, err := s.command.ChangeActionLibrary(ctx, updateActionLibraryRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateActionLibraryResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) DeleteActionLibrary(ctx context.Context, req *mgmt_pb.DeleteActionLibraryRequest) (*mgmt_pb.DeleteActionLibraryResponse, error) {
+	details, err := s.command.DeleteActionLibrary(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeleteActionLibraryResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListActionLibraryDependencies(ctx context.Context, req *mgmt_pb.ListActionLibraryDependenciesRequest) (*mgmt_pb.ListActionLibraryDependenciesResponse, error) {
+	dependencies, err := s.query.ActionLibraryDependencies(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListActionLibraryDependenciesResponse{
+		Result: action_grpc.ActionLibraryDependenciesToPb(dependencies),
+	}, nil
+}
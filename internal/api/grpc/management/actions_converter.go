@@ -7,6 +7,7 @@ import (
 	"github.com/caos/zitadel/internal/eventstore/v1/models"
 	"github.com/caos/zitadel/internal/query"
 	mgmt_pb "github.com/caos/zitadel/pkg/grpc/management"
+	object_pb "github.com/caos/zitadel/pkg/grpc/object"
 )
 
 func createActionRequestToDomain(req *mgmt_pb.CreateActionRequest) *domain.Action {
@@ -53,6 +54,59 @@ func listActionsToQuery(orgID string, req *mgmt_pb.ListActionsRequest) (_ *query
 	}, nil
 }
 
+func listActionExecutionsToQuery(actionID, orgID string, listQuery *object_pb.ListQuery) (_ *query.ActionExecutionSearchQueries, err error) {
+	offset, limit, _ := object.ListQueryToModel(listQuery)
+	queries := make([]query.SearchQuery, 2)
+	queries[0], err = query.NewActionExecutionActionIDSearchQuery(actionID)
+	if err != nil {
+		return nil, err
+	}
+	queries[1], err = query.NewActionExecutionResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ActionExecutionSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func createActionLibraryRequestToDomain(req *mgmt_pb.CreateActionLibraryRequest) *domain.ActionLibrary {
+	return &domain.ActionLibrary{
+		Name:   req.Name,
+		Script: req.Script,
+	}
+}
+
+func updateActionLibraryRequestToDomain(req *mgmt_pb.UpdateActionLibraryRequest) *domain.ActionLibrary {
+	return &domain.ActionLibrary{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Id,
+		},
+		Script: req.Script,
+	}
+}
+
+func listActionLibrariesToQuery(orgID string, req *mgmt_pb.ListActionLibrariesRequest) (_ *query.ActionLibrarySearchQueries, err error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries := make([]query.SearchQuery, 1)
+	queries[0], err = query.NewActionLibraryResourceOwnerQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ActionLibrarySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
 func ActionQueryToQuery(query interface{}) (query.SearchQuery, error) {
 	switch q := query.(type) {
 	case *mgmt_pb.ActionQuery_ActionNameQuery:
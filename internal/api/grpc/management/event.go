@@ -0,0 +1,24 @@
+package management
+
+import (
+	"github.com/caos/zitadel/internal/api/authz"
+	event_grpc "github.com/caos/zitadel/internal/api/grpc/event"
+	"github.com/caos/zitadel/internal/query"
+	mgmt_pb "github.com/caos/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) StreamEvents(req *mgmt_pb.StreamEventsRequest, stream mgmt_pb.ManagementService_StreamEventsServer) error {
+	ctx := stream.Context()
+	orgID := authz.GetCtxData(ctx).OrgID
+	features, err := s.query.FeaturesByOrgID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	streamQuery := event_grpc.StreamEventsToQuery(req.Sequence, req.AggregateTypes, req.EventTypes, req.BatchSize, orgID)
+	streamQuery.AuditLogRetention = features.AuditLogRetention
+	return s.query.StreamEvents(ctx, streamQuery, event_grpc.PollInterval, func(event *query.StreamedEvent) error {
+		return stream.Send(&mgmt_pb.StreamEventsResponse{
+			Event: event_grpc.EventToPb(event),
+		})
+	})
+}
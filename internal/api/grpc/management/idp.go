@@ -63,6 +63,36 @@ func (s *Server) AddOrgJWTIDP(ctx context.Context, req *mgmt_pb.AddOrgJWTIDPRequ
 	}, nil
 }
 
+func (s *Server) AddOrgSAMLIDP(ctx context.Context, req *mgmt_pb.AddOrgSAMLIDPRequest) (*mgmt_pb.AddOrgSAMLIDPResponse, error) {
+	config, err := s.command.AddIDPConfig(ctx, addSAMLIDPRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgSAMLIDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) AddOrgLDAPIDP(ctx context.Context, req *mgmt_pb.AddOrgLDAPIDPRequest) (*mgmt_pb.AddOrgLDAPIDPResponse, error) {
+	config, err := s.command.AddIDPConfig(ctx, addLDAPIDPRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgLDAPIDPResponse{
+		IdpId: config.IDPConfigID,
+		Details: object_pb.AddToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) DeactivateOrgIDP(ctx context.Context, req *mgmt_pb.DeactivateOrgIDPRequest) (*mgmt_pb.DeactivateOrgIDPResponse, error) {
 	objectDetails, err := s.command.DeactivateIDPConfig(ctx, req.IdpId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -142,3 +172,31 @@ func (s *Server) UpdateOrgIDPJWTConfig(ctx context.Context, req *mgmt_pb.UpdateO
 		),
 	}, nil
 }
+
+func (s *Server) UpdateOrgIDPSAMLConfig(ctx context.Context, req *mgmt_pb.UpdateOrgIDPSAMLConfigRequest) (*mgmt_pb.UpdateOrgIDPSAMLConfigResponse, error) {
+	config, err := s.command.ChangeIDPSAMLConfig(ctx, updateSAMLConfigToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgIDPSAMLConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateOrgIDPLDAPConfig(ctx context.Context, req *mgmt_pb.UpdateOrgIDPLDAPConfigRequest) (*mgmt_pb.UpdateOrgIDPLDAPConfigResponse, error) {
+	config, err := s.command.ChangeIDPLDAPConfig(ctx, updateLDAPConfigToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgIDPLDAPConfigResponse{
+		Details: object_pb.ChangeToDetailsPb(
+			config.Sequence,
+			config.ChangeDate,
+			config.ResourceOwner,
+		),
+	}, nil
+}
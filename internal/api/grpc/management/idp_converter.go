@@ -54,6 +54,60 @@ func addJWTIDPRequestToDomainJWTIDPConfig(req *mgmt_pb.AddOrgJWTIDPRequest) *dom
 	}
 }
 
+func addSAMLIDPRequestToDomain(req *mgmt_pb.AddOrgSAMLIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		SAMLConfig:   addSAMLIDPRequestToDomainSAMLIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeSAML,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addSAMLIDPRequestToDomainSAMLIDPConfig(req *mgmt_pb.AddOrgSAMLIDPRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		MetadataURL:          req.MetadataUrl,
+		Metadata:             req.Metadata,
+		Binding:              idp_grpc.SAMLBindingToDomain(req.Binding),
+		WithSignedRequest:    req.WithSignedRequest,
+		NameIDFormat:         idp_grpc.SAMLNameIDFormatToDomain(req.NameIdFormat),
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
+func addLDAPIDPRequestToDomain(req *mgmt_pb.AddOrgLDAPIDPRequest) *domain.IDPConfig {
+	return &domain.IDPConfig{
+		Name:         req.Name,
+		LDAPConfig:   addLDAPIDPRequestToDomainLDAPIDPConfig(req),
+		StylingType:  idp_grpc.IDPStylingTypeToDomain(req.StylingType),
+		Type:         domain.IDPConfigTypeLDAP,
+		AutoRegister: req.AutoRegister,
+	}
+}
+
+func addLDAPIDPRequestToDomainLDAPIDPConfig(req *mgmt_pb.AddOrgLDAPIDPRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		URL:                  req.Url,
+		StartTLS:             req.StartTls,
+		BindDN:               req.BindDn,
+		BindPasswordString:   req.BindPassword,
+		BaseDN:               req.BaseDn,
+		UserObjectClass:      req.UserObjectClass,
+		IDAttribute:          req.IdAttribute,
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
 func updateIDPToDomain(req *mgmt_pb.UpdateOrgIDPRequest) *domain.IDPConfig {
 	return &domain.IDPConfig{
 		IDPConfigID:  req.IdpId,
@@ -85,6 +139,42 @@ func updateJWTConfigToDomain(req *mgmt_pb.UpdateOrgIDPJWTConfigRequest) *domain.
 	}
 }
 
+func updateSAMLConfigToDomain(req *mgmt_pb.UpdateOrgIDPSAMLConfigRequest) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		IDPConfigID:          req.IdpId,
+		MetadataURL:          req.MetadataUrl,
+		Metadata:             req.Metadata,
+		Binding:              idp_grpc.SAMLBindingToDomain(req.Binding),
+		WithSignedRequest:    req.WithSignedRequest,
+		NameIDFormat:         idp_grpc.SAMLNameIDFormatToDomain(req.NameIdFormat),
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
+func updateLDAPConfigToDomain(req *mgmt_pb.UpdateOrgIDPLDAPConfigRequest) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		IDPConfigID:          req.IdpId,
+		URL:                  req.Url,
+		StartTLS:             req.StartTls,
+		BindDN:               req.BindDn,
+		BindPasswordString:   req.BindPassword,
+		BaseDN:               req.BaseDn,
+		UserObjectClass:      req.UserObjectClass,
+		IDAttribute:          req.IdAttribute,
+		UsernameAttribute:    req.UsernameAttribute,
+		DisplayNameAttribute: req.DisplayNameAttribute,
+		FirstNameAttribute:   req.FirstNameAttribute,
+		LastNameAttribute:    req.LastNameAttribute,
+		EmailAttribute:       req.EmailAttribute,
+		PhoneAttribute:       req.PhoneAttribute,
+	}
+}
+
 func listIDPsToModel(ctx context.Context, req *mgmt_pb.ListOrgIDPsRequest) (queries *query.IDPSearchQueries, err error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	q, err := idpQueriesToModel(req.Queries)
@@ -47,6 +47,8 @@ func Test_addOIDCIDPRequestToDomain(t *testing.T) {
 				"OIDCConfig.TokenEndpoint",
 				"Type", //TODO: default (0) is oidc
 				"JWTConfig",
+				"SAMLConfig",
+				"LDAPConfig",
 			)
 		})
 	}
@@ -115,6 +117,8 @@ func Test_updateIDPToDomain(t *testing.T) {
 				"ObjectRoot",
 				"OIDCConfig",
 				"JWTConfig",
+				"SAMLConfig",
+				"LDAPConfig",
 				"State",
 				"Type", //TODO: type should not be changeable
 			)
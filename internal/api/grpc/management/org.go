@@ -102,6 +102,135 @@ func (s *Server) ReactivateOrg(ctx context.Context, req *mgmt_pb.ReactivateOrgRe
 	}, err
 }
 
+func (s *Server) RemoveOrg(ctx context.Context, req *mgmt_pb.RemoveOrgRequest) (*mgmt_pb.RemoveOrgResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	memberships, err := s.orgUserMemberships(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	grantIDs, err := s.orgUserGrantIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	projectGrants, err := s.orgProjectGrants(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	idpLinks, err := s.orgIDPUserLinks(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	objectDetails, err := s.command.RemoveOrg(ctx, orgID, memberships, projectGrants, idpLinks, grantIDs...)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) orgUserMemberships(ctx context.Context, orgID string) ([]*query.Membership, error) {
+	resourceOwnerQuery, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.query.SearchUsers(ctx, &query.UserSearchQueries{
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users.Users) == 0 {
+		return nil, nil
+	}
+	userIDs := make([]string, len(users.Users))
+	for i, user := range users.Users {
+		userIDs[i] = user.ID
+	}
+	userIDsQuery, err := query.NewMembershipUserIDsQuery(userIDs...)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := s.query.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{userIDsQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return memberships.Memberships, nil
+}
+
+func (s *Server) orgUserGrantIDs(ctx context.Context, orgID string) ([]string, error) {
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	projectOwnerQuery, err := query.NewUserGrantProjectOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	userOwnerQuery, err := query.NewUserGrantUserResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewOrQuery(resourceOwnerQuery, projectOwnerQuery, userOwnerQuery)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{ownerQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userGrantsToIDs(grants.UserGrants), nil
+}
+
+func (s *Server) orgProjectGrants(ctx context.Context, orgID string) ([]*query.ProjectGrant, error) {
+	grantedOrgQuery, err := query.NewProjectGrantGrantedOrgIDSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.query.SearchProjectGrants(ctx, &query.ProjectGrantSearchQueries{
+		Queries: []query.SearchQuery{grantedOrgQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grants.ProjectGrants, nil
+}
+
+func (s *Server) orgIDPUserLinks(ctx context.Context, orgID string) ([]*domain.UserIDPLink, error) {
+	resourceOwnerQuery, err := query.NewIDPResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	idps, err := s.query.IDPs(ctx, &query.IDPSearchQueries{
+		Queries: []query.SearchQuery{resourceOwnerQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(idps.IDPs) == 0 {
+		return nil, nil
+	}
+	idpIDs := make([]string, len(idps.IDPs))
+	for i, idp := range idps.IDPs {
+		idpIDs[i] = idp.ID
+	}
+	idpIDsQuery, err := query.NewIDPUserLinkIDPIDsSearchQuery(idpIDs)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.query.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{
+		Queries: []query.SearchQuery{idpIDsQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userLinksToDomain(links.Links), nil
+}
+
 func (s *Server) GetOrgIAMPolicy(ctx context.Context, req *mgmt_pb.GetOrgIAMPolicyRequest) (*mgmt_pb.GetOrgIAMPolicyResponse, error) {
 	policy, err := s.query.OrgIAMPolicyByOrg(ctx, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
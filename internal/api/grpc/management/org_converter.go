@@ -67,7 +67,11 @@ func SetPrimaryOrgDomainRequestToDomain(ctx context.Context, req *mgmt_pb.SetPri
 }
 
 func AddOrgMemberRequestToDomain(ctx context.Context, req *mgmt_pb.AddOrgMemberRequest) *domain.Member {
-	return domain.NewMember(authz.GetCtxData(ctx).OrgID, req.UserId, req.Roles...)
+	member := domain.NewMember(authz.GetCtxData(ctx).OrgID, req.UserId, req.Roles...)
+	if req.ExpirationDate != nil {
+		member.ExpirationDate = req.ExpirationDate.AsTime()
+	}
+	return member
 }
 
 func UpdateOrgMemberRequestToDomain(ctx context.Context, req *mgmt_pb.UpdateOrgMemberRequest) *domain.Member {
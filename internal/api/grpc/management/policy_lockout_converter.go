@@ -8,11 +8,19 @@ import (
 func AddLockoutPolicyToDomain(p *mgmt.AddCustomLockoutPolicyRequest) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
 		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
+		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		MaxU2FAttempts:      uint64(p.MaxU2FAttempts),
+		LockoutDuration:     p.LockoutDuration.AsDuration(),
+		BackoffDelay:        p.BackoffDelay.AsDuration(),
 	}
 }
 
 func UpdateLockoutPolicyToDomain(p *mgmt.UpdateCustomLockoutPolicyRequest) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
 		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
+		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		MaxU2FAttempts:      uint64(p.MaxU2FAttempts),
+		LockoutDuration:     p.LockoutDuration.AsDuration(),
+		BackoffDelay:        p.BackoffDelay.AsDuration(),
 	}
 }
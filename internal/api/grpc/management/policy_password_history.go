@@ -0,0 +1,68 @@
+package management
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/caos/zitadel/internal/api/grpc/policy"
+	mgmt_pb "github.com/caos/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetPasswordHistoryPolicy(ctx context.Context, req *mgmt_pb.GetPasswordHistoryPolicyRequest) (*mgmt_pb.GetPasswordHistoryPolicyResponse, error) {
+	policy, err := s.query.PasswordHistoryPolicyByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetPasswordHistoryPolicyResponse{
+		Policy: policy_grpc.ModelPasswordHistoryPolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) GetDefaultPasswordHistoryPolicy(ctx context.Context, req *mgmt_pb.GetDefaultPasswordHistoryPolicyRequest) (*mgmt_pb.GetDefaultPasswordHistoryPolicyResponse, error) {
+	policy, err := s.query.DefaultPasswordHistoryPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetDefaultPasswordHistoryPolicyResponse{
+		Policy: policy_grpc.ModelPasswordHistoryPolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) AddCustomPasswordHistoryPolicy(ctx context.Context, req *mgmt_pb.AddCustomPasswordHistoryPolicyRequest) (*mgmt_pb.AddCustomPasswordHistoryPolicyResponse, error) {
+	result, err := s.command.AddPasswordHistoryPolicy(ctx, authz.GetCtxData(ctx).OrgID, AddPasswordHistoryPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddCustomPasswordHistoryPolicyResponse{
+		Details: object.AddToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateCustomPasswordHistoryPolicy(ctx context.Context, req *mgmt_pb.UpdateCustomPasswordHistoryPolicyRequest) (*mgmt_pb.UpdateCustomPasswordHistoryPolicyResponse, error) {
+	result, err := s.command.ChangePasswordHistoryPolicy(ctx, authz.GetCtxData(ctx).OrgID, UpdatePasswordHistoryPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateCustomPasswordHistoryPolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetPasswordHistoryPolicyToDefault(ctx context.Context, req *mgmt_pb.ResetPasswordHistoryPolicyToDefaultRequest) (*mgmt_pb.ResetPasswordHistoryPolicyToDefaultResponse, error) {
+	objectDetails, err := s.command.RemovePasswordHistoryPolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetPasswordHistoryPolicyToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
@@ -69,6 +69,17 @@ func AddAPIAppRequestToDomain(app *mgmt_pb.AddAPIAppRequest) *domain.APIApp {
 	}
 }
 
+func AddSAMLAppRequestToDomain(app *mgmt_pb.AddSAMLAppRequest) *domain.SAMLApp {
+	return &domain.SAMLApp{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: app.ProjectId,
+		},
+		AppName:     app.Name,
+		Metadata:    app.Metadata,
+		MetadataURL: app.MetadataUrl,
+	}
+}
+
 func UpdateAppRequestToDomain(app *mgmt_pb.UpdateAppRequest) domain.Application {
 	return &domain.ChangeApp{
 		AppID:   app.AppId,
@@ -108,6 +119,17 @@ func UpdateAPIAppConfigRequestToDomain(app *mgmt_pb.UpdateAPIAppConfigRequest) *
 	}
 }
 
+func UpdateSAMLAppConfigRequestToDomain(app *mgmt_pb.UpdateSAMLAppConfigRequest) *domain.SAMLApp {
+	return &domain.SAMLApp{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: app.ProjectId,
+		},
+		AppID:       app.AppId,
+		Metadata:    app.Metadata,
+		MetadataURL: app.MetadataUrl,
+	}
+}
+
 func AddAPIClientKeyRequestToDomain(key *mgmt_pb.AddAppKeyRequest) *domain.ApplicationKey {
 	expirationDate := time.Time{}
 	if key.ExpirationDate != nil {
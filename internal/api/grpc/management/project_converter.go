@@ -16,11 +16,13 @@ import (
 
 func ProjectCreateToDomain(req *mgmt_pb.AddProjectRequest) *domain.Project {
 	return &domain.Project{
-		Name:                   req.Name,
-		ProjectRoleAssertion:   req.ProjectRoleAssertion,
-		ProjectRoleCheck:       req.ProjectRoleCheck,
-		HasProjectCheck:        req.HasProjectCheck,
-		PrivateLabelingSetting: privateLabelingSettingToDomain(req.PrivateLabelingSetting),
+		Name:                       req.Name,
+		ProjectRoleAssertion:       req.ProjectRoleAssertion,
+		ProjectRoleCheck:           req.ProjectRoleCheck,
+		HasProjectCheck:            req.HasProjectCheck,
+		PrivateLabelingSetting:     privateLabelingSettingToDomain(req.PrivateLabelingSetting),
+		TokenExchangeDelegation:    req.TokenExchangeDelegation,
+		TokenExchangeImpersonation: req.TokenExchangeImpersonation,
 	}
 }
 
@@ -29,11 +31,13 @@ func ProjectUpdateToDomain(req *mgmt_pb.UpdateProjectRequest) *domain.Project {
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.Id,
 		},
-		Name:                   req.Name,
-		ProjectRoleAssertion:   req.ProjectRoleAssertion,
-		ProjectRoleCheck:       req.ProjectRoleCheck,
-		HasProjectCheck:        req.HasProjectCheck,
-		PrivateLabelingSetting: privateLabelingSettingToDomain(req.PrivateLabelingSetting),
+		Name:                       req.Name,
+		ProjectRoleAssertion:       req.ProjectRoleAssertion,
+		ProjectRoleCheck:           req.ProjectRoleCheck,
+		HasProjectCheck:            req.HasProjectCheck,
+		PrivateLabelingSetting:     privateLabelingSettingToDomain(req.PrivateLabelingSetting),
+		TokenExchangeDelegation:    req.TokenExchangeDelegation,
+		TokenExchangeImpersonation: req.TokenExchangeImpersonation,
 	}
 }
 
@@ -94,7 +98,11 @@ func ProjectGrantsToIDs(projectGrants *query.ProjectGrants) []string {
 }
 
 func AddProjectMemberRequestToDomain(req *mgmt_pb.AddProjectMemberRequest) *domain.Member {
-	return domain.NewMember(req.ProjectId, req.UserId, req.Roles...)
+	member := domain.NewMember(req.ProjectId, req.UserId, req.Roles...)
+	if req.ExpirationDate != nil {
+		member.ExpirationDate = req.ExpirationDate.AsTime()
+	}
+	return member
 }
 
 func UpdateProjectMemberRequestToDomain(req *mgmt_pb.UpdateProjectMemberRequest) *domain.Member {
@@ -0,0 +1,46 @@
+package management
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	mgmt_pb "github.com/caos/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetOrgSMSProviders(ctx context.Context, req *mgmt_pb.GetOrgSMSProvidersRequest) (*mgmt_pb.GetOrgSMSProvidersResponse, error) {
+	providers, err := s.query.OrgSMSProvidersByOrgID(ctx, authz.GetCtxData(ctx).OrgID)
+	if caos_errs.IsNotFound(err) {
+		return &mgmt_pb.GetOrgSMSProvidersResponse{
+			IsDefault: true,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetOrgSMSProvidersResponse{
+		Details:     object.ChangeToDetailsPb(providers.Sequence, providers.ChangeDate, providers.OrgID),
+		ProviderIds: providers.ProviderIDs,
+	}, nil
+}
+
+func (s *Server) SetOrgSMSProviders(ctx context.Context, req *mgmt_pb.SetOrgSMSProvidersRequest) (*mgmt_pb.SetOrgSMSProvidersResponse, error) {
+	details, err := s.command.SetOrgSMSProviders(ctx, authz.GetCtxData(ctx).OrgID, req.ProviderIds)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgSMSProvidersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ResetOrgSMSProvidersToDefault(ctx context.Context, req *mgmt_pb.ResetOrgSMSProvidersToDefaultRequest) (*mgmt_pb.ResetOrgSMSProvidersToDefaultResponse, error) {
+	details, err := s.command.ResetOrgSMSProviders(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetOrgSMSProvidersToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
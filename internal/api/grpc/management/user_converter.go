@@ -117,8 +117,11 @@ func ImportHumanUserRequestToDomain(req *mgmt_pb.ImportHumanUserRequest) (human
 			IsPhoneVerified: req.Phone.IsPhoneVerified,
 		}
 	}
-	if req.Password != "" {
-		human.Password = &domain.Password{SecretString: req.Password}
+	if req.Password != "" || req.HashedPassword != nil {
+		human.Password = &domain.Password{
+			SecretString: req.Password,
+			EncodedHash:  req.HashedPassword.GetValue(),
+		}
 		human.Password.ChangeRequired = req.PasswordChangeRequired
 	}
 
@@ -2,6 +2,7 @@ package management
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/api/authz"
 	"github.com/caos/zitadel/internal/api/grpc/object"
@@ -50,11 +51,16 @@ func shouldAppendUserGrantOwnerQuery(queries []*user.UserGrantQuery) bool {
 }
 
 func AddUserGrantRequestToDomain(req *mgmt_pb.AddUserGrantRequest) *domain.UserGrant {
+	expirationDate := time.Time{}
+	if req.ExpirationDate != nil {
+		expirationDate = req.ExpirationDate.AsTime()
+	}
 	return &domain.UserGrant{
 		UserID:         req.UserId,
 		ProjectID:      req.ProjectId,
 		ProjectGrantID: req.ProjectGrantId,
 		RoleKeys:       req.RoleKeys,
+		ExpirationDate: expirationDate,
 	}
 }
 
@@ -0,0 +1,138 @@
+package management
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	obj_grpc "github.com/caos/zitadel/internal/api/grpc/object"
+	webhook_grpc "github.com/caos/zitadel/internal/api/grpc/webhook"
+	mgmt_pb "github.com/caos/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListWebhooks(ctx context.Context, req *mgmt_pb.ListWebhooksRequest) (*mgmt_pb.ListWebhooksResponse, error) {
+	query, err := webhook_grpc.ListWebhooksToQuery(authz.GetCtxData(ctx).OrgID, req.Query, req.SortingColumn, req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.query.SearchWebhooks(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListWebhooksResponse{
+		Details:       obj_grpc.ToListDetails(webhooks.Count, webhooks.Sequence, webhooks.Timestamp),
+		SortingColumn: req.SortingColumn,
+		Result:        webhook_grpc.WebhooksToPb(webhooks.Webhooks),
+	}, nil
+}
+
+func (s *Server) GetWebhook(ctx context.Context, req *mgmt_pb.GetWebhookRequest) (*mgmt_pb.GetWebhookResponse, error) {
+	webhook, err := s.query.GetWebhookByID(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetWebhookResponse{
+		Webhook: webhook_grpc.WebhookToPb(webhook),
+	}, nil
+}
+
+func (s *Server) CreateWebhook(ctx context.Context, req *mgmt_pb.CreateWebhookRequest) (*mgmt_pb.CreateWebhookResponse, error) {
+	id, signingKey, details, err := s.command.AddWebhook(ctx, webhook_grpc.WebhookToDomain("", req.Name, req.Url, req.EventTypes), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CreateWebhookResponse{
+		Id:         id,
+		SigningKey: signingKey,
+		Details: obj_grpc.AddToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateWebhook(ctx context.Context, req *mgmt_pb.UpdateWebhookRequest) (*mgmt_pb.UpdateWebhookResponse, error) {
+	details, err := s.command.ChangeWebhook(ctx, webhook_grpc.WebhookToDomain(req.Id, req.Name, req.Url, req.EventTypes), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) RegenerateWebhookSigningKey(ctx context.Context, req *mgmt_pb.RegenerateWebhookSigningKeyRequest) (*mgmt_pb.RegenerateWebhookSigningKeyResponse, error) {
+	signingKey, details, err := s.command.RegenerateWebhookSigningKey(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RegenerateWebhookSigningKeyResponse{
+		SigningKey: signingKey,
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) DeactivateWebhook(ctx context.Context, req *mgmt_pb.DeactivateWebhookRequest) (*mgmt_pb.DeactivateWebhookResponse, error) {
+	details, err := s.command.DeactivateWebhook(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ReactivateWebhook(ctx context.Context, req *mgmt_pb.ReactivateWebhookRequest) (*mgmt_pb.ReactivateWebhookResponse, error) {
+	details, err := s.command.ReactivateWebhook(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ReactivateWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *mgmt_pb.DeleteWebhookRequest) (*mgmt_pb.DeleteWebhookResponse, error) {
+	details, err := s.command.RemoveWebhook(ctx, req.Id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeleteWebhookResponse{
+		Details: obj_grpc.ChangeToDetailsPb(
+			details.Sequence,
+			details.EventDate,
+			details.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ListWebhookFailedDeliveries(ctx context.Context, req *mgmt_pb.ListWebhookFailedDeliveriesRequest) (*mgmt_pb.ListWebhookFailedDeliveriesResponse, error) {
+	query, err := webhook_grpc.ListFailedDeliveriesToQuery(req.Id, authz.GetCtxData(ctx).OrgID, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	failedDeliveries, err := s.query.SearchWebhookFailedDeliveries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListWebhookFailedDeliveriesResponse{
+		Details: obj_grpc.ToListDetails(failedDeliveries.Count, failedDeliveries.Sequence, failedDeliveries.Timestamp),
+		Result:  webhook_grpc.FailedDeliveriesToPb(failedDeliveries.FailedDeliveries),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package policy
+
+import (
+	"github.com/caos/zitadel/internal/api/grpc/object"
+	"github.com/caos/zitadel/internal/query"
+	policy_pb "github.com/caos/zitadel/pkg/grpc/policy"
+)
+
+func ModelPasswordHistoryPolicyToPb(policy *query.PasswordHistoryPolicy) *policy_pb.PasswordHistoryPolicy {
+	return &policy_pb.PasswordHistoryPolicy{
+		IsDefault:    policy.IsDefault,
+		HistoryCount: policy.HistoryCount,
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/caos/zitadel/internal/api/grpc/object"
 	"github.com/caos/zitadel/internal/query"
 	policy_pb "github.com/caos/zitadel/pkg/grpc/policy"
@@ -10,6 +12,10 @@ func ModelLockoutPolicyToPb(policy *query.LockoutPolicy) *policy_pb.LockoutPolic
 	return &policy_pb.LockoutPolicy{
 		IsDefault:           policy.IsDefault,
 		MaxPasswordAttempts: policy.MaxPasswordAttempts,
+		MaxOtpAttempts:      policy.MaxOTPAttempts,
+		MaxU2FAttempts:      policy.MaxU2FAttempts,
+		LockoutDuration:     durationpb.New(policy.LockoutDuration),
+		BackoffDelay:        durationpb.New(policy.BackoffDelay),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
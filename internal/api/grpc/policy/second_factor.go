@@ -11,6 +11,10 @@ func SecondFactorTypeToDomain(secondFactorType policy_pb.SecondFactorType) domai
 		return domain.SecondFactorTypeOTP
 	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_U2F:
 		return domain.SecondFactorTypeU2F
+	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL:
+		return domain.SecondFactorTypeOTPEmail
+	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS:
+		return domain.SecondFactorTypeOTPSMS
 	default:
 		return domain.SecondFactorTypeUnspecified
 	}
@@ -30,6 +34,10 @@ func ModelSecondFactorTypeToPb(secondFactorType domain.SecondFactorType) policy_
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP
 	case domain.SecondFactorTypeU2F:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_U2F
+	case domain.SecondFactorTypeOTPEmail:
+		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_EMAIL
+	case domain.SecondFactorTypeOTPSMS:
+		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
 	default:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -42,3 +43,41 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	span.End()
 	return handler(ctxSetter(ctx), req)
 }
+
+func AuthorizationStreamInterceptor(verifier *authz.TokenVerifier, authConfig authz.Config) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return authorizeStream(srv, stream, info, handler, verifier, authConfig)
+	}
+}
+
+//authorizeStream checks the permissions before the request is received
+//therefore the fields of the request can't be checked (authz.Option.CheckParam)
+func authorizeStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler, verifier *authz.TokenVerifier, authConfig authz.Config) (err error) {
+	authOpt, needsToken := verifier.CheckAuthMethod(info.FullMethod)
+	if !needsToken {
+		return handler(srv, stream)
+	}
+	if authOpt.CheckParam != "" {
+		return status.Error(codes.Unimplemented, "check param not supported on streams")
+	}
+
+	ctx := stream.Context()
+	authCtx, span := tracing.NewServerInterceptorSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	authToken := grpc_util.GetAuthorizationHeader(authCtx)
+	if authToken == "" {
+		return status.Error(codes.Unauthenticated, "auth header missing")
+	}
+
+	orgID := grpc_util.GetHeader(authCtx, http.ZitadelOrgID)
+
+	ctxSetter, err := authz.CheckUserAuthorization(authCtx, nil, authToken, orgID, verifier, authConfig, authOpt, info.FullMethod)
+	if err != nil {
+		return err
+	}
+	span.End()
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = ctxSetter(ctx)
+	return handler(srv, wrapped)
+}
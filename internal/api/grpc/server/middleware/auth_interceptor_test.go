@@ -153,3 +153,82 @@ func Test_authorize(t *testing.T) {
 		})
 	}
 }
+
+func Test_authorizeStream(t *testing.T) {
+	type args struct {
+		stream   grpc.ServerStream
+		info     *grpc.StreamServerInfo
+		handler  grpc.StreamHandler
+		verifier *authz.TokenVerifier
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			"no token needed ok",
+			args{
+				stream:  &mockServerStream{ctx: context.Background()},
+				info:    mockStreamInfo("/no/token/needed"),
+				handler: emptyMockStreamHandler,
+				verifier: func() *authz.TokenVerifier {
+					verifier := authz.Start(&verifierMock{})
+					verifier.RegisterServer("need", "need", authz.MethodMapping{})
+					return verifier
+				}(),
+			},
+			false,
+		},
+		{
+			"auth header missing error",
+			args{
+				stream:  &mockServerStream{ctx: context.Background()},
+				info:    mockStreamInfo("/need/authentication"),
+				handler: emptyMockStreamHandler,
+				verifier: func() *authz.TokenVerifier {
+					verifier := authz.Start(&verifierMock{})
+					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated"}})
+					return verifier
+				}(),
+			},
+			true,
+		},
+		{
+			"check param error",
+			args{
+				stream:  &mockServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))},
+				info:    mockStreamInfo("/need/authentication"),
+				handler: emptyMockStreamHandler,
+				verifier: func() *authz.TokenVerifier {
+					verifier := authz.Start(&verifierMock{})
+					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated", CheckParam: "ID"}})
+					return verifier
+				}(),
+			},
+			true,
+		},
+		{
+			"authorized ok",
+			args{
+				stream:  &mockServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))},
+				info:    mockStreamInfo("/need/authentication"),
+				handler: emptyMockStreamHandler,
+				verifier: func() *authz.TokenVerifier {
+					verifier := authz.Start(&verifierMock{})
+					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated"}})
+					return verifier
+				}(),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeStream(nil, tt.args.stream, tt.args.info, tt.args.handler, tt.args.verifier, authz.Config{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authorizeStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
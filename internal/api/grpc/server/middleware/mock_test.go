@@ -24,3 +24,23 @@ func mockInfo(path string) *grpc.UnaryServerInfo {
 		FullMethod: path,
 	}
 }
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func emptyMockStreamHandler(interface{}, grpc.ServerStream) error {
+	return nil
+}
+
+func mockStreamInfo(path string) *grpc.StreamServerInfo {
+	return &grpc.StreamServerInfo{
+		FullMethod:     path,
+		IsServerStream: true,
+	}
+}
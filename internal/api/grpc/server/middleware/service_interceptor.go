@@ -5,6 +5,7 @@ import (
 
 	"github.com/caos/zitadel/internal/api/service"
 	_ "github.com/caos/zitadel/internal/statik"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 )
 
@@ -15,3 +16,12 @@ func ServiceHandler() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+func ServiceStreamHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		namer := srv.(interface{ AppName() string })
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = service.WithService(stream.Context(), namer.AppName())
+		return handler(srv, wrapped)
+	}
+}
@@ -24,3 +24,15 @@ func TranslationHandler(defaultLanguage language.Tag) func(ctx context.Context,
 		return resp, err
 	}
 }
+
+func TranslationStreamHandler(defaultLanguage language.Tag) grpc.StreamServerInterceptor {
+	translator := newZitadelTranslator(defaultLanguage)
+
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		if err != nil {
+			err = translateError(stream.Context(), err, translator)
+		}
+		return err
+	}
+}
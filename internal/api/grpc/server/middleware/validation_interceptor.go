@@ -17,6 +17,31 @@ func ValidationHandler() grpc.UnaryServerInterceptor {
 	}
 }
 
+func ValidationStreamHandler() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: stream})
+	}
+}
+
+//validatingServerStream validates every received message
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	validate, ok := m.(validator)
+	if !ok {
+		return nil
+	}
+	if err := validate.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
 //validator interface needed for github.com/envoyproxy/protoc-gen-validate
 //(it does not expose an interface itself)
 type validator interface {
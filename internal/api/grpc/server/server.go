@@ -44,6 +44,16 @@ func CreateServer(verifier *authz.TokenVerifier, authConfig authz.Config, lang l
 				middleware.ServiceHandler(),
 			),
 		),
+		grpc.StreamInterceptor(
+			grpc_middleware.ChainStreamServer(
+				middleware.DefaultTracingStreamServer(),
+				middleware.ErrorStreamHandler(),
+				middleware.AuthorizationStreamInterceptor(verifier, authConfig),
+				middleware.TranslationStreamHandler(lang),
+				middleware.ValidationStreamHandler(),
+				middleware.ServiceStreamHandler(),
+			),
+		),
 	)
 }
 
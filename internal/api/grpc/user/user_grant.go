@@ -3,6 +3,9 @@ package user
 import (
 	"context"
 	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/caos/zitadel/internal/api/authz"
 	"github.com/caos/zitadel/internal/api/grpc/object"
@@ -37,6 +40,7 @@ func UserGrantToPb(assetPrefix string, grant *query.UserGrant) *user_pb.UserGran
 		OrgName:        grant.OrgName,
 		ProjectName:    grant.ProjectName,
 		AvatarUrl:      domain.AvatarURL(assetPrefix, grant.UserResourceOwner, grant.AvatarURL),
+		ExpirationDate: ExpirationDateToPb(grant.ExpirationDate),
 		Details: object.ToViewDetailsPb(
 			grant.Sequence,
 			grant.CreationDate,
@@ -46,6 +50,14 @@ func UserGrantToPb(assetPrefix string, grant *query.UserGrant) *user_pb.UserGran
 	}
 }
 
+//ExpirationDateToPb returns nil if no expiration date is set
+func ExpirationDateToPb(expirationDate time.Time) *timestamppb.Timestamp {
+	if expirationDate.IsZero() {
+		return nil
+	}
+	return timestamppb.New(expirationDate)
+}
+
 func UserGrantQueriesToQuery(ctx context.Context, queries []*user_pb.UserGrantQuery) (q []query.SearchQuery, err error) {
 	q = make([]query.SearchQuery, len(queries))
 	for i, query := range queries {
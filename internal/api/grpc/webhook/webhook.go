@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	object_grpc "github.com/caos/zitadel/internal/api/grpc/object"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/query"
+	object_pb "github.com/caos/zitadel/pkg/grpc/object"
+	webhook_pb "github.com/caos/zitadel/pkg/grpc/webhook"
+)
+
+func WebhookToDomain(id, name, url string, eventTypes []string) *domain.Webhook {
+	return &domain.Webhook{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: id,
+		},
+		Name:       name,
+		URL:        url,
+		EventTypes: eventTypes,
+	}
+}
+
+func WebhooksToPb(webhooks []*query.Webhook) []*webhook_pb.Webhook {
+	list := make([]*webhook_pb.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		list[i] = WebhookToPb(webhook)
+	}
+	return list
+}
+
+func WebhookToPb(webhook *query.Webhook) *webhook_pb.Webhook {
+	return &webhook_pb.Webhook{
+		Id:         webhook.ID,
+		Details:    object_grpc.ChangeToDetailsPb(webhook.Sequence, webhook.ChangeDate, webhook.ResourceOwner),
+		State:      WebhookStateToPb(webhook.State),
+		Name:       webhook.Name,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+	}
+}
+
+func WebhookStateToPb(state domain.WebhookState) webhook_pb.WebhookState {
+	switch state {
+	case domain.WebhookStateActive:
+		return webhook_pb.WebhookState_WEBHOOK_STATE_ACTIVE
+	case domain.WebhookStateInactive:
+		return webhook_pb.WebhookState_WEBHOOK_STATE_INACTIVE
+	default:
+		return webhook_pb.WebhookState_WEBHOOK_STATE_UNSPECIFIED
+	}
+}
+
+func WebhookStateToDomain(state webhook_pb.WebhookState) domain.WebhookState {
+	switch state {
+	case webhook_pb.WebhookState_WEBHOOK_STATE_ACTIVE:
+		return domain.WebhookStateActive
+	case webhook_pb.WebhookState_WEBHOOK_STATE_INACTIVE:
+		return domain.WebhookStateInactive
+	default:
+		return domain.WebhookStateUnspecified
+	}
+}
+
+func FieldNameToModel(fieldName webhook_pb.WebhookFieldName) query.Column {
+	switch fieldName {
+	case webhook_pb.WebhookFieldName_WEBHOOK_FIELD_NAME_NAME:
+		return query.WebhookColumnName
+	case webhook_pb.WebhookFieldName_WEBHOOK_FIELD_NAME_ID:
+		return query.WebhookColumnID
+	case webhook_pb.WebhookFieldName_WEBHOOK_FIELD_NAME_STATE:
+		return query.WebhookColumnState
+	default:
+		return query.Column{}
+	}
+}
+
+func ListWebhooksToQuery(resourceOwner string, listQuery *object_pb.ListQuery, sortingColumn webhook_pb.WebhookFieldName, webhookQueries []*webhook_pb.WebhookQuery) (_ *query.WebhookSearchQueries, err error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(listQuery)
+	queries := make([]query.SearchQuery, len(webhookQueries)+1)
+	queries[0], err = query.NewWebhookResourceOwnerQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	for i, webhookQuery := range webhookQueries {
+		queries[i+1], err = WebhookQueryToQuery(webhookQuery.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.WebhookSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: FieldNameToModel(sortingColumn),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func WebhookQueryToQuery(q interface{}) (query.SearchQuery, error) {
+	switch q := q.(type) {
+	case *webhook_pb.WebhookQuery_NameQuery:
+		return query.NewWebhookNameSearchQuery(object_grpc.TextMethodToQuery(q.NameQuery.Method), q.NameQuery.Name)
+	case *webhook_pb.WebhookQuery_StateQuery:
+		return query.NewWebhookStateSearchQuery(WebhookStateToDomain(q.StateQuery.State))
+	}
+	return nil, nil
+}
+
+func ListFailedDeliveriesToQuery(webhookID, resourceOwner string, listQuery *object_pb.ListQuery) (_ *query.WebhookFailedDeliverySearchQueries, err error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(listQuery)
+	queries := make([]query.SearchQuery, 2)
+	queries[0], err = query.NewWebhookFailedDeliveryWebhookIDQuery(webhookID)
+	if err != nil {
+		return nil, err
+	}
+	queries[1], err = query.NewWebhookFailedDeliveryResourceOwnerQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.WebhookFailedDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func FailedDeliveriesToPb(failedDeliveries []*query.WebhookFailedDelivery) []*webhook_pb.FailedDelivery {
+	list := make([]*webhook_pb.FailedDelivery, len(failedDeliveries))
+	for i, failedDelivery := range failedDeliveries {
+		list[i] = &webhook_pb.FailedDelivery{
+			WebhookId:      failedDelivery.WebhookID,
+			FailedSequence: failedDelivery.FailedSequence,
+			AggregateType:  failedDelivery.AggregateType,
+			AggregateId:    failedDelivery.AggregateID,
+			EventType:      failedDelivery.EventType,
+			FailureCount:   failedDelivery.FailureCount,
+			ErrorMessage:   failedDelivery.Error,
+			LastFailed:     timestamppb.New(failedDelivery.LastFailed),
+		}
+	}
+	return list
+}
@@ -13,18 +13,30 @@ import (
 type AuthInterceptor struct {
 	verifier   *authz.TokenVerifier
 	authConfig authz.Config
+	methodFn   func(r *http.Request) string
 }
 
 func AuthorizationInterceptor(verifier *authz.TokenVerifier, authConfig authz.Config) *AuthInterceptor {
 	return &AuthInterceptor{
 		verifier:   verifier,
 		authConfig: authConfig,
+		methodFn:   requestURI,
+	}
+}
+
+//WithMethod returns an interceptor which checks the method returned by methodFn instead of the request uri
+//e.g. the route template for routes containing path parameters
+func (a *AuthInterceptor) WithMethod(methodFn func(r *http.Request) string) *AuthInterceptor {
+	return &AuthInterceptor{
+		verifier:   a.verifier,
+		authConfig: a.authConfig,
+		methodFn:   methodFn,
 	}
 }
 
 func (a *AuthInterceptor) Handler(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx, err := authorize(r, a.verifier, a.authConfig)
+		ctx, err := authorize(r, a.verifier, a.authConfig, a.methodFn(r))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusUnauthorized)
 			return
@@ -36,7 +48,7 @@ func (a *AuthInterceptor) Handler(next http.Handler) http.Handler {
 
 func (a *AuthInterceptor) HandlerFunc(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx, err := authorize(r, a.verifier, a.authConfig)
+		ctx, err := authorize(r, a.verifier, a.authConfig, a.methodFn(r))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusUnauthorized)
 			return
@@ -48,9 +60,13 @@ func (a *AuthInterceptor) HandlerFunc(next http.HandlerFunc) http.HandlerFunc {
 
 type httpReq struct{}
 
-func authorize(r *http.Request, verifier *authz.TokenVerifier, authConfig authz.Config) (_ context.Context, err error) {
+func requestURI(r *http.Request) string {
+	return r.RequestURI
+}
+
+func authorize(r *http.Request, verifier *authz.TokenVerifier, authConfig authz.Config, method string) (_ context.Context, err error) {
 	ctx := r.Context()
-	authOpt, needsToken := verifier.CheckAuthMethod(r.Method + ":" + r.RequestURI)
+	authOpt, needsToken := verifier.CheckAuthMethod(r.Method + ":" + method)
 	if !needsToken {
 		return ctx, nil
 	}
@@ -62,7 +78,7 @@ func authorize(r *http.Request, verifier *authz.TokenVerifier, authConfig authz.
 		return nil, errors.New("auth header missing")
 	}
 
-	ctxSetter, err := authz.CheckUserAuthorization(authCtx, &httpReq{}, authToken, http_util.GetOrgID(r), verifier, authConfig, authOpt, r.RequestURI) //TODO: permission
+	ctxSetter, err := authz.CheckUserAuthorization(authCtx, &httpReq{}, authToken, http_util.GetOrgID(r), verifier, authConfig, authOpt, method) //TODO: permission
 	if err != nil {
 		return nil, err
 	}
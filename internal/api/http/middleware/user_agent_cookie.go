@@ -22,6 +22,10 @@ func UserAgentIDFromCtx(ctx context.Context) (string, bool) {
 	return userAgentID, ok
 }
 
+func UserAgentIDToCtx(ctx context.Context, userAgentID string) context.Context {
+	return context.WithValue(ctx, userAgentKey, userAgentID)
+}
+
 type UserAgent struct {
 	ID string
 }
@@ -82,11 +82,14 @@ func (o *OPStorage) CreateAccessToken(ctx context.Context, req op.TokenRequest)
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 	var userAgentID, applicationID, userOrgID string
-	authReq, ok := req.(*AuthRequest)
-	if ok {
-		userAgentID = authReq.AgentID
-		applicationID = authReq.ApplicationID
-		userOrgID = authReq.UserOrgID
+	switch tokenReq := req.(type) {
+	case *AuthRequest:
+		userAgentID = tokenReq.AgentID
+		applicationID = tokenReq.ApplicationID
+		userOrgID = tokenReq.UserOrgID
+	case *DeviceTokenRequest:
+		applicationID = tokenReq.ClientID
+		userOrgID = tokenReq.UserOrgID
 	}
 	resp, err := o.command.AddUserToken(ctx, userOrgID, userAgentID, applicationID, req.GetSubject(), req.GetAudience(), req.GetScopes(), o.defaultAccessTokenLifetime) //PLANNED: lifetime from client
 	if err != nil {
@@ -137,6 +140,10 @@ func getInfoFromRequest(req op.TokenRequest) (string, string, string, time.Time,
 	if ok {
 		return refreshReq.UserAgentID, refreshReq.ClientID, "", refreshReq.AuthTime, refreshReq.AuthMethodsReferences
 	}
+	deviceReq, ok := req.(*DeviceTokenRequest)
+	if ok {
+		return "", deviceReq.ClientID, deviceReq.UserOrgID, deviceReq.AuthTime, deviceReq.AMR
+	}
 	return "", "", "", time.Time{}, nil
 }
 
@@ -199,10 +206,8 @@ func (o *OPStorage) RevokeToken(ctx context.Context, token, userID, clientID str
 }
 
 func (o *OPStorage) assertProjectRoleScopes(ctx context.Context, clientID string, scopes []string) ([]string, error) {
-	for _, scope := range scopes {
-		if strings.HasPrefix(scope, ScopeProjectRolePrefix) {
-			return scopes, nil
-		}
+	if hasProjectRoleScope(scopes) {
+		return scopes, nil
 	}
 	projectID, err := o.query.ProjectIDFromOIDCClientID(ctx, clientID)
 	if err != nil {
@@ -212,6 +217,11 @@ func (o *OPStorage) assertProjectRoleScopes(ctx context.Context, clientID string
 	if err != nil {
 		return nil, errors.ThrowPreconditionFailed(nil, "OIDC-w4wIn", "Errors.Internal")
 	}
+	return o.assertRoleScopesOfProject(ctx, project, scopes)
+}
+
+// assertRoleScopesOfProject adds the scopes of all roles of the project if its role assertion is enabled
+func (o *OPStorage) assertRoleScopesOfProject(ctx context.Context, project *query.Project, scopes []string) ([]string, error) {
 	if !project.ProjectRoleAssertion {
 		return scopes, nil
 	}
@@ -229,6 +239,15 @@ func (o *OPStorage) assertProjectRoleScopes(ctx context.Context, clientID string
 	return scopes, nil
 }
 
+func hasProjectRoleScope(scopes []string) bool {
+	for _, scope := range scopes {
+		if strings.HasPrefix(scope, ScopeProjectRolePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *OPStorage) assertClientScopesForPAT(ctx context.Context, token *model.TokenView, clientID string) error {
 	token.Audience = append(token.Audience, clientID)
 	projectID, err := o.query.ProjectIDFromClientID(ctx, clientID)
@@ -276,7 +276,10 @@ func CodeChallengeToOIDC(challenge *domain.OIDCCodeChallenge) *oidc.CodeChalleng
 
 func AMRFromMFAType(mfaType domain.MFAType) string {
 	switch mfaType {
-	case domain.MFATypeOTP:
+	case domain.MFATypeOTP,
+		domain.MFATypeOTPEmail,
+		domain.MFATypeOTPSMS,
+		domain.MFATypeRecoveryCode:
 		return amrOTP
 	case domain.MFATypeU2F,
 		domain.MFATypeU2FUserVerification:
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"strings"
+	"time"
 
 	"github.com/caos/oidc/pkg/oidc"
 	"github.com/caos/oidc/pkg/op"
@@ -26,6 +27,7 @@ const (
 	ClaimUserMetaData      = ScopeUserMetaData
 	ScopeResourceOwner     = "urn:zitadel:iam:user:resourceowner"
 	ClaimResourceOwner     = ScopeResourceOwner + ":"
+	ClaimActor             = "act"
 
 	oidcCtx = "oidc"
 )
@@ -177,6 +179,9 @@ func (o *OPStorage) SetIntrospectionFromToken(ctx context.Context, introspection
 			}
 			introspection.SetScopes(token.Scopes)
 			introspection.SetClientID(token.ApplicationID)
+			if token.ActorSubject != "" {
+				introspection.AppendClaims(ClaimActor, actorClaim(token.ActorSubject, token.ActorClientID))
+			}
 			return nil
 		}
 	}
@@ -191,6 +196,7 @@ func (o *OPStorage) setUserinfo(ctx context.Context, userInfo oidc.UserInfoSette
 		return err
 	}
 	roles := make([]string, 0)
+	var claims map[string]interface{}
 	for _, scope := range scopes {
 		switch scope {
 		case oidc.ScopeOpenID:
@@ -227,15 +233,15 @@ func (o *OPStorage) setUserinfo(ctx context.Context, userInfo oidc.UserInfoSette
 				return err
 			}
 			if len(userMetaData) > 0 {
-				userInfo.AppendClaims(ClaimUserMetaData, userMetaData)
+				claims = appendClaim(claims, ClaimUserMetaData, userMetaData)
 			}
 		case ScopeResourceOwner:
-			resourceOwnerClaims, err := o.assertUserResourceOwner(ctx, userID)
+			resourceOwnerClaims, err := o.assertUserResourceOwner(ctx, user.ResourceOwner)
 			if err != nil {
 				return err
 			}
 			for claim, value := range resourceOwnerClaims {
-				userInfo.AppendClaims(claim, value)
+				claims = appendClaim(claims, claim, value)
 			}
 
 		default:
@@ -243,24 +249,31 @@ func (o *OPStorage) setUserinfo(ctx context.Context, userInfo oidc.UserInfoSette
 				roles = append(roles, strings.TrimPrefix(scope, ScopeProjectRolePrefix))
 			}
 			if strings.HasPrefix(scope, authreq_model.OrgDomainPrimaryScope) {
-				userInfo.AppendClaims(authreq_model.OrgDomainPrimaryClaim, strings.TrimPrefix(scope, authreq_model.OrgDomainPrimaryScope))
+				claims = appendClaim(claims, authreq_model.OrgDomainPrimaryClaim, strings.TrimPrefix(scope, authreq_model.OrgDomainPrimaryScope))
 			}
 		}
 	}
-	if len(roles) == 0 || applicationID == "" {
-		return nil
+	if len(roles) > 0 && applicationID != "" {
+		projectRoles, err := o.assertRoles(ctx, userID, applicationID, roles)
+		if err != nil {
+			return err
+		}
+		if len(projectRoles) > 0 {
+			claims = appendClaim(claims, ClaimProjectRoles, projectRoles)
+		}
 	}
-	projectRoles, err := o.assertRoles(ctx, userID, applicationID, roles)
+	claims, err = o.complementToken(ctx, domain.TriggerTypePreUserinfoCreation, userID, user, claims)
 	if err != nil {
 		return err
 	}
-	if len(projectRoles) > 0 {
-		userInfo.AppendClaims(ClaimProjectRoles, projectRoles)
+	for claim, value := range claims {
+		userInfo.AppendClaims(claim, value)
 	}
 	return nil
 }
 
 func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clientID string, scopes []string) (claims map[string]interface{}, err error) {
+	var user *query.User
 	roles := make([]string, 0)
 	for _, scope := range scopes {
 		switch scope {
@@ -273,7 +286,13 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 				claims = appendClaim(claims, ClaimUserMetaData, userMetaData)
 			}
 		case ScopeResourceOwner:
-			resourceOwnerClaims, err := o.assertUserResourceOwner(ctx, userID)
+			if user == nil {
+				user, err = o.query.GetUserByID(ctx, userID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			resourceOwnerClaims, err := o.assertUserResourceOwner(ctx, user.ResourceOwner)
 			if err != nil {
 				return nil, err
 			}
@@ -287,17 +306,16 @@ func (o *OPStorage) GetPrivateClaimsFromScopes(ctx context.Context, userID, clie
 			claims = appendClaim(claims, authreq_model.OrgDomainPrimaryClaim, strings.TrimPrefix(scope, authreq_model.OrgDomainPrimaryScope))
 		}
 	}
-	if len(roles) == 0 || clientID == "" {
-		return claims, nil
-	}
-	projectRoles, err := o.assertRoles(ctx, userID, clientID, roles)
-	if err != nil {
-		return nil, err
-	}
-	if len(projectRoles) > 0 {
-		claims = appendClaim(claims, ClaimProjectRoles, projectRoles)
+	if len(roles) > 0 && clientID != "" {
+		projectRoles, err := o.assertRoles(ctx, userID, clientID, roles)
+		if err != nil {
+			return nil, err
+		}
+		if len(projectRoles) > 0 {
+			claims = appendClaim(claims, ClaimProjectRoles, projectRoles)
+		}
 	}
-	return claims, err
+	return o.complementToken(ctx, domain.TriggerTypePreAccessTokenCreation, userID, user, claims)
 }
 
 func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID string, requestedRoles []string) (map[string]map[string]string, error) {
@@ -305,6 +323,10 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if err != nil {
 		return nil, err
 	}
+	return o.assertProjectRoles(ctx, userID, projectID, requestedRoles)
+}
+
+func (o *OPStorage) assertProjectRoles(ctx context.Context, userID, projectID string, requestedRoles []string) (map[string]map[string]string, error) {
 	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
 	if err != nil {
 		return nil, err
@@ -313,8 +335,12 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	if err != nil {
 		return nil, err
 	}
+	notExpiredQuery, err := query.NewUserGrantNotExpiredQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	grants, err := o.query.UserGrants(ctx, &query.UserGrantsQueries{
-		Queries: []query.SearchQuery{projectQuery, userIDQuery},
+		Queries: []query.SearchQuery{projectQuery, userIDQuery, notExpiredQuery},
 	})
 	if err != nil {
 		return nil, err
@@ -328,6 +354,52 @@ func (o *OPStorage) assertRoles(ctx context.Context, userID, applicationID strin
 	return projectRoles, nil
 }
 
+// checkProjectAccess checks the project and role check of the project for the user (e.g. for token exchange,
+// where the user does not log in to the project)
+func (o *OPStorage) checkProjectAccess(ctx context.Context, project *query.Project, userID, userOrgID string) (bool, error) {
+	if project.HasProjectCheck && project.ResourceOwner != userOrgID {
+		projectIDQuery, err := query.NewProjectGrantProjectIDSearchQuery(project.ID)
+		if err != nil {
+			return false, err
+		}
+		grantedOrgQuery, err := query.NewProjectGrantGrantedOrgIDSearchQuery(userOrgID)
+		if err != nil {
+			return false, err
+		}
+		grants, err := o.query.SearchProjectGrants(ctx, &query.ProjectGrantSearchQueries{
+			Queries: []query.SearchQuery{projectIDQuery, grantedOrgQuery},
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(grants.ProjectGrants) == 0 {
+			return false, nil
+		}
+	}
+	if !project.ProjectRoleCheck {
+		return true, nil
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(project.ID)
+	if err != nil {
+		return false, err
+	}
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return false, err
+	}
+	notExpiredQuery, err := query.NewUserGrantNotExpiredQuery(time.Now())
+	if err != nil {
+		return false, err
+	}
+	grants, err := o.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, userIDQuery, notExpiredQuery},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(grants.UserGrants) > 0, nil
+}
+
 func (o *OPStorage) assertUserMetaData(ctx context.Context, userID string) (map[string]string, error) {
 	metaData, err := o.query.SearchUserMetadata(ctx, userID, &query.UserMetadataSearchQueries{})
 	if err != nil {
@@ -341,12 +413,8 @@ func (o *OPStorage) assertUserMetaData(ctx context.Context, userID string) (map[
 	return userMetaData, nil
 }
 
-func (o *OPStorage) assertUserResourceOwner(ctx context.Context, userID string) (map[string]string, error) {
-	user, err := o.query.GetUserByID(ctx, userID)
-	if err != nil {
-		return nil, err
-	}
-	resourceOwner, err := o.query.OrgByID(ctx, user.ResourceOwner)
+func (o *OPStorage) assertUserResourceOwner(ctx context.Context, resourceOwnerID string) (map[string]string, error) {
+	resourceOwner, err := o.query.OrgByID(ctx, resourceOwnerID)
 	if err != nil {
 		return nil, err
 	}
@@ -384,6 +452,14 @@ func getGender(gender domain.Gender) oidc.Gender {
 	return ""
 }
 
+// actorClaim creates the `act` claim (RFC 8693) of tokens issued by the token exchange grant
+func actorClaim(subject, clientID string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":       subject,
+		"client_id": clientID,
+	}
+}
+
 func appendClaim(claims map[string]interface{}, claim string, value interface{}) map[string]interface{} {
 	if claims == nil {
 		claims = make(map[string]interface{})
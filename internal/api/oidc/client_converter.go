@@ -195,6 +195,10 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeImplicit
 	case domain.OIDCGrantTypeRefreshToken:
 		return oidc.GrantTypeRefreshToken
+	case domain.OIDCGrantTypeDeviceCode:
+		return GrantTypeDeviceCode
+	case domain.OIDCGrantTypeTokenExchange:
+		return oidc.GrantTypeTokenExchange
 	default:
 		return oidc.GrantTypeCode
 	}
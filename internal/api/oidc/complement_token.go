@@ -0,0 +1,149 @@
+package oidc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/caos/zitadel/internal/actions"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+)
+
+//reservedClaimPrefix is the prefix of the claims set by zitadel (e.g. roles, metadata and resource owner)
+const reservedClaimPrefix = "urn:zitadel:"
+
+//reservedClaims can't be set, renamed or removed by the actions of the customise token flow
+var reservedClaims = []string{
+	"iss",
+	"sub",
+	"aud",
+	"exp",
+	"iat",
+	"nbf",
+	"jti",
+	"azp",
+	"nonce",
+	"auth_time",
+	"amr",
+	"acr",
+	"at_hash",
+	"c_hash",
+	"client_id",
+	"scope",
+	"active",
+	"name",
+	"given_name",
+	"family_name",
+	"middle_name",
+	"nickname",
+	"preferred_username",
+	"profile",
+	"picture",
+	"website",
+	"gender",
+	"birthdate",
+	"zoneinfo",
+	"locale",
+	"updated_at",
+	"email",
+	"email_verified",
+	"phone_number",
+	"phone_number_verified",
+	"address",
+	ClaimActor,
+}
+
+//complementToken runs the actions of the customise token flow of the users organisation for the given trigger
+//the actions can add, rename and remove the private claims of the token
+//the user is only loaded if it's not passed and at least one action is defined
+func (o *OPStorage) complementToken(ctx context.Context, triggerType domain.TriggerType, userID string, user *query.User, claims map[string]interface{}) (map[string]interface{}, error) {
+	triggerActions, err := o.query.GetActiveActionsByFlowAndTriggerTypeOfUser(ctx, domain.FlowTypeCustomiseToken, triggerType, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(triggerActions) == 0 {
+		return claims, nil
+	}
+	if user == nil {
+		user, err = o.query.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	userGrants, err := o.activeUserGrants(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := o.query.SearchUserMetadata(ctx, user.ID, &query.UserMetadataSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	actionCtx := (&actions.Context{}).
+		SetUser(user).
+		SetUserGrants(userGrants).
+		SetUserMetadata(metadata.Metadata)
+	return complementClaims(ctx, o.actionsDeps, triggerType, triggerActions, actionCtx, claims)
+}
+
+//complementClaims runs the actions on a copy of the claims
+//reserved claims keep the value they had before the actions were run
+func complementClaims(ctx context.Context, deps actions.Dependencies, triggerType domain.TriggerType, triggerActions []*query.Action, actionCtx *actions.Context, claims map[string]interface{}) (map[string]interface{}, error) {
+	complemented := make(map[string]interface{}, len(claims))
+	for claim, value := range claims {
+		complemented[claim] = value
+	}
+	actionCtx.SetClaims(complemented)
+	api := (&actions.API{}).SetClaims(complemented)
+	for _, a := range triggerActions {
+		err := actions.RunAction(ctx, deps, actionCtx, api, a, domain.FlowTypeCustomiseToken, triggerType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for claim := range complemented {
+		if isReservedClaim(claim) {
+			delete(complemented, claim)
+		}
+	}
+	for claim, value := range claims {
+		if isReservedClaim(claim) {
+			complemented[claim] = value
+		}
+	}
+	return complemented, nil
+}
+
+func isReservedClaim(claim string) bool {
+	if strings.HasPrefix(claim, reservedClaimPrefix) {
+		return true
+	}
+	for _, reserved := range reservedClaims {
+		if claim == reserved {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *OPStorage) activeUserGrants(ctx context.Context, userID string) ([]*query.UserGrant, error) {
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	stateQuery, err := query.NewUserGrantStateQuery(domain.UserGrantStateActive)
+	if err != nil {
+		return nil, err
+	}
+	notExpiredQuery, err := query.NewUserGrantNotExpiredQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	grants, err := o.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userIDQuery, stateQuery, notExpiredQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grants.UserGrants, nil
+}
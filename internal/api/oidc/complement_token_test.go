@@ -0,0 +1,237 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/actions"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+)
+
+func testAction(script string, allowedToFail bool) *query.Action {
+	return &query.Action{
+		ID:            "action-id",
+		ResourceOwner: "org-id",
+		Name:          "action",
+		Script:        script,
+		Timeout:       time.Second,
+		AllowedToFail: allowedToFail,
+	}
+}
+
+func Test_complementClaims(t *testing.T) {
+	type args struct {
+		triggerActions []*query.Action
+		claims         map[string]interface{}
+	}
+	type res struct {
+		claims map[string]interface{}
+		err    bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "no actions, claims unchanged",
+			args: args{
+				claims: map[string]interface{}{
+					"sub":    "user-id",
+					"custom": "value",
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"sub":    "user-id",
+					"custom": "value",
+				},
+			},
+		},
+		{
+			name: "set, rename and remove claims",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.setClaim("added", ctx.getClaim("custom") + "-added");
+	api.renameClaim("rename", "renamed");
+	api.removeClaim("remove");
+}`, false),
+				},
+				claims: map[string]interface{}{
+					"custom": "value",
+					"rename": "renamed-value",
+					"remove": "removed-value",
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"custom":  "value",
+					"added":   "value-added",
+					"renamed": "renamed-value",
+				},
+			},
+		},
+		{
+			name: "actions run in order on the same claims",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.setClaim("first", "1");
+}`, false),
+					testAction(`function action(ctx, api) {
+	api.setClaim("second", ctx.getClaim("first") + "2");
+}`, false),
+				},
+				claims: map[string]interface{}{},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"first":  "1",
+					"second": "12",
+				},
+			},
+		},
+		{
+			name: "reserved claims can't be added",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.setClaim("sub", "other-user");
+	api.setClaim("aud", "other-client");
+	api.setClaim("iss", "https://evil.example.com");
+	api.setClaim("exp", 4102444800);
+	api.setClaim("urn:zitadel:iam:org:project:roles", {"admin": {"org-id": "org.example.com"}});
+	api.setClaim("custom", "value");
+}`, false),
+				},
+				claims: map[string]interface{}{},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"custom": "value",
+				},
+			},
+		},
+		{
+			name: "reserved claims can't be overwritten",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.setClaim("sub", "other-user");
+	api.setClaim("aud", "other-client");
+	api.setClaim("iss", "https://evil.example.com");
+	api.setClaim("exp", 4102444800);
+	api.setClaim("urn:zitadel:iam:org:project:roles", {"admin": {"org-id": "org.example.com"}});
+}`, false),
+				},
+				claims: map[string]interface{}{
+					"sub":                               "user-id",
+					"aud":                               "client-id",
+					"iss":                               "https://issuer.example.com",
+					"exp":                               1640995200,
+					"urn:zitadel:iam:org:project:roles": "roles",
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"sub":                               "user-id",
+					"aud":                               "client-id",
+					"iss":                               "https://issuer.example.com",
+					"exp":                               1640995200,
+					"urn:zitadel:iam:org:project:roles": "roles",
+				},
+			},
+		},
+		{
+			name: "reserved claims can't be removed or renamed",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.removeClaim("sub");
+	api.removeClaim("urn:zitadel:iam:user:metadata");
+	api.renameClaim("aud", "audience");
+	api.renameClaim("urn:zitadel:iam:org:project:roles", "roles");
+}`, false),
+				},
+				claims: map[string]interface{}{
+					"sub":                               "user-id",
+					"aud":                               "client-id",
+					"urn:zitadel:iam:user:metadata":     "metadata",
+					"urn:zitadel:iam:org:project:roles": "roles",
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"sub":                               "user-id",
+					"aud":                               "client-id",
+					"audience":                          "client-id",
+					"roles":                             "roles",
+					"urn:zitadel:iam:user:metadata":     "metadata",
+					"urn:zitadel:iam:org:project:roles": "roles",
+				},
+			},
+		},
+		{
+			name: "action throws, error",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.setClaim("custom", "value");
+	throw "failed";
+}`, false),
+				},
+				claims: map[string]interface{}{
+					"sub": "user-id",
+				},
+			},
+			res: res{
+				err: true,
+			},
+		},
+		{
+			name: "action throws and is allowed to fail, claims kept",
+			args: args{
+				triggerActions: []*query.Action{
+					testAction(`function action(ctx, api) {
+	api.setClaim("before", "value");
+	throw "failed";
+}`, true),
+					testAction(`function action(ctx, api) {
+	api.setClaim("after", "value");
+}`, false),
+				},
+				claims: map[string]interface{}{
+					"sub": "user-id",
+				},
+			},
+			res: res{
+				claims: map[string]interface{}{
+					"sub":    "user-id",
+					"before": "value",
+					"after":  "value",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make(map[string]interface{}, len(tt.args.claims))
+			for claim, value := range tt.args.claims {
+				input[claim] = value
+			}
+			claims, err := complementClaims(context.Background(), actions.Dependencies{}, domain.TriggerTypePreAccessTokenCreation, tt.args.triggerActions, &actions.Context{}, tt.args.claims)
+			if tt.res.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.claims, claims)
+			assert.Equal(t, input, tt.args.claims, "input claims must not be changed")
+		})
+	}
+}
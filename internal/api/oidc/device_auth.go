@@ -0,0 +1,250 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httphelper "github.com/caos/oidc/pkg/http"
+	"github.com/caos/oidc/pkg/oidc"
+	"github.com/caos/oidc/pkg/op"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+)
+
+const (
+	GrantTypeDeviceCode oidc.GrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	errorTypeAuthorizationPending = "authorization_pending"
+	errorTypeAccessDenied         = "access_denied"
+	errorTypeExpiredToken         = "expired_token"
+
+	userCodeCharset  = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+	deviceCodeLength = 32
+)
+
+type deviceAuthorizationRequest struct {
+	clientCredentials
+	Scopes oidc.SpaceDelimitedArray `schema:"scope"`
+}
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               uint64 `json:"expires_in"`
+	Interval                uint64 `json:"interval"`
+}
+
+// handleDeviceAuthorization starts a device authorization and returns the codes:
+// the device polls the token endpoint with the device code
+// while the user enters the user code on the verification uri
+func (p *provider) handleDeviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := new(deviceAuthorizationRequest)
+	err := op.ParseAuthenticatedTokenRequest(r, p.Decoder(), req)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	client, err := p.authorizeClient(ctx, &req.clientCredentials, GrantTypeDeviceCode)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	scopes, err := p.storage.assertProjectRoleScopes(ctx, client.GetID(), req.Scopes)
+	if err != nil {
+		op.RequestError(w, r, oidc.ErrInvalidScope().WithParent(err))
+		return
+	}
+	deviceCode, err := generateDeviceCode()
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	userCode, err := generateUserCode()
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	_, _, err = p.storage.command.AddDeviceAuth(ctx, &domain.DeviceAuth{
+		ClientID:   client.GetID(),
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		Expires:    time.Now().UTC().Add(p.deviceCodeLifetime),
+		Scopes:     scopes,
+	}, client.(*Client).app.ResourceOwner)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, &deviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationURI:         p.verificationURL,
+		VerificationURIComplete: p.verificationURL + "?user_code=" + url.QueryEscape(userCode),
+		ExpiresIn:               uint64(p.deviceCodeLifetime.Seconds()),
+		Interval:                uint64(p.pollInterval.Seconds()),
+	})
+}
+
+type deviceAccessTokenRequest struct {
+	clientCredentials
+	DeviceCode string `schema:"device_code"`
+}
+
+// handleDeviceAccessToken handles the polling of the device on the token endpoint,
+// the tokens are issued exactly once after the user approved the authorization
+func (p *provider) handleDeviceAccessToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := new(deviceAccessTokenRequest)
+	err := op.ParseAuthenticatedTokenRequest(r, p.Decoder(), req)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	if req.DeviceCode == "" {
+		op.RequestError(w, r, oidc.ErrInvalidRequest().WithDescription("device_code missing"))
+		return
+	}
+	client, err := p.authorizeClient(ctx, &req.clientCredentials, GrantTypeDeviceCode)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	deviceAuth, err := p.storage.query.DeviceAuthByDeviceCode(ctx, req.DeviceCode)
+	if err != nil || deviceAuth.ClientID != client.GetID() {
+		op.RequestError(w, r, oidc.ErrInvalidGrant().WithDescription("invalid device_code").WithParent(err))
+		return
+	}
+	switch deviceAuth.State {
+	case domain.DeviceAuthStateInitiated:
+		if deviceAuth.IsExpired() {
+			op.RequestError(w, r, &oidc.Error{ErrorType: errorTypeExpiredToken})
+			return
+		}
+		op.RequestError(w, r, &oidc.Error{ErrorType: errorTypeAuthorizationPending})
+		return
+	case domain.DeviceAuthStateDenied:
+		op.RequestError(w, r, &oidc.Error{ErrorType: errorTypeAccessDenied})
+		return
+	case domain.DeviceAuthStateApproved:
+	default:
+		op.RequestError(w, r, oidc.ErrInvalidGrant().WithDescription("invalid device_code"))
+		return
+	}
+	_, err = p.storage.command.RemoveDeviceAuth(ctx, deviceAuth.ID, deviceAuth.ResourceOwner)
+	if err != nil {
+		op.RequestError(w, r, oidc.ErrInvalidGrant().WithDescription("invalid device_code").WithParent(err))
+		return
+	}
+	resp, err := p.createDeviceTokenResponse(ctx, &DeviceTokenRequest{deviceAuth}, client)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (p *provider) createDeviceTokenResponse(ctx context.Context, req *DeviceTokenRequest, client op.Client) (_ *oidc.AccessTokenResponse, err error) {
+	var tokenID, refreshToken, accessToken, idToken string
+	var exp time.Time
+	if containsScope(req.Scopes, oidc.ScopeOfflineAccess) && op.ValidateGrantType(client, oidc.GrantTypeRefreshToken) {
+		tokenID, refreshToken, exp, err = p.Storage().CreateAccessAndRefreshTokens(ctx, req, "")
+	} else {
+		tokenID, exp, err = p.Storage().CreateAccessToken(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if client.AccessTokenType() == op.AccessTokenTypeJWT {
+		accessToken, err = op.CreateJWT(ctx, p.Issuer(), req, exp, tokenID, p.Signer(), client, p.Storage())
+	} else {
+		accessToken, err = op.CreateBearerToken(tokenID, req.GetSubject(), p.Crypto())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if containsScope(req.Scopes, oidc.ScopeOpenID) {
+		idToken, err = op.CreateIDToken(ctx, p.Issuer(), req, client.IDTokenLifetime(), accessToken, "", p.Storage(), p.Signer(), client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &oidc.AccessTokenResponse{
+		AccessToken:  accessToken,
+		IDToken:      idToken,
+		RefreshToken: refreshToken,
+		TokenType:    oidc.BearerToken,
+		ExpiresIn:    uint64(exp.Add(client.ClockSkew()).Sub(time.Now().UTC()).Seconds()),
+	}, nil
+}
+
+// DeviceTokenRequest is the token request of an approved device authorization
+type DeviceTokenRequest struct {
+	*query.DeviceAuth
+}
+
+func (r *DeviceTokenRequest) GetAMR() []string {
+	return r.AMR
+}
+
+func (r *DeviceTokenRequest) GetAudience() []string {
+	return r.Audience
+}
+
+func (r *DeviceTokenRequest) GetAuthTime() time.Time {
+	return r.AuthTime
+}
+
+func (r *DeviceTokenRequest) GetClientID() string {
+	return r.ClientID
+}
+
+func (r *DeviceTokenRequest) GetScopes() []string {
+	return r.Scopes
+}
+
+func (r *DeviceTokenRequest) GetSubject() string {
+	return r.Subject
+}
+
+func generateDeviceCode() (string, error) {
+	code := make([]byte, deviceCodeLength)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(code), nil
+}
+
+// generateUserCode creates a code of consonants only (preventing words and confusable characters)
+// in the format XXXX-XXXX
+func generateUserCode() (string, error) {
+	max := big.NewInt(int64(len(userCodeCharset)))
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+	return string(code[:userCodeLength/2]) + "-" + string(code[userCodeLength/2:]), nil
+}
+
+// NormalizeUserCode formats the code entered by the user the way it was generated
+func NormalizeUserCode(userCode string) string {
+	userCode = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(userCode))
+	if len(userCode) != userCodeLength {
+		return userCode
+	}
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rakyll/statik/fs"
 	"golang.org/x/text/language"
 
+	"github.com/caos/zitadel/internal/actions"
 	http_utils "github.com/caos/zitadel/internal/api/http"
 	"github.com/caos/zitadel/internal/api/http/middleware"
 	"github.com/caos/zitadel/internal/auth/repository"
@@ -31,6 +32,7 @@ type OPHandlerConfig struct {
 	UserAgentCookieConfig *middleware.UserAgentCookieConfig
 	Cache                 *middleware.CacheConfig
 	Endpoints             *EndpointConfig
+	DeviceAuth            *DeviceAuthConfig
 }
 
 type StorageConfig struct {
@@ -42,14 +44,21 @@ type StorageConfig struct {
 	DefaultRefreshTokenExpiration     types.Duration
 }
 
+type DeviceAuthConfig struct {
+	VerificationURL string
+	Lifetime        types.Duration
+	PollInterval    types.Duration
+}
+
 type EndpointConfig struct {
-	Auth          *Endpoint
-	Token         *Endpoint
-	Introspection *Endpoint
-	Userinfo      *Endpoint
-	Revocation    *Endpoint
-	EndSession    *Endpoint
-	Keys          *Endpoint
+	Auth                *Endpoint
+	Token               *Endpoint
+	Introspection       *Endpoint
+	Userinfo            *Endpoint
+	Revocation          *Endpoint
+	EndSession          *Endpoint
+	Keys                *Endpoint
+	DeviceAuthorization *Endpoint
 }
 
 type Endpoint struct {
@@ -75,9 +84,10 @@ type OPStorage struct {
 	signingKeyGracefulPeriod          time.Duration
 	locker                            crdb.Locker
 	assetAPIPrefix                    string
+	actionsDeps                       actions.Dependencies
 }
 
-func NewProvider(ctx context.Context, config OPHandlerConfig, command *command.Commands, query *query.Queries, repo repository.Repository, keyConfig systemdefaults.KeyConfig, localDevMode bool, es *eventstore.Eventstore, projections types.SQL, keyChan <-chan interface{}, assetAPIPrefix string) op.OpenIDProvider {
+func NewProvider(ctx context.Context, config OPHandlerConfig, command *command.Commands, query *query.Queries, repo repository.Repository, keyConfig systemdefaults.KeyConfig, localDevMode bool, es *eventstore.Eventstore, projections types.SQL, keyChan <-chan interface{}, assetAPIPrefix string, actionsDeps actions.Dependencies) op.OpenIDProvider {
 	cookieHandler, err := middleware.NewUserAgentHandler(config.UserAgentCookieConfig, id.SonyFlakeGenerator, localDevMode)
 	logging.Log("OIDC-sd4fd").OnError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Panic("cannot user agent handler")
 	tokenKey, err := crypto.LoadKey(keyConfig.EncryptionConfig, keyConfig.EncryptionConfig.EncryptionKeyID)
@@ -95,9 +105,9 @@ func NewProvider(ctx context.Context, config OPHandlerConfig, command *command.C
 	logging.Log("OIDC-GBd3t").OnError(err).Panic("cannot get supported languages")
 	config.OPConfig.SupportedUILocales = supportedLanguages
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
-	storage, err := newStorage(config.StorageConfig, command, query, repo, keyConfig, es, projections, keyChan, assetAPIPrefix)
+	storage, err := newStorage(config.StorageConfig, command, query, repo, keyConfig, es, projections, keyChan, assetAPIPrefix, actionsDeps)
 	logging.Log("OIDC-Jdg2k").OnError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Panic("cannot create storage")
-	provider, err := op.NewOpenIDProvider(
+	openIDProvider, err := op.NewOpenIDProvider(
 		ctx,
 		config.OPConfig,
 		storage,
@@ -117,10 +127,17 @@ func NewProvider(ctx context.Context, config OPHandlerConfig, command *command.C
 		op.WithCustomKeysEndpoint(op.NewEndpointWithURL(config.Endpoints.Keys.Path, config.Endpoints.Keys.URL)),
 	)
 	logging.Log("OIDC-asf13").OnError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Panic("cannot create provider")
-	return provider
+	return &provider{
+		OpenIDProvider:              openIDProvider,
+		storage:                     storage,
+		deviceAuthorizationEndpoint: op.NewEndpointWithURL(config.Endpoints.DeviceAuthorization.Path, config.Endpoints.DeviceAuthorization.URL),
+		verificationURL:             config.DeviceAuth.VerificationURL,
+		deviceCodeLifetime:          config.DeviceAuth.Lifetime.Duration,
+		pollInterval:                config.DeviceAuth.PollInterval.Duration,
+	}
 }
 
-func newStorage(config StorageConfig, command *command.Commands, query *query.Queries, repo repository.Repository, keyConfig systemdefaults.KeyConfig, es *eventstore.Eventstore, projections types.SQL, keyChan <-chan interface{}, assetAPIPrefix string) (*OPStorage, error) {
+func newStorage(config StorageConfig, command *command.Commands, query *query.Queries, repo repository.Repository, keyConfig systemdefaults.KeyConfig, es *eventstore.Eventstore, projections types.SQL, keyChan <-chan interface{}, assetAPIPrefix string, actionsDeps actions.Dependencies) (*OPStorage, error) {
 	encAlg, err := crypto.NewAESCrypto(keyConfig.EncryptionConfig)
 	if err != nil {
 		return nil, err
@@ -146,6 +163,7 @@ func newStorage(config StorageConfig, command *command.Commands, query *query.Qu
 		locker:                            crdb.NewLocker(sqlClient, locksTable, signingKey),
 		keyChan:                           keyChan,
 		assetAPIPrefix:                    assetAPIPrefix,
+		actionsDeps:                       actionsDeps,
 	}, nil
 }
 
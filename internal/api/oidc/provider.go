@@ -0,0 +1,130 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	httphelper "github.com/caos/oidc/pkg/http"
+	"github.com/caos/oidc/pkg/oidc"
+	"github.com/caos/oidc/pkg/op"
+	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
+
+	http_utils "github.com/caos/zitadel/internal/api/http"
+	"github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/telemetry/metrics"
+)
+
+// provider extends the OpenID Provider of the oidc library with the OAuth 2.0 Device Authorization Grant (RFC 8628)
+// and the OAuth 2.0 Token Exchange (RFC 8693), all other requests are handled by the library
+type provider struct {
+	op.OpenIDProvider
+	storage                     *OPStorage
+	deviceAuthorizationEndpoint op.Endpoint
+	verificationURL             string
+	deviceCodeLifetime          time.Duration
+	pollInterval                time.Duration
+}
+
+func (p *provider) HttpHandler() http.Handler {
+	router := mux.NewRouter()
+	router.Use(handlers.CORS(
+		handlers.AllowCredentials(),
+		handlers.AllowedHeaders([]string{"authorization", "content-type"}),
+		handlers.AllowedOriginValidator(func(_ string) bool { return true }),
+	))
+	router.Path(oidc.DiscoveryEndpoint).HandlerFunc(p.handleDiscovery)
+	router.Path(p.deviceAuthorizationEndpoint.Relative()).Methods(http.MethodPost).Handler(intercept(p.handleDeviceAuthorization))
+	router.Path(p.TokenEndpoint().Relative()).Methods(http.MethodPost).MatcherFunc(isGrantType(GrantTypeDeviceCode)).Handler(intercept(p.handleDeviceAccessToken))
+	router.Path(p.TokenEndpoint().Relative()).Methods(http.MethodPost).MatcherFunc(isGrantType(oidc.GrantTypeTokenExchange)).Handler(intercept(p.handleTokenExchange))
+	router.PathPrefix("/").Handler(p.OpenIDProvider.HttpHandler())
+	return router
+}
+
+func intercept(handler http.HandlerFunc) http.Handler {
+	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
+	return middleware.MetricsHandler(metricTypes)(
+		middleware.TelemetryHandler()(
+			middleware.NoCacheInterceptor(
+				http_utils.CopyHeadersToContext(handler),
+			),
+		),
+	)
+}
+
+func isGrantType(grantType oidc.GrantType) mux.MatcherFunc {
+	return func(r *http.Request, _ *mux.RouteMatch) bool {
+		return r.FormValue("grant_type") == string(grantType)
+	}
+}
+
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+}
+
+func (p *provider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	config := op.CreateDiscoveryConfig(p, p.Signer())
+	config.GrantTypesSupported = append(config.GrantTypesSupported, GrantTypeDeviceCode, oidc.GrantTypeTokenExchange)
+	httphelper.MarshalJSON(w, &discoveryConfiguration{
+		DiscoveryConfiguration:      config,
+		DeviceAuthorizationEndpoint: p.deviceAuthorizationEndpoint.Absolute(p.Issuer()),
+	})
+}
+
+type clientCredentials struct {
+	ClientID            string `schema:"client_id"`
+	ClientSecret        string `schema:"client_secret"`
+	ClientAssertion     string `schema:"client_assertion"`
+	ClientAssertionType string `schema:"client_assertion_type"`
+}
+
+func (c *clientCredentials) SetClientID(clientID string) {
+	c.ClientID = clientID
+}
+
+func (c *clientCredentials) SetClientSecret(clientSecret string) {
+	c.ClientSecret = clientSecret
+}
+
+// authorizeClient authenticates the client the same way the token endpoint of the library does
+// and ensures the client is allowed to use the grant type
+func (p *provider) authorizeClient(ctx context.Context, credentials *clientCredentials, grantType oidc.GrantType) (client op.Client, err error) {
+	if credentials.ClientAssertionType == oidc.ClientAssertionTypeJWTAssertion {
+		exchanger, ok := p.OpenIDProvider.(op.JWTAuthorizationGrantExchanger)
+		if !ok {
+			return nil, oidc.ErrInvalidClient().WithDescription("auth_method private_key_jwt not supported")
+		}
+		client, err = op.AuthorizePrivateJWTKey(ctx, credentials.ClientAssertion, exchanger)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		client, err = p.Storage().GetClientByClientID(ctx, credentials.ClientID)
+		if err != nil {
+			return nil, oidc.ErrInvalidClient().WithParent(err)
+		}
+		switch client.AuthMethod() {
+		case oidc.AuthMethodPrivateKeyJWT:
+			return nil, oidc.ErrInvalidClient().WithDescription("private_key_jwt not allowed for this client")
+		case oidc.AuthMethodBasic, oidc.AuthMethodPost:
+			if err = op.AuthorizeClientIDSecret(ctx, credentials.ClientID, credentials.ClientSecret, p.Storage()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !op.ValidateGrantType(client, grantType) {
+		return nil, oidc.ErrUnauthorizedClient()
+	}
+	return client, nil
+}
+
+func containsScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
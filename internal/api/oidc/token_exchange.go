@@ -0,0 +1,253 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/caos/oidc/pkg/crypto"
+	httphelper "github.com/caos/oidc/pkg/http"
+	"github.com/caos/oidc/pkg/oidc"
+	"github.com/caos/oidc/pkg/op"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/user/model"
+)
+
+const (
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+
+	errorTypeInvalidTarget = "invalid_target"
+)
+
+type tokenExchangeRequest struct {
+	clientCredentials
+	SubjectToken       string                   `schema:"subject_token"`
+	SubjectTokenType   string                   `schema:"subject_token_type"`
+	ActorToken         string                   `schema:"actor_token"`
+	ActorTokenType     string                   `schema:"actor_token_type"`
+	Audience           []string                 `schema:"audience"`
+	Scopes             oidc.SpaceDelimitedArray `schema:"scope"`
+	RequestedTokenType string                   `schema:"requested_token_type"`
+}
+
+func (r *tokenExchangeRequest) validate() error {
+	if r.SubjectToken == "" {
+		return oidc.ErrInvalidRequest().WithDescription("subject_token missing")
+	}
+	if r.SubjectTokenType != TokenTypeAccessToken {
+		return oidc.ErrInvalidRequest().WithDescription("subject_token_type %s not supported", r.SubjectTokenType)
+	}
+	if r.ActorToken != "" && r.ActorTokenType != TokenTypeAccessToken {
+		return oidc.ErrInvalidRequest().WithDescription("actor_token_type %s not supported", r.ActorTokenType)
+	}
+	if r.RequestedTokenType != "" && r.RequestedTokenType != TokenTypeAccessToken {
+		return oidc.ErrInvalidRequest().WithDescription("requested_token_type %s not supported", r.RequestedTokenType)
+	}
+	if len(r.Audience) != 1 {
+		return oidc.ErrInvalidRequest().WithDescription("exactly one audience (project id) is required")
+	}
+	return nil
+}
+
+type tokenExchangeResponse struct {
+	AccessToken     string                   `json:"access_token"`
+	IssuedTokenType string                   `json:"issued_token_type"`
+	TokenType       string                   `json:"token_type"`
+	ExpiresIn       uint64                   `json:"expires_in,omitempty"`
+	Scopes          oidc.SpaceDelimitedArray `json:"scope,omitempty"`
+}
+
+// handleTokenExchange issues a token of the user of the subject token for another project (audience),
+// either on behalf of the user of the actor token (delegation) or of the client itself (impersonation),
+// if the project allows it
+func (p *provider) handleTokenExchange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := new(tokenExchangeRequest)
+	err := op.ParseAuthenticatedTokenRequest(r, p.Decoder(), req)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	if err = req.validate(); err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	client, err := p.authorizeClient(ctx, &req.clientCredentials, oidc.GrantTypeTokenExchange)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	subjectToken, err := p.verifyExchangeToken(ctx, req.SubjectToken)
+	if err != nil {
+		op.RequestError(w, r, oidc.ErrInvalidRequest().WithDescription("invalid subject_token").WithParent(err))
+		return
+	}
+	if !isTokenAudience(subjectToken, client) {
+		op.RequestError(w, r, oidc.ErrInvalidRequest().WithDescription("subject_token was not issued for this client"))
+		return
+	}
+	exchangeType := domain.TokenExchangeTypeImpersonation
+	actor := &domain.TokenActor{Subject: client.GetID(), ClientID: client.GetID()}
+	if req.ActorToken != "" {
+		actorToken, err := p.verifyExchangeToken(ctx, req.ActorToken)
+		if err != nil {
+			op.RequestError(w, r, oidc.ErrInvalidRequest().WithDescription("invalid actor_token").WithParent(err))
+			return
+		}
+		exchangeType = domain.TokenExchangeTypeDelegation
+		actor.Subject = actorToken.UserID
+	}
+	project, err := p.exchangeTargetProject(ctx, req.Audience[0], exchangeType, subjectToken)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	scopes, err := exchangeScopes(req.Scopes, subjectToken.Scopes)
+	if err != nil {
+		op.RequestError(w, r, err)
+		return
+	}
+	if !hasProjectRoleScope(scopes) {
+		scopes, err = p.storage.assertRoleScopesOfProject(ctx, project, scopes)
+		if err != nil {
+			op.RequestError(w, r, oidc.ErrServerError().WithParent(err))
+			return
+		}
+	}
+	lifetime := p.storage.defaultAccessTokenLifetime
+	if subjectLifetime := subjectToken.Expiration.Sub(time.Now().UTC()); subjectLifetime < lifetime {
+		lifetime = subjectLifetime
+	}
+	token, err := p.storage.command.AddExchangedUserToken(ctx, subjectToken.ResourceOwner, client.GetID(), subjectToken.UserID, subjectToken.ID, exchangeType, actor, []string{project.ID}, scopes, lifetime)
+	if err != nil {
+		op.RequestError(w, r, oidc.ErrServerError().WithParent(err))
+		return
+	}
+	accessToken, err := p.createExchangedAccessToken(ctx, client, token, subjectToken.UserID, project.ID)
+	if err != nil {
+		op.RequestError(w, r, oidc.ErrServerError().WithParent(err))
+		return
+	}
+	httphelper.MarshalJSON(w, &tokenExchangeResponse{
+		AccessToken:     accessToken,
+		IssuedTokenType: TokenTypeAccessToken,
+		TokenType:       oidc.BearerToken,
+		ExpiresIn:       uint64(token.Expiration.Add(client.ClockSkew()).Sub(time.Now().UTC()).Seconds()),
+		Scopes:          token.Scopes,
+	})
+}
+
+// verifyExchangeToken returns the (active) token of an opaque or JWT access token issued by ZITADEL
+func (p *provider) verifyExchangeToken(ctx context.Context, token string) (*model.TokenView, error) {
+	var tokenID, subject string
+	if tokenIDSubject, err := p.Crypto().Decrypt(token); err == nil {
+		split := strings.Split(tokenIDSubject, ":")
+		if len(split) != 2 {
+			return nil, errors.ThrowPermissionDenied(nil, "OIDC-Sm2fs", "token is not valid")
+		}
+		tokenID, subject = split[0], split[1]
+	} else {
+		claims, err := op.VerifyAccessToken(ctx, token, p.AccessTokenVerifier())
+		if err != nil {
+			return nil, errors.ThrowPermissionDenied(err, "OIDC-Pw0fs", "token is not valid")
+		}
+		tokenID, subject = claims.GetTokenID(), claims.GetSubject()
+	}
+	return p.storage.repo.TokenByID(ctx, subject, tokenID)
+}
+
+// exchangeTargetProject returns the project the token is exchanged for
+// and ensures it allows the type of exchange and the user is allowed to use it
+func (p *provider) exchangeTargetProject(ctx context.Context, projectID string, exchangeType domain.TokenExchangeType, subjectToken *model.TokenView) (*query.Project, error) {
+	project, err := p.storage.query.ProjectByID(ctx, projectID)
+	if err != nil || project.State != domain.ProjectStateActive {
+		return nil, &oidc.Error{ErrorType: errorTypeInvalidTarget, Description: "unknown audience"}
+	}
+	if exchangeType == domain.TokenExchangeTypeDelegation && !project.TokenExchangeDelegation {
+		return nil, &oidc.Error{ErrorType: errorTypeInvalidTarget, Description: "delegation is not allowed for the audience"}
+	}
+	if exchangeType == domain.TokenExchangeTypeImpersonation && !project.TokenExchangeImpersonation {
+		return nil, &oidc.Error{ErrorType: errorTypeInvalidTarget, Description: "impersonation is not allowed for the audience"}
+	}
+	allowed, err := p.storage.checkProjectAccess(ctx, project, subjectToken.UserID, subjectToken.ResourceOwner)
+	if err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	if !allowed {
+		return nil, &oidc.Error{ErrorType: errorTypeAccessDenied, Description: "user is not allowed to use the audience"}
+	}
+	return project, nil
+}
+
+func (p *provider) createExchangedAccessToken(ctx context.Context, client op.Client, token *domain.Token, userID, projectID string) (string, error) {
+	if client.AccessTokenType() != op.AccessTokenTypeJWT {
+		return op.CreateBearerToken(token.TokenID, userID, p.Crypto())
+	}
+	claims := oidc.NewAccessTokenClaims(p.Issuer(), userID, token.Audience, token.Expiration, token.TokenID, client.GetID(), client.ClockSkew())
+	scopes := client.RestrictAdditionalAccessTokenScopes()(token.Scopes)
+	privateClaims, err := p.storage.GetPrivateClaimsFromScopes(ctx, userID, "", scopes)
+	if err != nil {
+		return "", err
+	}
+	roles := make([]string, 0)
+	for _, scope := range scopes {
+		if strings.HasPrefix(scope, ScopeProjectRolePrefix) {
+			roles = append(roles, strings.TrimPrefix(scope, ScopeProjectRolePrefix))
+		}
+	}
+	if len(roles) > 0 {
+		projectRoles, err := p.storage.assertProjectRoles(ctx, userID, projectID, roles)
+		if err != nil {
+			return "", err
+		}
+		if len(projectRoles) > 0 {
+			privateClaims = appendClaim(privateClaims, ClaimProjectRoles, projectRoles)
+		}
+	}
+	privateClaims = appendClaim(privateClaims, ClaimActor, actorClaim(token.Actor.Subject, token.Actor.ClientID))
+	claims.SetPrivateClaims(privateClaims)
+	return crypto.Sign(claims, p.Signer().Signer())
+}
+
+func isTokenAudience(token *model.TokenView, client op.Client) bool {
+	projectID := client.(*Client).app.ProjectID
+	for _, aud := range token.Audience {
+		if aud == client.GetID() || aud == projectID {
+			return true
+		}
+	}
+	return false
+}
+
+// exchangeScopes returns the scopes of the exchanged token, which must be a subset of the scopes of the subject token
+// (except for roles, which are asserted for the audience);
+// neither refresh tokens nor additional audiences (project id scopes) can be requested
+func exchangeScopes(requested, subjectScopes []string) ([]string, error) {
+	if len(requested) == 0 {
+		scopes := make([]string, 0, len(subjectScopes))
+		for _, scope := range subjectScopes {
+			if !isNotExchangeableScope(scope) && !strings.HasPrefix(scope, ScopeProjectRolePrefix) {
+				scopes = append(scopes, scope)
+			}
+		}
+		return scopes, nil
+	}
+	for _, scope := range requested {
+		if isNotExchangeableScope(scope) {
+			return nil, oidc.ErrInvalidScope().WithDescription("scope %s cannot be exchanged", scope)
+		}
+		if !strings.HasPrefix(scope, ScopeProjectRolePrefix) && !containsScope(subjectScopes, scope) {
+			return nil, oidc.ErrInvalidScope().WithDescription("scope %s is not granted by the subject_token", scope)
+		}
+	}
+	return requested, nil
+}
+
+func isNotExchangeableScope(scope string) bool {
+	return scope == oidc.ScopeOfflineAccess ||
+		(strings.HasPrefix(scope, domain.ProjectIDScope) && strings.HasSuffix(scope, domain.AudSuffix))
+}
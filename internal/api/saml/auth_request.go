@@ -0,0 +1,199 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/caos/logging"
+	"github.com/crewjam/saml"
+
+	"github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	paramSAMLRequest   = "SAMLRequest"
+	paramRelayState    = "RelayState"
+	paramAuthRequestID = "id"
+)
+
+// handleSSOPost converts a request of the HTTP-POST binding into the HTTP-Redirect binding.
+// Browsers don't send the (lax) user agent cookie on cross-site POST requests,
+// but they do on the following top-level GET request.
+func (p *Provider) handleSSOPost(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	samlRequest, err := base64.StdEncoding.DecodeString(r.PostForm.Get(paramSAMLRequest))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	compressed := new(bytes.Buffer)
+	writer, err := flate.NewWriter(compressed, flate.DefaultCompression)
+	if err == nil {
+		_, err = writer.Write(samlRequest)
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	params := url.Values{}
+	params.Set(paramSAMLRequest, base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	if relayState := r.PostForm.Get(paramRelayState); relayState != "" {
+		params.Set(paramRelayState, relayState)
+	}
+	redirect := p.ssoURL
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusSeeOther)
+}
+
+// handleSSO validates the authentication request of the service provider
+// and redirects the user agent to the login
+func (p *Provider) handleSSO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	idp, _, err := p.identityProvider(ctx)
+	if err != nil {
+		logging.Log("SAML-Mf93s").WithError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Error("unable to create identity provider")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	req, err := saml.NewIdpAuthnRequest(idp, r)
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		logging.Log("SAML-0Pfs2").WithError(err).Debug("invalid authn request")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if req.ACSEndpoint.Binding != saml.HTTPPostBinding {
+		logging.LogWithFields("SAML-2m0fs", "binding", req.ACSEndpoint.Binding).Debug("unsupported binding of assertion consumer service")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	authRequest, err := p.repo.CreateAuthRequest(ctx, createAuthRequestToBusiness(r, req, userAgentID))
+	if err != nil {
+		logging.Log("SAML-Gm2ps").WithError(err).Debug("unable to create auth request")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	http.Redirect(w, r, p.defaultLoginURL+authRequest.ID, http.StatusFound)
+}
+
+// handleCallback is called by the login after the user has been authenticated
+// and responds the signed assertion to the assertion consumer service of the service provider
+func (p *Provider) handleCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	authRequest, err := p.repo.AuthRequestByIDCheckLoggedIn(ctx, r.URL.Query().Get(paramAuthRequestID), userAgentID)
+	if err != nil {
+		logging.Log("SAML-3m9fs").WithError(err).Debug("auth request not found or user not logged in")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if err = p.respondAssertion(ctx, w, r, authRequest); err != nil {
+		logging.Log("SAML-Qw9fd").WithError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Error("unable to respond assertion")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	err = p.repo.DeleteAuthRequest(ctx, authRequest.ID)
+	logging.LogWithFields("SAML-2m0sd", "authRequestID", authRequest.ID).OnError(err).Warn("unable to delete auth request")
+}
+
+func (p *Provider) respondAssertion(ctx context.Context, w http.ResponseWriter, r *http.Request, authRequest *domain.AuthRequest) error {
+	samlRequest, ok := authRequest.Request.(*domain.AuthRequestSAML)
+	if !ok {
+		return errors.ThrowInvalidArgument(nil, "SAML-Fm0ws", "Errors.AuthRequest.RequestTypeNotSupported")
+	}
+	idp, _, err := p.identityProvider(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := p.idpAuthnRequest(ctx, idp, r, authRequest, samlRequest)
+	if err != nil {
+		return err
+	}
+	session, err := p.session(ctx, authRequest)
+	if err != nil {
+		return err
+	}
+	if err = (saml.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		return err
+	}
+	return req.WriteResponse(w)
+}
+
+// idpAuthnRequest restores the (already validated) authentication request of the service provider
+func (p *Provider) idpAuthnRequest(ctx context.Context, idp *saml.IdentityProvider, r *http.Request, authRequest *domain.AuthRequest, samlRequest *domain.AuthRequestSAML) (*saml.IdpAuthnRequest, error) {
+	serviceProvider, err := p.serviceProvider(ctx, samlRequest.Issuer)
+	if err != nil {
+		return nil, errors.ThrowPreconditionFailed(err, "SAML-2n9sd", "Errors.Project.App.NotExisting")
+	}
+	req := &saml.IdpAuthnRequest{
+		IDP:         idp,
+		HTTPRequest: r,
+		RelayState:  authRequest.TransferState,
+		Request: saml.AuthnRequest{
+			ID:          samlRequest.ID,
+			Destination: samlRequest.Destination,
+			Issuer:      &saml.Issuer{Value: samlRequest.Issuer},
+		},
+		ServiceProviderMetadata: serviceProvider,
+		Now:                     saml.TimeNow(),
+	}
+	for i, descriptor := range serviceProvider.SPSSODescriptors {
+		for j, acs := range descriptor.AssertionConsumerServices {
+			if acs.Location == authRequest.CallbackURI && acs.Binding == samlRequest.BindingType {
+				req.SPSSODescriptor = &serviceProvider.SPSSODescriptors[i]
+				req.ACSEndpoint = &serviceProvider.SPSSODescriptors[i].AssertionConsumerServices[j]
+				return req, nil
+			}
+		}
+	}
+	return nil, errors.ThrowPreconditionFailed(nil, "SAML-Wm2fs", "Errors.Project.App.SAMLMetadataInvalid")
+}
+
+func createAuthRequestToBusiness(r *http.Request, req *saml.IdpAuthnRequest, userAgentID string) *domain.AuthRequest {
+	authRequest := &domain.AuthRequest{
+		CreationDate:  time.Now(),
+		AgentID:       userAgentID,
+		BrowserInfo:   domain.BrowserInfoFromRequest(r),
+		ApplicationID: req.ServiceProviderMetadata.EntityID,
+		CallbackURI:   req.ACSEndpoint.Location,
+		TransferState: req.RelayState,
+		Request: &domain.AuthRequestSAML{
+			ID:          req.Request.ID,
+			BindingType: req.ACSEndpoint.Binding,
+			Issuer:      req.Request.Issuer.Value,
+			Destination: req.Request.Destination,
+		},
+	}
+	if req.Request.ForceAuthn != nil && *req.Request.ForceAuthn {
+		authRequest.Prompt = append(authRequest.Prompt, domain.PromptLogin)
+	}
+	if req.Request.IsPassive != nil && *req.Request.IsPassive {
+		authRequest.Prompt = append(authRequest.Prompt, domain.PromptNone)
+	}
+	return authRequest
+}
@@ -0,0 +1,67 @@
+package saml
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/crewjam/saml"
+)
+
+func TestProvider_handleSSOPost(t *testing.T) {
+	samlRequest := `<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="id-1" Version="2.0"/>`
+	ssoURL, _ := url.Parse("https://accounts.zitadel.ch/saml/v2/SSO")
+	p := &Provider{ssoURL: *ssoURL}
+
+	form := url.Values{}
+	form.Set(paramSAMLRequest, base64.StdEncoding.EncodeToString([]byte(samlRequest)))
+	form.Set(paramRelayState, "state")
+	r := httptest.NewRequest(http.MethodPost, "/SSO", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+
+	p.handleSSOPost(w, r)
+
+	if w.Code != http.StatusSeeOther {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusSeeOther)
+	}
+	location := w.Header().Get("Location")
+	if !strings.HasPrefix(location, ssoURL.String()+"?") {
+		t.Fatalf("got location %q, want redirect to %q", location, ssoURL.String())
+	}
+	req, err := saml.NewIdpAuthnRequest(&saml.IdentityProvider{}, httptest.NewRequest(http.MethodGet, location, nil))
+	if err != nil {
+		t.Fatalf("unable to parse redirected request: %v", err)
+	}
+	if string(req.RequestBuffer) != samlRequest {
+		t.Errorf("got request %q, want %q", req.RequestBuffer, samlRequest)
+	}
+	if req.RelayState != "state" {
+		t.Errorf("got relay state %q, want %q", req.RelayState, "state")
+	}
+}
+
+func TestProvider_handleSSOPost_invalid(t *testing.T) {
+	p := &Provider{}
+	form := url.Values{}
+	form.Set(paramSAMLRequest, "%invalid%")
+	r := httptest.NewRequest(http.MethodPost, "/SSO", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+
+	p.handleSSOPost(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func Test_appendRoles(t *testing.T) {
+	roles := appendRoles([]string{"admin"}, "admin", "user")
+	if len(roles) != 2 || roles[0] != "admin" || roles[1] != "user" {
+		t.Errorf("got roles %v, want [admin user]", roles)
+	}
+}
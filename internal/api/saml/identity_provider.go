@@ -0,0 +1,95 @@
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/caos/logging"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+)
+
+type serviceProviderProvider struct {
+	ctx      context.Context
+	provider *Provider
+}
+
+// GetServiceProvider implements saml.ServiceProviderProvider by searching an active SAML application with the entity id
+func (s *serviceProviderProvider) GetServiceProvider(_ *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	return s.provider.serviceProvider(s.ctx, serviceProviderID)
+}
+
+func (p *Provider) serviceProvider(ctx context.Context, entityID string) (*saml.EntityDescriptor, error) {
+	app, err := p.query.AppBySAMLEntityID(ctx, entityID)
+	if errors.IsNotFound(err) {
+		return nil, os.ErrNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	if app.State != domain.AppStateActive || app.SAMLConfig == nil {
+		return nil, os.ErrNotExist
+	}
+	if len(app.SAMLConfig.Metadata) > 0 {
+		return samlsp.ParseMetadata(app.SAMLConfig.Metadata)
+	}
+	metadataURL, err := url.Parse(app.SAMLConfig.MetadataURL)
+	if err != nil {
+		return nil, err
+	}
+	return samlsp.FetchMetadata(ctx, http.DefaultClient, *metadataURL)
+}
+
+// identityProvider creates the identity provider signing with the newest active key
+func (p *Provider) identityProvider(ctx context.Context) (*saml.IdentityProvider, []*signingKey, error) {
+	keys, err := p.signingKeys(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	current := keys[len(keys)-1]
+	return &saml.IdentityProvider{
+		Key:                     current.key,
+		Certificate:             current.certificate,
+		MetadataURL:             p.metadataURL,
+		SSOURL:                  p.ssoURL,
+		SignatureMethod:         p.signatureMethod,
+		ServiceProviderProvider: &serviceProviderProvider{ctx: ctx, provider: p},
+	}, keys, nil
+}
+
+func (p *Provider) handleMetadata(w http.ResponseWriter, r *http.Request) {
+	idp, keys, err := p.identityProvider(r.Context())
+	if err != nil {
+		logging.Log("SAML-Wm3ks").WithError(err).Error("unable to create identity provider")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	metadata := idp.Metadata()
+	for _, key := range keys[:len(keys)-1] {
+		metadata.IDPSSODescriptors[0].KeyDescriptors = append(metadata.IDPSSODescriptors[0].KeyDescriptors, saml.KeyDescriptor{
+			Use: "signing",
+			KeyInfo: saml.KeyInfo{
+				X509Data: saml.X509Data{
+					X509Certificates: []saml.X509Certificate{
+						{Data: base64.StdEncoding.EncodeToString(key.certificate.Raw)},
+					},
+				},
+			},
+		})
+	}
+	buf, err := xml.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, err = w.Write(buf)
+	logging.Log("SAML-Pw0sf").OnError(err).Debug("unable to write metadata")
+}
@@ -0,0 +1,71 @@
+package saml
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"time"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type signingKey struct {
+	key         *rsa.PrivateKey
+	certificate *x509.Certificate
+}
+
+// signingKeys returns all active signing keys with their (self-signed) certificate.
+// The last key is the newest one and used to sign the assertions, the others are published in the metadata,
+// so that service providers are able to verify assertions during key rotation.
+func (p *Provider) signingKeys(ctx context.Context) ([]*signingKey, error) {
+	keys, err := p.query.ActivePrivateSigningKey(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "SAML-2k0fs", "Errors.Internal")
+	}
+	signingKeys := make([]*signingKey, len(keys.Keys))
+	for i, key := range keys.Keys {
+		signingKeys[i], err = p.signingKey(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return signingKeys, nil
+}
+
+func (p *Provider) signingKey(key query.PrivateKey) (*signingKey, error) {
+	keyData, err := crypto.Decrypt(key.Key(), p.encAlg)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := crypto.BytesToPrivateKey(keyData)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := p.certificate(key.ID(), key.Expiry(), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &signingKey{key: privateKey, certificate: certificate}, nil
+}
+
+// certificate creates the certificate for the key (or takes it from the cache);
+// as the certificate only depends on the key, every instance serves the same certificate
+func (p *Provider) certificate(keyID string, expiry time.Time, privateKey *rsa.PrivateKey) (*x509.Certificate, error) {
+	p.certificatesLock.Lock()
+	defer p.certificatesLock.Unlock()
+	certBytes, ok := p.certificates[keyID]
+	if !ok {
+		var err error
+		certBytes, err = crypto.GenerateCertificateForKey(privateKey, keyID, p.metadataURL.Host, expiry.Add(-p.privateKeyLifetime), expiry)
+		if err != nil {
+			return nil, err
+		}
+		p.certificates[keyID] = certBytes
+	}
+	return x509.ParseCertificate(certBytes)
+}
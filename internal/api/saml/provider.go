@@ -0,0 +1,99 @@
+package saml
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/caos/logging"
+	"github.com/gorilla/mux"
+
+	http_utils "github.com/caos/zitadel/internal/api/http"
+	"github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/auth/repository"
+	"github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/telemetry/metrics"
+	"github.com/caos/zitadel/internal/telemetry/tracing"
+)
+
+type Config struct {
+	DefaultLoginURL       string
+	SignatureMethod       string
+	UserAgentCookieConfig *middleware.UserAgentCookieConfig
+	Endpoints             *EndpointConfig
+}
+
+type EndpointConfig struct {
+	Metadata *Endpoint
+	SSO      *Endpoint
+	Callback *Endpoint
+}
+
+type Endpoint struct {
+	Path string
+	URL  string
+}
+
+type Provider struct {
+	repo               repository.Repository
+	query              *query.Queries
+	encAlg             crypto.EncryptionAlgorithm
+	cookieHandler      func(http.Handler) http.Handler
+	defaultLoginURL    string
+	signatureMethod    string
+	privateKeyLifetime time.Duration
+	endpoints          *EndpointConfig
+	metadataURL        url.URL
+	ssoURL             url.URL
+
+	certificates     map[string][]byte
+	certificatesLock sync.Mutex
+}
+
+func NewProvider(ctx context.Context, config Config, query *query.Queries, repo repository.Repository, keyConfig systemdefaults.KeyConfig, localDevMode bool) *Provider {
+	cookieHandler, err := middleware.NewUserAgentHandler(config.UserAgentCookieConfig, id.SonyFlakeGenerator, localDevMode)
+	logging.Log("SAML-3n0fs").OnError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Panic("cannot create user agent handler")
+	encAlg, err := crypto.NewAESCrypto(keyConfig.EncryptionConfig)
+	logging.Log("SAML-2mf9s").OnError(err).Panic("cannot load key crypto")
+	metadataURL, err := url.Parse(config.Endpoints.Metadata.URL)
+	logging.Log("SAML-Pq0ds").OnError(err).Panic("invalid metadata url")
+	ssoURL, err := url.Parse(config.Endpoints.SSO.URL)
+	logging.Log("SAML-Ww2kd").OnError(err).Panic("invalid sso url")
+	return &Provider{
+		repo:               repo,
+		query:              query,
+		encAlg:             encAlg,
+		cookieHandler:      cookieHandler,
+		defaultLoginURL:    config.DefaultLoginURL,
+		signatureMethod:    config.SignatureMethod,
+		privateKeyLifetime: keyConfig.PrivateKeyLifetime.Duration,
+		endpoints:          config.Endpoints,
+		metadataURL:        *metadataURL,
+		ssoURL:             *ssoURL,
+		certificates:       make(map[string][]byte),
+	}
+}
+
+// HttpHandler serves the metadata, single sign on and callback endpoints of the identity provider.
+// Only the endpoints the user agent is redirected to (not the cross-site POST) are wrapped by the user agent cookie handler,
+// as the cookie is not sent on cross-site POST requests and would otherwise be replaced.
+func (p *Provider) HttpHandler() http.Handler {
+	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
+	router := mux.NewRouter()
+	router.Use(
+		middleware.MetricsHandler(metricTypes),
+		middleware.TelemetryHandler(),
+		middleware.NoCacheInterceptor,
+		http_utils.CopyHeadersToContext,
+	)
+	router.Path("/" + p.endpoints.Metadata.Path).Methods(http.MethodGet).HandlerFunc(p.handleMetadata)
+	router.Path("/" + p.endpoints.SSO.Path).Methods(http.MethodGet).Handler(p.cookieHandler(http.HandlerFunc(p.handleSSO)))
+	router.Path("/" + p.endpoints.SSO.Path).Methods(http.MethodPost).HandlerFunc(p.handleSSOPost)
+	router.Path("/" + p.endpoints.Callback.Path).Methods(http.MethodGet).Handler(p.cookieHandler(http.HandlerFunc(p.handleCallback)))
+	return router
+}
@@ -0,0 +1,117 @@
+package saml
+
+import (
+	"context"
+	"time"
+
+	"github.com/crewjam/saml"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/query"
+)
+
+const (
+	AttributeProjectRoles = "urn:zitadel:iam:org:project:roles"
+	AttributeUserMetadata = "urn:zitadel:iam:user:metadata:"
+	attributeNameFormat   = "urn:oasis:names:tc:SAML:2.0:attrname-format:uri"
+)
+
+// session maps the authenticated user of the auth request to the attributes of the assertion:
+// the profile as the default attributes, the granted roles of the project and the metadata of the user
+func (p *Provider) session(ctx context.Context, authRequest *domain.AuthRequest) (*saml.Session, error) {
+	user, err := p.query.GetUserByID(ctx, authRequest.UserID)
+	if err != nil {
+		return nil, err
+	}
+	session := &saml.Session{
+		ID:         authRequest.ID,
+		CreateTime: authRequest.AuthTime,
+		Index:      authRequest.ID,
+		NameID:     user.ID,
+		UserName:   user.PreferredLoginName,
+	}
+	if user.Human != nil {
+		session.UserEmail = user.Human.Email
+		session.UserGivenName = user.Human.FirstName
+		session.UserSurname = user.Human.LastName
+		session.UserCommonName = user.Human.DisplayName
+	}
+	roles, err := p.projectRoles(ctx, authRequest)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) > 0 {
+		session.CustomAttributes = append(session.CustomAttributes, attribute(AttributeProjectRoles, roles...))
+	}
+	metadata, err := p.query.SearchUserMetadata(ctx, user.ID, &query.UserMetadataSearchQueries{})
+	if err != nil {
+		return nil, err
+	}
+	for _, md := range metadata.Metadata {
+		session.CustomAttributes = append(session.CustomAttributes, attribute(AttributeUserMetadata+md.Key, string(md.Value)))
+	}
+	return session, nil
+}
+
+func (p *Provider) projectRoles(ctx context.Context, authRequest *domain.AuthRequest) ([]string, error) {
+	project, err := p.query.ProjectBySAMLEntityID(ctx, authRequest.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(project.ID)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(authRequest.UserID)
+	if err != nil {
+		return nil, err
+	}
+	notExpiredQuery, err := query.NewUserGrantNotExpiredQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	grants, err := p.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, userIDQuery, notExpiredQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]string, 0)
+	for _, grant := range grants.UserGrants {
+		roles = appendRoles(roles, grant.Roles...)
+	}
+	return roles, nil
+}
+
+func appendRoles(roles []string, granted ...string) []string {
+	for _, role := range granted {
+		if !containsRole(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func attribute(name string, values ...string) saml.Attribute {
+	attributeValues := make([]saml.AttributeValue, len(values))
+	for i, value := range values {
+		attributeValues[i] = saml.AttributeValue{
+			Type:  "xs:string",
+			Value: value,
+		}
+	}
+	return saml.Attribute{
+		Name:       name,
+		NameFormat: attributeNameFormat,
+		Values:     attributeValues,
+	}
+}
@@ -0,0 +1,185 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/caos/zitadel/internal/api/authz"
+)
+
+const (
+	bulkIDPrefix = "bulkId:"
+)
+
+func (h *Handler) handleBulk(w http.ResponseWriter, r *http.Request) {
+	req := new(BulkRequest)
+	if err := readJSON(r, req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(req.Operations) > maxBulkOperations {
+		writeError(w, newError(http.StatusRequestEntityTooLarge, scimTypeTooMany, "at most "+strconv.Itoa(maxBulkOperations)+" operations are allowed"))
+		return
+	}
+	writeResource(w, http.StatusOK, "", h.bulk(r.Context(), req))
+}
+
+//bulk executes the operations in the order of the request (RFC 7644 section 3.7),
+//bulkIds of previously created resources can be referenced in the path and data of the subsequent operations
+func (h *Handler) bulk(ctx context.Context, req *BulkRequest) *BulkResponse {
+	resp := &BulkResponse{
+		Schemas:    []string{schemaBulkResponse},
+		Operations: make([]*BulkOperationResponse, 0, len(req.Operations)),
+	}
+	bulkIDs := make(map[string]string)
+	errorCount := 0
+	for _, operation := range req.Operations {
+		if req.FailOnErrors > 0 && errorCount >= req.FailOnErrors {
+			break
+		}
+		result := &BulkOperationResponse{
+			Method: operation.Method,
+			BulkID: operation.BulkID,
+		}
+		id, location, status, err := h.bulkOperation(ctx, operation, bulkIDs)
+		if err != nil {
+			errorCount++
+			scimErr := toSCIMError(err)
+			result.Status = scimErr.Status
+			result.Response = scimErr
+		} else {
+			result.Status = strconv.Itoa(status)
+			result.Location = location
+			if operation.BulkID != "" {
+				bulkIDs[operation.BulkID] = id
+			}
+		}
+		resp.Operations = append(resp.Operations, result)
+	}
+	return resp
+}
+
+func (h *Handler) bulkOperation(ctx context.Context, operation *BulkOperation, bulkIDs map[string]string) (id, location string, status int, err error) {
+	method := strings.ToUpper(operation.Method)
+	if method == http.MethodPost && operation.BulkID == "" {
+		return "", "", 0, invalidValueError("bulkId missing")
+	}
+	path, data, err := resolveBulkIDs(operation.Path, operation.Data, bulkIDs)
+	if err != nil {
+		return "", "", 0, err
+	}
+	resource, id, err := parseBulkPath(path, method)
+	if err != nil {
+		return "", "", 0, err
+	}
+	template := resource
+	if id != "" {
+		template += "/{id}"
+	}
+	if err = checkBulkPermission(ctx, method, template); err != nil {
+		return "", "", 0, err
+	}
+	switch resource + " " + method {
+	case pathUsers + " " + http.MethodPost:
+		user := new(User)
+		if err = decodeJSON(data, user); err != nil {
+			return "", "", 0, err
+		}
+		user, err = h.createUser(ctx, user)
+		if err != nil {
+			return "", "", 0, err
+		}
+		return user.ID, user.Meta.Location, http.StatusCreated, nil
+	case pathUsers + " " + http.MethodPut:
+		user := new(User)
+		if err = decodeJSON(data, user); err != nil {
+			return "", "", 0, err
+		}
+		_, err = h.replaceUser(ctx, id, user)
+	case pathUsers + " " + http.MethodPatch:
+		patch := new(PatchRequest)
+		if err = decodeJSON(data, patch); err != nil {
+			return "", "", 0, err
+		}
+		_, err = h.patchUser(ctx, id, patch)
+	case pathUsers + " " + http.MethodDelete:
+		err = h.deleteUser(ctx, id)
+		return id, "", http.StatusNoContent, err
+	case pathGroups + " " + http.MethodPost:
+		group := new(Group)
+		if err = decodeJSON(data, group); err != nil {
+			return "", "", 0, err
+		}
+		group, err = h.createGroup(ctx, group)
+		if err != nil {
+			return "", "", 0, err
+		}
+		return group.ID, group.Meta.Location, http.StatusCreated, nil
+	case pathGroups + " " + http.MethodPut:
+		group := new(Group)
+		if err = decodeJSON(data, group); err != nil {
+			return "", "", 0, err
+		}
+		_, err = h.replaceGroup(ctx, id, group)
+	case pathGroups + " " + http.MethodPatch:
+		patch := new(PatchRequest)
+		if err = decodeJSON(data, patch); err != nil {
+			return "", "", 0, err
+		}
+		_, err = h.patchGroup(ctx, id, patch)
+	case pathGroups + " " + http.MethodDelete:
+		err = h.deleteGroup(ctx, id)
+		return id, "", http.StatusNoContent, err
+	default:
+		return "", "", 0, newError(http.StatusMethodNotAllowed, "", "method "+operation.Method+" not allowed on "+path)
+	}
+	return id, h.location(resource, id), http.StatusOK, err
+}
+
+//parseBulkPath returns the resource endpoint and the id of the path (e.g. /Users/123),
+//which is only allowed and required if the method isn't POST
+func parseBulkPath(path, method string) (resource, id string, err error) {
+	split := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	resource = "/" + split[0]
+	if resource != pathUsers && resource != pathGroups {
+		return "", "", invalidPathError("unknown resource " + path)
+	}
+	if len(split) > 2 || (len(split) == 2) == (method == http.MethodPost) {
+		return "", "", invalidPathError("invalid path " + path)
+	}
+	if len(split) == 2 {
+		id = split[1]
+		if id == "" {
+			return "", "", invalidPathError("id missing in " + path)
+		}
+	}
+	return resource, id, nil
+}
+
+//resolveBulkIDs replaces the references to resources created in the same request by their ids
+func resolveBulkIDs(path string, data []byte, bulkIDs map[string]string) (string, []byte, error) {
+	for bulkID, id := range bulkIDs {
+		path = strings.ReplaceAll(path, bulkIDPrefix+bulkID, id)
+		data = bytes.ReplaceAll(data, []byte(`"`+bulkIDPrefix+bulkID+`"`), []byte(`"`+id+`"`))
+	}
+	if strings.Contains(path, bulkIDPrefix) || bytes.Contains(data, []byte(`"`+bulkIDPrefix)) {
+		return "", nil, newError(http.StatusConflict, scimTypeInvalidValue, "unresolved bulkId reference")
+	}
+	return path, data, nil
+}
+
+//checkBulkPermission checks if the permissions of the user allow the operation,
+//the bulk endpoint itself only requires the permission to write users
+func checkBulkPermission(ctx context.Context, method, template string) error {
+	option, ok := authMethods[method+":"+HandlerPrefix+template]
+	if !ok {
+		return newError(http.StatusMethodNotAllowed, "", "method "+method+" not allowed on "+template)
+	}
+	if !authz.ExistsPerm(authz.GetAllPermissionsFromCtx(ctx), option.Permission) {
+		return newError(http.StatusForbidden, "", "Errors.PermissionDenied")
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type serviceProviderConfig struct {
+	Schemas               []string                `json:"schemas"`
+	DocumentationURI      string                  `json:"documentationUri,omitempty"`
+	Patch                 supported               `json:"patch"`
+	Bulk                  bulkConfig              `json:"bulk"`
+	Filter                filterConfig            `json:"filter"`
+	ChangePassword        supported               `json:"changePassword"`
+	Sort                  supported               `json:"sort"`
+	ETag                  supported               `json:"etag"`
+	AuthenticationSchemes []*authenticationScheme `json:"authenticationSchemes"`
+	Meta                  *Meta                   `json:"meta"`
+}
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type bulkConfig struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type filterConfig struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+type resourceType struct {
+	Schemas          []string           `json:"schemas"`
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Endpoint         string             `json:"endpoint"`
+	Description      string             `json:"description"`
+	Schema           string             `json:"schema"`
+	SchemaExtensions []*schemaExtension `json:"schemaExtensions,omitempty"`
+	Meta             *Meta              `json:"meta"`
+}
+
+type schemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+type schema struct {
+	Schemas     []string           `json:"schemas"`
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Attributes  []*schemaAttribute `json:"attributes"`
+	Meta        *Meta              `json:"meta"`
+}
+
+type schemaAttribute struct {
+	Name          string             `json:"name"`
+	Type          string             `json:"type"`
+	MultiValued   bool               `json:"multiValued"`
+	Required      bool               `json:"required"`
+	CaseExact     bool               `json:"caseExact"`
+	Mutability    string             `json:"mutability"`
+	Returned      string             `json:"returned"`
+	Uniqueness    string             `json:"uniqueness"`
+	SubAttributes []*schemaAttribute `json:"subAttributes,omitempty"`
+}
+
+func stringAttribute(name string, required bool) *schemaAttribute {
+	return &schemaAttribute{
+		Name:       name,
+		Type:       "string",
+		Required:   required,
+		Mutability: "readWrite",
+		Returned:   "default",
+		Uniqueness: "none",
+	}
+}
+
+func multiValuedAttribute(name string, subAttributes ...*schemaAttribute) *schemaAttribute {
+	return &schemaAttribute{
+		Name:          name,
+		Type:          "complex",
+		MultiValued:   true,
+		Mutability:    "readWrite",
+		Returned:      "default",
+		Uniqueness:    "none",
+		SubAttributes: subAttributes,
+	}
+}
+
+func (h *Handler) handleServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeResource(w, http.StatusOK, "", &serviceProviderConfig{
+		Schemas:          []string{schemaServiceProviderConfig},
+		DocumentationURI: "https://docs.zitadel.ch/docs/apis/scim",
+		Patch:            supported{Supported: true},
+		Bulk: bulkConfig{
+			Supported:      true,
+			MaxOperations:  maxBulkOperations,
+			MaxPayloadSize: maxPayloadSize,
+		},
+		Filter: filterConfig{
+			Supported:  true,
+			MaxResults: maxResults,
+		},
+		ChangePassword: supported{Supported: true},
+		Sort:           supported{Supported: false},
+		ETag:           supported{Supported: false},
+		AuthenticationSchemes: []*authenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication with an access token of a (machine) user of ZITADEL",
+				Primary:     true,
+			},
+		},
+		Meta: &Meta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     h.baseURL + pathServiceProviderConfig,
+		},
+	})
+}
+
+func (h *Handler) handleResourceTypes(w http.ResponseWriter, r *http.Request) {
+	resourceTypes := h.resourceTypes()
+	writeResource(w, http.StatusOK, "", &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: uint64(len(resourceTypes)),
+		StartIndex:   1,
+		ItemsPerPage: uint64(len(resourceTypes)),
+		Resources:    resourceTypes,
+	})
+}
+
+func (h *Handler) handleResourceType(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	for _, resourceType := range h.resourceTypes() {
+		if resourceType.ID == id {
+			writeResource(w, http.StatusOK, "", resourceType)
+			return
+		}
+	}
+	writeError(w, notFoundError("resource type "+id+" not found"))
+}
+
+func (h *Handler) handleSchemas(w http.ResponseWriter, r *http.Request) {
+	schemas := h.schemas()
+	writeResource(w, http.StatusOK, "", &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: uint64(len(schemas)),
+		StartIndex:   1,
+		ItemsPerPage: uint64(len(schemas)),
+		Resources:    schemas,
+	})
+}
+
+func (h *Handler) handleSchema(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	for _, schema := range h.schemas() {
+		if schema.ID == id {
+			writeResource(w, http.StatusOK, "", schema)
+			return
+		}
+	}
+	writeError(w, notFoundError("schema "+id+" not found"))
+}
+
+func (h *Handler) resourceTypes() []*resourceType {
+	return []*resourceType{
+		{
+			Schemas:     []string{schemaResourceType},
+			ID:          resourceTypeUser,
+			Name:        resourceTypeUser,
+			Endpoint:    pathUsers,
+			Description: "Human users of the organisation",
+			Schema:      schemaUser,
+			Meta: &Meta{
+				ResourceType: "ResourceType",
+				Location:     h.location(pathResourceTypes, resourceTypeUser),
+			},
+		},
+		{
+			Schemas:     []string{schemaResourceType},
+			ID:          resourceTypeGroup,
+			Name:        resourceTypeGroup,
+			Endpoint:    pathGroups,
+			Description: "Roles of the projects of the organisation, the members are the users granted the role",
+			Schema:      schemaGroup,
+			SchemaExtensions: []*schemaExtension{
+				{Schema: schemaZitadelGroup, Required: false},
+			},
+			Meta: &Meta{
+				ResourceType: "ResourceType",
+				Location:     h.location(pathResourceTypes, resourceTypeGroup),
+			},
+		},
+	}
+}
+
+func (h *Handler) schemas() []*schema {
+	userName := stringAttribute("userName", true)
+	userName.Uniqueness = "server"
+	password := stringAttribute("password", false)
+	password.Mutability = "writeOnly"
+	password.Returned = "never"
+	members := multiValuedAttribute("members",
+		stringAttribute("value", false),
+		stringAttribute("$ref", false),
+		stringAttribute("display", false),
+		stringAttribute("type", false),
+	)
+	return []*schema{
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          schemaUser,
+			Name:        resourceTypeUser,
+			Description: "User Account",
+			Attributes: []*schemaAttribute{
+				userName,
+				stringAttribute("externalId", false),
+				{
+					Name:       "name",
+					Type:       "complex",
+					Required:   true,
+					Mutability: "readWrite",
+					Returned:   "default",
+					Uniqueness: "none",
+					SubAttributes: []*schemaAttribute{
+						stringAttribute("formatted", false),
+						stringAttribute("familyName", true),
+						stringAttribute("givenName", true),
+					},
+				},
+				stringAttribute("displayName", false),
+				stringAttribute("nickName", false),
+				stringAttribute("preferredLanguage", false),
+				{
+					Name:       "active",
+					Type:       "boolean",
+					Mutability: "readWrite",
+					Returned:   "default",
+					Uniqueness: "none",
+				},
+				password,
+				multiValuedAttribute("emails", stringAttribute("value", true), stringAttribute("type", false)),
+				multiValuedAttribute("phoneNumbers", stringAttribute("value", false), stringAttribute("type", false)),
+			},
+			Meta: &Meta{
+				ResourceType: "Schema",
+				Location:     h.location(pathSchemas, schemaUser),
+			},
+		},
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          schemaGroup,
+			Name:        resourceTypeGroup,
+			Description: "Group",
+			Attributes: []*schemaAttribute{
+				stringAttribute("displayName", true),
+				members,
+			},
+			Meta: &Meta{
+				ResourceType: "Schema",
+				Location:     h.location(pathSchemas, schemaGroup),
+			},
+		},
+		{
+			Schemas:     []string{schemaSchema},
+			ID:          schemaZitadelGroup,
+			Name:        "ZitadelGroup",
+			Description: "Project role the group is mapped to",
+			Attributes: []*schemaAttribute{
+				{
+					Name:       "projectId",
+					Type:       "string",
+					Required:   true,
+					CaseExact:  true,
+					Mutability: "immutable",
+					Returned:   "default",
+					Uniqueness: "none",
+				},
+				{
+					Name:       "roleKey",
+					Type:       "string",
+					CaseExact:  true,
+					Mutability: "immutable",
+					Returned:   "default",
+					Uniqueness: "none",
+				},
+				stringAttribute("group", false),
+			},
+			Meta: &Meta{
+				ResourceType: "Schema",
+				Location:     h.location(pathSchemas, schemaZitadelGroup),
+			},
+		},
+	}
+}
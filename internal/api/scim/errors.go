@@ -0,0 +1,102 @@
+package scim
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeNoTarget      = "noTarget"
+	scimTypeUniqueness    = "uniqueness"
+	scimTypeMutability    = "mutability"
+	scimTypeTooMany       = "tooMany"
+)
+
+//scimError is the error response defined in RFC 7644 section 3.12
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	status int
+}
+
+func (e *scimError) Error() string {
+	if e.ScimType == "" {
+		return e.Status + ": " + e.Detail
+	}
+	return e.Status + " " + e.ScimType + ": " + e.Detail
+}
+
+func newError(status int, scimType, detail string) *scimError {
+	return &scimError{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+		status:   status,
+	}
+}
+
+func invalidFilterError(detail string) *scimError {
+	return newError(http.StatusBadRequest, scimTypeInvalidFilter, detail)
+}
+
+func invalidSyntaxError(detail string) *scimError {
+	return newError(http.StatusBadRequest, scimTypeInvalidSyntax, detail)
+}
+
+func invalidPathError(detail string) *scimError {
+	return newError(http.StatusBadRequest, scimTypeInvalidPath, detail)
+}
+
+func invalidValueError(detail string) *scimError {
+	return newError(http.StatusBadRequest, scimTypeInvalidValue, detail)
+}
+
+func noTargetError(detail string) *scimError {
+	return newError(http.StatusBadRequest, scimTypeNoTarget, detail)
+}
+
+func notFoundError(detail string) *scimError {
+	return newError(http.StatusNotFound, "", detail)
+}
+
+//toSCIMError maps the errors of the commands and queries to the corresponding scim error
+func toSCIMError(err error) *scimError {
+	scimErr := new(scimError)
+	if errors.As(err, &scimErr) {
+		return scimErr
+	}
+	detail := err.Error()
+	caosErr := new(caos_errs.CaosError)
+	if errors.As(err, &caosErr) {
+		detail = caosErr.GetMessage()
+	}
+	switch {
+	case caos_errs.IsErrorInvalidArgument(err):
+		return invalidValueError(detail)
+	case caos_errs.IsPreconditionFailed(err):
+		return invalidValueError(detail)
+	case caos_errs.IsErrorAlreadyExists(err):
+		return newError(http.StatusConflict, scimTypeUniqueness, detail)
+	case caos_errs.IsNotFound(err):
+		return notFoundError(detail)
+	case caos_errs.IsPermissionDenied(err):
+		return newError(http.StatusForbidden, "", detail)
+	case caos_errs.IsUnauthenticated(err):
+		return newError(http.StatusUnauthorized, "", detail)
+	case caos_errs.IsUnimplemented(err):
+		return newError(http.StatusNotImplemented, "", detail)
+	default:
+		return newError(http.StatusInternalServerError, "", detail)
+	}
+}
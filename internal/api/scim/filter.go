@@ -0,0 +1,498 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+//filter is a parsed scim filter expression (RFC 7644 section 3.4.2.2)
+type filter interface {
+	//matches evaluates the filter against a (json decoded) resource or multi-valued attribute
+	matches(resource map[string]interface{}) bool
+}
+
+type logicalExpression struct {
+	operator string
+	left     filter
+	right    filter
+}
+
+type notExpression struct {
+	filter filter
+}
+
+type attributeExpression struct {
+	path     *attributePath
+	operator string
+	value    interface{}
+}
+
+//valuePathExpression filters on the values of a multi-valued attribute e.g. emails[type eq "work"]
+type valuePathExpression struct {
+	attribute string
+	filter    filter
+}
+
+//attributePath is an attribute with an optional sub attribute e.g. name.givenName,
+//the schema urn prefix is removed and the names are lower case as scim attributes are case insensitive
+type attributePath struct {
+	attribute    string
+	subAttribute string
+}
+
+func (p *attributePath) String() string {
+	if p.subAttribute == "" {
+		return p.attribute
+	}
+	return p.attribute + "." + p.subAttribute
+}
+
+//patchPath is the path of a patch operation e.g. emails[type eq "work"].value
+type patchPath struct {
+	attributePath
+	filter filter
+}
+
+func (p *patchPath) String() string {
+	if p.filter == nil {
+		return p.attributePath.String()
+	}
+	path := p.attribute + "[...]"
+	if p.subAttribute != "" {
+		path += "." + p.subAttribute
+	}
+	return path
+}
+
+const (
+	operatorEqual          = "eq"
+	operatorNotEqual       = "ne"
+	operatorContains       = "co"
+	operatorStartsWith     = "sw"
+	operatorEndsWith       = "ew"
+	operatorGreater        = "gt"
+	operatorGreaterOrEqual = "ge"
+	operatorLess           = "lt"
+	operatorLessOrEqual    = "le"
+	operatorPresent        = "pr"
+
+	operatorAnd = "and"
+	operatorOr  = "or"
+	operatorNot = "not"
+)
+
+var compareOperators = map[string]bool{
+	operatorEqual:          true,
+	operatorNotEqual:       true,
+	operatorContains:       true,
+	operatorStartsWith:     true,
+	operatorEndsWith:       true,
+	operatorGreater:        true,
+	operatorGreaterOrEqual: true,
+	operatorLess:           true,
+	operatorLessOrEqual:    true,
+}
+
+func parseFilter(value string) (filter, error) {
+	p, err := newFilterParser(value)
+	if err != nil {
+		return nil, err
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, invalidFilterError("unexpected " + p.peek().value)
+	}
+	return f, nil
+}
+
+func parsePatchPath(value string) (*patchPath, error) {
+	p, err := newFilterParser(value)
+	if err != nil {
+		return nil, invalidPathError(err.(*scimError).Detail)
+	}
+	if p.done() || p.peek().kind != tokenWord {
+		return nil, invalidPathError("attribute missing")
+	}
+	path := &patchPath{attributePath: *newAttributePath(p.next().value)}
+	if !p.done() && p.peek().kind == tokenBracketOpen {
+		p.next()
+		path.filter, err = p.parseOr()
+		if err != nil {
+			return nil, invalidPathError(err.(*scimError).Detail)
+		}
+		if p.done() || p.next().kind != tokenBracketClose {
+			return nil, invalidPathError("] missing")
+		}
+		if !p.done() {
+			subAttribute := p.next()
+			if subAttribute.kind != tokenWord || !strings.HasPrefix(subAttribute.value, ".") {
+				return nil, invalidPathError("unexpected " + subAttribute.value)
+			}
+			path.subAttribute = strings.ToLower(strings.TrimPrefix(subAttribute.value, "."))
+		}
+	}
+	if !p.done() {
+		return nil, invalidPathError("unexpected " + p.peek().value)
+	}
+	return path, nil
+}
+
+//newAttributePath removes the schema urn (if any) and splits the sub attribute
+func newAttributePath(value string) *attributePath {
+	value = strings.ToLower(value)
+	if strings.HasPrefix(value, "urn:") {
+		if i := strings.LastIndex(value, ":"); i > 0 {
+			value = value[i+1:]
+		}
+	}
+	path := new(attributePath)
+	path.attribute = value
+	if i := strings.Index(value, "."); i > 0 {
+		path.attribute, path.subAttribute = value[:i], value[i+1:]
+	}
+	return path
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenParenthesisOpen
+	tokenParenthesisClose
+	tokenBracketOpen
+	tokenBracketClose
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+type filterParser struct {
+	tokens []*token
+	pos    int
+}
+
+func newFilterParser(value string) (*filterParser, error) {
+	tokens, err := tokenize(value)
+	if err != nil {
+		return nil, err
+	}
+	return &filterParser{tokens: tokens}, nil
+}
+
+func tokenize(value string) ([]*token, error) {
+	tokens := make([]*token, 0)
+	for i := 0; i < len(value); {
+		switch c := value[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, &token{kind: tokenParenthesisOpen, value: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, &token{kind: tokenParenthesisClose, value: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, &token{kind: tokenBracketOpen, value: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, &token{kind: tokenBracketClose, value: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(value) && value[end] != '"'; end++ {
+				if value[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(value) {
+				return nil, invalidFilterError("unterminated string")
+			}
+			var s string
+			if err := json.Unmarshal([]byte(value[i:end+1]), &s); err != nil {
+				return nil, invalidFilterError("invalid string " + value[i:end+1])
+			}
+			tokens = append(tokens, &token{kind: tokenString, value: s})
+			i = end + 1
+		default:
+			end := i
+			for ; end < len(value) && !strings.ContainsRune(" \t()[]\"", rune(value[end])); end++ {
+			}
+			tokens = append(tokens, &token{kind: tokenWord, value: value[i:end]})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+func (p *filterParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() *token {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() *token {
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *filterParser) nextIsKeyword(keyword string) bool {
+	return !p.done() && p.peek().kind == tokenWord && strings.EqualFold(p.peek().value, keyword)
+}
+
+func (p *filterParser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.nextIsKeyword(operatorOr) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpression{operator: operatorOr, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.nextIsKeyword(operatorAnd) {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpression{operator: operatorAnd, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filter, error) {
+	if !p.nextIsKeyword(operatorNot) {
+		return p.parseExpression()
+	}
+	p.next()
+	if p.done() || p.peek().kind != tokenParenthesisOpen {
+		return nil, invalidFilterError("( expected after not")
+	}
+	f, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return &notExpression{filter: f}, nil
+}
+
+func (p *filterParser) parseExpression() (filter, error) {
+	if p.done() {
+		return nil, invalidFilterError("expression missing")
+	}
+	t := p.next()
+	switch t.kind {
+	case tokenParenthesisOpen:
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.done() || p.next().kind != tokenParenthesisClose {
+			return nil, invalidFilterError(") missing")
+		}
+		return f, nil
+	case tokenWord:
+	default:
+		return nil, invalidFilterError("unexpected " + t.value)
+	}
+	if !p.done() && p.peek().kind == tokenBracketOpen {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.done() || p.next().kind != tokenBracketClose {
+			return nil, invalidFilterError("] missing")
+		}
+		return &valuePathExpression{attribute: newAttributePath(t.value).attribute, filter: f}, nil
+	}
+	if p.done() || p.peek().kind != tokenWord {
+		return nil, invalidFilterError("operator missing after " + t.value)
+	}
+	expression := &attributeExpression{
+		path:     newAttributePath(t.value),
+		operator: strings.ToLower(p.next().value),
+	}
+	if expression.operator == operatorPresent {
+		return expression, nil
+	}
+	if !compareOperators[expression.operator] {
+		return nil, invalidFilterError("unknown operator " + expression.operator)
+	}
+	if p.done() {
+		return nil, invalidFilterError("value missing after " + expression.operator)
+	}
+	value, err := parseValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	expression.value = value
+	return expression, nil
+}
+
+func parseValue(t *token) (interface{}, error) {
+	switch t.kind {
+	case tokenString:
+		return t.value, nil
+	case tokenWord:
+		switch strings.ToLower(t.value) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		if number, err := strconv.ParseFloat(t.value, 64); err == nil {
+			return number, nil
+		}
+	}
+	return nil, invalidFilterError("invalid value " + t.value)
+}
+
+func (e *logicalExpression) matches(resource map[string]interface{}) bool {
+	if e.operator == operatorAnd {
+		return e.left.matches(resource) && e.right.matches(resource)
+	}
+	return e.left.matches(resource) || e.right.matches(resource)
+}
+
+func (e *notExpression) matches(resource map[string]interface{}) bool {
+	return !e.filter.matches(resource)
+}
+
+func (e *valuePathExpression) matches(resource map[string]interface{}) bool {
+	values, _ := attributeValue(resource, e.attribute).([]interface{})
+	for _, value := range values {
+		if element, ok := value.(map[string]interface{}); ok && e.filter.matches(element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *attributeExpression) matches(resource map[string]interface{}) bool {
+	value := attributeValue(resource, e.path.attribute)
+	subAttribute := e.path.subAttribute
+	if complexValue, ok := value.(map[string]interface{}); ok && subAttribute != "" {
+		value = attributeValue(complexValue, subAttribute)
+	}
+	if values, ok := value.([]interface{}); ok {
+		//a multi-valued attribute matches if any of its values matches (e.g. emails or emails.value)
+		if subAttribute == "" {
+			subAttribute = "value"
+		}
+		for _, v := range values {
+			if element, ok := v.(map[string]interface{}); ok {
+				v = attributeValue(element, subAttribute)
+			}
+			if e.compare(v) {
+				return true
+			}
+		}
+		return false
+	}
+	return e.compare(value)
+}
+
+func (e *attributeExpression) compare(value interface{}) bool {
+	if e.operator == operatorPresent {
+		return value != nil && value != ""
+	}
+	switch expected := e.value.(type) {
+	case string:
+		actual, ok := value.(string)
+		if !ok {
+			return false
+		}
+		return compareStrings(e.operator, strings.ToLower(actual), strings.ToLower(expected))
+	case bool:
+		actual, ok := value.(bool)
+		return ok && (e.operator == operatorEqual) == (actual == expected)
+	case float64:
+		actual, ok := value.(float64)
+		return ok && compareNumbers(e.operator, actual, expected)
+	case nil:
+		return (e.operator == operatorEqual) == (value == nil)
+	}
+	return false
+}
+
+func compareStrings(operator, actual, expected string) bool {
+	switch operator {
+	case operatorEqual:
+		return actual == expected
+	case operatorNotEqual:
+		return actual != expected
+	case operatorContains:
+		return strings.Contains(actual, expected)
+	case operatorStartsWith:
+		return strings.HasPrefix(actual, expected)
+	case operatorEndsWith:
+		return strings.HasSuffix(actual, expected)
+	case operatorGreater:
+		return actual > expected
+	case operatorGreaterOrEqual:
+		return actual >= expected
+	case operatorLess:
+		return actual < expected
+	case operatorLessOrEqual:
+		return actual <= expected
+	}
+	return false
+}
+
+func compareNumbers(operator string, actual, expected float64) bool {
+	switch operator {
+	case operatorEqual:
+		return actual == expected
+	case operatorNotEqual:
+		return actual != expected
+	case operatorGreater:
+		return actual > expected
+	case operatorGreaterOrEqual:
+		return actual >= expected
+	case operatorLess:
+		return actual < expected
+	case operatorLessOrEqual:
+		return actual <= expected
+	}
+	return false
+}
+
+//attributeValue returns the value of the attribute ignoring the case of its name
+func attributeValue(resource map[string]interface{}, attribute string) interface{} {
+	if key, ok := attributeKey(resource, attribute); ok {
+		return resource[key]
+	}
+	return nil
+}
+
+func attributeKey(resource map[string]interface{}, attribute string) (string, bool) {
+	for key := range resource {
+		if strings.EqualFold(key, attribute) {
+			return key, true
+		}
+	}
+	return attribute, false
+}
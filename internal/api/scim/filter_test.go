@@ -0,0 +1,242 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	type args struct {
+		filter string
+	}
+	type res struct {
+		err bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "attribute expression",
+			args: args{filter: `userName eq "bjensen"`},
+		},
+		{
+			name: "present",
+			args: args{filter: `title pr`},
+		},
+		{
+			name: "logical and grouping",
+			args: args{filter: `userType eq "Employee" and (emails co "example.com" or emails.value co "example.org")`},
+		},
+		{
+			name: "not",
+			args: args{filter: `not (userName sw "j")`},
+		},
+		{
+			name: "value path",
+			args: args{filter: `emails[type eq "work" and value co "@example.com"]`},
+		},
+		{
+			name: "schema urn",
+			args: args{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "bjensen"`},
+		},
+		{
+			name: "empty",
+			args: args{filter: ``},
+			res:  res{err: true},
+		},
+		{
+			name: "unknown operator",
+			args: args{filter: `userName is "bjensen"`},
+			res:  res{err: true},
+		},
+		{
+			name: "value missing",
+			args: args{filter: `userName eq`},
+			res:  res{err: true},
+		},
+		{
+			name: "unterminated string",
+			args: args{filter: `userName eq "bjensen`},
+			res:  res{err: true},
+		},
+		{
+			name: "parenthesis missing",
+			args: args{filter: `(userName eq "bjensen"`},
+			res:  res{err: true},
+		},
+		{
+			name: "trailing token",
+			args: args{filter: `userName eq "bjensen" "jensen"`},
+			res:  res{err: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseFilter(tt.args.filter)
+			if (err != nil) != tt.res.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil && err.(*scimError).ScimType != scimTypeInvalidFilter {
+				t.Errorf("expected scimType %s, got %s", scimTypeInvalidFilter, err.(*scimError).ScimType)
+			}
+		})
+	}
+}
+
+func TestFilter_matches(t *testing.T) {
+	resource := `{
+		"userName": "bjensen",
+		"name": {"givenName": "Barbara", "familyName": "Jensen"},
+		"active": true,
+		"emails": [
+			{"value": "bjensen@example.com", "type": "work", "primary": true},
+			{"value": "babs@jensen.org", "type": "home"}
+		]
+	}`
+	type args struct {
+		filter string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "equal case insensitive",
+			args: args{filter: `USERNAME eq "BJensen"`},
+			want: true,
+		},
+		{
+			name: "not equal",
+			args: args{filter: `userName ne "bjensen"`},
+			want: false,
+		},
+		{
+			name: "sub attribute",
+			args: args{filter: `name.familyName sw "jen"`},
+			want: true,
+		},
+		{
+			name: "boolean",
+			args: args{filter: `active eq false`},
+			want: false,
+		},
+		{
+			name: "multi-valued attribute",
+			args: args{filter: `emails.value ew "jensen.org"`},
+			want: true,
+		},
+		{
+			name: "value path",
+			args: args{filter: `emails[type eq "work" and value co "jensen.org"]`},
+			want: false,
+		},
+		{
+			name: "present",
+			args: args{filter: `nickName pr`},
+			want: false,
+		},
+		{
+			name: "or",
+			args: args{filter: `nickName pr or name.givenName eq "barbara"`},
+			want: true,
+		},
+		{
+			name: "not",
+			args: args{filter: `not (emails[type eq "home"])`},
+			want: false,
+		},
+	}
+	attributes := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(resource), &attributes); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parseFilter(tt.args.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := f.matches(attributes); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePatchPath(t *testing.T) {
+	type args struct {
+		path string
+	}
+	type res struct {
+		attribute    string
+		subAttribute string
+		filtered     bool
+		err          bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "attribute",
+			args: args{path: "displayName"},
+			res:  res{attribute: "displayname"},
+		},
+		{
+			name: "sub attribute",
+			args: args{path: "name.givenName"},
+			res:  res{attribute: "name", subAttribute: "givenname"},
+		},
+		{
+			name: "schema urn",
+			args: args{path: "urn:ietf:params:scim:schemas:core:2.0:User:active"},
+			res:  res{attribute: "active"},
+		},
+		{
+			name: "value path",
+			args: args{path: `members[value eq "123"]`},
+			res:  res{attribute: "members", filtered: true},
+		},
+		{
+			name: "value path with sub attribute",
+			args: args{path: `emails[type eq "work"].value`},
+			res:  res{attribute: "emails", subAttribute: "value", filtered: true},
+		},
+		{
+			name: "empty",
+			args: args{path: ""},
+			res:  res{err: true},
+		},
+		{
+			name: "bracket missing",
+			args: args{path: `emails[type eq "work"`},
+			res:  res{err: true},
+		},
+		{
+			name: "invalid value filter",
+			args: args{path: `emails[type "work"]`},
+			res:  res{err: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePatchPath(tt.args.path)
+			if (err != nil) != tt.res.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				if err.(*scimError).ScimType != scimTypeInvalidPath {
+					t.Errorf("expected scimType %s, got %s", scimTypeInvalidPath, err.(*scimError).ScimType)
+				}
+				return
+			}
+			if got.attribute != tt.res.attribute || got.subAttribute != tt.res.subAttribute || (got.filter != nil) != tt.res.filtered {
+				t.Errorf("unexpected path %+v, want %+v", got, tt.res)
+			}
+		})
+	}
+}
@@ -0,0 +1,625 @@
+package scim
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/query"
+)
+
+func (h *Handler) handleListGroups(w http.ResponseWriter, r *http.Request) {
+	req, err := parseListRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	resp, err := h.listGroups(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", resp)
+}
+
+func (h *Handler) handleGetGroup(w http.ResponseWriter, r *http.Request) {
+	req, err := parseListRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := h.getGroup(r.Context(), mux.Vars(r)["id"], !req.isExcluded("members"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", group)
+}
+
+func (h *Handler) handleCreateGroup(w http.ResponseWriter, r *http.Request) {
+	group := new(Group)
+	if err := readJSON(r, group); err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := h.createGroup(r.Context(), group)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusCreated, group.Meta.Location, group)
+}
+
+func (h *Handler) handleReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	group := new(Group)
+	if err := readJSON(r, group); err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := h.replaceGroup(r.Context(), mux.Vars(r)["id"], group)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", group)
+}
+
+func (h *Handler) handlePatchGroup(w http.ResponseWriter, r *http.Request) {
+	patch := new(PatchRequest)
+	if err := readJSON(r, patch); err != nil {
+		writeError(w, err)
+		return
+	}
+	group, err := h.patchGroup(r.Context(), mux.Vars(r)["id"], patch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", group)
+}
+
+func (h *Handler) handleDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	if err := h.deleteGroup(r.Context(), mux.Vars(r)["id"]); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (h *Handler) listGroups(ctx context.Context, req *listRequest) (*ListResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	resourceOwnerQuery, err := query.NewProjectRoleResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{resourceOwnerQuery}
+	if req.filter != nil {
+		filterQuery, err := groupFilterToQuery(req.filter)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, filterQuery)
+	}
+	searchRequest := req.searchRequest()
+	searchRequest.SortingColumn = query.ProjectRoleColumnCreationDate
+	searchRequest.Asc = true
+	roles, err := h.query.SearchProjectRoles(ctx, &query.ProjectRoleSearchQueries{
+		SearchRequest: searchRequest,
+		Queries:       queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*Group, req.resultLength(len(roles.ProjectRoles)))
+	for i := range resources {
+		resources[i], err = h.roleToGroup(ctx, orgID, roles.ProjectRoles[i], !req.isExcluded("members"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newListResponse(roles.Count, req, resources, len(resources)), nil
+}
+
+func (h *Handler) getGroup(ctx context.Context, id string, withMembers bool) (*Group, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	role, err := h.projectRoleByGroupID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return h.roleToGroup(ctx, orgID, role, withMembers)
+}
+
+//createGroup adds a role to the project of the zitadel group extension,
+//the role key is taken from the extension or the display name
+func (h *Handler) createGroup(ctx context.Context, group *Group) (*Group, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	if group.ZitadelGroup == nil || group.ZitadelGroup.ProjectID == "" {
+		return nil, invalidValueError(schemaZitadelGroup + ":projectId missing")
+	}
+	role := &domain.ProjectRole{
+		ObjectRoot:  models.ObjectRoot{AggregateID: group.ZitadelGroup.ProjectID},
+		Key:         group.ZitadelGroup.RoleKey,
+		DisplayName: group.DisplayName,
+		Group:       group.ZitadelGroup.Group,
+	}
+	if role.Key == "" {
+		role.Key = group.DisplayName
+	}
+	role, err := h.command.AddProjectRole(ctx, role, orgID)
+	if err != nil {
+		return nil, err
+	}
+	id := groupID(role.AggregateID, role.Key)
+	if err = h.setMembers(ctx, orgID, role.AggregateID, role.Key, memberIDs(group.Members)); err != nil {
+		return nil, err
+	}
+	return h.getGroup(ctx, id, true)
+}
+
+func (h *Handler) replaceGroup(ctx context.Context, id string, group *Group) (*Group, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	role, err := h.projectRoleByGroupID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.changeGroupDisplayName(ctx, orgID, role, group.DisplayName); err != nil {
+		return nil, err
+	}
+	if err = h.setMembers(ctx, orgID, role.ProjectID, role.Key, memberIDs(group.Members)); err != nil {
+		return nil, err
+	}
+	return h.getGroup(ctx, id, true)
+}
+
+//patchGroup supports changing the display name and adding, removing or replacing members,
+//the members are changed directly to prevent loading the members of large groups
+func (h *Handler) patchGroup(ctx context.Context, id string, patch *PatchRequest) (*Group, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	role, err := h.projectRoleByGroupID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(patch.Operations) == 0 {
+		return nil, invalidValueError("operations missing")
+	}
+	for _, operation := range patch.Operations {
+		op, value, err := parseOperation(operation)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Path == "" {
+			values, ok := value.(map[string]interface{})
+			if !ok || op == patchOpRemove {
+				return nil, noTargetError("path missing")
+			}
+			for attribute, v := range values {
+				if err = h.patchGroupAttribute(ctx, orgID, role, op, &patchPath{attributePath: *newAttributePath(attribute)}, v); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		path, err := parsePatchPath(operation.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err = h.patchGroupAttribute(ctx, orgID, role, op, path, value); err != nil {
+			return nil, err
+		}
+	}
+	return h.getGroup(ctx, id, true)
+}
+
+func (h *Handler) patchGroupAttribute(ctx context.Context, orgID string, role *query.ProjectRole, op string, path *patchPath, value interface{}) error {
+	switch path.attribute {
+	case "displayname":
+		displayName, ok := value.(string)
+		if !ok || op == patchOpRemove {
+			return invalidValueError("displayName must be a string")
+		}
+		return h.changeGroupDisplayName(ctx, orgID, role, displayName)
+	case "members":
+		return h.patchMembers(ctx, orgID, role, op, path, value)
+	case "externalid":
+		//the external id of groups is not stored
+		return nil
+	}
+	return newError(http.StatusBadRequest, scimTypeMutability, path.String()+" is read only")
+}
+
+func (h *Handler) patchMembers(ctx context.Context, orgID string, role *query.ProjectRole, op string, path *patchPath, value interface{}) error {
+	ids, err := patchMemberIDs(value)
+	if err != nil {
+		return err
+	}
+	if path.filter != nil {
+		if op != patchOpRemove {
+			return invalidPathError("filters are only supported to remove members")
+		}
+		members, err := h.groupMembers(ctx, orgID, role.ProjectID, role.Key)
+		if err != nil {
+			return err
+		}
+		ids = make([]string, 0)
+		for _, member := range members {
+			if path.filter.matches(map[string]interface{}{"value": member.Value, "display": member.Display}) {
+				ids = append(ids, member.Value)
+			}
+		}
+		op = patchOpRemove
+	}
+	switch op {
+	case patchOpAdd:
+		for _, id := range ids {
+			if err = h.addMember(ctx, orgID, role.ProjectID, role.Key, id); err != nil {
+				return err
+			}
+		}
+	case patchOpRemove:
+		if path.filter == nil && value == nil {
+			return h.setMembers(ctx, orgID, role.ProjectID, role.Key, nil)
+		}
+		for _, id := range ids {
+			if err = h.removeMember(ctx, orgID, role.ProjectID, role.Key, id); err != nil {
+				return err
+			}
+		}
+	case patchOpReplace:
+		return h.setMembers(ctx, orgID, role.ProjectID, role.Key, ids)
+	}
+	return nil
+}
+
+func (h *Handler) deleteGroup(ctx context.Context, id string) error {
+	orgID := authz.GetCtxData(ctx).OrgID
+	role, err := h.projectRoleByGroupID(ctx, id, orgID)
+	if err != nil {
+		return err
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(role.ProjectID)
+	if err != nil {
+		return err
+	}
+	rolesQuery, err := query.NewUserGrantRoleQuery(role.Key)
+	if err != nil {
+		return err
+	}
+	userGrants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, rolesQuery},
+	})
+	if err != nil {
+		return err
+	}
+	projectGrants, err := h.query.SearchProjectGrantsByProjectIDAndRoleKey(ctx, role.ProjectID, role.Key)
+	if err != nil {
+		return err
+	}
+	userGrantIDs := make([]string, len(userGrants.UserGrants))
+	for i, grant := range userGrants.UserGrants {
+		userGrantIDs[i] = grant.ID
+	}
+	projectGrantIDs := make([]string, len(projectGrants.ProjectGrants))
+	for i, grant := range projectGrants.ProjectGrants {
+		projectGrantIDs[i] = grant.GrantID
+	}
+	_, err = h.command.RemoveProjectRole(ctx, role.ProjectID, role.Key, orgID, projectGrantIDs, userGrantIDs...)
+	return err
+}
+
+func (h *Handler) changeGroupDisplayName(ctx context.Context, orgID string, role *query.ProjectRole, displayName string) error {
+	if displayName == "" || displayName == groupDisplayName(role) {
+		return nil
+	}
+	_, err := h.command.ChangeProjectRole(ctx, &domain.ProjectRole{
+		ObjectRoot:  models.ObjectRoot{AggregateID: role.ProjectID},
+		Key:         role.Key,
+		DisplayName: displayName,
+		Group:       role.Group,
+	}, orgID)
+	return err
+}
+
+//setMembers grants the role to the provided users and removes it from all other users of the organisation
+func (h *Handler) setMembers(ctx context.Context, orgID, projectID, roleKey string, userIDs []string) error {
+	members, err := h.groupMembers(ctx, orgID, projectID, roleKey)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool, len(members))
+	for _, member := range members {
+		existing[member.Value] = true
+	}
+	desired := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		desired[id] = true
+		if existing[id] {
+			continue
+		}
+		if err = h.addMember(ctx, orgID, projectID, roleKey, id); err != nil {
+			return err
+		}
+	}
+	for _, member := range members {
+		if desired[member.Value] {
+			continue
+		}
+		if err = h.removeMember(ctx, orgID, projectID, roleKey, member.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//addMember adds the role to the user grant of the user on the project or creates the grant
+func (h *Handler) addMember(ctx context.Context, orgID, projectID, roleKey, userID string) error {
+	if _, err := h.humanByID(ctx, userID, orgID); err != nil {
+		if caos_errs.IsNotFound(err) {
+			return invalidValueError("member " + userID + " not found")
+		}
+		return err
+	}
+	grant, err := h.userGrant(ctx, orgID, projectID, userID)
+	if caos_errs.IsNotFound(err) {
+		_, err = h.command.AddUserGrant(ctx, &domain.UserGrant{
+			UserID:    userID,
+			ProjectID: projectID,
+			RoleKeys:  []string{roleKey},
+		}, orgID)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if containsString(grant.Roles, roleKey) {
+		return nil
+	}
+	_, err = h.command.ChangeUserGrant(ctx, &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{AggregateID: grant.ID, ResourceOwner: orgID},
+		UserID:     userID,
+		RoleKeys:   append(grant.Roles, roleKey),
+	}, orgID)
+	return err
+}
+
+//removeMember removes the role from the user grant of the user on the project,
+//the grant is removed if it was the last role
+func (h *Handler) removeMember(ctx context.Context, orgID, projectID, roleKey, userID string) error {
+	grant, err := h.userGrant(ctx, orgID, projectID, userID)
+	if caos_errs.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !containsString(grant.Roles, roleKey) {
+		return nil
+	}
+	roles := make([]string, 0, len(grant.Roles)-1)
+	for _, role := range grant.Roles {
+		if role != roleKey {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		_, err = h.command.RemoveUserGrant(ctx, grant.ID, orgID)
+		return err
+	}
+	_, err = h.command.ChangeUserGrant(ctx, &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{AggregateID: grant.ID, ResourceOwner: orgID},
+		UserID:     userID,
+		RoleKeys:   roles,
+	}, orgID)
+	return err
+}
+
+func (h *Handler) userGrant(ctx context.Context, orgID, projectID, userID string) (*query.UserGrant, error) {
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return h.query.UserGrant(ctx, userQuery, projectQuery, resourceOwnerQuery)
+}
+
+func (h *Handler) groupMembers(ctx context.Context, orgID, projectID, roleKey string) ([]*Member, error) {
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	roleQuery, err := query.NewUserGrantRoleQuery(roleKey)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectQuery, roleQuery, resourceOwnerQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*Member, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		members[i] = &Member{
+			Value:   grant.UserID,
+			Ref:     h.location(pathUsers, grant.UserID),
+			Display: grant.DisplayName,
+			Type:    resourceTypeUser,
+		}
+	}
+	return members, nil
+}
+
+func (h *Handler) projectRoleByGroupID(ctx context.Context, id, orgID string) (*query.ProjectRole, error) {
+	projectID, roleKey, ok := parseGroupID(id)
+	if !ok {
+		return nil, notFoundError("Errors.Project.Role.NotFound")
+	}
+	role, err := h.query.ProjectRoleByID(ctx, projectID, roleKey)
+	if err != nil {
+		return nil, err
+	}
+	if role.ResourceOwner != orgID {
+		return nil, notFoundError("Errors.Project.Role.NotFound")
+	}
+	return role, nil
+}
+
+func (h *Handler) roleToGroup(ctx context.Context, orgID string, role *query.ProjectRole, withMembers bool) (_ *Group, err error) {
+	id := groupID(role.ProjectID, role.Key)
+	group := &Group{
+		Schemas:     []string{schemaGroup, schemaZitadelGroup},
+		ID:          id,
+		DisplayName: groupDisplayName(role),
+		ZitadelGroup: &ZitadelGroup{
+			ProjectID: role.ProjectID,
+			RoleKey:   role.Key,
+			Group:     role.Group,
+		},
+		Meta: newMeta(resourceTypeGroup, h.location(pathGroups, id), role.CreationDate, role.ChangeDate, role.Sequence),
+	}
+	if withMembers {
+		group.Members, err = h.groupMembers(ctx, orgID, role.ProjectID, role.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return group, nil
+}
+
+func groupFilterToQuery(f filter) (query.SearchQuery, error) {
+	switch f := f.(type) {
+	case *logicalExpression:
+		left, err := groupFilterToQuery(f.left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := groupFilterToQuery(f.right)
+		if err != nil {
+			return nil, err
+		}
+		if f.operator == operatorAnd {
+			return query.NewAndQuery(left, right)
+		}
+		return query.NewOrQuery(left, right)
+	case *notExpression:
+		q, err := groupFilterToQuery(f.filter)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewNotQuery(q)
+	case *attributeExpression:
+		switch f.path.String() {
+		case "id":
+			return groupIDQuery(f)
+		case "displayname":
+			//the display name falls back to the key of the role
+			displayNameQuery, err := textFilterToQuery(f, query.ProjectRoleColumnDisplayName, true)
+			if err != nil {
+				return nil, err
+			}
+			keyQuery, err := textFilterToQuery(f, query.ProjectRoleColumnKey, true)
+			if err != nil {
+				return nil, err
+			}
+			return query.NewOrQuery(displayNameQuery, keyQuery)
+		}
+		return nil, invalidFilterError("unsupported attribute " + f.path.String())
+	}
+	return nil, invalidFilterError("unsupported filter")
+}
+
+func groupIDQuery(f *attributeExpression) (query.SearchQuery, error) {
+	id, ok := f.value.(string)
+	if !ok || f.operator != operatorEqual {
+		return nil, invalidFilterError("id only supports eq with a string")
+	}
+	projectID, roleKey, ok := parseGroupID(id)
+	if !ok {
+		//an unknown id must not match any group
+		projectID, roleKey = "", ""
+	}
+	projectQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	keyQuery, err := query.NewProjectRoleKeySearchQuery(query.TextEquals, roleKey)
+	if err != nil {
+		return nil, err
+	}
+	return query.NewAndQuery(projectQuery, keyQuery)
+}
+
+//groupID is the (url safe) encoded project id and role key
+func groupID(projectID, roleKey string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(projectID + ":" + roleKey))
+}
+
+func parseGroupID(id string) (projectID, roleKey string, ok bool) {
+	decoded, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return "", "", false
+	}
+	split := strings.SplitN(string(decoded), ":", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", false
+	}
+	return split[0], split[1], true
+}
+
+func groupDisplayName(role *query.ProjectRole) string {
+	if role.DisplayName != "" {
+		return role.DisplayName
+	}
+	return role.Key
+}
+
+func memberIDs(members []*Member) []string {
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.Value
+	}
+	return ids
+}
+
+//patchMemberIDs returns the user ids of the members of a patch value
+func patchMemberIDs(value interface{}) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(toList(value))
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*Member, 0)
+	if err = decodeJSON(data, &members); err != nil {
+		return nil, err
+	}
+	return memberIDs(members), nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
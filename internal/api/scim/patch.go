@@ -0,0 +1,190 @@
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+const (
+	patchOpAdd     = "add"
+	patchOpReplace = "replace"
+	patchOpRemove  = "remove"
+)
+
+//applyPatch applies the operations (RFC 7644 section 3.5.2) on the json representation of the resource
+//and decodes the result into the resource again
+func applyPatch(resource interface{}, patch *PatchRequest) error {
+	if len(patch.Operations) == 0 {
+		return invalidValueError("operations missing")
+	}
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	attributes := make(map[string]interface{})
+	if err = json.Unmarshal(data, &attributes); err != nil {
+		return err
+	}
+	for _, operation := range patch.Operations {
+		if err = applyOperation(attributes, operation); err != nil {
+			return err
+		}
+	}
+	data, err = json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	//removed attributes must not keep their previous value
+	value := reflect.ValueOf(resource).Elem()
+	value.Set(reflect.Zero(value.Type()))
+	return decodeJSON(data, resource)
+}
+
+func applyOperation(attributes map[string]interface{}, operation *PatchOperation) error {
+	op, value, err := parseOperation(operation)
+	if err != nil {
+		return err
+	}
+	if operation.Path == "" {
+		if op == patchOpRemove {
+			return noTargetError("path missing")
+		}
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return invalidValueError("value must be an object if no path is provided")
+		}
+		for attribute, v := range values {
+			path := &patchPath{attributePath: *newAttributePath(attribute)}
+			if err = applyPath(attributes, op, path, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	path, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	}
+	return applyPath(attributes, op, path, value)
+}
+
+func parseOperation(operation *PatchOperation) (op string, value interface{}, err error) {
+	op = strings.ToLower(operation.Op)
+	if op != patchOpAdd && op != patchOpReplace && op != patchOpRemove {
+		return "", nil, invalidSyntaxError("unknown op " + operation.Op)
+	}
+	if len(operation.Value) > 0 {
+		if err = json.Unmarshal(operation.Value, &value); err != nil {
+			return "", nil, invalidSyntaxError(err.Error())
+		}
+	}
+	if op != patchOpRemove && value == nil {
+		return "", nil, invalidValueError("value missing")
+	}
+	return op, value, nil
+}
+
+func applyPath(attributes map[string]interface{}, op string, path *patchPath, value interface{}) error {
+	key, _ := attributeKey(attributes, path.attribute)
+	if path.filter != nil {
+		values, err := applyFilteredPath(attributes[key], op, path, value)
+		if err != nil {
+			return err
+		}
+		attributes[key] = values
+		return nil
+	}
+	if path.subAttribute != "" {
+		complexValue, _ := attributes[key].(map[string]interface{})
+		if complexValue == nil {
+			complexValue = make(map[string]interface{})
+		}
+		subKey, _ := attributeKey(complexValue, path.subAttribute)
+		if op == patchOpRemove {
+			delete(complexValue, subKey)
+		} else {
+			complexValue[subKey] = value
+		}
+		attributes[key] = complexValue
+		return nil
+	}
+	if op == patchOpRemove {
+		delete(attributes, key)
+		return nil
+	}
+	switch existing := attributes[key].(type) {
+	case []interface{}:
+		if op == patchOpAdd {
+			attributes[key] = append(existing, toList(value)...)
+			return nil
+		}
+		attributes[key] = toList(value)
+	case map[string]interface{}:
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return invalidValueError("object expected for " + path.attribute)
+		}
+		for k, v := range values {
+			subKey, _ := attributeKey(existing, k)
+			existing[subKey] = v
+		}
+	default:
+		attributes[key] = value
+	}
+	return nil
+}
+
+//applyFilteredPath applies the operation on the values of the multi-valued attribute matching the filter,
+//if none matches an add or replace adds a new value with the attribute of the filter (e.g. emails[type eq "work"].value)
+func applyFilteredPath(attribute interface{}, op string, path *patchPath, value interface{}) ([]interface{}, error) {
+	values, _ := attribute.([]interface{})
+	result := make([]interface{}, 0, len(values))
+	matched := false
+	for _, v := range values {
+		element, ok := v.(map[string]interface{})
+		if !ok || !path.filter.matches(element) {
+			result = append(result, v)
+			continue
+		}
+		matched = true
+		if op == patchOpRemove && path.subAttribute == "" {
+			continue
+		}
+		if path.subAttribute == "" {
+			//without sub attribute the provided attributes of the value are replaced
+			attributes, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, invalidValueError("object expected for " + path.String())
+			}
+			for k, v := range attributes {
+				subKey, _ := attributeKey(element, k)
+				element[subKey] = v
+			}
+		} else if err := applyPath(element, op, &patchPath{attributePath: attributePath{attribute: path.subAttribute}}, value); err != nil {
+			return nil, err
+		}
+		result = append(result, element)
+	}
+	if matched {
+		return result, nil
+	}
+	if op == patchOpRemove {
+		return nil, noTargetError("no value matches " + path.String())
+	}
+	expression, ok := path.filter.(*attributeExpression)
+	if !ok || expression.operator != operatorEqual || path.subAttribute == "" {
+		return nil, noTargetError("no value matches " + path.String())
+	}
+	return append(result, map[string]interface{}{
+		expression.path.attribute: expression.value,
+		path.subAttribute:         value,
+	}), nil
+}
+
+func toList(value interface{}) []interface{} {
+	if values, ok := value.([]interface{}); ok {
+		return values
+	}
+	return []interface{}{value}
+}
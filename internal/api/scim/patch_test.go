@@ -0,0 +1,158 @@
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestApplyPatch(t *testing.T) {
+	newUser := func() *User {
+		return &User{
+			Schemas:  []string{schemaUser},
+			ID:       "id",
+			UserName: "bjensen",
+			Name: &Name{
+				GivenName:  "Barbara",
+				FamilyName: "Jensen",
+			},
+			Active: newBoolean(true),
+			Emails: []*MultiValued{
+				{Value: "bjensen@example.com", Type: "work", Primary: newBoolean(true)},
+			},
+			PhoneNumbers: []*MultiValued{
+				{Value: "+41 71 000 00 00", Type: "work", Primary: newBoolean(true)},
+			},
+		}
+	}
+	type args struct {
+		operations []*PatchOperation
+	}
+	type res struct {
+		user     func(*User)
+		scimType string
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "no operations",
+			args: args{},
+			res:  res{scimType: scimTypeInvalidValue},
+		},
+		{
+			name: "unknown op",
+			args: args{operations: []*PatchOperation{{Op: "move", Path: "userName", Value: json.RawMessage(`"babs"`)}}},
+			res:  res{scimType: scimTypeInvalidSyntax},
+		},
+		{
+			name: "value missing",
+			args: args{operations: []*PatchOperation{{Op: "replace", Path: "userName"}}},
+			res:  res{scimType: scimTypeInvalidValue},
+		},
+		{
+			name: "replace attribute",
+			args: args{operations: []*PatchOperation{{Op: "Replace", Path: "userName", Value: json.RawMessage(`"babs"`)}}},
+			res: res{user: func(user *User) {
+				user.UserName = "babs"
+			}},
+		},
+		{
+			name: "replace sub attribute",
+			args: args{operations: []*PatchOperation{{Op: "replace", Path: "name.givenName", Value: json.RawMessage(`"Babs"`)}}},
+			res: res{user: func(user *User) {
+				user.Name.GivenName = "Babs"
+			}},
+		},
+		{
+			name: "replace active as string",
+			args: args{operations: []*PatchOperation{{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)}}},
+			res: res{user: func(user *User) {
+				user.Active = newBoolean(false)
+			}},
+		},
+		{
+			name: "replace without path",
+			args: args{operations: []*PatchOperation{{Op: "replace", Value: json.RawMessage(`{"displayName": "Babs Jensen", "name": {"familyName": "Jensen-Smith"}}`)}}},
+			res: res{user: func(user *User) {
+				user.DisplayName = "Babs Jensen"
+				user.Name.FamilyName = "Jensen-Smith"
+			}},
+		},
+		{
+			name: "replace filtered value",
+			args: args{operations: []*PatchOperation{{Op: "replace", Path: `emails[type eq "work"].value`, Value: json.RawMessage(`"babs@example.com"`)}}},
+			res: res{user: func(user *User) {
+				user.Emails[0].Value = "babs@example.com"
+			}},
+		},
+		{
+			name: "add filtered value not matching",
+			args: args{operations: []*PatchOperation{{Op: "add", Path: `emails[type eq "home"].value`, Value: json.RawMessage(`"babs@jensen.org"`)}}},
+			res: res{user: func(user *User) {
+				user.Emails = append(user.Emails, &MultiValued{Value: "babs@jensen.org", Type: "home"})
+			}},
+		},
+		{
+			name: "add multi-valued attribute",
+			args: args{operations: []*PatchOperation{{Op: "add", Path: "emails", Value: json.RawMessage(`[{"value": "babs@jensen.org", "type": "home"}]`)}}},
+			res: res{user: func(user *User) {
+				user.Emails = append(user.Emails, &MultiValued{Value: "babs@jensen.org", Type: "home"})
+			}},
+		},
+		{
+			name: "remove attribute",
+			args: args{operations: []*PatchOperation{{Op: "remove", Path: "phoneNumbers"}}},
+			res: res{user: func(user *User) {
+				user.PhoneNumbers = nil
+			}},
+		},
+		{
+			name: "remove filtered value",
+			args: args{operations: []*PatchOperation{{Op: "remove", Path: `phoneNumbers[type eq "work"]`}}},
+			res: res{user: func(user *User) {
+				user.PhoneNumbers = []*MultiValued{}
+			}},
+		},
+		{
+			name: "remove filtered value not matching",
+			args: args{operations: []*PatchOperation{{Op: "remove", Path: `phoneNumbers[type eq "home"]`}}},
+			res:  res{scimType: scimTypeNoTarget},
+		},
+		{
+			name: "remove without path",
+			args: args{operations: []*PatchOperation{{Op: "remove"}}},
+			res:  res{scimType: scimTypeNoTarget},
+		},
+		{
+			name: "invalid path",
+			args: args{operations: []*PatchOperation{{Op: "replace", Path: `emails[type eq "work"`, Value: json.RawMessage(`"babs@example.com"`)}}},
+			res:  res{scimType: scimTypeInvalidPath},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newUser()
+			err := applyPatch(user, &PatchRequest{Operations: tt.args.operations})
+			if tt.res.scimType != "" {
+				scimErr, ok := err.(*scimError)
+				if !ok || scimErr.ScimType != tt.res.scimType {
+					t.Fatalf("expected scimType %s, got %v", tt.res.scimType, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := newUser()
+			tt.res.user(want)
+			if !reflect.DeepEqual(user, want) {
+				got, _ := json.Marshal(user)
+				expected, _ := json.Marshal(want)
+				t.Errorf("unexpected user\ngot:  %s\nwant: %s", got, expected)
+			}
+		})
+	}
+}
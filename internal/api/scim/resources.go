@@ -0,0 +1,185 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaZitadelGroup          = "urn:zitadel:params:scim:schemas:extension:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	schemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaBulkRequest           = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	schemaBulkResponse          = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+)
+
+type User struct {
+	Schemas           []string       `json:"schemas"`
+	ID                string         `json:"id,omitempty"`
+	ExternalID        string         `json:"externalId,omitempty"`
+	UserName          string         `json:"userName"`
+	Name              *Name          `json:"name,omitempty"`
+	DisplayName       string         `json:"displayName,omitempty"`
+	NickName          string         `json:"nickName,omitempty"`
+	PreferredLanguage string         `json:"preferredLanguage,omitempty"`
+	Active            *Boolean       `json:"active,omitempty"`
+	Password          string         `json:"password,omitempty"`
+	Emails            []*MultiValued `json:"emails,omitempty"`
+	PhoneNumbers      []*MultiValued `json:"phoneNumbers,omitempty"`
+	Meta              *Meta          `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type MultiValued struct {
+	Value   string   `json:"value"`
+	Display string   `json:"display,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Primary *Boolean `json:"primary,omitempty"`
+}
+
+//primaryValue returns the value marked as primary or the first one
+func primaryValue(values []*MultiValued) string {
+	for _, value := range values {
+		if value.Primary != nil && bool(*value.Primary) {
+			return value.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+type Group struct {
+	Schemas      []string      `json:"schemas"`
+	ID           string        `json:"id,omitempty"`
+	ExternalID   string        `json:"externalId,omitempty"`
+	DisplayName  string        `json:"displayName"`
+	Members      []*Member     `json:"members,omitempty"`
+	ZitadelGroup *ZitadelGroup `json:"urn:zitadel:params:scim:schemas:extension:2.0:Group,omitempty"`
+	Meta         *Meta         `json:"meta,omitempty"`
+}
+
+//ZitadelGroup is the extension of the group containing the project role the group is mapped to
+type ZitadelGroup struct {
+	ProjectID string `json:"projectId,omitempty"`
+	RoleKey   string `json:"roleKey,omitempty"`
+	Group     string `json:"group,omitempty"`
+}
+
+type Member struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Version      string     `json:"version,omitempty"`
+}
+
+func newMeta(resourceType, location string, created, lastModified time.Time, sequence uint64) *Meta {
+	return &Meta{
+		ResourceType: resourceType,
+		Created:      &created,
+		LastModified: &lastModified,
+		Location:     location,
+		Version:      `W/"` + strconv.FormatUint(sequence, 10) + `"`,
+	}
+}
+
+//Boolean accepts json booleans as well as their string representation ("True", "false"),
+//which are sent by some well known scim clients
+type Boolean bool
+
+func (b *Boolean) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case bool:
+		*b = Boolean(v)
+	case string:
+		parsed, err := strconv.ParseBool(strings.ToLower(v))
+		if err != nil {
+			return errors.New("invalid boolean " + v)
+		}
+		*b = Boolean(parsed)
+	default:
+		return errors.New("invalid boolean")
+	}
+	return nil
+}
+
+func newBoolean(value bool) *Boolean {
+	b := Boolean(value)
+	return &b
+}
+
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults uint64      `json:"totalResults"`
+	StartIndex   uint64      `json:"startIndex"`
+	ItemsPerPage uint64      `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type BulkRequest struct {
+	Schemas      []string         `json:"schemas"`
+	FailOnErrors int              `json:"failOnErrors,omitempty"`
+	Operations   []*BulkOperation `json:"Operations"`
+}
+
+type BulkOperation struct {
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Path    string          `json:"path"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+type BulkResponse struct {
+	Schemas    []string                 `json:"schemas"`
+	Operations []*BulkOperationResponse `json:"Operations"`
+}
+
+type BulkOperationResponse struct {
+	Method   string      `json:"method"`
+	BulkID   string      `json:"bulkId,omitempty"`
+	Location string      `json:"location,omitempty"`
+	Status   string      `json:"status"`
+	Response interface{} `json:"response,omitempty"`
+}
@@ -0,0 +1,263 @@
+package scim
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/caos/logging"
+	sentryhttp "github.com/getsentry/sentry-go/http"
+	"github.com/gorilla/mux"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	http_mw "github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/command"
+	"github.com/caos/zitadel/internal/query"
+)
+
+const (
+	HandlerPrefix = "/scim/v2"
+
+	contentType = "application/scim+json"
+
+	//maxResults is the maximum number of resources returned by a list request
+	maxResults = 100
+	//maxPayloadSize limits the size of all requests (including bulk requests)
+	maxPayloadSize    = 1 << 20
+	maxBulkOperations = 100
+
+	pathUsers                 = "/Users"
+	pathUser                  = "/Users/{id}"
+	pathGroups                = "/Groups"
+	pathGroup                 = "/Groups/{id}"
+	pathBulk                  = "/Bulk"
+	pathServiceProviderConfig = "/ServiceProviderConfig"
+	pathResourceTypes         = "/ResourceTypes"
+	pathResourceType          = "/ResourceTypes/{id}"
+	pathSchemas               = "/Schemas"
+	pathSchema                = "/Schemas/{id}"
+)
+
+//authMethods maps the routes (templates) to the required permissions,
+//the discovery endpoints (service provider config, resource types and schemas) don't require authentication
+var authMethods = authz.MethodMapping{
+	http.MethodGet + ":" + HandlerPrefix + pathUsers:    {Permission: "user.read"},
+	http.MethodPost + ":" + HandlerPrefix + pathUsers:   {Permission: "user.write"},
+	http.MethodGet + ":" + HandlerPrefix + pathUser:     {Permission: "user.read"},
+	http.MethodPut + ":" + HandlerPrefix + pathUser:     {Permission: "user.write"},
+	http.MethodPatch + ":" + HandlerPrefix + pathUser:   {Permission: "user.write"},
+	http.MethodDelete + ":" + HandlerPrefix + pathUser:  {Permission: "user.delete"},
+	http.MethodGet + ":" + HandlerPrefix + pathGroups:   {Permission: "user.grant.read"},
+	http.MethodPost + ":" + HandlerPrefix + pathGroups:  {Permission: "project.role.write"},
+	http.MethodGet + ":" + HandlerPrefix + pathGroup:    {Permission: "user.grant.read"},
+	http.MethodPut + ":" + HandlerPrefix + pathGroup:    {Permission: "user.grant.write"},
+	http.MethodPatch + ":" + HandlerPrefix + pathGroup:  {Permission: "user.grant.write"},
+	http.MethodDelete + ":" + HandlerPrefix + pathGroup: {Permission: "project.role.delete"},
+	http.MethodPost + ":" + HandlerPrefix + pathBulk:    {Permission: "user.write"},
+}
+
+//Handler serves the SCIM 2.0 (RFC 7643 / RFC 7644) provisioning api for users and groups.
+//The resources are managed in the organisation of the authenticated (machine) user
+//or the one provided in the x-zitadel-orgid header.
+//Groups are mapped to the roles of the projects of the organisation, their members are the users granted the role.
+type Handler struct {
+	command *command.Commands
+	query   *query.Queries
+	baseURL string
+}
+
+func NewHandler(
+	commands *command.Commands,
+	queries *query.Queries,
+	verifier *authz.TokenVerifier,
+	authConfig authz.Config,
+	baseURL string,
+) http.Handler {
+	h := &Handler{
+		command: commands,
+		query:   queries,
+		baseURL: baseURL,
+	}
+	verifier.RegisterServer("Management-API", "scim", authMethods)
+	authInterceptor := http_mw.AuthorizationInterceptor(verifier, authConfig).WithMethod(routeTemplate)
+
+	router := mux.NewRouter()
+	router.Use(sentryhttp.New(sentryhttp.Options{}).Handle, authInterceptor.Handler)
+	router.Path(pathUsers).Methods(http.MethodGet).HandlerFunc(h.handleListUsers)
+	router.Path(pathUsers).Methods(http.MethodPost).HandlerFunc(h.handleCreateUser)
+	router.Path(pathUser).Methods(http.MethodGet).HandlerFunc(h.handleGetUser)
+	router.Path(pathUser).Methods(http.MethodPut).HandlerFunc(h.handleReplaceUser)
+	router.Path(pathUser).Methods(http.MethodPatch).HandlerFunc(h.handlePatchUser)
+	router.Path(pathUser).Methods(http.MethodDelete).HandlerFunc(h.handleDeleteUser)
+	router.Path(pathGroups).Methods(http.MethodGet).HandlerFunc(h.handleListGroups)
+	router.Path(pathGroups).Methods(http.MethodPost).HandlerFunc(h.handleCreateGroup)
+	router.Path(pathGroup).Methods(http.MethodGet).HandlerFunc(h.handleGetGroup)
+	router.Path(pathGroup).Methods(http.MethodPut).HandlerFunc(h.handleReplaceGroup)
+	router.Path(pathGroup).Methods(http.MethodPatch).HandlerFunc(h.handlePatchGroup)
+	router.Path(pathGroup).Methods(http.MethodDelete).HandlerFunc(h.handleDeleteGroup)
+	router.Path(pathBulk).Methods(http.MethodPost).HandlerFunc(h.handleBulk)
+	router.Path(pathServiceProviderConfig).Methods(http.MethodGet).HandlerFunc(h.handleServiceProviderConfig)
+	router.Path(pathResourceTypes).Methods(http.MethodGet).HandlerFunc(h.handleResourceTypes)
+	router.Path(pathResourceType).Methods(http.MethodGet).HandlerFunc(h.handleResourceType)
+	router.Path(pathSchemas).Methods(http.MethodGet).HandlerFunc(h.handleSchemas)
+	router.Path(pathSchema).Methods(http.MethodGet).HandlerFunc(h.handleSchema)
+	router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, notFoundError("unknown endpoint "+r.URL.Path))
+	})
+	router.MethodNotAllowedHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, newError(http.StatusMethodNotAllowed, "", "method "+r.Method+" not allowed"))
+	})
+	return router
+}
+
+//routeTemplate returns the (prefixed) template of the matched route,
+//so the permissions of routes containing ids can be checked
+func routeTemplate(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return r.RequestURI
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return r.RequestURI
+	}
+	return HandlerPrefix + template
+}
+
+func (h *Handler) location(path, id string) string {
+	return h.baseURL + path + "/" + id
+}
+
+func writeResource(w http.ResponseWriter, status int, location string, resource interface{}) {
+	if location != "" {
+		w.Header().Set("Location", location)
+	}
+	writeJSON(w, status, resource)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	scimErr := toSCIMError(err)
+	if scimErr.status >= http.StatusInternalServerError {
+		logging.Log("SCIM-Ks92m").WithError(err).Error("error occurred on scim api")
+	}
+	writeJSON(w, scimErr.status, scimErr)
+}
+
+func writeJSON(w http.ResponseWriter, status int, resource interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if resource == nil {
+		return
+	}
+	err := json.NewEncoder(w).Encode(resource)
+	logging.Log("SCIM-Wm2sd").OnError(err).Error("error writing response")
+}
+
+func readJSON(r *http.Request, resource interface{}) error {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize+1))
+	if err != nil {
+		return invalidSyntaxError("unable to read request")
+	}
+	if len(data) > maxPayloadSize {
+		return newError(http.StatusRequestEntityTooLarge, "", "request too large")
+	}
+	return decodeJSON(data, resource)
+}
+
+func decodeJSON(data []byte, resource interface{}) error {
+	if err := json.Unmarshal(data, resource); err != nil {
+		return invalidSyntaxError(err.Error())
+	}
+	return nil
+}
+
+//listRequest contains the pagination and filter parameters of a list request (RFC 7644 section 3.4.2)
+type listRequest struct {
+	filter             filter
+	startIndex         uint64
+	count              uint64
+	excludedAttributes []string
+}
+
+func parseListRequest(r *http.Request) (_ *listRequest, err error) {
+	params := r.URL.Query()
+	req := &listRequest{
+		startIndex: 1,
+		count:      maxResults,
+	}
+	if value := params.Get("filter"); value != "" {
+		req.filter, err = parseFilter(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value := params.Get("startIndex"); value != "" {
+		startIndex, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, invalidValueError("invalid startIndex")
+		}
+		//values less than 1 are interpreted as 1 (RFC 7644 section 3.4.2.4)
+		if startIndex > 1 {
+			req.startIndex = uint64(startIndex)
+		}
+	}
+	if value := params.Get("count"); value != "" {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, invalidValueError("invalid count")
+		}
+		if count < 0 {
+			count = 0
+		}
+		if count < maxResults {
+			req.count = uint64(count)
+		}
+	}
+	if value := params.Get("excludedAttributes"); value != "" {
+		for _, attribute := range strings.Split(value, ",") {
+			req.excludedAttributes = append(req.excludedAttributes, newAttributePath(strings.TrimSpace(attribute)).attribute)
+		}
+	}
+	return req, nil
+}
+
+func (r *listRequest) searchRequest() query.SearchRequest {
+	//a limit of 0 would return all resources, but a count of 0 only requests the total
+	limit := r.count
+	if limit == 0 {
+		limit = 1
+	}
+	return query.SearchRequest{
+		Offset: r.startIndex - 1,
+		Limit:  limit,
+	}
+}
+
+//resultLength returns the number of resources to return out of the found ones
+func (r *listRequest) resultLength(found int) int {
+	if uint64(found) > r.count {
+		return int(r.count)
+	}
+	return found
+}
+
+func (r *listRequest) isExcluded(attribute string) bool {
+	for _, excluded := range r.excludedAttributes {
+		if excluded == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+func newListResponse(total uint64, req *listRequest, resources interface{}, length int) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   req.startIndex,
+		ItemsPerPage: uint64(length),
+		Resources:    resources,
+	}
+}
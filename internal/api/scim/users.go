@@ -0,0 +1,540 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/text/language"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/query"
+)
+
+const (
+	//externalIDMetadataKey is the key of the user metadata the externalId of the provisioning client is stored in
+	externalIDMetadataKey = "urn:zitadel:scim:externalId"
+)
+
+//userFilterColumns maps the (lower case) filterable attributes of the user to their columns
+var userFilterColumns = map[string]query.Column{
+	"id":                 query.UserIDCol,
+	"username":           query.UserUsernameCol,
+	"name.givenname":     query.HumanFirstNameCol,
+	"name.familyname":    query.HumanLastNameCol,
+	"displayname":        query.HumanDisplayNameCol,
+	"nickname":           query.HumanNickNameCol,
+	"emails":             query.HumanEmailCol,
+	"emails.value":       query.HumanEmailCol,
+	"phonenumbers":       query.HumanPhoneCol,
+	"phonenumbers.value": query.HumanPhoneCol,
+}
+
+func (h *Handler) handleListUsers(w http.ResponseWriter, r *http.Request) {
+	req, err := parseListRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	resp, err := h.listUsers(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", resp)
+}
+
+func (h *Handler) handleGetUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUser(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", user)
+}
+
+func (h *Handler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
+	user := new(User)
+	if err := readJSON(r, user); err != nil {
+		writeError(w, err)
+		return
+	}
+	user, err := h.createUser(r.Context(), user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusCreated, user.Meta.Location, user)
+}
+
+func (h *Handler) handleReplaceUser(w http.ResponseWriter, r *http.Request) {
+	user := new(User)
+	if err := readJSON(r, user); err != nil {
+		writeError(w, err)
+		return
+	}
+	user, err := h.replaceUser(r.Context(), mux.Vars(r)["id"], user)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", user)
+}
+
+func (h *Handler) handlePatchUser(w http.ResponseWriter, r *http.Request) {
+	patch := new(PatchRequest)
+	if err := readJSON(r, patch); err != nil {
+		writeError(w, err)
+		return
+	}
+	user, err := h.patchUser(r.Context(), mux.Vars(r)["id"], patch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeResource(w, http.StatusOK, "", user)
+}
+
+func (h *Handler) handleDeleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.deleteUser(r.Context(), mux.Vars(r)["id"]); err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusNoContent, nil)
+}
+
+func (h *Handler) listUsers(ctx context.Context, req *listRequest) (*ListResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	resourceOwnerQuery, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	typeQuery, err := query.NewUserTypeSearchQuery(int32(domain.UserTypeHuman))
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{resourceOwnerQuery, typeQuery}
+	if req.filter != nil {
+		filterQuery, err := userFilterToQuery(req.filter, "")
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, filterQuery)
+	}
+	searchRequest := req.searchRequest()
+	searchRequest.SortingColumn = query.UserIDCol
+	searchRequest.Asc = true
+	users, err := h.query.SearchUsers(ctx, &query.UserSearchQueries{
+		SearchRequest: searchRequest,
+		Queries:       queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*User, req.resultLength(len(users.Users)))
+	for i := range resources {
+		externalID, err := h.externalID(ctx, users.Users[i].ID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		resources[i] = userToSCIM(users.Users[i], externalID, h.location(pathUsers, users.Users[i].ID))
+	}
+	return newListResponse(users.Count, req, resources, len(resources)), nil
+}
+
+func (h *Handler) getUser(ctx context.Context, id string) (*User, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	user, err := h.humanByID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	externalID, err := h.externalID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return userToSCIM(user, externalID, h.location(pathUsers, id)), nil
+}
+
+func (h *Handler) createUser(ctx context.Context, user *User) (*User, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	human, err := userToHuman(user)
+	if err != nil {
+		return nil, err
+	}
+	inactive := user.Active != nil && !bool(*user.Active)
+	if inactive && human.IsInitialState(false, false) {
+		return nil, invalidValueError("Errors.User.CantDeactivateInitial")
+	}
+	human, _, err = h.command.ImportHuman(ctx, orgID, human, false)
+	if err != nil {
+		return nil, err
+	}
+	if user.ExternalID != "" {
+		_, err = h.command.SetUserMetadata(ctx, &domain.Metadata{Key: externalIDMetadataKey, Value: []byte(user.ExternalID)}, human.AggregateID, orgID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if inactive {
+		if _, err = h.command.DeactivateUser(ctx, human.AggregateID, orgID); err != nil {
+			return nil, err
+		}
+	}
+	return h.getUser(ctx, human.AggregateID)
+}
+
+func (h *Handler) replaceUser(ctx context.Context, id string, user *User) (*User, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	existing, err := h.humanByID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	externalID, err := h.externalID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.updateUser(ctx, orgID, existing, externalID, user); err != nil {
+		return nil, err
+	}
+	return h.getUser(ctx, id)
+}
+
+//patchUser applies the operations on the current representation of the user and updates the changed attributes
+func (h *Handler) patchUser(ctx context.Context, id string, patch *PatchRequest) (*User, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	existing, err := h.humanByID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	externalID, err := h.externalID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	user := userToSCIM(existing, externalID, "")
+	if err = applyPatch(user, patch); err != nil {
+		return nil, err
+	}
+	if err = h.updateUser(ctx, orgID, existing, externalID, user); err != nil {
+		return nil, err
+	}
+	return h.getUser(ctx, id)
+}
+
+func (h *Handler) deleteUser(ctx context.Context, id string) error {
+	orgID := authz.GetCtxData(ctx).OrgID
+	if _, err := h.humanByID(ctx, id, orgID); err != nil {
+		return err
+	}
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(id)
+	if err != nil {
+		return err
+	}
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	})
+	if err != nil {
+		return err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(id)
+	if err != nil {
+		return err
+	}
+	memberships, err := h.query.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	})
+	if err != nil {
+		return err
+	}
+	grantIDs := make([]string, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		grantIDs[i] = grant.ID
+	}
+	_, err = h.command.RemoveUser(ctx, id, orgID, memberships.Memberships, grantIDs...)
+	return err
+}
+
+//updateUser replaces the attributes of the existing user by the ones of the provided user,
+//only the changed attributes are updated
+func (h *Handler) updateUser(ctx context.Context, orgID string, existing *query.User, existingExternalID string, user *User) (err error) {
+	if user.UserName == "" {
+		return invalidValueError("userName missing")
+	}
+	if user.UserName != existing.Username {
+		if _, err = h.command.ChangeUsername(ctx, orgID, existing.ID, user.UserName); err != nil {
+			return err
+		}
+	}
+	if profile := userToProfile(user, existing); profileChanged(profile, existing.Human) {
+		if _, err = h.command.ChangeHumanProfile(ctx, profile); err != nil {
+			return err
+		}
+	}
+	if email := primaryValue(user.Emails); email != existing.Human.Email {
+		if email == "" {
+			return invalidValueError("Errors.User.Email.Empty")
+		}
+		_, err = h.command.ChangeHumanEmail(ctx, &domain.Email{
+			ObjectRoot:   models.ObjectRoot{AggregateID: existing.ID, ResourceOwner: orgID},
+			EmailAddress: email,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if phone := primaryValue(user.PhoneNumbers); phone != existing.Human.Phone {
+		if phone == "" {
+			_, err = h.command.RemoveHumanPhone(ctx, existing.ID, orgID)
+		} else {
+			_, err = h.command.ChangeHumanPhone(ctx, &domain.Phone{
+				ObjectRoot:  models.ObjectRoot{AggregateID: existing.ID},
+				PhoneNumber: phone,
+			}, orgID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if user.Password != "" {
+		if _, err = h.command.SetPassword(ctx, orgID, existing.ID, user.Password, false); err != nil {
+			return err
+		}
+	}
+	if user.ExternalID != existingExternalID {
+		if user.ExternalID == "" {
+			_, err = h.command.RemoveUserMetadata(ctx, externalIDMetadataKey, existing.ID, orgID)
+		} else {
+			_, err = h.command.SetUserMetadata(ctx, &domain.Metadata{Key: externalIDMetadataKey, Value: []byte(user.ExternalID)}, existing.ID, orgID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if user.Active != nil {
+		return h.changeUserActive(ctx, orgID, existing, bool(*user.Active))
+	}
+	return nil
+}
+
+func (h *Handler) changeUserActive(ctx context.Context, orgID string, existing *query.User, active bool) (err error) {
+	switch {
+	case active && existing.State == domain.UserStateInactive:
+		_, err = h.command.ReactivateUser(ctx, existing.ID, orgID)
+	case !active && existing.State == domain.UserStateInitial:
+		return invalidValueError("Errors.User.CantDeactivateInitial")
+	case !active && existing.State != domain.UserStateInactive:
+		_, err = h.command.DeactivateUser(ctx, existing.ID, orgID)
+	}
+	return err
+}
+
+//humanByID returns the human user of the organisation, machine users are not provisioned by scim
+func (h *Handler) humanByID(ctx context.Context, id, orgID string) (*query.User, error) {
+	resourceOwnerQuery, err := query.NewUserResourceOwnerSearchQuery(orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	user, err := h.query.GetUserByID(ctx, id, resourceOwnerQuery)
+	if err != nil {
+		return nil, err
+	}
+	if user.Human == nil {
+		return nil, notFoundError("Errors.User.NotFound")
+	}
+	return user, nil
+}
+
+func (h *Handler) externalID(ctx context.Context, userID, orgID string) (string, error) {
+	resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return "", err
+	}
+	metadata, err := h.query.GetUserMetadataByKey(ctx, userID, externalIDMetadataKey, resourceOwnerQuery)
+	if caos_errs.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(metadata.Value), nil
+}
+
+func userFilterToQuery(f filter, parentAttribute string) (query.SearchQuery, error) {
+	switch f := f.(type) {
+	case *logicalExpression:
+		left, err := userFilterToQuery(f.left, parentAttribute)
+		if err != nil {
+			return nil, err
+		}
+		right, err := userFilterToQuery(f.right, parentAttribute)
+		if err != nil {
+			return nil, err
+		}
+		if f.operator == operatorAnd {
+			return query.NewAndQuery(left, right)
+		}
+		return query.NewOrQuery(left, right)
+	case *notExpression:
+		q, err := userFilterToQuery(f.filter, parentAttribute)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewNotQuery(q)
+	case *valuePathExpression:
+		if parentAttribute != "" {
+			return nil, invalidFilterError("nested value path " + f.attribute)
+		}
+		return userFilterToQuery(f.filter, f.attribute)
+	case *attributeExpression:
+		attribute := f.path.String()
+		if parentAttribute != "" {
+			attribute = parentAttribute + "." + attribute
+		}
+		if attribute == "active" {
+			return userActiveQuery(f)
+		}
+		column, ok := userFilterColumns[attribute]
+		if !ok {
+			return nil, invalidFilterError("unsupported attribute " + attribute)
+		}
+		return textFilterToQuery(f, column, attribute != "id")
+	}
+	return nil, invalidFilterError("unsupported filter")
+}
+
+func userActiveQuery(f *attributeExpression) (query.SearchQuery, error) {
+	active, ok := f.value.(bool)
+	if !ok || (f.operator != operatorEqual && f.operator != operatorNotEqual) {
+		return nil, invalidFilterError("active only supports eq and ne with a boolean")
+	}
+	inactiveQuery, err := query.NewNumberQuery(query.UserStateCol, int32(domain.UserStateInactive), query.NumberEquals)
+	if err != nil {
+		return nil, err
+	}
+	if active == (f.operator == operatorEqual) {
+		return query.NewNotQuery(inactiveQuery)
+	}
+	return inactiveQuery, nil
+}
+
+//textFilterToQuery maps the text operators, string attributes are compared case insensitive (caseExact false)
+func textFilterToQuery(f *attributeExpression, column query.Column, ignoreCase bool) (query.SearchQuery, error) {
+	if f.operator == operatorPresent {
+		return query.NewNotNullQuery(column)
+	}
+	value, ok := f.value.(string)
+	if !ok {
+		return nil, invalidFilterError("string value expected for " + f.path.String())
+	}
+	var comparison query.TextComparison
+	switch f.operator {
+	case operatorEqual, operatorNotEqual:
+		comparison = query.TextEquals
+		if ignoreCase {
+			comparison = query.TextEqualsIgnoreCase
+		}
+	case operatorContains:
+		comparison = query.TextContainsIgnoreCase
+	case operatorStartsWith:
+		comparison = query.TextStartsWithIgnoreCase
+	case operatorEndsWith:
+		comparison = query.TextEndsWithIgnoreCase
+	default:
+		return nil, invalidFilterError("unsupported operator " + f.operator + " for " + f.path.String())
+	}
+	q, err := query.NewTextQuery(column, value, comparison)
+	if err != nil {
+		return nil, err
+	}
+	if f.operator == operatorNotEqual {
+		return query.NewNotQuery(q)
+	}
+	return q, nil
+}
+
+func userToSCIM(user *query.User, externalID, location string) *User {
+	scimUser := &User{
+		Schemas:     []string{schemaUser},
+		ID:          user.ID,
+		ExternalID:  externalID,
+		UserName:    user.Username,
+		DisplayName: user.Human.DisplayName,
+		NickName:    user.Human.NickName,
+		Name: &Name{
+			Formatted:  strings.TrimSpace(user.Human.FirstName + " " + user.Human.LastName),
+			FamilyName: user.Human.LastName,
+			GivenName:  user.Human.FirstName,
+		},
+		Active: newBoolean(user.State != domain.UserStateInactive),
+		Meta:   newMeta(resourceTypeUser, location, user.CreationDate, user.ChangeDate, user.Sequence),
+	}
+	if !user.Human.PreferredLanguage.IsRoot() {
+		scimUser.PreferredLanguage = user.Human.PreferredLanguage.String()
+	}
+	if user.Human.Email != "" {
+		scimUser.Emails = []*MultiValued{{Value: user.Human.Email, Type: "work", Primary: newBoolean(true)}}
+	}
+	if user.Human.Phone != "" {
+		scimUser.PhoneNumbers = []*MultiValued{{Value: user.Human.Phone, Type: "work", Primary: newBoolean(true)}}
+	}
+	return scimUser
+}
+
+func userToHuman(user *User) (*domain.Human, error) {
+	if user.UserName == "" {
+		return nil, invalidValueError("userName missing")
+	}
+	human := &domain.Human{
+		Username: user.UserName,
+		Profile:  userToProfile(user, nil),
+		Email: &domain.Email{
+			EmailAddress: primaryValue(user.Emails),
+		},
+	}
+	if phone := primaryValue(user.PhoneNumbers); phone != "" {
+		human.Phone = &domain.Phone{PhoneNumber: phone}
+	}
+	if user.Password != "" {
+		human.Password = &domain.Password{SecretString: user.Password}
+	}
+	return human, nil
+}
+
+//userToProfile maps the profile of the scim user, the preferred language and gender of the existing user
+//are kept if not provided (gender is not part of the scim schema)
+func userToProfile(user *User, existing *query.User) *domain.Profile {
+	profile := &domain.Profile{
+		DisplayName: user.DisplayName,
+		NickName:    user.NickName,
+	}
+	if user.Name != nil {
+		profile.FirstName = user.Name.GivenName
+		profile.LastName = user.Name.FamilyName
+	}
+	if profile.DisplayName == "" {
+		profile.DisplayName = strings.TrimSpace(profile.FirstName + " " + profile.LastName)
+	}
+	if user.PreferredLanguage != "" {
+		profile.PreferredLanguage, _ = language.Parse(user.PreferredLanguage)
+	}
+	if existing != nil {
+		profile.AggregateID = existing.ID
+		profile.ResourceOwner = existing.ResourceOwner
+		profile.Gender = existing.Human.Gender
+		if user.PreferredLanguage == "" {
+			profile.PreferredLanguage = existing.Human.PreferredLanguage
+		}
+	}
+	return profile
+}
+
+func profileChanged(profile *domain.Profile, existing *query.Human) bool {
+	return profile.FirstName != existing.FirstName ||
+		profile.LastName != existing.LastName ||
+		profile.NickName != existing.NickName ||
+		profile.DisplayName != existing.DisplayName ||
+		profile.PreferredLanguage != existing.PreferredLanguage
+}
@@ -41,6 +41,7 @@ type AuthRequestRepo struct {
 	OrgViewProvider           orgViewProvider
 	LoginPolicyViewProvider   loginPolicyViewProvider
 	LockoutPolicyViewProvider lockoutPolicyViewProvider
+	PasswordAgePolicyProvider passwordAgePolicyProvider
 	PrivacyPolicyProvider     privacyPolicyProvider
 	IDPProviderViewProvider   idpProviderViewProvider
 	UserGrantProvider         userGrantProvider
@@ -84,6 +85,10 @@ type lockoutPolicyViewProvider interface {
 	LockoutPolicyByOrg(context.Context, string) (*query.LockoutPolicy, error)
 }
 
+type passwordAgePolicyProvider interface {
+	PasswordAgePolicyByOrg(context.Context, string) (*query.PasswordAgePolicy, error)
+}
+
 type idpProviderViewProvider interface {
 	IDPProvidersByAggregateIDAndState(string, iam_model.IDPConfigState) ([]*iam_view_model.IDPProviderView, error)
 }
@@ -94,6 +99,7 @@ type userEventProvider interface {
 
 type userCommandProvider interface {
 	BulkAddedUserIDPLinks(ctx context.Context, userID, resourceOwner string, externalIDPs []*domain.UserIDPLink) error
+	UserLockoutExpired(ctx context.Context, userID, resourceOwner string) (bool, error)
 }
 
 type orgViewProvider interface {
@@ -101,13 +107,18 @@ type orgViewProvider interface {
 	OrgByDomainGlobal(context.Context, string) (*query.Org, error)
 }
 
-type userGrantProvider interface {
+type projectByAppProvider interface {
 	ProjectByOIDCClientID(context.Context, string) (*query.Project, error)
+	ProjectBySAMLEntityID(context.Context, string) (*query.Project, error)
+}
+
+type userGrantProvider interface {
+	projectByAppProvider
 	UserGrantsByProjectAndUserID(string, string) ([]*query.UserGrant, error)
 }
 
 type projectProvider interface {
-	ProjectByOIDCClientID(context.Context, string) (*query.Project, error)
+	projectByAppProvider
 	OrgProjectMappingByIDs(orgID, projectID string) (*project_view_model.OrgProjectMapping, error)
 }
 
@@ -127,7 +138,7 @@ func (repo *AuthRequestRepo) CreateAuthRequest(ctx context.Context, request *dom
 		return nil, err
 	}
 	request.ID = reqID
-	project, err := repo.ProjectProvider.ProjectByOIDCClientID(ctx, request.ApplicationID)
+	project, err := projectByRequest(ctx, request, repo.ProjectProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +301,7 @@ func (repo *AuthRequestRepo) SelectUser(ctx context.Context, id, userID, userAge
 	if err != nil {
 		return err
 	}
-	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.LockoutPolicyViewProvider, userID)
+	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.UserCommandProvider, userID)
 	if err != nil {
 		return err
 	}
@@ -330,7 +341,11 @@ func lockoutPolicyToDomain(policy *query.LockoutPolicy) *domain.LockoutPolicy {
 		},
 		Default:             policy.IsDefault,
 		MaxPasswordAttempts: policy.MaxPasswordAttempts,
+		MaxOTPAttempts:      policy.MaxOTPAttempts,
+		MaxU2FAttempts:      policy.MaxU2FAttempts,
 		ShowLockOutFailures: policy.ShowFailures,
+		LockoutDuration:     policy.LockoutDuration,
+		BackoffDelay:        policy.BackoffDelay,
 	}
 }
 
@@ -341,7 +356,73 @@ func (repo *AuthRequestRepo) VerifyMFAOTP(ctx context.Context, authRequestID, us
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanCheckMFAOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckMFAOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
+}
+
+func (repo *AuthRequestRepo) SendMFAOTPSMS(ctx context.Context, authRequestID, userID, resourceOwner, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanSendOTPSMS(ctx, userID, resourceOwner, request)
+}
+
+func (repo *AuthRequestRepo) VerifyMFAOTPSMS(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckOTPSMS(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
+}
+
+func (repo *AuthRequestRepo) SendMFAOTPEmail(ctx context.Context, authRequestID, userID, resourceOwner, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanSendOTPEmail(ctx, userID, resourceOwner, request)
+}
+
+func (repo *AuthRequestRepo) VerifyMFAOTPEmail(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckOTPEmail(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
+}
+
+func (repo *AuthRequestRepo) VerifyMFARecoveryCode(ctx context.Context, authRequestID, userID, resourceOwner, code, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckRecoveryCode(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info), lockoutPolicyToDomain(policy))
 }
 
 func (repo *AuthRequestRepo) BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (login *domain.WebAuthNLogin, err error) {
@@ -362,7 +443,11 @@ func (repo *AuthRequestRepo) VerifyMFAU2F(ctx context.Context, userID, resourceO
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanFinishU2FLogin(ctx, userID, resourceOwner, credentialData, request, true)
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanFinishU2FLogin(ctx, userID, resourceOwner, credentialData, request, true, lockoutPolicyToDomain(policy))
 }
 
 func (repo *AuthRequestRepo) BeginPasswordlessSetup(ctx context.Context, userID, resourceOwner string, authenticatorPlatform domain.AuthenticatorAttachment) (login *domain.WebAuthNToken, err error) {
@@ -408,7 +493,11 @@ func (repo *AuthRequestRepo) VerifyPasswordless(ctx context.Context, userID, res
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request, true)
+	policy, err := repo.getLockoutPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request, true, lockoutPolicyToDomain(policy))
 }
 
 func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, userAgentID string, info *domain.BrowserInfo) (err error) {
@@ -440,6 +529,15 @@ func (repo *AuthRequestRepo) ResetLinkingUsers(ctx context.Context, authReqID, u
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+func (repo *AuthRequestRepo) SkipPasswordExpiryWarning(ctx context.Context, authReqID, userAgentID string) error {
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	request.PasswordExpiryWarned = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) AutoRegisterExternalUser(ctx context.Context, registerUser *domain.Human, externalIDP *domain.UserIDPLink, orgMemberRoles []string, authReqID, userAgentID, resourceOwner string, metadatas []*domain.Metadata, info *domain.BrowserInfo) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -489,7 +587,7 @@ func (repo *AuthRequestRepo) getAuthRequestEnsureUser(ctx context.Context, authR
 	if request.UserID != userID {
 		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-GBH32", "Errors.User.NotMatchingUserID")
 	}
-	_, err = activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.LockoutPolicyViewProvider, request.UserID)
+	_, err = activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.UserCommandProvider, request.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -688,7 +786,7 @@ func (repo *AuthRequestRepo) checkExternalUserLogin(ctx context.Context, request
 	if err != nil {
 		return err
 	}
-	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.LockoutPolicyViewProvider, externalIDP.UserID)
+	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.UserCommandProvider, externalIDP.UserID)
 	if err != nil {
 		return err
 	}
@@ -734,7 +832,7 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		}
 		return steps, nil
 	}
-	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.LockoutPolicyViewProvider, request.UserID)
+	user, err := activeUserByID(ctx, repo.UserViewProvider, repo.UserEventProvider, repo.OrgViewProvider, repo.UserCommandProvider, request.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -767,8 +865,12 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		return append(steps, step), nil
 	}
 
-	if user.PasswordChangeRequired {
-		steps = append(steps, &domain.ChangePasswordStep{})
+	passwordExpired, expiryWarning, err := repo.passwordAgeChecked(ctx, request, user)
+	if err != nil {
+		return nil, err
+	}
+	if user.PasswordChangeRequired || passwordExpired {
+		steps = append(steps, &domain.ChangePasswordStep{Expired: passwordExpired})
 	}
 	if !user.IsEmailVerified {
 		steps = append(steps, &domain.VerifyEMailStep{})
@@ -777,9 +879,12 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		steps = append(steps, &domain.ChangeUsernameStep{})
 	}
 
-	if user.PasswordChangeRequired || !user.IsEmailVerified || user.UsernameChangeRequired {
+	if user.PasswordChangeRequired || passwordExpired || !user.IsEmailVerified || user.UsernameChangeRequired {
 		return steps, nil
 	}
+	if expiryWarning != nil {
+		return append(steps, expiryWarning), nil
+	}
 
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
@@ -914,6 +1019,27 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 	}, false, nil
 }
 
+//passwordAgeChecked checks the age of a verified password against the password age policy of the user's organisation
+//it returns if the password is expired or the warning step if the password expires soon
+//the notification about the expiry is requested by the expiration job and not while computing the next steps
+func (repo *AuthRequestRepo) passwordAgeChecked(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView) (bool, domain.NextStep, error) {
+	if !request.PasswordVerified || !user.PasswordSet {
+		return false, nil, nil
+	}
+	policy, err := repo.getPasswordAgePolicy(ctx, user.ResourceOwner)
+	if err != nil {
+		return false, nil, err
+	}
+	now := time.Now()
+	if policy.IsExpired(user.PasswordChanged, now) {
+		return true, nil, nil
+	}
+	if request.PasswordExpiryWarned || !policy.IsInWarnPeriod(user.PasswordChanged, now) {
+		return false, nil, nil
+	}
+	return false, &domain.PasswordExpiryWarningStep{ExpiryDate: policy.ExpiryDate(user.PasswordChanged)}, nil
+}
+
 func (repo *AuthRequestRepo) mfaSkippedOrSetUp(user *user_model.UserView) bool {
 	if user.MFAMaxSetUp > model.MFALevelNotSetUp {
 		return true
@@ -953,6 +1079,28 @@ func (repo *AuthRequestRepo) getLockoutPolicy(ctx context.Context, orgID string)
 	return policy, err
 }
 
+func (repo *AuthRequestRepo) getPasswordAgePolicy(ctx context.Context, orgID string) (*domain.PasswordAgePolicy, error) {
+	policy, err := repo.PasswordAgePolicyProvider.PasswordAgePolicyByOrg(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return passwordAgePolicyToDomain(policy), nil
+}
+
+func passwordAgePolicyToDomain(policy *query.PasswordAgePolicy) *domain.PasswordAgePolicy {
+	return &domain.PasswordAgePolicy{
+		ObjectRoot: es_models.ObjectRoot{
+			AggregateID:   policy.ID,
+			Sequence:      policy.Sequence,
+			ResourceOwner: policy.ResourceOwner,
+			CreationDate:  policy.CreationDate,
+			ChangeDate:    policy.ChangeDate,
+		},
+		MaxAgeDays:     policy.MaxAgeDays,
+		ExpireWarnDays: policy.ExpireWarnDays,
+	}
+}
+
 func (repo *AuthRequestRepo) getLabelPolicy(ctx context.Context, orgID string) (*domain.LabelPolicy, error) {
 	policy, err := repo.LabelPolicyProvider.ActiveLabelPolicyByOrg(ctx, orgID)
 	if err != nil {
@@ -1000,6 +1148,9 @@ func (repo *AuthRequestRepo) getLoginTexts(ctx context.Context, aggregateID stri
 }
 
 func (repo *AuthRequestRepo) hasSucceededPage(ctx context.Context, request *domain.AuthRequest, provider applicationProvider) (bool, error) {
+	if request.Request.Type() != domain.AuthRequestTypeOIDC {
+		return false, nil
+	}
 	app, err := provider.AppByOIDCClientID(ctx, request.ApplicationID)
 	if err != nil {
 		return false, err
@@ -1088,6 +1239,12 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 			es_model.HumanExternalLoginCheckSucceeded,
 			es_model.HumanMFAOTPCheckSucceeded,
 			es_model.HumanMFAOTPCheckFailed,
+			es_model.HumanMFAOTPSMSCheckSucceeded,
+			es_model.HumanMFAOTPSMSCheckFailed,
+			es_model.HumanMFAOTPEmailCheckSucceeded,
+			es_model.HumanMFAOTPEmailCheckFailed,
+			es_model.HumanMFARecoveryCodeCheckSucceeded,
+			es_model.HumanMFARecoveryCodeCheckFailed,
 			es_model.HumanSignedOut,
 			es_model.HumanPasswordlessTokenCheckSucceeded,
 			es_model.HumanPasswordlessTokenCheckFailed,
@@ -1110,8 +1267,7 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 	return user_view_model.UserSessionToModel(&sessionCopy, provider.PrefixAvatarURL()), nil
 }
 
-func activeUserByID(ctx context.Context, userViewProvider userViewProvider, userEventProvider userEventProvider, queries orgViewProvider, lockoutPolicyProvider lockoutPolicyViewProvider, userID string) (*user_model.UserView, error) {
-	// PLANNED: Check LockoutPolicy
+func activeUserByID(ctx context.Context, userViewProvider userViewProvider, userEventProvider userEventProvider, queries orgViewProvider, userCommandProvider userCommandProvider, userID string) (*user_model.UserView, error) {
 	user, err := userByID(ctx, userViewProvider, userEventProvider, userID)
 	if err != nil {
 		return nil, err
@@ -1120,6 +1276,16 @@ func activeUserByID(ctx context.Context, userViewProvider userViewProvider, user
 	if user.HumanView == nil {
 		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-Lm69x", "Errors.User.NotHuman")
 	}
+	if user.State == user_model.UserStateLocked {
+		//the unlock itself is done by the command side on the next check of the user
+		expired, err := userCommandProvider.UserLockoutExpired(ctx, user.ID, user.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			user.State = user_model.UserStateActive
+		}
+	}
 	if user.State == user_model.UserStateLocked || user.State == user_model.UserStateSuspend {
 		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-FJ262", "Errors.User.Locked")
 	}
@@ -1201,15 +1367,9 @@ func linkingIDPConfigExistingInAllowedIDPs(linkingUsers []*domain.ExternalUser,
 }
 
 func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView, userGrantProvider userGrantProvider) (_ bool, err error) {
-	var project *query.Project
-	switch request.Request.Type() {
-	case domain.AuthRequestTypeOIDC:
-		project, err = userGrantProvider.ProjectByOIDCClientID(ctx, request.ApplicationID)
-		if err != nil {
-			return false, err
-		}
-	default:
-		return false, errors.ThrowPreconditionFailed(nil, "EVENT-dfrw2", "Errors.AuthRequest.RequestTypeNotSupported")
+	project, err := projectByRequest(ctx, request, userGrantProvider)
+	if err != nil {
+		return false, err
 	}
 	if !project.ProjectRoleCheck {
 		return false, nil
@@ -1221,16 +1381,21 @@ func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *u
 	return len(grants) == 0, nil
 }
 
-func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (_ bool, err error) {
-	var project *query.Project
+func projectByRequest(ctx context.Context, request *domain.AuthRequest, provider projectByAppProvider) (*query.Project, error) {
 	switch request.Request.Type() {
-	case domain.AuthRequestTypeOIDC:
-		project, err = projectProvider.ProjectByOIDCClientID(ctx, request.ApplicationID)
-		if err != nil {
-			return false, err
-		}
+	case domain.AuthRequestTypeOIDC, domain.AuthRequestTypeDevice:
+		return provider.ProjectByOIDCClientID(ctx, request.ApplicationID)
+	case domain.AuthRequestTypeSAML:
+		return provider.ProjectBySAMLEntityID(ctx, request.ApplicationID)
 	default:
-		return false, errors.ThrowPreconditionFailed(nil, "EVENT-dfrw2", "Errors.AuthRequest.RequestTypeNotSupported")
+		return nil, errors.ThrowPreconditionFailed(nil, "EVENT-dfrw2", "Errors.AuthRequest.RequestTypeNotSupported")
+	}
+}
+
+func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (_ bool, err error) {
+	project, err := projectByRequest(ctx, request, projectProvider)
+	if err != nil {
+		return false, err
 	}
 	if !project.HasProjectCheck {
 		return false, nil
@@ -92,7 +92,11 @@ func (i *IDPConfig) processIdpConfig(providerType iam_model.IDPProviderType, eve
 		model.OIDCIDPConfigAdded, iam_es_model.OIDCIDPConfigAdded,
 		model.OIDCIDPConfigChanged, iam_es_model.OIDCIDPConfigChanged,
 		es_models.EventType(org.IDPJWTConfigAddedEventType), es_models.EventType(iam.IDPJWTConfigAddedEventType),
-		es_models.EventType(org.IDPJWTConfigChangedEventType), es_models.EventType(iam.IDPJWTConfigChangedEventType):
+		es_models.EventType(org.IDPJWTConfigChangedEventType), es_models.EventType(iam.IDPJWTConfigChangedEventType),
+		es_models.EventType(org.IDPSAMLConfigAddedEventType), es_models.EventType(iam.IDPSAMLConfigAddedEventType),
+		es_models.EventType(org.IDPSAMLConfigChangedEventType), es_models.EventType(iam.IDPSAMLConfigChangedEventType),
+		es_models.EventType(org.IDPLDAPConfigAddedEventType), es_models.EventType(iam.IDPLDAPConfigAddedEventType),
+		es_models.EventType(org.IDPLDAPConfigChangedEventType), es_models.EventType(iam.IDPLDAPConfigChangedEventType):
 		err = idp.SetData(event)
 		if err != nil {
 			return err
@@ -119,6 +123,8 @@ func (i *IDPConfig) processIdpConfig(providerType iam_model.IDPProviderType, eve
 			return err
 		}
 		return i.view.DeleteIDPConfig(idp.IDPConfigID, event)
+	case model.OrgRemoved:
+		return i.view.DeleteIDPConfigsByAggregateID(event.AggregateID, event)
 	default:
 		return i.view.ProcessedIDPConfigSequence(event)
 	}
@@ -132,7 +132,7 @@ func (i *IDPProvider) processIdpProvider(event *es_models.Event) (err error) {
 			i.fillConfigData(provider, config)
 		}
 		return i.view.PutIDPProviders(event, providers...)
-	case org_es_model.LoginPolicyRemoved:
+	case org_es_model.LoginPolicyRemoved, org_es_model.OrgRemoved:
 		return i.view.DeleteIDPProvidersByAggregateID(event.AggregateID, event)
 	default:
 		return i.view.ProcessedIDPProviderSequence(event)
@@ -164,6 +164,10 @@ func (i *IDPProvider) fillConfigData(provider *iam_view_model.IDPProviderView, c
 		provider.IDPConfigType = int32(domain.IDPConfigTypeOIDC)
 	} else if config.JWTIDP != nil {
 		provider.IDPConfigType = int32(domain.IDPConfigTypeJWT)
+	} else if config.SAMLIDP != nil {
+		provider.IDPConfigType = int32(domain.IDPConfigTypeSAML)
+	} else if config.LDAPIDP != nil {
+		provider.IDPConfigType = int32(domain.IDPConfigTypeLDAP)
 	}
 	switch config.State {
 	case domain.IDPConfigStateActive:
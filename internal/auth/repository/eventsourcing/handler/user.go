@@ -136,6 +136,13 @@ func (u *User) ProcessUser(event *es_models.Event) (err error) {
 		es_model.HumanMFAOTPAdded,
 		es_model.HumanMFAOTPVerified,
 		es_model.HumanMFAOTPRemoved,
+		es_model.HumanMFAOTPSMSAdded,
+		es_model.HumanMFAOTPSMSRemoved,
+		es_model.HumanMFAOTPEmailAdded,
+		es_model.HumanMFAOTPEmailRemoved,
+		es_model.HumanMFARecoveryCodesAdded,
+		es_model.HumanMFARecoveryCodesRemoved,
+		es_model.HumanMFARecoveryCodeCheckSucceeded,
 		es_model.HumanMFAU2FTokenAdded,
 		es_model.HumanMFAU2FTokenVerified,
 		es_model.HumanMFAU2FTokenRemoved,
@@ -84,6 +84,12 @@ func (u *UserSession) Reduce(event *models.Event) (err error) {
 		es_model.HumanExternalLoginCheckSucceeded,
 		es_model.HumanMFAOTPCheckSucceeded,
 		es_model.HumanMFAOTPCheckFailed,
+		es_model.HumanMFAOTPSMSCheckSucceeded,
+		es_model.HumanMFAOTPSMSCheckFailed,
+		es_model.HumanMFAOTPEmailCheckSucceeded,
+		es_model.HumanMFAOTPEmailCheckFailed,
+		es_model.HumanMFARecoveryCodeCheckSucceeded,
+		es_model.HumanMFARecoveryCodeCheckFailed,
 		es_model.HumanMFAU2FTokenCheckSucceeded,
 		es_model.HumanMFAU2FTokenCheckFailed,
 		es_model.HumanPasswordlessTokenCheckSucceeded,
@@ -114,6 +120,9 @@ func (u *UserSession) Reduce(event *models.Event) (err error) {
 		es_model.UserDeactivated,
 		es_model.HumanPasswordChanged,
 		es_model.HumanMFAOTPRemoved,
+		es_model.HumanMFAOTPSMSRemoved,
+		es_model.HumanMFAOTPEmailRemoved,
+		es_model.HumanMFARecoveryCodesRemoved,
 		es_model.HumanProfileChanged,
 		es_model.HumanAvatarAdded,
 		es_model.HumanAvatarRemoved,
@@ -2,6 +2,7 @@ package eventsourcing
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/auth/repository/eventsourcing/eventstore"
 	"github.com/caos/zitadel/internal/auth/repository/eventsourcing/spooler"
@@ -101,6 +102,7 @@ func Start(conf Config, systemDefaults sd.SystemDefaults, command *command.Comma
 			UserEventProvider:          &userRepo,
 			IDPProviderViewProvider:    view,
 			LockoutPolicyViewProvider:  queries,
+			PasswordAgePolicyProvider:  queries,
 			LoginPolicyViewProvider:    queries,
 			UserGrantProvider:          queryView,
 			ProjectProvider:            queryView,
@@ -150,7 +152,11 @@ func (q queryViewWrapper) UserGrantsByProjectAndUserID(projectID, userID string)
 	if err != nil {
 		return nil, err
 	}
-	queries := &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID, userGrantProjectID}}
+	notExpiredQuery, err := query.NewUserGrantNotExpiredQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	queries := &query.UserGrantsQueries{Queries: []query.SearchQuery{userGrantUserID, userGrantProjectID, notExpiredQuery}}
 	grants, err := q.Queries.UserGrants(context.TODO(), queries)
 	if err != nil {
 		return nil, err
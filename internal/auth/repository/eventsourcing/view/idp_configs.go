@@ -41,6 +41,14 @@ func (v *View) DeleteIDPConfig(idpID string, event *models.Event) error {
 	return v.ProcessedIDPConfigSequence(event)
 }
 
+func (v *View) DeleteIDPConfigsByAggregateID(aggregateID string, event *models.Event) error {
+	err := view.DeleteIDPsByAggregateID(v.Db, idpConfigTable, aggregateID)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return v.ProcessedIDPConfigSequence(event)
+}
+
 func (v *View) GetLatestIDPConfigSequence() (*global_view.CurrentSequence, error) {
 	return v.latestSequence(idpConfigTable)
 }
@@ -159,6 +159,9 @@ const (
 	MFATypeOTP MFAType = iota
 	MFATypeU2F
 	MFATypeU2FUserVerification
+	MFATypeOTPEmail
+	MFATypeOTPSMS
+	MFATypeRecoveryCode
 )
 
 type MFALevel int
@@ -178,6 +181,12 @@ func MFATypeToDomain(mfaType MFAType) domain.MFAType {
 		return domain.MFATypeU2F
 	case MFATypeU2FUserVerification:
 		return domain.MFATypeU2FUserVerification
+	case MFATypeOTPEmail:
+		return domain.MFATypeOTPEmail
+	case MFATypeOTPSMS:
+		return domain.MFATypeOTPSMS
+	case MFATypeRecoveryCode:
+		return domain.MFATypeRecoveryCode
 	default:
 		return domain.MFATypeOTP
 	}
@@ -149,6 +149,8 @@ func (m *UserMembership) processOrg(event *es_models.Event) (err error) {
 		return m.view.DeleteUserMembership(member.UserID, event.AggregateID, event.AggregateID, usr_model.MemberTypeOrganisation, event)
 	case org_es_model.OrgChanged:
 		return m.updateOrgName(event)
+	case org_es_model.OrgRemoved:
+		return m.view.DeleteUserMembershipsByAggregateID(event.AggregateID, event)
 	default:
 		return m.view.ProcessedUserMembershipSequence(event)
 	}
@@ -0,0 +1,88 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/caos/zitadel/internal/config/types"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const prefixLength = 5
+
+//Checker checks if a password is part of a known data breach
+//the password never leaves the checker, only the first 5 characters
+//of its SHA-1 hash are used to lookup the range of possible hashes (k-anonymity)
+type Checker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+//Config configures the source of the breached password hashes
+//only one of the sources can be set, if none is set no checker is created
+type Config struct {
+	//RangeDir is a directory containing a file per hash prefix (e.g. 21BD1.txt)
+	RangeDir string
+	//RangeURL is the url of an api compatible with the range api of haveibeenpwned.com
+	//the hash prefix is appended to the url (e.g. https://api.pwnedpasswords.com/range/)
+	RangeURL string
+	Timeout  types.Duration
+}
+
+func (c *Config) NewChecker() (Checker, error) {
+	if c == nil {
+		return nil, nil
+	}
+	switch {
+	case c.RangeDir != "" && c.RangeURL != "":
+		return nil, caos_errs.ThrowInvalidArgument(nil, "BREAC-Mf82k", "only one of RangeDir and RangeURL can be set")
+	case c.RangeDir != "":
+		return NewRangeDirChecker(c.RangeDir)
+	case c.RangeURL != "":
+		return NewRangeAPIChecker(c.RangeURL, c.Timeout.Duration)
+	}
+	return nil, nil
+}
+
+//hashRange returns the prefix used to lookup the range and the suffix to search in it
+func hashRange(password string) (prefix, suffix string) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return hash[:prefixLength], hash[prefixLength:]
+}
+
+//inRange searches the suffix in a range of the format SUFFIX:COUNT (one per line)
+//entries with a count of 0 are padding and ignored
+func inRange(r io.Reader, suffix string) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		hash, count, found := cut(line, ":")
+		if !strings.EqualFold(hash, suffix) {
+			continue
+		}
+		if !found {
+			return true, nil
+		}
+		occurrences, err := strconv.ParseUint(count, 10, 64)
+		if err != nil {
+			return false, caos_errs.ThrowInternal(err, "BREAC-Pq93n", "invalid count in hash range")
+		}
+		return occurrences > 0, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, caos_errs.ThrowInternal(err, "BREAC-Kr04v", "unable to read hash range")
+	}
+	return false, nil
+}
+
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
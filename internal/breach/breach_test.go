@@ -0,0 +1,179 @@
+package breach
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	//sha1 of "password": 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	passwordPrefix = "5BAA6"
+	passwordSuffix = "1E4C9B93F3F0682250B6CF8331B7EE68FD8"
+)
+
+func Test_hashRange(t *testing.T) {
+	prefix, suffix := hashRange("password")
+	if prefix != passwordPrefix || suffix != passwordSuffix {
+		t.Errorf("got wrong hash range: prefix: %s, suffix: %s", prefix, suffix)
+	}
+}
+
+func Test_inRange(t *testing.T) {
+	type args struct {
+		hashRange string
+		suffix    string
+	}
+	type res struct {
+		breached bool
+		wantErr  bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "empty range",
+			args: args{
+				hashRange: "",
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				breached: false,
+			},
+		},
+		{
+			name: "not in range",
+			args: args{
+				hashRange: "003D68EB55068C33ACE09247EE4C639306B:3\r\n012C192B2F16F82EA0EB9EF18D9D539B0DD:1",
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				breached: false,
+			},
+		},
+		{
+			name: "in range",
+			args: args{
+				hashRange: "003D68EB55068C33ACE09247EE4C639306B:3\r\n" + passwordSuffix + ":9659365",
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				breached: true,
+			},
+		},
+		{
+			name: "in range lower case",
+			args: args{
+				hashRange: strings.ToLower(passwordSuffix) + ":2",
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				breached: true,
+			},
+		},
+		{
+			name: "in range without count",
+			args: args{
+				hashRange: passwordSuffix,
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				breached: true,
+			},
+		},
+		{
+			name: "padding entry",
+			args: args{
+				hashRange: passwordSuffix + ":0",
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				breached: false,
+			},
+		},
+		{
+			name: "invalid count",
+			args: args{
+				hashRange: passwordSuffix + ":many",
+				suffix:    passwordSuffix,
+			},
+			res: res{
+				wantErr: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breached, err := inRange(strings.NewReader(tt.args.hashRange), tt.args.suffix)
+			if (err != nil) != tt.res.wantErr {
+				t.Errorf("got wrong err: %v", err)
+				return
+			}
+			if breached != tt.res.breached {
+				t.Errorf("got wrong result: expected: %v, actual: %v", tt.res.breached, breached)
+			}
+		})
+	}
+}
+
+func TestConfig_NewChecker(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		wantChecker bool
+		wantErr     bool
+	}{
+		{
+			name:        "no config",
+			config:      nil,
+			wantChecker: false,
+		},
+		{
+			name:        "no source",
+			config:      &Config{},
+			wantChecker: false,
+		},
+		{
+			name: "both sources",
+			config: &Config{
+				RangeDir: t.TempDir(),
+				RangeURL: "https://api.pwnedpasswords.com/range/",
+			},
+			wantErr: true,
+		},
+		{
+			name: "range dir",
+			config: &Config{
+				RangeDir: t.TempDir(),
+			},
+			wantChecker: true,
+		},
+		{
+			name: "range url",
+			config: &Config{
+				RangeURL: "https://api.pwnedpasswords.com/range/",
+			},
+			wantChecker: true,
+		},
+		{
+			name: "invalid range url",
+			config: &Config{
+				RangeURL: "ftp://pwned/range/",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker, err := tt.config.NewChecker()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got wrong err: %v", err)
+				return
+			}
+			if (checker != nil) != tt.wantChecker {
+				t.Errorf("got wrong checker: %v", checker)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package breach
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const defaultRequestTimeout = 5 * time.Second
+
+type rangeAPIChecker struct {
+	url    string
+	client *http.Client
+}
+
+//NewRangeAPIChecker checks the passwords against an api compatible with the range api of haveibeenpwned.com
+//the hash prefix is appended to rangeURL
+func NewRangeAPIChecker(rangeURL string, timeout time.Duration) (Checker, error) {
+	u, err := url.Parse(rangeURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, caos_errs.ThrowInvalidArgument(err, "BREAC-Ur82n", "range url must be http or https")
+	}
+	if timeout == 0 {
+		timeout = defaultRequestTimeout
+	}
+	return &rangeAPIChecker{
+		url:    rangeURL,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (c *rangeAPIChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	prefix, suffix := hashRange(password)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+prefix, nil)
+	if err != nil {
+		return false, caos_errs.ThrowInternal(err, "BREAC-Rq83m", "unable to create request")
+	}
+	//padding hides the size of the response from observers
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, caos_errs.ThrowUnavailable(err, "BREAC-Ap92n", "range api not reachable")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, caos_errs.ThrowUnavailable(nil, "BREAC-As83k", "range api returned unexpected status")
+	}
+	return inRange(resp.Body, suffix)
+}
@@ -0,0 +1,70 @@
+package breach
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRangeAPIChecker_IsBreached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, "/range/") {
+		case passwordPrefix:
+			w.Write([]byte("003D68EB55068C33ACE09247EE4C639306B:3\r\n" + passwordSuffix + ":9659365\r\n"))
+		case "00000":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte("003D68EB55068C33ACE09247EE4C639306B:0\r\n"))
+		}
+	}))
+	defer server.Close()
+
+	checker, err := NewRangeAPIChecker(server.URL+"/range/", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		password string
+		breached bool
+	}{
+		{
+			name:     "breached",
+			password: "password",
+			breached: true,
+		},
+		{
+			name:     "not breached",
+			password: "Tr0ub4dor&3-correct-horse",
+			breached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breached, err := checker.IsBreached(context.Background(), tt.password)
+			if err != nil {
+				t.Errorf("unexpected err: %v", err)
+			}
+			if breached != tt.breached {
+				t.Errorf("got wrong result: expected: %v, actual: %v", tt.breached, breached)
+			}
+		})
+	}
+}
+
+func TestRangeAPIChecker_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	checker, err := NewRangeAPIChecker(server.URL+"/range/", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = checker.IsBreached(context.Background(), "password"); err == nil {
+		t.Error("expected error if api is unavailable")
+	}
+}
@@ -0,0 +1,42 @@
+package breach
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const rangeFileExtension = ".txt"
+
+type rangeDirChecker struct {
+	dir string
+}
+
+//NewRangeDirChecker checks the passwords against the range files in dir
+//the files are named after the hash prefix, as written by the downloader of haveibeenpwned.com
+func NewRangeDirChecker(dir string) (Checker, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "BREAC-Dr93k", "unable to read range directory")
+	}
+	if !info.IsDir() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "BREAC-Dn28s", "range directory is not a directory")
+	}
+	return &rangeDirChecker{dir: dir}, nil
+}
+
+func (c *rangeDirChecker) IsBreached(_ context.Context, password string) (bool, error) {
+	prefix, suffix := hashRange(password)
+	file, err := os.Open(filepath.Join(c.dir, prefix+rangeFileExtension))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, caos_errs.ThrowInternal(err, "BREAC-Fo92l", "unable to open range file")
+	}
+	defer file.Close()
+	return inRange(file, suffix)
+}
@@ -0,0 +1,54 @@
+package breach
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRangeDirChecker_IsBreached(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, passwordPrefix+rangeFileExtension), []byte(passwordSuffix+":3\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checker, err := NewRangeDirChecker(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		password string
+		breached bool
+	}{
+		{
+			name:     "breached",
+			password: "password",
+			breached: true,
+		},
+		{
+			name:     "range file missing",
+			password: "Tr0ub4dor&3-correct-horse",
+			breached: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breached, err := checker.IsBreached(context.Background(), tt.password)
+			if err != nil {
+				t.Errorf("unexpected err: %v", err)
+			}
+			if breached != tt.breached {
+				t.Errorf("got wrong result: expected: %v, actual: %v", tt.breached, breached)
+			}
+		})
+	}
+}
+
+func TestNewRangeDirChecker_NotExisting(t *testing.T) {
+	_, err := NewRangeDirChecker(filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/actions"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type actionsQuerier interface {
+	GetActiveActionsByFlowAndTriggerType(ctx context.Context, flowType domain.FlowType, triggerType domain.TriggerType, orgID string) ([]*query.Action, error)
+}
+
+//runActions runs the active actions of the organisation for the given flow and trigger
+//an action which fails and is not allowed to fail rejects the operation
+func (c *Commands) runActions(ctx context.Context, flowType domain.FlowType, triggerType domain.TriggerType, resourceOwner string, actionCtx *actions.Context, api *actions.API) error {
+	if c.actionsQuerier == nil {
+		return nil
+	}
+	triggerActions, err := c.actionsQuerier.GetActiveActionsByFlowAndTriggerType(ctx, flowType, triggerType, resourceOwner)
+	if err != nil {
+		return err
+	}
+	for _, a := range triggerActions {
+		err = actions.RunAction(ctx, c.actionsDeps, actionCtx, api, a, flowType, triggerType)
+		if err != nil {
+			return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Ak2fr", "Errors.Action.Rejected")
+		}
+	}
+	return nil
+}
+
+//runPostActions runs the active actions of a post trigger
+//the operation is already executed, so failing actions are only logged
+func (c *Commands) runPostActions(ctx context.Context, flowType domain.FlowType, triggerType domain.TriggerType, resourceOwner string, actionCtx *actions.Context, api *actions.API) {
+	err := c.runActions(ctx, flowType, triggerType, resourceOwner, actionCtx, api)
+	logging.LogWithFields("COMMAND-Ak2pt", "flowType", flowType, "triggerType", triggerType, "resourceOwner", resourceOwner).OnError(err).Warn("post actions failed")
+}
+
+//humanPreCreationActions lets the actions of the flow mutate or reject the human before it's created
+//the metadata added by the actions has to be set on the created user
+func (c *Commands) humanPreCreationActions(ctx context.Context, flowType domain.FlowType, orgID string, human *domain.Human) ([]*domain.Metadata, error) {
+	metadata := make([]*domain.Metadata, 0)
+	actionCtx := (&actions.Context{}).SetHuman(human)
+	api := (&actions.API{}).SetHuman(human).SetMetadata(&metadata)
+	err := c.runActions(ctx, flowType, domain.TriggerTypePreCreation, orgID, actionCtx, api)
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+//humanPostCreationActions passes the created human to the actions of the flow
+//and sets the metadata and adds the user grants the actions returned
+func (c *Commands) humanPostCreationActions(ctx context.Context, flowType domain.FlowType, orgID string, human *domain.Human) {
+	if c.actionsQuerier == nil {
+		return
+	}
+	metadata := make([]*domain.Metadata, 0)
+	userGrants := make([]actions.UserGrant, 0)
+	actionCtx := (&actions.Context{}).SetHuman(human)
+	api := (&actions.API{}).SetMetadata(&metadata).SetUserGrants(&userGrants)
+	c.runPostActions(ctx, flowType, domain.TriggerTypePostCreation, orgID, actionCtx, api)
+	if len(metadata) > 0 {
+		_, err := c.BulkSetUserMetadata(ctx, human.AggregateID, orgID, metadata...)
+		logging.LogWithFields("COMMAND-Ak2pm", "userID", human.AggregateID).OnError(err).Warn("unable to set metadata of post creation actions")
+	}
+	for _, grant := range userGrants {
+		_, err := c.AddUserGrant(ctx, &domain.UserGrant{
+			UserID:         human.AggregateID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.ProjectGrantID,
+			RoleKeys:       grant.Roles,
+		}, orgID)
+		logging.LogWithFields("COMMAND-Ak2pg", "userID", human.AggregateID, "projectID", grant.ProjectID).OnError(err).Warn("unable to add user grant of post creation actions")
+	}
+}
+
+//userGrantPreActions lets the actions of the user grant flow mutate or reject the user grant
+func (c *Commands) userGrantPreActions(ctx context.Context, triggerType domain.TriggerType, resourceOwner string, userGrant *domain.UserGrant) error {
+	actionCtx := (&actions.Context{}).SetUserGrant(userGrant)
+	api := (&actions.API{}).SetUserGrant(userGrant)
+	return c.runActions(ctx, domain.FlowTypeUserGrant, triggerType, resourceOwner, actionCtx, api)
+}
+
+//userGrantPostActions passes the resulting user grant to the actions of the user grant flow
+func (c *Commands) userGrantPostActions(ctx context.Context, triggerType domain.TriggerType, resourceOwner string, userGrant *domain.UserGrant) {
+	actionCtx := (&actions.Context{}).SetUserGrant(userGrant)
+	c.runPostActions(ctx, domain.FlowTypeUserGrant, triggerType, resourceOwner, actionCtx, &actions.API{})
+}
@@ -0,0 +1,275 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type mockActionsQuerier struct {
+	actions []*query.Action
+	err     error
+}
+
+func (m *mockActionsQuerier) GetActiveActionsByFlowAndTriggerType(context.Context, domain.FlowType, domain.TriggerType, string) ([]*query.Action, error) {
+	return m.actions, m.err
+}
+
+func newMockActionsQuerier(allowedToFail bool, scripts ...string) *mockActionsQuerier {
+	actions := make([]*query.Action, len(scripts))
+	for i, script := range scripts {
+		actions[i] = &query.Action{
+			Name:          "action",
+			Script:        script,
+			Timeout:       time.Second,
+			AllowedToFail: allowedToFail,
+		}
+	}
+	return &mockActionsQuerier{actions: actions}
+}
+
+func TestCommands_humanPreCreationActions(t *testing.T) {
+	type fields struct {
+		actionsQuerier actionsQuerier
+	}
+	type args struct {
+		human *domain.Human
+	}
+	type res struct {
+		human    *domain.Human
+		metadata []*domain.Metadata
+		err      func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name:   "no actions querier, unchanged",
+			fields: fields{},
+			args: args{
+				human: &domain.Human{
+					Username: "username",
+					Profile:  &domain.Profile{FirstName: "firstname"},
+				},
+			},
+			res: res{
+				human: &domain.Human{
+					Username: "username",
+					Profile:  &domain.Profile{FirstName: "firstname"},
+				},
+				metadata: []*domain.Metadata{},
+			},
+		},
+		{
+			name: "query error, error",
+			fields: fields{
+				actionsQuerier: &mockActionsQuerier{err: caos_errs.ThrowInternal(nil, "ID", "Errors.Internal")},
+			},
+			args: args{
+				human: &domain.Human{
+					Username: "username",
+				},
+			},
+			res: res{
+				err: caos_errs.IsInternal,
+			},
+		},
+		{
+			name: "action rejects, precondition error",
+			fields: fields{
+				actionsQuerier: newMockActionsQuerier(false, `function action(ctx, api) {
+	if (!ctx.human.EmailAddress.endsWith("@zitadel.ch")) {
+		throw "email domain not allowed";
+	}
+}`),
+			},
+			args: args{
+				human: &domain.Human{
+					Username: "username",
+					Profile:  &domain.Profile{FirstName: "firstname"},
+					Email:    &domain.Email{EmailAddress: "email@caos.ch"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "action fails but allowed to fail, unchanged",
+			fields: fields{
+				actionsQuerier: newMockActionsQuerier(true, `function action(ctx, api) {
+	throw "failed";
+}`),
+			},
+			args: args{
+				human: &domain.Human{
+					Username: "username",
+				},
+			},
+			res: res{
+				human: &domain.Human{
+					Username: "username",
+				},
+				metadata: []*domain.Metadata{},
+			},
+		},
+		{
+			name: "action changes human and adds metadata, ok",
+			fields: fields{
+				actionsQuerier: newMockActionsQuerier(false, `function action(ctx, api) {
+	api.setFirstName("changed");
+	api.metadata.push({Key: "key", Value: "value"});
+}`),
+			},
+			args: args{
+				human: &domain.Human{
+					Username: "username",
+					Profile:  &domain.Profile{FirstName: "firstname"},
+				},
+			},
+			res: res{
+				human: &domain.Human{
+					Username: "username",
+					Profile:  &domain.Profile{FirstName: "changed"},
+				},
+				metadata: []*domain.Metadata{
+					{Key: "key", Value: []byte("value")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				actionsQuerier: tt.fields.actionsQuerier,
+			}
+			metadata, err := c.humanPreCreationActions(context.Background(), domain.FlowTypeUserCreation, "org1", tt.args.human)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.human, tt.args.human)
+				assert.Equal(t, tt.res.metadata, metadata)
+			}
+		})
+	}
+}
+
+func TestCommands_userGrantPreActions(t *testing.T) {
+	type fields struct {
+		actionsQuerier actionsQuerier
+	}
+	type args struct {
+		userGrant *domain.UserGrant
+	}
+	type res struct {
+		userGrant *domain.UserGrant
+		err       func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "action rejects, precondition error",
+			fields: fields{
+				actionsQuerier: newMockActionsQuerier(false, `function action(ctx, api) {
+	if (ctx.userGrant.ProjectID == "project1") {
+		throw "project not allowed";
+	}
+}`),
+			},
+			args: args{
+				userGrant: &domain.UserGrant{
+					ProjectID: "project1",
+					RoleKeys:  []string{"role1"},
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "action changes roles, ok",
+			fields: fields{
+				actionsQuerier: newMockActionsQuerier(false, `function action(ctx, api) {
+	api.removeRole("role1");
+	api.addRole("role2");
+	api.addRole("role3");
+}`),
+			},
+			args: args{
+				userGrant: &domain.UserGrant{
+					ProjectID: "project1",
+					RoleKeys:  []string{"role1", "role2"},
+				},
+			},
+			res: res{
+				userGrant: &domain.UserGrant{
+					ProjectID: "project1",
+					RoleKeys:  []string{"role2", "role3"},
+				},
+			},
+		},
+		{
+			name: "action sets roles, ok",
+			fields: fields{
+				actionsQuerier: newMockActionsQuerier(false, `function action(ctx, api) {
+	api.setRoles(["role3"]);
+}`),
+			},
+			args: args{
+				userGrant: &domain.UserGrant{
+					ProjectID: "project1",
+					RoleKeys:  []string{"role1"},
+				},
+			},
+			res: res{
+				userGrant: &domain.UserGrant{
+					ProjectID: "project1",
+					RoleKeys:  []string{"role3"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				actionsQuerier: tt.fields.actionsQuerier,
+			}
+			err := c.userGrantPreActions(context.Background(), domain.TriggerTypePreCreation, "org1", tt.args.userGrant)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.userGrant, tt.args.userGrant)
+			}
+		})
+	}
+}
+
+func TestCommands_runActions_queryError(t *testing.T) {
+	c := &Commands{
+		actionsQuerier: &mockActionsQuerier{err: errors.New("unavailable")},
+	}
+	err := c.runActions(context.Background(), domain.FlowTypeUserGrant, domain.TriggerTypePreRemoval, "org1", nil, nil)
+	assert.Error(t, err)
+}
@@ -2,29 +2,41 @@ package command
 
 import (
 	"context"
+	"net"
 	"time"
 
+	"github.com/caos/zitadel/internal/actions"
 	"github.com/caos/zitadel/internal/api/authz"
 	"github.com/caos/zitadel/internal/api/http"
 	authz_repo "github.com/caos/zitadel/internal/authz/repository"
+	"github.com/caos/zitadel/internal/breach"
 	sd "github.com/caos/zitadel/internal/config/systemdefaults"
 	"github.com/caos/zitadel/internal/config/types"
 	"github.com/caos/zitadel/internal/crypto"
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/notification/channels/smtp"
+	"github.com/caos/zitadel/internal/notification/messages"
+	"github.com/caos/zitadel/internal/query"
 	"github.com/caos/zitadel/internal/repository/action"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
 	iam_repo "github.com/caos/zitadel/internal/repository/iam"
 	"github.com/caos/zitadel/internal/repository/keypair"
 	"github.com/caos/zitadel/internal/repository/org"
 	proj_repo "github.com/caos/zitadel/internal/repository/project"
 	usr_repo "github.com/caos/zitadel/internal/repository/user"
 	usr_grant_repo "github.com/caos/zitadel/internal/repository/usergrant"
+	"github.com/caos/zitadel/internal/repository/webhook"
 	"github.com/caos/zitadel/internal/static"
 	"github.com/caos/zitadel/internal/telemetry/tracing"
 	webauthn_helper "github.com/caos/zitadel/internal/webauthn"
 )
 
+const (
+	samlCertificateLifetime = 10 * 365 * 24 * time.Hour
+)
+
 type Commands struct {
 	eventstore   *eventstore.Eventstore
 	static       static.Storage
@@ -35,11 +47,15 @@ type Commands struct {
 	idpConfigSecretCrypto crypto.EncryptionAlgorithm
 
 	userPasswordAlg             crypto.HashAlgorithm
+	passwordBreachChecker       breach.Checker
 	initializeUserCode          crypto.Generator
 	emailVerificationCode       crypto.Generator
 	phoneVerificationCode       crypto.Generator
 	passwordVerificationCode    crypto.Generator
 	passwordlessInitCode        crypto.Generator
+	otpSMSCode                  crypto.Generator
+	otpEmailCode                crypto.Generator
+	recoveryCode                crypto.Generator
 	machineKeyAlg               crypto.EncryptionAlgorithm
 	machineKeySize              int
 	applicationKeySize          int
@@ -47,14 +63,23 @@ type Commands struct {
 	domainVerificationAlg       crypto.EncryptionAlgorithm
 	domainVerificationGenerator crypto.Generator
 	domainVerificationValidator func(domain, token, verifier string, checkType http.CheckType) error
+	webhookSigningKeyGenerator  crypto.Generator
+	webhookHostResolver         func(ctx context.Context, host string) ([]net.IPAddr, error)
+	notificationProviderCrypto  crypto.EncryptionAlgorithm
+	smtpTestSender              func(config smtp.EmailConfig, message *messages.Email) error
+	smsTestSender               func(provider sd.SMSProvider, message *messages.SMS) error
 	multifactors                domain.MultifactorConfigs
 
+	samlCertificateAndKeyGenerator func(id string) ([]byte, []byte, error)
+
 	webauthn           *webauthn_helper.WebAuthN
 	keySize            int
 	keyAlgorithm       crypto.EncryptionAlgorithm
 	privateKeyLifetime time.Duration
 	publicKeyLifetime  time.Duration
 	tokenVerifier      orgFeatureChecker
+	actionsQuerier     actionsQuerier
+	actionsDeps        actions.Dependencies
 }
 
 type orgFeatureChecker interface {
@@ -71,6 +96,8 @@ func StartCommands(
 	authZConfig authz.Config,
 	staticStore static.Storage,
 	authZRepo authz_repo.Repository,
+	queries *query.Queries,
+	actionsDeps actions.Dependencies,
 ) (repo *Commands, err error) {
 	repo = &Commands{
 		eventstore:         es,
@@ -81,7 +108,9 @@ func StartCommands(
 		keySize:            defaults.KeyConfig.Size,
 		privateKeyLifetime: defaults.KeyConfig.PrivateKeyLifetime.Duration,
 		publicKeyLifetime:  defaults.KeyConfig.PublicKeyLifetime.Duration,
+		actionsDeps:        actionsDeps,
 	}
+	repo.webhookHostResolver = net.DefaultResolver.LookupIPAddr
 	iam_repo.RegisterEventMappers(repo.eventstore)
 	org.RegisterEventMappers(repo.eventstore)
 	usr_repo.RegisterEventMappers(repo.eventstore)
@@ -89,6 +118,8 @@ func StartCommands(
 	proj_repo.RegisterEventMappers(repo.eventstore)
 	keypair.RegisterEventMappers(repo.eventstore)
 	action.RegisterEventMappers(repo.eventstore)
+	deviceauth.RegisterEventMappers(repo.eventstore)
+	webhook.RegisterEventMappers(repo.eventstore)
 
 	repo.idpConfigSecretCrypto, err = crypto.NewAESCrypto(defaults.IDPConfigVerificationKey)
 	if err != nil {
@@ -103,7 +134,22 @@ func StartCommands(
 	repo.phoneVerificationCode = crypto.NewEncryptionGenerator(defaults.SecretGenerators.PhoneVerificationCode, userEncryptionAlgorithm)
 	repo.passwordVerificationCode = crypto.NewEncryptionGenerator(defaults.SecretGenerators.PasswordVerificationCode, userEncryptionAlgorithm)
 	repo.passwordlessInitCode = crypto.NewEncryptionGenerator(defaults.SecretGenerators.PasswordlessInitCode, userEncryptionAlgorithm)
-	repo.userPasswordAlg = crypto.NewBCrypt(defaults.SecretGenerators.PasswordSaltCost)
+	repo.otpSMSCode = crypto.NewEncryptionGenerator(defaults.SecretGenerators.OTPSMSCode, userEncryptionAlgorithm)
+	repo.otpEmailCode = crypto.NewEncryptionGenerator(defaults.SecretGenerators.OTPEmailCode, userEncryptionAlgorithm)
+	repo.userPasswordAlg, err = defaults.PasswordHasher.NewHasher()
+	if err != nil {
+		return nil, err
+	}
+	//recovery codes are generated with enough entropy, so a keyed fast hash is sufficient
+	recoveryCodeAlg, err := crypto.NewHMACSHA256(defaults.Multifactors.RecoveryCodes.HashKey)
+	if err != nil {
+		return nil, err
+	}
+	repo.recoveryCode = crypto.NewHashGenerator(defaults.SecretGenerators.RecoveryCode, recoveryCodeAlg)
+	repo.passwordBreachChecker, err = defaults.PasswordBreachCheck.NewChecker()
+	if err != nil {
+		return nil, err
+	}
 	repo.machineKeyAlg = userEncryptionAlgorithm
 	repo.machineKeySize = int(defaults.SecretGenerators.MachineKeySize)
 	repo.applicationKeySize = int(defaults.SecretGenerators.ApplicationKeySize)
@@ -117,6 +163,10 @@ func StartCommands(
 			CryptoMFA: aesOTPCrypto,
 			Issuer:    defaults.Multifactors.OTP.Issuer,
 		},
+		RecoveryCodes: domain.RecoveryCodesConfig{
+			Count:        defaults.Multifactors.RecoveryCodes.Count,
+			LowThreshold: defaults.Multifactors.RecoveryCodes.LowThreshold,
+		},
 	}
 	passwordAlg := crypto.NewBCrypt(defaults.SecretGenerators.PasswordSaltCost)
 	repo.applicationSecretGenerator = crypto.NewHashGenerator(defaults.SecretGenerators.ClientSecretGenerator, passwordAlg)
@@ -127,6 +177,18 @@ func StartCommands(
 	}
 	repo.domainVerificationGenerator = crypto.NewEncryptionGenerator(defaults.DomainVerification.VerificationGenerator, repo.domainVerificationAlg)
 	repo.domainVerificationValidator = http.ValidateDomain
+	webhookKeyAlg, err := crypto.NewAESCrypto(defaults.WebhookVerificationKey)
+	if err != nil {
+		return nil, err
+	}
+	repo.webhookSigningKeyGenerator = crypto.NewEncryptionGenerator(defaults.SecretGenerators.WebhookSigningKey, webhookKeyAlg)
+	repo.notificationProviderCrypto, err = crypto.NewAESCrypto(defaults.NotificationProviderKey)
+	if err != nil {
+		return nil, err
+	}
+	repo.smtpTestSender = sendSMTPTestMessage
+	repo.smsTestSender = sendSMSTestMessage
+	repo.samlCertificateAndKeyGenerator = samlCertificateAndKeyGenerator(defaults.KeyConfig.Size)
 	web, err := webauthn_helper.StartServer(defaults.WebAuthN)
 	if err != nil {
 		return nil, err
@@ -140,6 +202,9 @@ func StartCommands(
 	repo.keyAlgorithm = keyAlgorithm
 
 	repo.tokenVerifier = authZRepo
+	if queries != nil {
+		repo.actionsQuerier = queries
+	}
 	return repo, nil
 }
 
@@ -156,6 +221,20 @@ func (c *Commands) getIAMWriteModel(ctx context.Context) (_ *IAMWriteModel, err
 	return writeModel, nil
 }
 
+func samlCertificateAndKeyGenerator(keySize int) func(id string) ([]byte, []byte, error) {
+	return func(id string) ([]byte, []byte, error) {
+		priv, _, err := crypto.GenerateKeyPair(keySize)
+		if err != nil {
+			return nil, nil, err
+		}
+		cert, err := crypto.GenerateSelfSignedCertificate(priv, id, samlCertificateLifetime)
+		if err != nil {
+			return nil, nil, err
+		}
+		return crypto.PrivateKeyToBytes(priv), cert, nil
+	}
+}
+
 func AppendAndReduce(object interface {
 	AppendEvents(...eventstore.Event)
 	Reduce() error
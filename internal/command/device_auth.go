@@ -0,0 +1,139 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
+)
+
+func (c *Commands) AddDeviceAuth(ctx context.Context, deviceAuth *domain.DeviceAuth, resourceOwner string) (_ string, _ *domain.ObjectDetails, err error) {
+	if deviceAuth.ClientID == "" || deviceAuth.DeviceCode == "" || deviceAuth.UserCode == "" || deviceAuth.Expires.IsZero() {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-2Nf0s", "Errors.DeviceAuth.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	writeModel := NewDeviceAuthWriteModel(id, resourceOwner)
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewAddedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		deviceAuth.ClientID,
+		deviceAuth.DeviceCode,
+		deviceAuth.UserCode,
+		deviceAuth.Expires,
+		deviceAuth.Scopes,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return writeModel.AggregateID, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ApproveDeviceAuth is called by the login after the user authenticated and allowed the device to access the account
+func (c *Commands) ApproveDeviceAuth(ctx context.Context, id, resourceOwner, subject, userOrgID string, audience, amr []string, authTime time.Time) (*domain.ObjectDetails, error) {
+	if subject == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bm2fs", "Errors.DeviceAuth.Invalid")
+	}
+	writeModel, err := c.getInitiatedDeviceAuthWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewApprovedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.UserCode,
+		subject,
+		userOrgID,
+		audience,
+		amr,
+		authTime,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) DenyDeviceAuth(ctx context.Context, id, resourceOwner string) (*domain.ObjectDetails, error) {
+	writeModel, err := c.getInitiatedDeviceAuthWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewDeniedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.UserCode,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveDeviceAuth removes the device authorization,
+// so that the device code can't be used (again) on the token endpoint
+func (c *Commands) RemoveDeviceAuth(ctx context.Context, id, resourceOwner string) (*domain.ObjectDetails, error) {
+	writeModel, err := c.getDeviceAuthWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hk2s0", "Errors.DeviceAuth.NotFound")
+	}
+	var userCode string
+	if writeModel.State == domain.DeviceAuthStateInitiated {
+		userCode = writeModel.UserCode
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, deviceauth.NewRemovedEvent(
+		ctx,
+		DeviceAuthAggregateFromWriteModel(&writeModel.WriteModel),
+		userCode,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getInitiatedDeviceAuthWriteModel(ctx context.Context, id, resourceOwner string) (*DeviceAuthWriteModel, error) {
+	writeModel, err := c.getDeviceAuthWriteModel(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.DeviceAuthStateInitiated {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-2m0Fs", "Errors.DeviceAuth.NotFound")
+	}
+	if writeModel.isExpired() {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gm3ks", "Errors.DeviceAuth.Expired")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) getDeviceAuthWriteModel(ctx context.Context, id, resourceOwner string) (*DeviceAuthWriteModel, error) {
+	writeModel := NewDeviceAuthWriteModel(id, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,485 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/id/mock"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
+)
+
+func TestCommands_AddDeviceAuth(t *testing.T) {
+	expires := time.Now().Add(5 * time.Minute).UTC()
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		deviceAuth    *domain.DeviceAuth
+		resourceOwner string
+	}
+	type res struct {
+		id      string
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no user code, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				deviceAuth: &domain.DeviceAuth{
+					ClientID:   "clientID",
+					DeviceCode: "deviceCode",
+					Expires:    expires,
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"user code already exists, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPushFailed(
+						errors.ThrowAlreadyExists(nil, "id", "user code already exists"),
+						[]*repository.Event{
+							eventFromEventPusher(
+								deviceauth.NewAddedEvent(context.Background(),
+									&deviceauth.NewAggregate("id1", "org1").Aggregate,
+									"clientID",
+									"deviceCode",
+									"BCDF-GHJK",
+									expires,
+									[]string{"openid"},
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(deviceauth.NewAddUserCodeUniqueConstraint("BCDF-GHJK")),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "id1"),
+			},
+			args{
+				ctx: context.Background(),
+				deviceAuth: &domain.DeviceAuth{
+					ClientID:   "clientID",
+					DeviceCode: "deviceCode",
+					UserCode:   "BCDF-GHJK",
+					Expires:    expires,
+					Scopes:     []string{"openid"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								deviceauth.NewAddedEvent(context.Background(),
+									&deviceauth.NewAggregate("id1", "org1").Aggregate,
+									"clientID",
+									"deviceCode",
+									"BCDF-GHJK",
+									expires,
+									[]string{"openid"},
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(deviceauth.NewAddUserCodeUniqueConstraint("BCDF-GHJK")),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "id1"),
+			},
+			args{
+				ctx: context.Background(),
+				deviceAuth: &domain.DeviceAuth{
+					ClientID:   "clientID",
+					DeviceCode: "deviceCode",
+					UserCode:   "BCDF-GHJK",
+					Expires:    expires,
+					Scopes:     []string{"openid"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				id: "id1",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			id, details, err := c.AddDeviceAuth(tt.args.ctx, tt.args.deviceAuth, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ApproveDeviceAuth(t *testing.T) {
+	authTime := time.Now().UTC()
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+		subject       string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+				subject:       "user1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"expired, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceauth.NewAddedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"clientID",
+								"deviceCode",
+								"BCDF-GHJK",
+								time.Now().Add(-time.Minute),
+								[]string{"openid"},
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+				subject:       "user1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"already denied, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceauth.NewAddedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"clientID",
+								"deviceCode",
+								"BCDF-GHJK",
+								time.Now().Add(time.Minute),
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							deviceauth.NewDeniedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"BCDF-GHJK",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+				subject:       "user1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceauth.NewAddedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"clientID",
+								"deviceCode",
+								"BCDF-GHJK",
+								time.Now().Add(time.Minute),
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								deviceauth.NewApprovedEvent(context.Background(),
+									&deviceauth.NewAggregate("id1", "org1").Aggregate,
+									"BCDF-GHJK",
+									"user1",
+									"org2",
+									[]string{"clientID"},
+									[]string{"password"},
+									authTime,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(deviceauth.NewRemoveUserCodeUniqueConstraint("BCDF-GHJK")),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+				subject:       "user1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.ApproveDeviceAuth(tt.args.ctx, tt.args.id, tt.args.resourceOwner, tt.args.subject, "org2", []string{"clientID"}, []string{"password"}, authTime)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveDeviceAuth(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"already removed, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceauth.NewAddedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"clientID",
+								"deviceCode",
+								"BCDF-GHJK",
+								time.Now().Add(time.Minute),
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							deviceauth.NewRemovedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"BCDF-GHJK",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"approved, push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceauth.NewAddedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"clientID",
+								"deviceCode",
+								"BCDF-GHJK",
+								time.Now().Add(time.Minute),
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							deviceauth.NewApprovedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"BCDF-GHJK",
+								"user1",
+								"org2",
+								[]string{"clientID"},
+								[]string{"password"},
+								time.Now(),
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								deviceauth.NewRemovedEvent(context.Background(),
+									&deviceauth.NewAggregate("id1", "org1").Aggregate,
+									"",
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"initiated, push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							deviceauth.NewAddedEvent(context.Background(),
+								&deviceauth.NewAggregate("id1", "org1").Aggregate,
+								"clientID",
+								"deviceCode",
+								"BCDF-GHJK",
+								time.Now().Add(-time.Minute),
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								deviceauth.NewRemovedEvent(context.Background(),
+									&deviceauth.NewAggregate("id1", "org1").Aggregate,
+									"BCDF-GHJK",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(deviceauth.NewRemoveUserCodeUniqueConstraint("BCDF-GHJK")),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.RemoveDeviceAuth(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+//SetActionsHTTPConfig sets the hosts the actions are allowed to call and the max response size of the http module
+func (c *Commands) SetActionsHTTPConfig(ctx context.Context, config *domain.ActionsHTTPConfig) (*domain.ObjectDetails, error) {
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ah2lw", "Errors.IAM.ActionsHTTPConfig.Invalid")
+	}
+	writeModel := NewIAMActionsHTTPConfigWriteModel()
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.HasChanged(config.AllowList, config.MaxResponseSize) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ah8nc", "Errors.NoChangesFound")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&writeModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewActionsHTTPConfigSetEvent(ctx, iamAgg, config.AllowList, config.MaxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"reflect"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+type IAMActionsHTTPConfigWriteModel struct {
+	eventstore.WriteModel
+
+	AllowList       []string
+	MaxResponseSize int64
+}
+
+func NewIAMActionsHTTPConfigWriteModel() *IAMActionsHTTPConfigWriteModel {
+	return &IAMActionsHTTPConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   domain.IAMID,
+			ResourceOwner: domain.IAMID,
+		},
+	}
+}
+
+func (wm *IAMActionsHTTPConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *iam.ActionsHTTPConfigSetEvent:
+			wm.AllowList = e.AllowList
+			wm.MaxResponseSize = e.MaxResponseSize
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IAMActionsHTTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(iam.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(iam.ActionsHTTPConfigSetEventType).
+		Builder()
+}
+
+func (wm *IAMActionsHTTPConfigWriteModel) HasChanged(allowList []string, maxResponseSize int64) bool {
+	if wm.MaxResponseSize != maxResponseSize {
+		return true
+	}
+	if len(wm.AllowList) == 0 && len(allowList) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(wm.AllowList, allowList)
+}
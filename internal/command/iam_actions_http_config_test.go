@@ -0,0 +1,181 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func TestCommandSide_SetActionsHTTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		config *domain.ActionsHTTPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid host, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.ActionsHTTPConfig{
+					AllowList: []string{"https://zitadel.ch"},
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewActionsHTTPConfigSetEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								[]string{"zitadel.ch"},
+								1024,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.ActionsHTTPConfig{
+					AllowList:       []string{"zitadel.ch"},
+					MaxResponseSize: 1024,
+				},
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "empty config not set before, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.ActionsHTTPConfig{
+					AllowList: []string{},
+				},
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "set config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewActionsHTTPConfigSetEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									[]string{"zitadel.ch", "*.caos.ch"},
+									1024,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.ActionsHTTPConfig{
+					AllowList:       []string{"zitadel.ch", "*.caos.ch"},
+					MaxResponseSize: 1024,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+		{
+			name: "change config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewActionsHTTPConfigSetEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								[]string{"zitadel.ch"},
+								1024,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewActionsHTTPConfigSetEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									nil,
+									0,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				config: &domain.ActionsHTTPConfig{},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.SetActionsHTTPConfig(tt.args.ctx, tt.args.config)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -27,9 +27,10 @@ func writeModelToIAM(wm *IAMWriteModel) *domain.IAM {
 
 func memberWriteModelToMember(writeModel *MemberWriteModel) *domain.Member {
 	return &domain.Member{
-		ObjectRoot: writeModelToObjectRoot(writeModel.WriteModel),
-		Roles:      writeModel.Roles,
-		UserID:     writeModel.UserID,
+		ObjectRoot:     writeModelToObjectRoot(writeModel.WriteModel),
+		Roles:          writeModel.Roles,
+		UserID:         writeModel.UserID,
+		ExpirationDate: writeModel.ExpirationDate,
 	}
 }
 
@@ -103,12 +104,13 @@ func writeModelToPasswordAgePolicy(wm *PasswordAgePolicyWriteModel) *domain.Pass
 
 func writeModelToPasswordComplexityPolicy(wm *PasswordComplexityPolicyWriteModel) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.WriteModel),
-		MinLength:    wm.MinLength,
-		HasLowercase: wm.HasLowercase,
-		HasUppercase: wm.HasUppercase,
-		HasNumber:    wm.HasNumber,
-		HasSymbol:    wm.HasSymbol,
+		ObjectRoot:    writeModelToObjectRoot(wm.WriteModel),
+		MinLength:     wm.MinLength,
+		HasLowercase:  wm.HasLowercase,
+		HasUppercase:  wm.HasUppercase,
+		HasNumber:     wm.HasNumber,
+		HasSymbol:     wm.HasSymbol,
+		CheckBreached: wm.CheckBreached,
 	}
 }
 
@@ -116,7 +118,11 @@ func writeModelToLockoutPolicy(wm *LockoutPolicyWriteModel) *domain.LockoutPolic
 	return &domain.LockoutPolicy{
 		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
 		MaxPasswordAttempts: wm.MaxPasswordAttempts,
+		MaxOTPAttempts:      wm.MaxOTPAttempts,
+		MaxU2FAttempts:      wm.MaxU2FAttempts,
 		ShowLockOutFailures: wm.ShowLockOutFailures,
+		LockoutDuration:     wm.LockoutDuration,
+		BackoffDelay:        wm.BackoffDelay,
 	}
 }
 
@@ -164,6 +170,46 @@ func writeModelToIDPJWTConfig(wm *JWTConfigWriteModel) *domain.JWTIDPConfig {
 	}
 }
 
+func writeModelToIDPSAMLConfig(wm *SAMLConfigWriteModel) *domain.SAMLIDPConfig {
+	return &domain.SAMLIDPConfig{
+		ObjectRoot:           writeModelToObjectRoot(wm.WriteModel),
+		IDPConfigID:          wm.IDPConfigID,
+		MetadataURL:          wm.MetadataURL,
+		Metadata:             wm.Metadata,
+		Key:                  wm.Key,
+		Certificate:          wm.Certificate,
+		Binding:              wm.Binding,
+		WithSignedRequest:    wm.WithSignedRequest,
+		NameIDFormat:         wm.NameIDFormat,
+		UsernameAttribute:    wm.UsernameAttribute,
+		DisplayNameAttribute: wm.DisplayNameAttribute,
+		FirstNameAttribute:   wm.FirstNameAttribute,
+		LastNameAttribute:    wm.LastNameAttribute,
+		EmailAttribute:       wm.EmailAttribute,
+		PhoneAttribute:       wm.PhoneAttribute,
+	}
+}
+
+func writeModelToIDPLDAPConfig(wm *LDAPConfigWriteModel) *domain.LDAPIDPConfig {
+	return &domain.LDAPIDPConfig{
+		ObjectRoot:           writeModelToObjectRoot(wm.WriteModel),
+		IDPConfigID:          wm.IDPConfigID,
+		URL:                  wm.URL,
+		StartTLS:             wm.StartTLS,
+		BindDN:               wm.BindDN,
+		BindPassword:         wm.BindPassword,
+		BaseDN:               wm.BaseDN,
+		UserObjectClass:      wm.UserObjectClass,
+		IDAttribute:          wm.IDAttribute,
+		UsernameAttribute:    wm.UsernameAttribute,
+		DisplayNameAttribute: wm.DisplayNameAttribute,
+		FirstNameAttribute:   wm.FirstNameAttribute,
+		LastNameAttribute:    wm.LastNameAttribute,
+		EmailAttribute:       wm.EmailAttribute,
+		PhoneAttribute:       wm.PhoneAttribute,
+	}
+}
+
 func writeModelToIDPProvider(wm *IdentityProviderWriteModel) *domain.IDPProvider {
 	return &domain.IDPProvider{
 		ObjectRoot:  writeModelToObjectRoot(wm.WriteModel),
@@ -199,3 +245,10 @@ func writeModelToFeatures(wm *FeaturesWriteModel) *domain.Features {
 		MaxActions:               wm.MaxActions,
 	}
 }
+
+func writeModelToPasswordHistoryPolicy(wm *PasswordHistoryPolicyWriteModel) *domain.PasswordHistoryPolicy {
+	return &domain.PasswordHistoryPolicy{
+		ObjectRoot:   writeModelToObjectRoot(wm.WriteModel),
+		HistoryCount: wm.HistoryCount,
+	}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func (c *Commands) AddDefaultIDPConfig(ctx context.Context, config *domain.IDPConfig) (*domain.IDPConfig, error) {
-	if config.OIDCConfig == nil && config.JWTConfig == nil {
+	if config.OIDCConfig == nil && config.JWTConfig == nil && config.SAMLConfig == nil && config.LDAPConfig == nil {
 		return nil, errors.ThrowInvalidArgument(nil, "IAM-eUpQU", "Errors.idp.config.notset")
 	}
 
@@ -65,6 +65,61 @@ func (c *Commands) AddDefaultIDPConfig(ctx context.Context, config *domain.IDPCo
 			config.JWTConfig.KeysEndpoint,
 			config.JWTConfig.HeaderName,
 		))
+	} else if config.SAMLConfig != nil {
+		if err := validateSAMLConfig(config.SAMLConfig); err != nil {
+			return nil, err
+		}
+		key, certificate, err := c.generateSAMLCertificateAndKey(idpConfigID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, iam_repo.NewIDPSAMLConfigAddedEvent(
+			ctx,
+			iamAgg,
+			idpConfigID,
+			config.SAMLConfig.MetadataURL,
+			config.SAMLConfig.Metadata,
+			key,
+			certificate,
+			config.SAMLConfig.Binding,
+			config.SAMLConfig.WithSignedRequest,
+			config.SAMLConfig.NameIDFormat,
+			config.SAMLConfig.UsernameAttribute,
+			config.SAMLConfig.DisplayNameAttribute,
+			config.SAMLConfig.FirstNameAttribute,
+			config.SAMLConfig.LastNameAttribute,
+			config.SAMLConfig.EmailAttribute,
+			config.SAMLConfig.PhoneAttribute,
+		))
+	} else if config.LDAPConfig != nil {
+		if err := validateLDAPConfig(config.LDAPConfig); err != nil {
+			return nil, err
+		}
+		var bindPassword *crypto.CryptoValue
+		if config.LDAPConfig.BindPasswordString != "" {
+			bindPassword, err = crypto.Encrypt([]byte(config.LDAPConfig.BindPasswordString), c.idpConfigSecretCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		events = append(events, iam_repo.NewIDPLDAPConfigAddedEvent(
+			ctx,
+			iamAgg,
+			idpConfigID,
+			config.LDAPConfig.URL,
+			config.LDAPConfig.StartTLS,
+			config.LDAPConfig.BindDN,
+			bindPassword,
+			config.LDAPConfig.BaseDN,
+			config.LDAPConfig.UserObjectClass,
+			config.LDAPConfig.IDAttribute,
+			config.LDAPConfig.UsernameAttribute,
+			config.LDAPConfig.DisplayNameAttribute,
+			config.LDAPConfig.FirstNameAttribute,
+			config.LDAPConfig.LastNameAttribute,
+			config.LDAPConfig.EmailAttribute,
+			config.LDAPConfig.PhoneAttribute,
+		))
 	}
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
 	if err != nil {
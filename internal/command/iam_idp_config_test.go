@@ -24,6 +24,8 @@ func TestCommandSide_AddDefaultIDPConfig(t *testing.T) {
 		eventstore   *eventstore.Eventstore
 		idGenerator  id.Generator
 		secretCrypto crypto.EncryptionAlgorithm
+
+		samlCertificateAndKeyGenerator func(id string) ([]byte, []byte, error)
 	}
 	type args struct {
 		ctx    context.Context
@@ -188,6 +190,173 @@ func TestCommandSide_AddDefaultIDPConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "idp config saml add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewIDPConfigAddedEvent(context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeSAML,
+									domain.IDPConfigStylingTypeUnspecified,
+									true,
+								),
+							),
+							eventFromEventPusher(
+								iam.NewIDPSAMLConfigAddedEvent(context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"config1",
+									"https://idp.example.com/metadata",
+									nil,
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("key"),
+									},
+									[]byte("certificate"),
+									domain.SAMLBindingPost,
+									true,
+									domain.SAMLNameIDFormatPersistent,
+									"uid",
+									"displayName",
+									"givenName",
+									"sn",
+									"mail",
+									"phone",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "IAM")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				samlCertificateAndKeyGenerator: func(id string) ([]byte, []byte, error) {
+					return []byte("key"), []byte("certificate"), nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.IDPConfig{
+					Name:         "name1",
+					Type:         domain.IDPConfigTypeSAML,
+					AutoRegister: true,
+					SAMLConfig: &domain.SAMLIDPConfig{
+						MetadataURL:          "https://idp.example.com/metadata",
+						Binding:              domain.SAMLBindingPost,
+						WithSignedRequest:    true,
+						NameIDFormat:         domain.SAMLNameIDFormatPersistent,
+						UsernameAttribute:    "uid",
+						DisplayNameAttribute: "displayName",
+						FirstNameAttribute:   "givenName",
+						LastNameAttribute:    "sn",
+						EmailAttribute:       "mail",
+						PhoneAttribute:       "phone",
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "IAM",
+						ResourceOwner: "IAM",
+					},
+					IDPConfigID:  "config1",
+					Name:         "name1",
+					State:        domain.IDPConfigStateActive,
+					AutoRegister: true,
+				},
+			},
+		},
+		{
+			name: "idp config ldap add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewIDPConfigAddedEvent(context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeLDAP,
+									domain.IDPConfigStylingTypeUnspecified,
+									true,
+								),
+							),
+							eventFromEventPusher(
+								iam.NewIDPLDAPConfigAddedEvent(context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"config1",
+									"ldaps://ldap.example.com",
+									false,
+									"cn=admin,dc=example,dc=com",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("password"),
+									},
+									"dc=example,dc=com",
+									"person",
+									"entryUUID",
+									"uid",
+									"cn",
+									"givenName",
+									"sn",
+									"mail",
+									"telephoneNumber",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "IAM")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				config: &domain.IDPConfig{
+					Name:         "name1",
+					Type:         domain.IDPConfigTypeLDAP,
+					AutoRegister: true,
+					LDAPConfig: &domain.LDAPIDPConfig{
+						URL:                  "ldaps://ldap.example.com",
+						BindDN:               "cn=admin,dc=example,dc=com",
+						BindPasswordString:   "password",
+						BaseDN:               "dc=example,dc=com",
+						UserObjectClass:      "person",
+						IDAttribute:          "entryUUID",
+						UsernameAttribute:    "uid",
+						DisplayNameAttribute: "cn",
+						FirstNameAttribute:   "givenName",
+						LastNameAttribute:    "sn",
+						EmailAttribute:       "mail",
+						PhoneAttribute:       "telephoneNumber",
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "IAM",
+						ResourceOwner: "IAM",
+					},
+					IDPConfigID:  "config1",
+					Name:         "name1",
+					State:        domain.IDPConfigStateActive,
+					AutoRegister: true,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -195,6 +364,8 @@ func TestCommandSide_AddDefaultIDPConfig(t *testing.T) {
 				eventstore:            tt.fields.eventstore,
 				idGenerator:           tt.fields.idGenerator,
 				idpConfigSecretCrypto: tt.fields.secretCrypto,
+
+				samlCertificateAndKeyGenerator: tt.fields.samlCertificateAndKeyGenerator,
 			}
 			got, err := r.AddDefaultIDPConfig(tt.args.ctx, tt.args.config)
 			if tt.res.err == nil {
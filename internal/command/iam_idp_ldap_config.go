@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeDefaultIDPLDAPConfig(ctx context.Context, config *domain.LDAPIDPConfig) (*domain.LDAPIDPConfig, error) {
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "IAM-Lk3sd", "Errors.IDMissing")
+	}
+	if err := validateLDAPConfig(config); err != nil {
+		return nil, err
+	}
+	existingConfig := NewIAMIDPLDAPConfigWriteModel(config.IDPConfigID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "IAM-Mx9sl", "Errors.IAM.IDPConfig.AlreadyExists")
+	}
+
+	iamAgg := IAMAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(ctx, iamAgg, config, c.idpConfigSecretCrypto)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "IAM-Pq0dm", "Errors.IAM.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToIDPLDAPConfig(&existingConfig.LDAPConfigWriteModel), nil
+}
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/crypto"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+type IAMIDPLDAPConfigWriteModel struct {
+	LDAPConfigWriteModel
+}
+
+func NewIAMIDPLDAPConfigWriteModel(idpConfigID string) *IAMIDPLDAPConfigWriteModel {
+	return &IAMIDPLDAPConfigWriteModel{
+		LDAPConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   domain.IAMID,
+				ResourceOwner: domain.IAMID,
+			},
+			IDPConfigID: idpConfigID,
+		},
+	}
+}
+
+func (wm *IAMIDPLDAPConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *iam.IDPLDAPConfigAddedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.LDAPConfigAddedEvent)
+		case *iam.IDPLDAPConfigChangedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.LDAPConfigChangedEvent)
+		case *iam.IDPConfigReactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.IDPConfigReactivatedEvent)
+		case *iam.IDPConfigDeactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.IDPConfigDeactivatedEvent)
+		case *iam.IDPConfigRemovedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.IDPConfigRemovedEvent)
+		default:
+			wm.LDAPConfigWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *IAMIDPLDAPConfigWriteModel) Reduce() error {
+	if err := wm.LDAPConfigWriteModel.Reduce(); err != nil {
+		return err
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IAMIDPLDAPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(iam.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			iam.IDPLDAPConfigAddedEventType,
+			iam.IDPLDAPConfigChangedEventType,
+			iam.IDPConfigReactivatedEventType,
+			iam.IDPConfigDeactivatedEventType,
+			iam.IDPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *IAMIDPLDAPConfigWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	config *domain.LDAPIDPConfig,
+	passwordAlg crypto.EncryptionAlgorithm,
+) (*iam.IDPLDAPConfigChangedEvent, bool, error) {
+	changes, err := wm.changes(config, passwordAlg)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := iam.NewIDPLDAPConfigChangedEvent(ctx, aggregate, config.IDPConfigID, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeDefaultIDPSAMLConfig(ctx context.Context, config *domain.SAMLIDPConfig) (*domain.SAMLIDPConfig, error) {
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "IAM-8fKs2", "Errors.IDMissing")
+	}
+	if err := validateSAMLConfig(config); err != nil {
+		return nil, err
+	}
+	existingConfig := NewIAMIDPSAMLConfigWriteModel(config.IDPConfigID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "IAM-3Lg9s", "Errors.IAM.IDPConfig.AlreadyExists")
+	}
+
+	iamAgg := IAMAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(ctx, iamAgg, config)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "IAM-0Fj3s", "Errors.IAM.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToIDPSAMLConfig(&existingConfig.SAMLConfigWriteModel), nil
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+type IAMIDPSAMLConfigWriteModel struct {
+	SAMLConfigWriteModel
+}
+
+func NewIAMIDPSAMLConfigWriteModel(idpConfigID string) *IAMIDPSAMLConfigWriteModel {
+	return &IAMIDPSAMLConfigWriteModel{
+		SAMLConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   domain.IAMID,
+				ResourceOwner: domain.IAMID,
+			},
+			IDPConfigID: idpConfigID,
+		},
+	}
+}
+
+func (wm *IAMIDPSAMLConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *iam.IDPSAMLConfigAddedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.SAMLConfigAddedEvent)
+		case *iam.IDPSAMLConfigChangedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.SAMLConfigChangedEvent)
+		case *iam.IDPConfigReactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigReactivatedEvent)
+		case *iam.IDPConfigDeactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigDeactivatedEvent)
+		case *iam.IDPConfigRemovedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.SAMLConfigWriteModel.AppendEvents(&e.IDPConfigRemovedEvent)
+		default:
+			wm.SAMLConfigWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *IAMIDPSAMLConfigWriteModel) Reduce() error {
+	if err := wm.SAMLConfigWriteModel.Reduce(); err != nil {
+		return err
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IAMIDPSAMLConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(iam.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			iam.IDPSAMLConfigAddedEventType,
+			iam.IDPSAMLConfigChangedEventType,
+			iam.IDPConfigReactivatedEventType,
+			iam.IDPConfigDeactivatedEventType,
+			iam.IDPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *IAMIDPSAMLConfigWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	config *domain.SAMLIDPConfig,
+) (*iam.IDPSAMLConfigChangedEvent, bool, error) {
+	changes := wm.changes(config)
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := iam.NewIDPSAMLConfigChangedEvent(ctx, aggregate, config.IDPConfigID, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
@@ -62,7 +62,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 							iam.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&iam.NewAggregate().Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -94,7 +94,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 								iam.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 									&iam.NewAggregate().Aggregate,
 									8,
-									true, true, true, true,
+									true, true, true, true, false,
 								),
 							),
 						},
@@ -216,7 +216,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 							iam.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&iam.NewAggregate().Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -246,7 +246,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 							iam.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&iam.NewAggregate().Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
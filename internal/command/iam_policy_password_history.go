@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	iam_repo "github.com/caos/zitadel/internal/repository/iam"
+	"github.com/caos/zitadel/internal/telemetry/tracing"
+)
+
+//getDefaultPasswordHistoryPolicy returns an empty policy (history disabled)
+//if no default policy has been added
+func (c *Commands) getDefaultPasswordHistoryPolicy(ctx context.Context) (*domain.PasswordHistoryPolicy, error) {
+	policyWriteModel := NewIAMPasswordHistoryPolicyWriteModel()
+	err := c.eventstore.FilterToQueryReducer(ctx, policyWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	policy := writeModelToPasswordHistoryPolicy(&policyWriteModel.PasswordHistoryPolicyWriteModel)
+	policy.Default = true
+	return policy, nil
+}
+
+func (c *Commands) AddDefaultPasswordHistoryPolicy(ctx context.Context, policy *domain.PasswordHistoryPolicy) (*domain.PasswordHistoryPolicy, error) {
+	addedPolicy := NewIAMPasswordHistoryPolicyWriteModel()
+	iamAgg := IAMAggregateFromWriteModel(&addedPolicy.WriteModel)
+	event, err := c.addDefaultPasswordHistoryPolicy(ctx, iamAgg, addedPolicy, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToPasswordHistoryPolicy(&addedPolicy.PasswordHistoryPolicyWriteModel), nil
+}
+
+func (c *Commands) addDefaultPasswordHistoryPolicy(ctx context.Context, iamAgg *eventstore.Aggregate, addedPolicy *IAMPasswordHistoryPolicyWriteModel, policy *domain.PasswordHistoryPolicy) (eventstore.Command, error) {
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	err := c.eventstore.FilterToQueryReducer(ctx, addedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if addedPolicy.State == domain.PolicyStateActive {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "IAM-Hs8dq", "Errors.IAM.PasswordHistoryPolicy.AlreadyExists")
+	}
+
+	return iam_repo.NewPasswordHistoryPolicyAddedEvent(ctx, iamAgg, policy.HistoryCount), nil
+
+}
+
+func (c *Commands) ChangeDefaultPasswordHistoryPolicy(ctx context.Context, policy *domain.PasswordHistoryPolicy) (*domain.PasswordHistoryPolicy, error) {
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	existingPolicy, err := c.defaultPasswordHistoryPolicyWriteModelByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "IAM-Lw9sc", "Errors.IAM.PasswordHistoryPolicy.NotFound")
+	}
+
+	iamAgg := IAMAggregateFromWriteModel(&existingPolicy.PasswordHistoryPolicyWriteModel.WriteModel)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, iamAgg, policy.HistoryCount)
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "IAM-Rm3sd", "Errors.IAM.PasswordHistoryPolicy.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToPasswordHistoryPolicy(&existingPolicy.PasswordHistoryPolicyWriteModel), nil
+}
+
+func (c *Commands) defaultPasswordHistoryPolicyWriteModelByID(ctx context.Context) (policy *IAMPasswordHistoryPolicyWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewIAMPasswordHistoryPolicyWriteModel()
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/repository/iam"
+	"github.com/caos/zitadel/internal/repository/policy"
+)
+
+type IAMPasswordHistoryPolicyWriteModel struct {
+	PasswordHistoryPolicyWriteModel
+}
+
+func NewIAMPasswordHistoryPolicyWriteModel() *IAMPasswordHistoryPolicyWriteModel {
+	return &IAMPasswordHistoryPolicyWriteModel{
+		PasswordHistoryPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   domain.IAMID,
+				ResourceOwner: domain.IAMID,
+			},
+		},
+	}
+}
+
+func (wm *IAMPasswordHistoryPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *iam.PasswordHistoryPolicyAddedEvent:
+			wm.PasswordHistoryPolicyWriteModel.AppendEvents(&e.PasswordHistoryPolicyAddedEvent)
+		case *iam.PasswordHistoryPolicyChangedEvent:
+			wm.PasswordHistoryPolicyWriteModel.AppendEvents(&e.PasswordHistoryPolicyChangedEvent)
+		}
+	}
+}
+
+func (wm *IAMPasswordHistoryPolicyWriteModel) Reduce() error {
+	return wm.PasswordHistoryPolicyWriteModel.Reduce()
+}
+
+func (wm *IAMPasswordHistoryPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(iam.AggregateType).
+		AggregateIDs(wm.PasswordHistoryPolicyWriteModel.AggregateID).
+		EventTypes(
+			iam.PasswordHistoryPolicyAddedEventType,
+			iam.PasswordHistoryPolicyChangedEventType).
+		Builder()
+}
+
+func (wm *IAMPasswordHistoryPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	historyCount uint64) (*iam.PasswordHistoryPolicyChangedEvent, bool) {
+	changes := make([]policy.PasswordHistoryPolicyChanges, 0)
+	if wm.HistoryCount != historyCount {
+		changes = append(changes, policy.ChangeHistoryCount(historyCount))
+	}
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := iam.NewPasswordHistoryPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
@@ -41,7 +41,11 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 							iam.NewLockoutPolicyAddedEvent(context.Background(),
 								&iam.NewAggregate().Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -70,7 +74,11 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 								iam.NewLockoutPolicyAddedEvent(context.Background(),
 									&iam.NewAggregate().Aggregate,
 									10,
+									0,
+									0,
 									true,
+									0,
+									0,
 								),
 							),
 						},
@@ -162,7 +170,11 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 							iam.NewLockoutPolicyAddedEvent(context.Background(),
 								&iam.NewAggregate().Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -189,7 +201,11 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 							iam.NewLockoutPolicyAddedEvent(context.Background(),
 								&iam.NewAggregate().Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -0,0 +1,467 @@
+package command
+
+import (
+	"context"
+
+	sd "github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/notification/channels/webhook"
+	"github.com/caos/zitadel/internal/notification/messages"
+	"github.com/caos/zitadel/internal/notification/senders"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func (c *Commands) AddSMSConfigTwilio(ctx context.Context, config *domain.SMSConfigTwilio) (string, *domain.ObjectDetails, error) {
+	if !config.IsValid() || config.Token == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ms92k", "Errors.SMSConfig.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	token, err := crypto.Encrypt([]byte(config.Token), c.notificationProviderCrypto)
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel := NewIAMSMSConfigWriteModel(id)
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigTwilioAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.SID,
+		config.SenderNumber,
+		token,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigTwilio(ctx context.Context, id string, config *domain.SMSConfigTwilio) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wp9dk", "Errors.IDMissing")
+	}
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Fs9wm", "Errors.SMSConfig.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Twilio == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ks9wn", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewTwilioChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.SID,
+		config.SenderNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Jf93m", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigTwilioToken(ctx context.Context, id, token string) (*domain.ObjectDetails, error) {
+	if id == "" || token == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Tk92m", "Errors.SMSConfig.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Twilio == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Lp0sk", "Errors.SMSConfig.NotFound")
+	}
+	newToken, err := crypto.Encrypt([]byte(token), c.notificationProviderCrypto)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigTwilioTokenChangedEvent(ctx, iamAgg, id, newToken))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddSMSConfigVonage(ctx context.Context, config *domain.SMSConfigVonage) (string, *domain.ObjectDetails, error) {
+	if !config.IsValid() || config.APISecret == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vn92k", "Errors.SMSConfig.Invalid")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	apiSecret, err := crypto.Encrypt([]byte(config.APISecret), c.notificationProviderCrypto)
+	if err != nil {
+		return "", nil, err
+	}
+	smsConfigWriteModel := NewIAMSMSConfigWriteModel(id)
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigVonageAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.APIKey,
+		config.SenderNumber,
+		apiSecret,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigVonage(ctx context.Context, id string, config *domain.SMSConfigVonage) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vc82m", "Errors.IDMissing")
+	}
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vi93k", "Errors.SMSConfig.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Vf02n", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewVonageChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.APIKey,
+		config.SenderNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Vn0sl", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigVonageSecret(ctx context.Context, id, apiSecret string) (*domain.ObjectDetails, error) {
+	if id == "" || apiSecret == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vs83m", "Errors.SMSConfig.Invalid")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Vw92l", "Errors.SMSConfig.NotFound")
+	}
+	newSecret, err := crypto.Encrypt([]byte(apiSecret), c.notificationProviderCrypto)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigVonageSecretChangedEvent(ctx, iamAgg, id, newSecret))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddSMSConfigHTTP(ctx context.Context, config *domain.SMSConfigHTTP) (string, *domain.ObjectDetails, error) {
+	if err := validateSMSConfigHTTP(config); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	var authorization *crypto.CryptoValue
+	if config.Authorization != "" {
+		authorization, err = crypto.Encrypt([]byte(config.Authorization), c.notificationProviderCrypto)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	smsConfigWriteModel := NewIAMSMSConfigWriteModel(id)
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigHTTPAddedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.ContentType,
+		config.BodyTemplate,
+		config.SenderNumber,
+		authorization,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, id string, config *domain.SMSConfigHTTP) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hc92m", "Errors.IDMissing")
+	}
+	if err := validateSMSConfigHTTP(config); err != nil {
+		return nil, err
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hn03k", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		iamAgg,
+		id,
+		config.Endpoint,
+		config.Method,
+		config.ContentType,
+		config.BodyTemplate,
+		config.SenderNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Hp92l", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+//ChangeSMSConfigHTTPAuthorization sets the value of the authorization header sent to the gateway,
+//an empty authorization removes the header
+func (c *Commands) ChangeSMSConfigHTTPAuthorization(ctx context.Context, id, authorization string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ha82n", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hz92m", "Errors.SMSConfig.NotFound")
+	}
+	var newAuthorization *crypto.CryptoValue
+	if authorization != "" {
+		newAuthorization, err = crypto.Encrypt([]byte(authorization), c.notificationProviderCrypto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigHTTPAuthorizationChangedEvent(ctx, iamAgg, id, newAuthorization))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func validateSMSConfigHTTP(config *domain.SMSConfigHTTP) error {
+	if !config.IsValid() {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hv92k", "Errors.SMSConfig.Invalid")
+	}
+	webhookConfig := &webhook.WebhookConfig{
+		CallURL:      config.Endpoint,
+		Method:       config.Method,
+		BodyTemplate: config.BodyTemplate,
+	}
+	if err := webhookConfig.Validate(); err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Ht83m", "Errors.SMSConfig.InvalidHTTP")
+	}
+	return nil
+}
+
+//ActivateSMSConfig activates the sms config and deactivates the currently active one
+func (c *Commands) ActivateSMSConfig(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ac92m", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hd92k", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.State == domain.SMSConfigStateActive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ls9dm", "Errors.SMSConfig.AlreadyActive")
+	}
+	activeWriteModel := NewIAMActiveSMSConfigWriteModel()
+	err = c.eventstore.FilterToQueryReducer(ctx, activeWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	events := make([]eventstore.Command, 0, 2)
+	if activeWriteModel.ActiveID != "" {
+		events = append(events, iam.NewSMSConfigDeactivatedEvent(ctx, iamAgg, activeWriteModel.ActiveID))
+	}
+	events = append(events, iam.NewSMSConfigActivatedEvent(ctx, iamAgg, id))
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateSMSConfig(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-De92m", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Mw9sk", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.State != domain.SMSConfigStateActive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ow92n", "Errors.SMSConfig.NotActive")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigDeactivatedEvent(ctx, iamAgg, id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveSMSConfig(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rm92k", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Qs9dl", "Errors.SMSConfig.NotFound")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smsConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMSConfigRemovedEvent(ctx, iamAgg, id))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smsConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+//TestSMSConfig sends a test message to the phone number using the sms config
+func (c *Commands) TestSMSConfig(ctx context.Context, id, phoneNumber string) error {
+	if id == "" || phoneNumber == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Tp93m", "Errors.SMSConfig.ReceiverMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Wn92k", "Errors.SMSConfig.NotFound")
+	}
+	provider, err := smsConfigWriteModel.provider(c.notificationProviderCrypto)
+	if err != nil {
+		return err
+	}
+	err = c.smsTestSender(provider, &messages.SMS{
+		RecipientPhoneNumber: phoneNumber,
+		Content:              "This is a test message to verify the sms configuration of ZITADEL.",
+	})
+	if err != nil {
+		return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Hs83n", "Errors.SMSConfig.TestFailed")
+	}
+	return nil
+}
+
+func (c *Commands) getSMSConfig(ctx context.Context, id string) (*IAMSMSConfigWriteModel, error) {
+	writeModel := NewIAMSMSConfigWriteModel(id)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func sendSMSTestMessage(provider sd.SMSProvider, message *messages.SMS) error {
+	channel, err := senders.SMSProviderChannel(provider)
+	if err != nil {
+		return err
+	}
+	return channel.HandleMessage(message)
+}
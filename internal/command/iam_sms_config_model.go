@@ -0,0 +1,339 @@
+package command
+
+import (
+	"context"
+
+	sd "github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/notification/channels/twilio"
+	"github.com/caos/zitadel/internal/notification/channels/vonage"
+	"github.com/caos/zitadel/internal/notification/channels/webhook"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+type IAMSMSConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ID     string
+	Twilio *TwilioConfig
+	Vonage *VonageConfig
+	HTTP   *HTTPConfig
+	State  domain.SMSConfigState
+}
+
+type TwilioConfig struct {
+	SID          string
+	Token        *crypto.CryptoValue
+	SenderNumber string
+}
+
+type VonageConfig struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type HTTPConfig struct {
+	Endpoint      string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	SenderNumber  string
+	Authorization *crypto.CryptoValue
+}
+
+func NewIAMSMSConfigWriteModel(id string) *IAMSMSConfigWriteModel {
+	return &IAMSMSConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   domain.IAMID,
+			ResourceOwner: domain.IAMID,
+		},
+		ID: id,
+	}
+}
+
+func (wm *IAMSMSConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *iam.SMSConfigTwilioAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Twilio = &TwilioConfig{
+				SID:          e.SID,
+				Token:        e.Token,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *iam.SMSConfigTwilioChangedEvent:
+			if wm.ID != e.ID || wm.Twilio == nil {
+				continue
+			}
+			if e.SID != nil {
+				wm.Twilio.SID = *e.SID
+			}
+			if e.SenderNumber != nil {
+				wm.Twilio.SenderNumber = *e.SenderNumber
+			}
+		case *iam.SMSConfigTwilioTokenChangedEvent:
+			if wm.ID != e.ID || wm.Twilio == nil {
+				continue
+			}
+			wm.Twilio.Token = e.Token
+		case *iam.SMSConfigVonageAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage = &VonageConfig{
+				APIKey:       e.APIKey,
+				APISecret:    e.APISecret,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *iam.SMSConfigVonageChangedEvent:
+			if wm.ID != e.ID || wm.Vonage == nil {
+				continue
+			}
+			if e.APIKey != nil {
+				wm.Vonage.APIKey = *e.APIKey
+			}
+			if e.SenderNumber != nil {
+				wm.Vonage.SenderNumber = *e.SenderNumber
+			}
+		case *iam.SMSConfigVonageSecretChangedEvent:
+			if wm.ID != e.ID || wm.Vonage == nil {
+				continue
+			}
+			wm.Vonage.APISecret = e.APISecret
+		case *iam.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &HTTPConfig{
+				Endpoint:      e.Endpoint,
+				Method:        e.Method,
+				ContentType:   e.ContentType,
+				BodyTemplate:  e.BodyTemplate,
+				SenderNumber:  e.SenderNumber,
+				Authorization: e.Authorization,
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *iam.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID || wm.HTTP == nil {
+				continue
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+			if e.Method != nil {
+				wm.HTTP.Method = *e.Method
+			}
+			if e.ContentType != nil {
+				wm.HTTP.ContentType = *e.ContentType
+			}
+			if e.BodyTemplate != nil {
+				wm.HTTP.BodyTemplate = *e.BodyTemplate
+			}
+			if e.SenderNumber != nil {
+				wm.HTTP.SenderNumber = *e.SenderNumber
+			}
+		case *iam.SMSConfigHTTPAuthorizationChangedEvent:
+			if wm.ID != e.ID || wm.HTTP == nil {
+				continue
+			}
+			wm.HTTP.Authorization = e.Authorization
+		case *iam.SMSConfigActivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMSConfigStateActive
+		case *iam.SMSConfigDeactivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *iam.SMSConfigRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Twilio = nil
+			wm.Vonage = nil
+			wm.HTTP = nil
+			wm.State = domain.SMSConfigStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(iam.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			iam.SMSConfigTwilioAddedEventType,
+			iam.SMSConfigTwilioChangedEventType,
+			iam.SMSConfigTwilioTokenChangedEventType,
+			iam.SMSConfigActivatedEventType,
+			iam.SMSConfigDeactivatedEventType,
+			iam.SMSConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *IAMSMSConfigWriteModel) NewTwilioChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, sid, senderNumber string) (*iam.SMSConfigTwilioChangedEvent, bool, error) {
+	changes := make([]iam.SMSConfigTwilioChanges, 0)
+	if wm.Twilio.SID != sid {
+		changes = append(changes, iam.ChangeSMSConfigTwilioSID(sid))
+	}
+	if wm.Twilio.SenderNumber != senderNumber {
+		changes = append(changes, iam.ChangeSMSConfigTwilioSenderNumber(senderNumber))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := iam.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewVonageChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, apiKey, senderNumber string) (*iam.SMSConfigVonageChangedEvent, bool, error) {
+	changes := make([]iam.SMSConfigVonageChanges, 0)
+	if wm.Vonage.APIKey != apiKey {
+		changes = append(changes, iam.ChangeSMSConfigVonageAPIKey(apiKey))
+	}
+	if wm.Vonage.SenderNumber != senderNumber {
+		changes = append(changes, iam.ChangeSMSConfigVonageSenderNumber(senderNumber))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := iam.NewSMSConfigVonageChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, endpoint, method, contentType, bodyTemplate, senderNumber string) (*iam.SMSConfigHTTPChangedEvent, bool, error) {
+	changes := make([]iam.SMSConfigHTTPChanges, 0)
+	if wm.HTTP.Endpoint != endpoint {
+		changes = append(changes, iam.ChangeSMSConfigHTTPEndpoint(endpoint))
+	}
+	if wm.HTTP.Method != method {
+		changes = append(changes, iam.ChangeSMSConfigHTTPMethod(method))
+	}
+	if wm.HTTP.ContentType != contentType {
+		changes = append(changes, iam.ChangeSMSConfigHTTPContentType(contentType))
+	}
+	if wm.HTTP.BodyTemplate != bodyTemplate {
+		changes = append(changes, iam.ChangeSMSConfigHTTPBodyTemplate(bodyTemplate))
+	}
+	if wm.HTTP.SenderNumber != senderNumber {
+		changes = append(changes, iam.ChangeSMSConfigHTTPSenderNumber(senderNumber))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := iam.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+//provider decrypts the secrets of the config and returns it as sms provider
+func (wm *IAMSMSConfigWriteModel) provider(alg crypto.EncryptionAlgorithm) (sd.SMSProvider, error) {
+	switch {
+	case wm.Twilio != nil:
+		token, err := crypto.DecryptString(wm.Twilio.Token, alg)
+		if err != nil {
+			return sd.SMSProvider{}, err
+		}
+		return sd.SMSProvider{Twilio: &twilio.TwilioConfig{
+			SID:   wm.Twilio.SID,
+			Token: token,
+			From:  wm.Twilio.SenderNumber,
+		}}, nil
+	case wm.Vonage != nil:
+		apiSecret, err := crypto.DecryptString(wm.Vonage.APISecret, alg)
+		if err != nil {
+			return sd.SMSProvider{}, err
+		}
+		return sd.SMSProvider{Vonage: &vonage.VonageConfig{
+			APIKey:    wm.Vonage.APIKey,
+			APISecret: apiSecret,
+			From:      wm.Vonage.SenderNumber,
+		}}, nil
+	case wm.HTTP != nil:
+		var authorization string
+		if wm.HTTP.Authorization != nil {
+			var err error
+			authorization, err = crypto.DecryptString(wm.HTTP.Authorization, alg)
+			if err != nil {
+				return sd.SMSProvider{}, err
+			}
+		}
+		return sd.SMSProvider{HTTP: &webhook.WebhookConfig{
+			CallURL:       wm.HTTP.Endpoint,
+			Method:        wm.HTTP.Method,
+			ContentType:   wm.HTTP.ContentType,
+			BodyTemplate:  wm.HTTP.BodyTemplate,
+			Authorization: authorization,
+			From:          wm.HTTP.SenderNumber,
+		}}, nil
+	}
+	return sd.SMSProvider{}, caos_errs.ThrowNotFound(nil, "COMMAND-Pv92m", "Errors.SMSConfig.NotFound")
+}
+
+//IAMActiveSMSConfigWriteModel keeps track of the currently active sms config of the iam
+type IAMActiveSMSConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ActiveID string
+}
+
+func NewIAMActiveSMSConfigWriteModel() *IAMActiveSMSConfigWriteModel {
+	return &IAMActiveSMSConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   domain.IAMID,
+			ResourceOwner: domain.IAMID,
+		},
+	}
+}
+
+func (wm *IAMActiveSMSConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *iam.SMSConfigActivatedEvent:
+			wm.ActiveID = e.ID
+		case *iam.SMSConfigDeactivatedEvent:
+			if wm.ActiveID == e.ID {
+				wm.ActiveID = ""
+			}
+		case *iam.SMSConfigRemovedEvent:
+			if wm.ActiveID == e.ID {
+				wm.ActiveID = ""
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IAMActiveSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(iam.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			iam.SMSConfigActivatedEventType,
+			iam.SMSConfigDeactivatedEventType,
+			iam.SMSConfigRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,890 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/id/mock"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func TestCommandSide_AddSMSConfigTwilio(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *domain.SMSConfigTwilio
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "token missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigTwilio{
+					SID:          "sid",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add sms config twilio, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigTwilioAddedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+									"sid",
+									"+41791234567",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("token"),
+									},
+								),
+							),
+						},
+					),
+				),
+				idGenerator: mock.ExpectID(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigTwilio{
+					SID:          "sid",
+					Token:        "token",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				id: "providerid",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				idGenerator:                tt.fields.idGenerator,
+				notificationProviderCrypto: tt.fields.alg,
+			}
+			id, got, err := r.AddSMSConfigTwilio(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddSMSConfigVonage(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *domain.SMSConfigVonage
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "secret missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigVonage{
+					APIKey:       "key",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add sms config vonage, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigVonageAddedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+									"key",
+									"+41791234567",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("secret"),
+									},
+								),
+							),
+						},
+					),
+				),
+				idGenerator: mock.ExpectID(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigVonage{
+					APIKey:       "key",
+					APISecret:    "secret",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				id: "providerid",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				idGenerator:                tt.fields.idGenerator,
+				notificationProviderCrypto: tt.fields.alg,
+			}
+			id, got, err := r.AddSMSConfigVonage(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddSMSConfigHTTP(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *domain.SMSConfigHTTP
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid scheme, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigHTTP{
+					Endpoint:     "ftp://sms.example.com",
+					BodyTemplate: `{"to": {{json .To}}}`,
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid template, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigHTTP{
+					Endpoint:     "https://sms.example.com",
+					BodyTemplate: `{"to": {{json .To}`,
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add sms config http, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigHTTPAddedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+									"https://sms.example.com",
+									"POST",
+									"application/json",
+									`{"to": {{json .To}}}`,
+									"+41791234567",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("Bearer token"),
+									},
+								),
+							),
+						},
+					),
+				),
+				idGenerator: mock.ExpectID(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigHTTP{
+					Endpoint:      "https://sms.example.com",
+					Method:        "POST",
+					ContentType:   "application/json",
+					BodyTemplate:  `{"to": {{json .To}}}`,
+					SenderNumber:  "+41791234567",
+					Authorization: "Bearer token",
+				},
+			},
+			res: res{
+				id: "providerid",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				idGenerator:                tt.fields.idGenerator,
+				notificationProviderCrypto: tt.fields.alg,
+			}
+			id, got, err := r.AddSMSConfigHTTP(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigTwilio(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+		sms *domain.SMSConfigTwilio
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &domain.SMSConfigTwilio{
+					SID:          "sid",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "sms config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+				sms: &domain.SMSConfigTwilio{
+					SID:          "sid",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+				sms: &domain.SMSConfigTwilio{
+					SID:          "sid",
+					SenderNumber: "+41791234567",
+				},
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change sms config twilio, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newSMSConfigTwilioChangedEvent(
+									context.Background(),
+									"providerid",
+									"sid2",
+									"+41797654321",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+				sms: &domain.SMSConfigTwilio{
+					SID:          "sid2",
+					SenderNumber: "+41797654321",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeSMSConfigTwilio(tt.args.ctx, tt.args.id, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ActivateSMSConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "sms config already active, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							iam.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "activate sms config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigActivatedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+		{
+			name: "activate sms config, other config deactivated, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid2",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigDeactivatedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid2",
+								),
+							),
+							eventFromEventPusher(
+								iam.NewSMSConfigActivatedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ActivateSMSConfig(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_DeactivateSMSConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms config not active, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "deactivate sms config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							iam.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigDeactivatedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.DeactivateSMSConfig(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveSMSConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "remove sms config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"providerid",
+								"sid",
+								"+41791234567",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMSConfigRemovedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									"providerid",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveSMSConfig(tt.args.ctx, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newSMSConfigTwilioChangedEvent(ctx context.Context, id, sid, senderNumber string) *iam.SMSConfigTwilioChangedEvent {
+	changes := []iam.SMSConfigTwilioChanges{
+		iam.ChangeSMSConfigTwilioSID(sid),
+		iam.ChangeSMSConfigTwilioSenderNumber(senderNumber),
+	}
+	event, _ := iam.NewSMSConfigTwilioChangedEvent(ctx,
+		&iam.NewAggregate().Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
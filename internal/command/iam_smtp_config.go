@@ -0,0 +1,174 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/notification/channels/smtp"
+	"github.com/caos/zitadel/internal/notification/messages"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func (c *Commands) AddSMTPConfig(ctx context.Context, config *domain.SMTPConfig) (*domain.ObjectDetails, error) {
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Sm92k", "Errors.SMTPConfig.Invalid")
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if smtpConfigWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Nd92k", "Errors.SMTPConfig.AlreadyExists")
+	}
+	var smtpPassword *crypto.CryptoValue
+	if config.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.Password), c.notificationProviderCrypto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMTPConfigAddedEvent(
+		ctx,
+		iamAgg,
+		config.TLS,
+		config.SenderAddress,
+		config.SenderName,
+		config.Host,
+		config.User,
+		smtpPassword,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMTPConfig(ctx context.Context, config *domain.SMTPConfig) (*domain.ObjectDetails, error) {
+	if !config.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lw02m", "Errors.SMTPConfig.Invalid")
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Hs8ek", "Errors.SMTPConfig.NotFound")
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewChangedEvent(
+		ctx,
+		iamAgg,
+		config.TLS,
+		config.SenderAddress,
+		config.SenderName,
+		config.Host,
+		config.User,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Kd9sl", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeSMTPConfigPassword(ctx context.Context, password string) (*domain.ObjectDetails, error) {
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Pw92m", "Errors.SMTPConfig.NotFound")
+	}
+	var smtpPassword *crypto.CryptoValue
+	if password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(password), c.notificationProviderCrypto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	iamAgg := IAMAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, iam.NewSMTPConfigPasswordChangedEvent(ctx, iamAgg, smtpPassword))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(smtpConfigWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+//TestSMTPConfig sends a test message to the receiver using the stored smtp config
+func (c *Commands) TestSMTPConfig(ctx context.Context, receiverAddress string) error {
+	if receiverAddress == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Re92n", "Errors.SMTPConfig.ReceiverMissing")
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return caos_errs.ThrowNotFound(nil, "COMMAND-Ws0dk", "Errors.SMTPConfig.NotFound")
+	}
+	var password string
+	if smtpConfigWriteModel.Password != nil {
+		password, err = crypto.DecryptString(smtpConfigWriteModel.Password, c.notificationProviderCrypto)
+		if err != nil {
+			return err
+		}
+	}
+	config := smtp.EmailConfig{
+		SMTP: smtp.SMTP{
+			Host:     smtpConfigWriteModel.Host,
+			User:     smtpConfigWriteModel.User,
+			Password: password,
+		},
+		Tls:      smtpConfigWriteModel.TLS,
+		From:     smtpConfigWriteModel.SenderAddress,
+		FromName: smtpConfigWriteModel.SenderName,
+	}
+	err = c.smtpTestSender(config, &messages.Email{
+		Recipients:  []string{receiverAddress},
+		SenderEmail: smtpConfigWriteModel.SenderAddress,
+		Subject:     "ZITADEL SMTP test",
+		Content:     "This is a test message to verify the smtp configuration of ZITADEL.",
+	})
+	if err != nil {
+		return caos_errs.ThrowPreconditionFailed(err, "COMMAND-Ks03n", "Errors.SMTPConfig.TestFailed")
+	}
+	return nil
+}
+
+func (c *Commands) getSMTPConfig(ctx context.Context) (*IAMSMTPConfigWriteModel, error) {
+	writeModel := NewIAMSMTPConfigWriteModel()
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func sendSMTPTestMessage(config smtp.EmailConfig, message *messages.Email) error {
+	channel, err := smtp.InitSMTPChannel(config)
+	if err != nil {
+		return err
+	}
+	return channel.HandleMessage(message)
+}
@@ -0,0 +1,548 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/notification/channels/smtp"
+	"github.com/caos/zitadel/internal/notification/messages"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func TestCommandSide_AddSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx  context.Context
+		smtp *domain.SMTPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid host, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				smtp: &domain.SMTPConfig{
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+					Host:          "host",
+				},
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "smtp config already existing, error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				smtp: &domain.SMTPConfig{
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+					Host:          "host:587",
+				},
+			},
+			res: res{
+				err: errors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "add smtp config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMTPConfigAddedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									true,
+									"from@domain.ch",
+									"name",
+									"host:587",
+									"user",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("password"),
+									},
+								),
+							),
+						},
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				smtp: &domain.SMTPConfig{
+					TLS:           true,
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+					Host:          "host:587",
+					User:          "user",
+					Password:      "password",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				notificationProviderCrypto: tt.fields.alg,
+			}
+			got, err := r.AddSMTPConfig(tt.args.ctx, tt.args.smtp)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		smtp *domain.SMTPConfig
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				smtp: &domain.SMTPConfig{
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+					Host:          "host:587",
+				},
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				smtp: &domain.SMTPConfig{
+					TLS:           true,
+					SenderAddress: "from@domain.ch",
+					SenderName:    "name",
+					Host:          "host:587",
+					User:          "user",
+				},
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change smtp config, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newSMTPConfigChangedEvent(
+									context.Background(),
+									false,
+									"from2@domain.ch",
+									"name2",
+									"host2:587",
+									"user2",
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				smtp: &domain.SMTPConfig{
+					TLS:           false,
+					SenderAddress: "from2@domain.ch",
+					SenderName:    "name2",
+					Host:          "host2:587",
+					User:          "user2",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeSMTPConfig(tt.args.ctx, tt.args.smtp)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMTPConfigPassword(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx      context.Context
+		password string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				password: "password",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "change smtp config password, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								iam.NewSMTPConfigPasswordChangedEvent(
+									context.Background(),
+									&iam.NewAggregate().Aggregate,
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("password"),
+									},
+								),
+							),
+						},
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:      context.Background(),
+				password: "password",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "IAM",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				notificationProviderCrypto: tt.fields.alg,
+			}
+			got, err := r.ChangeSMTPConfigPassword(tt.args.ctx, tt.args.password)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_TestSMTPConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+		sender     func(smtp.EmailConfig, *messages.Email) error
+	}
+	type args struct {
+		ctx      context.Context
+		receiver string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no receiver, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			res: res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "smtp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				receiver: "to@domain.ch",
+			},
+			res: res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			name: "sending failed, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								nil,
+							),
+						),
+					),
+				),
+				sender: func(smtp.EmailConfig, *messages.Email) error {
+					return errors.ThrowInternal(nil, "id", "connection refused")
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				receiver: "to@domain.ch",
+			},
+			res: res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "test message sent, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewSMTPConfigAddedEvent(
+								context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+								"from@domain.ch",
+								"name",
+								"host:587",
+								"user",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+							),
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				sender: func(config smtp.EmailConfig, message *messages.Email) error {
+					if config.SMTP.Password != "password" || config.SMTP.Host != "host:587" || !config.Tls {
+						return errors.ThrowInternal(nil, "id", "wrong config")
+					}
+					if message.SenderEmail != "from@domain.ch" || len(message.Recipients) != 1 || message.Recipients[0] != "to@domain.ch" {
+						return errors.ThrowInternal(nil, "id", "wrong message")
+					}
+					return nil
+				},
+			},
+			args: args{
+				ctx:      context.Background(),
+				receiver: "to@domain.ch",
+			},
+			res: res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				notificationProviderCrypto: tt.fields.alg,
+				smtpTestSender:             tt.fields.sender,
+			}
+			err := r.TestSMTPConfig(tt.args.ctx, tt.args.receiver)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func newSMTPConfigChangedEvent(ctx context.Context, tls bool, senderAddress, senderName, host, user string) *iam.SMTPConfigChangedEvent {
+	changes := []iam.SMTPConfigChanges{
+		iam.ChangeSMTPConfigTLS(tls),
+		iam.ChangeSMTPConfigSenderAddress(senderAddress),
+		iam.ChangeSMTPConfigSenderName(senderName),
+		iam.ChangeSMTPConfigSMTPHost(host),
+		iam.ChangeSMTPConfigSMTPUser(user),
+	}
+	event, _ := iam.NewSMTPConfigChangedEvent(ctx,
+		&iam.NewAggregate().Aggregate,
+		changes,
+	)
+	return event
+}
@@ -0,0 +1,13 @@
+package command
+
+import (
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func validateLDAPConfig(config *domain.LDAPIDPConfig) error {
+	if !config.IsValid() {
+		return caos_errs.ThrowInvalidArgument(nil, "LDAP-Kw3nd", "Errors.IDPConfig.LDAPConfigInvalid")
+	}
+	return nil
+}
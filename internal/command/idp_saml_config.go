@@ -0,0 +1,34 @@
+package command
+
+import (
+	"github.com/crewjam/saml/samlsp"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func validateSAMLConfig(config *domain.SAMLIDPConfig) error {
+	if !config.IsValid() {
+		return caos_errs.ThrowInvalidArgument(nil, "SAML-9Dk2s", "Errors.IDPConfig.SAMLConfigInvalid")
+	}
+	if len(config.Metadata) == 0 {
+		return nil
+	}
+	if _, err := samlsp.ParseMetadata(config.Metadata); err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "SAML-Jv3Ls", "Errors.IDPConfig.SAMLMetadataInvalid")
+	}
+	return nil
+}
+
+func (c *Commands) generateSAMLCertificateAndKey(idpConfigID string) (*crypto.CryptoValue, []byte, error) {
+	key, certificate, err := c.samlCertificateAndKeyGenerator(idpConfigID)
+	if err != nil {
+		return nil, nil, caos_errs.ThrowInternal(err, "SAML-4Gk2d", "Errors.Internal")
+	}
+	encryptedKey, err := crypto.Encrypt(key, c.idpConfigSecretCrypto)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryptedKey, certificate, nil
+}
@@ -0,0 +1,157 @@
+package command
+
+import (
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/idpconfig"
+)
+
+type LDAPConfigWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID          string
+	URL                  string
+	StartTLS             bool
+	BindDN               string
+	BindPassword         *crypto.CryptoValue
+	BaseDN               string
+	UserObjectClass      string
+	IDAttribute          string
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+	State                domain.IDPConfigState
+}
+
+func (wm *LDAPConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idpconfig.LDAPConfigAddedEvent:
+			wm.reduceConfigAddedEvent(e)
+		case *idpconfig.LDAPConfigChangedEvent:
+			wm.reduceConfigChangedEvent(e)
+		case *idpconfig.IDPConfigDeactivatedEvent:
+			wm.State = domain.IDPConfigStateInactive
+		case *idpconfig.IDPConfigReactivatedEvent:
+			wm.State = domain.IDPConfigStateActive
+		case *idpconfig.IDPConfigRemovedEvent:
+			wm.State = domain.IDPConfigStateRemoved
+		}
+	}
+
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LDAPConfigWriteModel) reduceConfigAddedEvent(e *idpconfig.LDAPConfigAddedEvent) {
+	wm.IDPConfigID = e.IDPConfigID
+	wm.URL = e.URL
+	wm.StartTLS = e.StartTLS
+	wm.BindDN = e.BindDN
+	wm.BindPassword = e.BindPassword
+	wm.BaseDN = e.BaseDN
+	wm.UserObjectClass = e.UserObjectClass
+	wm.IDAttribute = e.IDAttribute
+	wm.UsernameAttribute = e.UsernameAttribute
+	wm.DisplayNameAttribute = e.DisplayNameAttribute
+	wm.FirstNameAttribute = e.FirstNameAttribute
+	wm.LastNameAttribute = e.LastNameAttribute
+	wm.EmailAttribute = e.EmailAttribute
+	wm.PhoneAttribute = e.PhoneAttribute
+	wm.State = domain.IDPConfigStateActive
+}
+
+func (wm *LDAPConfigWriteModel) reduceConfigChangedEvent(e *idpconfig.LDAPConfigChangedEvent) {
+	if e.URL != nil {
+		wm.URL = *e.URL
+	}
+	if e.StartTLS != nil {
+		wm.StartTLS = *e.StartTLS
+	}
+	if e.BindDN != nil {
+		wm.BindDN = *e.BindDN
+	}
+	if e.BindPassword != nil {
+		wm.BindPassword = e.BindPassword
+	}
+	if e.BaseDN != nil {
+		wm.BaseDN = *e.BaseDN
+	}
+	if e.UserObjectClass != nil {
+		wm.UserObjectClass = *e.UserObjectClass
+	}
+	if e.IDAttribute != nil {
+		wm.IDAttribute = *e.IDAttribute
+	}
+	if e.UsernameAttribute != nil {
+		wm.UsernameAttribute = *e.UsernameAttribute
+	}
+	if e.DisplayNameAttribute != nil {
+		wm.DisplayNameAttribute = *e.DisplayNameAttribute
+	}
+	if e.FirstNameAttribute != nil {
+		wm.FirstNameAttribute = *e.FirstNameAttribute
+	}
+	if e.LastNameAttribute != nil {
+		wm.LastNameAttribute = *e.LastNameAttribute
+	}
+	if e.EmailAttribute != nil {
+		wm.EmailAttribute = *e.EmailAttribute
+	}
+	if e.PhoneAttribute != nil {
+		wm.PhoneAttribute = *e.PhoneAttribute
+	}
+}
+
+// changes returns the changes of the config,
+// the bind password is only changed (and encrypted) if a new one is provided
+func (wm *LDAPConfigWriteModel) changes(config *domain.LDAPIDPConfig, passwordAlg crypto.EncryptionAlgorithm) ([]idpconfig.LDAPConfigChanges, error) {
+	changes := make([]idpconfig.LDAPConfigChanges, 0)
+	if config.BindPasswordString != "" {
+		bindPassword, err := crypto.Encrypt([]byte(config.BindPasswordString), passwordAlg)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idpconfig.ChangeLDAPBindPassword(bindPassword))
+	}
+	if wm.URL != config.URL {
+		changes = append(changes, idpconfig.ChangeLDAPURL(config.URL))
+	}
+	if wm.StartTLS != config.StartTLS {
+		changes = append(changes, idpconfig.ChangeLDAPStartTLS(config.StartTLS))
+	}
+	if wm.BindDN != config.BindDN {
+		changes = append(changes, idpconfig.ChangeLDAPBindDN(config.BindDN))
+	}
+	if wm.BaseDN != config.BaseDN {
+		changes = append(changes, idpconfig.ChangeLDAPBaseDN(config.BaseDN))
+	}
+	if wm.UserObjectClass != config.UserObjectClass {
+		changes = append(changes, idpconfig.ChangeLDAPUserObjectClass(config.UserObjectClass))
+	}
+	if wm.IDAttribute != config.IDAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPIDAttribute(config.IDAttribute))
+	}
+	if wm.UsernameAttribute != config.UsernameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPUsernameAttribute(config.UsernameAttribute))
+	}
+	if wm.DisplayNameAttribute != config.DisplayNameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPDisplayNameAttribute(config.DisplayNameAttribute))
+	}
+	if wm.FirstNameAttribute != config.FirstNameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPFirstNameAttribute(config.FirstNameAttribute))
+	}
+	if wm.LastNameAttribute != config.LastNameAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPLastNameAttribute(config.LastNameAttribute))
+	}
+	if wm.EmailAttribute != config.EmailAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPEmailAttribute(config.EmailAttribute))
+	}
+	if wm.PhoneAttribute != config.PhoneAttribute {
+		changes = append(changes, idpconfig.ChangeLDAPPhoneAttribute(config.PhoneAttribute))
+	}
+	return changes, nil
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/caos/zitadel/internal/eventstore/repository"
 	"github.com/caos/zitadel/internal/eventstore/repository/mock"
 	action_repo "github.com/caos/zitadel/internal/repository/action"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
 	iam_repo "github.com/caos/zitadel/internal/repository/iam"
 	key_repo "github.com/caos/zitadel/internal/repository/keypair"
 	"github.com/caos/zitadel/internal/repository/org"
 	proj_repo "github.com/caos/zitadel/internal/repository/project"
 	usr_repo "github.com/caos/zitadel/internal/repository/user"
 	"github.com/caos/zitadel/internal/repository/usergrant"
+	webhook_repo "github.com/caos/zitadel/internal/repository/webhook"
 )
 
 type expect func(mockRepository *mock.MockRepository)
@@ -36,8 +38,10 @@ func eventstoreExpect(t *testing.T, expects ...expect) *eventstore.Eventstore {
 	usr_repo.RegisterEventMappers(es)
 	proj_repo.RegisterEventMappers(es)
 	usergrant.RegisterEventMappers(es)
+	webhook_repo.RegisterEventMappers(es)
 	key_repo.RegisterEventMappers(es)
 	action_repo.RegisterEventMappers(es)
+	deviceauth.RegisterEventMappers(es)
 	return es
 }
 
@@ -163,6 +167,24 @@ func eventFromEventPusherWithCreationDateNow(event eventstore.Command) *reposito
 	return e
 }
 
+func eventFromEventPusherWithCreationDate(event eventstore.Command, creationDate time.Time) *repository.Event {
+	e := eventFromEventPusher(event)
+	e.CreationDate = creationDate
+	return e
+}
+
+func eventFromEventPusherWithSequence(event eventstore.Command, creationDate time.Time, sequence uint64) *repository.Event {
+	e := eventFromEventPusherWithCreationDate(event, creationDate)
+	e.Sequence = sequence
+	return e
+}
+
+func eventFromEventPusherWithExpectedSequence(event eventstore.Command, previousSequence uint64) *repository.Event {
+	e := eventFromEventPusher(event)
+	e.ExpectedPreviousAggregateSequence = previousSequence
+	return e
+}
+
 func uniqueConstraintsFromEventConstraint(constraint *eventstore.EventUniqueConstraint) *repository.UniqueConstraint {
 	return &repository.UniqueConstraint{
 		UniqueType:   constraint.UniqueType,
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/repository/member"
@@ -9,8 +11,9 @@ import (
 type MemberWriteModel struct {
 	eventstore.WriteModel
 
-	UserID string
-	Roles  []string
+	UserID         string
+	Roles          []string
+	ExpirationDate time.Time
 
 	State domain.MemberState
 }
@@ -27,6 +30,7 @@ func (wm *MemberWriteModel) Reduce() error {
 		case *member.MemberAddedEvent:
 			wm.UserID = e.UserID
 			wm.Roles = e.Roles
+			wm.ExpirationDate = e.ExpirationDate
 			wm.State = domain.MemberStateActive
 		case *member.MemberChangedEvent:
 			wm.Roles = e.Roles
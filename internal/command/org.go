@@ -3,10 +3,15 @@ package command
 import (
 	"context"
 
+	"github.com/caos/logging"
+
 	"github.com/caos/zitadel/internal/domain"
 	caos_errs "github.com/caos/zitadel/internal/errors"
 	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/query"
 	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/project"
+	"github.com/caos/zitadel/internal/repository/user"
 )
 
 func (c *Commands) getOrg(ctx context.Context, orgID string) (*domain.Org, error) {
@@ -162,6 +167,155 @@ func (c *Commands) ReactivateOrg(ctx context.Context, orgID string) (*domain.Obj
 	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
 }
 
+//RemoveOrg removes the org with its users, projects and domains
+//memberships, project grants, idp links and user grants of other orgs which reference the org are removed as well
+func (c *Commands) RemoveOrg(ctx context.Context, orgID string, cascadingUserMemberships []*query.Membership, cascadingProjectGrants []*query.ProjectGrant, cascadingIDPLinks []*domain.UserIDPLink, cascadingUserGrantIDs ...string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Kd92m", "Errors.Org.Invalid")
+	}
+	iamWriteModel, err := c.getIAMWriteModel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if iamWriteModel.GlobalOrgID == orgID {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Hs93k", "Errors.Org.DefaultOrgNotDeletable")
+	}
+	orgWriteModel, err := c.getOrgWriteModelByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State == domain.OrgStateUnspecified || orgWriteModel.State == domain.OrgStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Lm29s", "Errors.Org.NotFound")
+	}
+	orgIAMPolicy, err := c.getOrgIAMPolicy(ctx, orgID)
+	if err != nil {
+		return nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-Pw0sk", "Errors.Org.OrgIAM.NotExisting")
+	}
+	ownedObjects := NewOrgOwnedObjectsWriteModel(orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, ownedObjects)
+	if err != nil {
+		return nil, err
+	}
+	domainsWriteModel := NewOrgDomainsWriteModel(orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, domainsWriteModel)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]eventstore.Command, 0)
+	for _, grantID := range cascadingUserGrantIDs {
+		event, _, err := c.removeUserGrant(ctx, grantID, "", true)
+		if err != nil {
+			logging.LogWithFields("COMMAND-Mf82s", "usergrantid", grantID).WithError(err).Warn("could not cascade remove user grant")
+			continue
+		}
+		events = append(events, event)
+	}
+	membershipEvents, err := c.removeUserMemberships(ctx, foreignMemberships(orgID, cascadingUserMemberships))
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, membershipEvents...)
+	for _, grant := range cascadingProjectGrants {
+		if grant.ResourceOwner == orgID {
+			continue
+		}
+		projectAgg := project.NewAggregate(grant.ProjectID, grant.ResourceOwner)
+		events = append(events, project.NewGrantRemovedEvent(ctx, &projectAgg.Aggregate, grant.GrantID, grant.GrantedOrgID))
+	}
+	for _, link := range cascadingIDPLinks {
+		if link.ResourceOwner == orgID {
+			continue
+		}
+		event, _, err := c.removeUserIDPLink(ctx, link, true)
+		if err != nil {
+			logging.LogWithFields("COMMAND-Ps92n", "userid", link.AggregateID, "idpconfigid", link.IDPConfigID).WithError(err).Warn("could not cascade remove idp link")
+			continue
+		}
+		events = append(events, event)
+	}
+
+	userEvents, err := c.removeOrgUsers(ctx, orgID, ownedObjects.UserIDs, orgIAMPolicy.UserLoginMustBeDomain)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, userEvents...)
+	projectEvents, err := c.removeOrgProjects(ctx, orgID, ownedObjects.ProjectIDs)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, projectEvents...)
+
+	verifiedDomains := make([]string, 0, len(domainsWriteModel.Domains))
+	for _, orgDomain := range domainsWriteModel.Domains {
+		if orgDomain.Verified && orgDomain.State == domain.OrgDomainStateActive {
+			verifiedDomains = append(verifiedDomains, orgDomain.Domain)
+		}
+	}
+	orgAgg := OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)
+	events = append(events, org.NewOrgRemovedEvent(ctx, orgAgg, orgWriteModel.Name, verifiedDomains))
+
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(orgWriteModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+func (c *Commands) removeOrgUsers(ctx context.Context, orgID string, userIDs []string, userLoginMustBeDomain bool) ([]eventstore.Command, error) {
+	events := make([]eventstore.Command, 0, len(userIDs))
+	for _, userID := range userIDs {
+		existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if !isUserStateExists(existingUser.UserState) {
+			continue
+		}
+		userAgg := UserAggregateFromWriteModel(&existingUser.WriteModel)
+		events = append(events, user.NewUserRemovedEvent(ctx, userAgg, existingUser.UserName, existingUser.IDPLinks, userLoginMustBeDomain))
+	}
+	return events, nil
+}
+
+func (c *Commands) removeOrgProjects(ctx context.Context, orgID string, projectIDs []string) ([]eventstore.Command, error) {
+	events := make([]eventstore.Command, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		existingProject, err := c.getProjectWriteModelByID(ctx, projectID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
+			continue
+		}
+		samlEntityIDs := NewProjectSAMLEntityIDsWriteModel(projectID, orgID)
+		err = c.eventstore.FilterToQueryReducer(ctx, samlEntityIDs)
+		if err != nil {
+			return nil, err
+		}
+		projectAgg := ProjectAggregateFromWriteModel(&existingProject.WriteModel)
+		events = append(events, project.NewProjectRemovedEvent(ctx, projectAgg, existingProject.Name, samlEntityIDs.RemoveUniqueConstraints()))
+	}
+	return events, nil
+}
+
+//foreignMemberships returns the memberships on objects of other orgs,
+//memberships on the org and its projects are removed with them
+func foreignMemberships(orgID string, memberships []*query.Membership) []*query.Membership {
+	foreign := make([]*query.Membership, 0, len(memberships))
+	for _, membership := range memberships {
+		if membership.ResourceOwner == orgID {
+			continue
+		}
+		foreign = append(foreign, membership)
+	}
+	return foreign
+}
+
 func (c *Commands) setUpOrg(
 	ctx context.Context,
 	organisation *domain.Org,
@@ -202,9 +356,11 @@ func (c *Commands) addOrg(ctx context.Context, organisation *domain.Org, claimed
 		return nil, nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMM-deLSk", "Errors.Org.Invalid")
 	}
 
-	organisation.AggregateID, err = c.idGenerator.Next()
-	if err != nil {
-		return nil, nil, nil, caos_errs.ThrowInternal(err, "COMMA-OwciI", "Errors.Internal")
+	if organisation.AggregateID == "" {
+		organisation.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, nil, nil, caos_errs.ThrowInternal(err, "COMMA-OwciI", "Errors.Internal")
+		}
 	}
 	organisation.AddIAMDomain(c.iamDomain)
 	addedOrg := NewOrgWriteModel(organisation.AggregateID)
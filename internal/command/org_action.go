@@ -19,9 +19,11 @@ func (c *Commands) AddAction(ctx context.Context, addAction *domain.Action, reso
 	if err != nil {
 		return "", nil, err
 	}
-	addAction.AggregateID, err = c.idGenerator.Next()
-	if err != nil {
-		return "", nil, err
+	if addAction.AggregateID == "" {
+		addAction.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return "", nil, err
+		}
 	}
 	actionModel := NewActionWriteModel(addAction.AggregateID, resourceOwner)
 	actionAgg := ActionAggregateFromWriteModel(&actionModel.WriteModel)
@@ -201,14 +203,18 @@ func (c *Commands) removeActionsFromOrg(ctx context.Context, resourceOwner strin
 	if err != nil {
 		return nil, err
 	}
-	if len(existingActions.Actions) == 0 {
+	if len(existingActions.Actions) == 0 && len(existingActions.Libraries) == 0 {
 		return nil, nil
 	}
-	events := make([]eventstore.Command, 0, len(existingActions.Actions))
+	events := make([]eventstore.Command, 0, len(existingActions.Actions)+len(existingActions.Libraries))
 	for id, existingAction := range existingActions.Actions {
 		actionAgg := NewActionAggregate(id, resourceOwner)
 		events = append(events, action.NewRemovedEvent(ctx, actionAgg, existingAction.Name))
 	}
+	for id, library := range existingActions.Libraries {
+		libraryAgg := NewActionLibraryAggregate(id, resourceOwner)
+		events = append(events, action.NewLibraryRemovedEvent(ctx, libraryAgg, library.Name))
+	}
 	return events, nil
 }
 
@@ -0,0 +1,179 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/caos/zitadel/internal/actions"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/action"
+)
+
+func (c *Commands) AddActionLibrary(ctx context.Context, library *domain.ActionLibrary, resourceOwner string) (_ string, _ *domain.ObjectDetails, err error) {
+	if !library.IsValid() {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lb2nd", "Errors.ActionLibrary.Invalid")
+	}
+	orgActions, err := c.getActionsByOrgWriteModelByID(ctx, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if orgActions.libraryByName(library.Name) != nil {
+		return "", nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Lb5wq", "Errors.ActionLibrary.AlreadyExists")
+	}
+	if err = validateActionLibrary(orgActions, library.Name, library.Script); err != nil {
+		return "", nil, err
+	}
+	if library.AggregateID == "" {
+		library.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	libraryModel := NewActionLibraryWriteModel(library.AggregateID, resourceOwner)
+	libraryAgg := ActionLibraryAggregateFromWriteModel(&libraryModel.WriteModel)
+
+	pushedEvents, err := c.eventstore.Push(ctx, action.NewLibraryAddedEvent(
+		ctx,
+		libraryAgg,
+		library.Name,
+		library.Script,
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(libraryModel, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return libraryModel.AggregateID, writeModelToObjectDetails(&libraryModel.WriteModel), nil
+}
+
+//ChangeActionLibrary adds a new version of the library
+//the actions which require the latest version of the library are validated against the new version
+func (c *Commands) ChangeActionLibrary(ctx context.Context, libraryChange *domain.ActionLibrary, resourceOwner string) (*domain.ObjectDetails, error) {
+	if libraryChange.AggregateID == "" || libraryChange.Script == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lb7sm", "Errors.ActionLibrary.Invalid")
+	}
+	existingLibrary, err := c.getActionLibraryWriteModelByID(ctx, libraryChange.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingLibrary.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Lb3md", "Errors.ActionLibrary.NotFound")
+	}
+	if existingLibrary.Script == libraryChange.Script {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lb6ds", "Errors.NoChangesFound")
+	}
+	orgActions, err := c.getActionsByOrgWriteModelByID(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateActionLibrary(orgActions, existingLibrary.Name, libraryChange.Script); err != nil {
+		return nil, err
+	}
+	if err = validateActionLibraryDependents(orgActions, existingLibrary.Name, libraryChange.Script); err != nil {
+		return nil, err
+	}
+
+	libraryAgg := ActionLibraryAggregateFromWriteModel(&existingLibrary.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, action.NewLibraryChangedEvent(
+		ctx,
+		libraryAgg,
+		libraryChange.Script,
+		existingLibrary.Version+1,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingLibrary, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingLibrary.WriteModel), nil
+}
+
+//DeleteActionLibrary removes the library with all its versions
+//the library must not be required by an action or another library
+func (c *Commands) DeleteActionLibrary(ctx context.Context, libraryID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if libraryID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Lb4ns", "Errors.IDMissing")
+	}
+	existingLibrary, err := c.getActionLibraryWriteModelByID(ctx, libraryID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingLibrary.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Lb2pq", "Errors.ActionLibrary.NotFound")
+	}
+	orgActions, err := c.getActionsByOrgWriteModelByID(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if orgActions.requiresLibrary(existingLibrary.Name) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Lb8sd", "Errors.ActionLibrary.InUse")
+	}
+
+	libraryAgg := ActionLibraryAggregateFromWriteModel(&existingLibrary.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, action.NewLibraryRemovedEvent(ctx, libraryAgg, existingLibrary.Name))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingLibrary, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingLibrary.WriteModel), nil
+}
+
+//validateActionLibrary checks if the script of the library and the libraries it requires can be loaded
+func validateActionLibrary(orgActions *ActionsListByOrgModel, name, script string) error {
+	requirement := domain.ActionLibraryRequirement{Name: name}
+	libraries, err := actions.ResolveLibraries(`require("`+requirement.Module()+`");`, func(r domain.ActionLibraryRequirement) (string, error) {
+		if r.Name == name && r.Version == 0 {
+			return script, nil
+		}
+		return orgActions.libraryScript(r)
+	})
+	if err != nil {
+		return err
+	}
+	if err = actions.Validate(`require("`+requirement.Module()+`");`, libraries); err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Lb9cs", "Errors.ActionLibrary.ScriptInvalid")
+	}
+	return nil
+}
+
+//validateActionLibraryDependents checks if the actions which require the latest version of the library
+//(directly or through other libraries) can still be loaded with the new script
+func validateActionLibraryDependents(orgActions *ActionsListByOrgModel, name, script string) error {
+	for _, existingAction := range orgActions.Actions {
+		dependent := false
+		libraries, err := actions.ResolveLibraries(existingAction.Script, func(r domain.ActionLibraryRequirement) (string, error) {
+			if r.Name == name && r.Version == 0 {
+				dependent = true
+				return script, nil
+			}
+			return orgActions.libraryScript(r)
+		})
+		if !dependent {
+			continue
+		}
+		if err == nil {
+			err = actions.Validate(existingAction.Script, libraries)
+		}
+		if err != nil {
+			return caos_errs.ThrowPreconditionFailed(fmt.Errorf("action %s: %w", existingAction.Name, err), "COMMAND-Lb4kx", "Errors.ActionLibrary.DependentInvalid")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) getActionLibraryWriteModelByID(ctx context.Context, libraryID string, resourceOwner string) (*ActionLibraryWriteModel, error) {
+	libraryWriteModel := NewActionLibraryWriteModel(libraryID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, libraryWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	return libraryWriteModel, nil
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/action"
+)
+
+type ActionLibraryWriteModel struct {
+	eventstore.WriteModel
+
+	Name    string
+	Script  string
+	Version uint64
+	//Versions contains the scripts of all versions of the library
+	Versions map[uint64]string
+	State    domain.ActionLibraryState
+}
+
+func NewActionLibraryWriteModel(libraryID string, resourceOwner string) *ActionLibraryWriteModel {
+	return &ActionLibraryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   libraryID,
+			ResourceOwner: resourceOwner,
+		},
+		Versions: make(map[uint64]string),
+	}
+}
+
+func (wm *ActionLibraryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		wm.reduceEvent(event)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ActionLibraryWriteModel) reduceEvent(event eventstore.Event) {
+	switch e := event.(type) {
+	case *action.LibraryAddedEvent:
+		wm.Name = e.Name
+		wm.Script = e.Script
+		wm.Version = 1
+		wm.Versions[wm.Version] = e.Script
+		wm.State = domain.ActionLibraryStateActive
+	case *action.LibraryChangedEvent:
+		wm.Script = e.Script
+		wm.Version = e.Version
+		wm.Versions[wm.Version] = e.Script
+	case *action.LibraryRemovedEvent:
+		wm.State = domain.ActionLibraryStateRemoved
+	}
+}
+
+func (wm *ActionLibraryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(action.LibraryAggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(action.LibraryAddedEventType,
+			action.LibraryChangedEventType,
+			action.LibraryRemovedEventType).
+		Builder()
+}
+
+func ActionLibraryAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, action.LibraryAggregateType, action.LibraryAggregateVersion)
+}
+
+func NewActionLibraryAggregate(id, resourceOwner string) *eventstore.Aggregate {
+	return ActionLibraryAggregateFromWriteModel(&eventstore.WriteModel{
+		AggregateID:   id,
+		ResourceOwner: resourceOwner,
+	})
+}
@@ -0,0 +1,540 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/id/mock"
+	"github.com/caos/zitadel/internal/repository/action"
+)
+
+func TestCommands_AddActionLibrary(t *testing.T) {
+	type fields struct {
+		eventstore  *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		library       *domain.ActionLibrary
+		resourceOwner string
+	}
+	type res struct {
+		id      string
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid name, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					Name:   "helpers/strings",
+					Script: "exports.a = 1;",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"already exists, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.a = 1;",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					Name:   "helpers",
+					Script: "exports.a = 2;",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			"script invalid, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					Name:   "helpers",
+					Script: "exports.a = function() {",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"required library not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					Name:   "helpers",
+					Script: `exports.upper = require("zitadel/libraries/strings").upper;`,
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"strings",
+								"exports.upper = function(s) { return s.toUpperCase(); };",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								action.NewLibraryAddedEvent(context.Background(),
+									&action.NewLibraryAggregate("lib2", "org1").Aggregate,
+									"helpers",
+									`exports.upper = require("zitadel/libraries/strings@1").upper;`,
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(action.NewAddLibraryNameUniqueConstraint("helpers", "org1")),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "lib2"),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					Name:   "helpers",
+					Script: `exports.upper = require("zitadel/libraries/strings@1").upper;`,
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				id: "lib2",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore,
+				idGenerator: tt.fields.idGenerator,
+			}
+			id, details, err := c.AddActionLibrary(tt.args.ctx, tt.args.library, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeActionLibrary(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		library       *domain.ActionLibrary
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					Script: "exports.a = 1;",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "lib1",
+					},
+					Script: "exports.a = 1;",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"no changes, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.a = 1;",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "lib1",
+					},
+					Script: "exports.a = 1;",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"dependent action invalid, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.init = function() {};",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.init = function() {};",
+							),
+						),
+						eventFromEventPusher(
+							action.NewAddedEvent(context.Background(),
+								&action.NewAggregate("action1", "org1").Aggregate,
+								"action",
+								`require("zitadel/libraries/helpers").init(); function action(ctx, api) {}`,
+								0,
+								false,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "lib1",
+					},
+					Script: "exports.start = function() {};",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"pinned dependent action not validated, push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.init = function() {};",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.init = function() {};",
+							),
+						),
+						eventFromEventPusher(
+							action.NewAddedEvent(context.Background(),
+								&action.NewAggregate("action1", "org1").Aggregate,
+								"action",
+								`require("zitadel/libraries/helpers@1").init(); function action(ctx, api) {}`,
+								0,
+								false,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								action.NewLibraryChangedEvent(context.Background(),
+									&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+									"exports.start = function() {};",
+									2,
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				library: &domain.ActionLibrary{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "lib1",
+					},
+					Script: "exports.start = function() {};",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.ChangeActionLibrary(tt.args.ctx, tt.args.library, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_DeleteActionLibrary(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		libraryID     string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				libraryID:     "lib1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"required by action, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.a = 1;",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.a = 1;",
+							),
+						),
+						eventFromEventPusher(
+							action.NewAddedEvent(context.Background(),
+								&action.NewAggregate("action1", "org1").Aggregate,
+								"action",
+								`function action(ctx, api) { require("zitadel/libraries/helpers@1"); }`,
+								0,
+								false,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				libraryID:     "lib1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.a = 1;",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							action.NewLibraryAddedEvent(context.Background(),
+								&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+								"helpers",
+								"exports.a = 1;",
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								action.NewLibraryRemovedEvent(context.Background(),
+									&action.NewLibraryAggregate("lib1", "org1").Aggregate,
+									"helpers",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(action.NewRemoveLibraryNameUniqueConstraint("helpers", "org1")),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				libraryID:     "lib1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.DeleteActionLibrary(tt.args.ctx, tt.args.libraryID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
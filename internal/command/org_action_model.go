@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/repository/action"
 )
@@ -156,10 +157,12 @@ func (wm *ActionExistsModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+//ActionsListByOrgModel contains the actions and the action libraries of the org
 type ActionsListByOrgModel struct {
 	eventstore.WriteModel
 
-	Actions map[string]*ActionWriteModel
+	Actions   map[string]*ActionWriteModel
+	Libraries map[string]*ActionLibraryWriteModel
 }
 
 func NewActionsListByOrgModel(resourceOwner string) *ActionsListByOrgModel {
@@ -167,7 +170,8 @@ func NewActionsListByOrgModel(resourceOwner string) *ActionsListByOrgModel {
 		WriteModel: eventstore.WriteModel{
 			ResourceOwner: resourceOwner,
 		},
-		Actions: make(map[string]*ActionWriteModel),
+		Actions:   make(map[string]*ActionWriteModel),
+		Libraries: make(map[string]*ActionLibraryWriteModel),
 	}
 }
 
@@ -180,9 +184,24 @@ func (wm *ActionsListByOrgModel) Reduce() error {
 					AggregateID: e.Aggregate().ID,
 					ChangeDate:  e.CreationDate(),
 				},
-				Name:  e.Name,
-				State: domain.ActionStateActive,
+				Name:   e.Name,
+				Script: e.Script,
+				State:  domain.ActionStateActive,
 			}
+		case *action.ChangedEvent:
+			if a, ok := wm.Actions[e.Aggregate().ID]; ok && e.Script != nil {
+				a.Script = *e.Script
+			}
+		case *action.LibraryAddedEvent,
+			*action.LibraryChangedEvent:
+			library, ok := wm.Libraries[e.Aggregate().ID]
+			if !ok {
+				library = NewActionLibraryWriteModel(e.Aggregate().ID, wm.ResourceOwner)
+				wm.Libraries[e.Aggregate().ID] = library
+			}
+			library.reduceEvent(event)
+		case *action.LibraryRemovedEvent:
+			delete(wm.Libraries, e.Aggregate().ID)
 		case *action.DeactivatedEvent:
 			wm.Actions[e.Aggregate().ID].State = domain.ActionStateInactive
 		case *action.ReactivatedEvent:
@@ -198,10 +217,61 @@ func (wm *ActionsListByOrgModel) Query() *eventstore.SearchQueryBuilder {
 	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		ResourceOwner(wm.ResourceOwner).
 		AddQuery().
-		AggregateTypes(action.AggregateType).
+		AggregateTypes(action.AggregateType, action.LibraryAggregateType).
 		EventTypes(action.AddedEventType,
+			action.ChangedEventType,
 			action.DeactivatedEventType,
 			action.ReactivatedEventType,
-			action.RemovedEventType).
+			action.RemovedEventType,
+			action.LibraryAddedEventType,
+			action.LibraryChangedEventType,
+			action.LibraryRemovedEventType).
 		Builder()
 }
+
+//libraryByName returns the existing library with the name
+func (wm *ActionsListByOrgModel) libraryByName(name string) *ActionLibraryWriteModel {
+	for _, library := range wm.Libraries {
+		if library.Name == name {
+			return library
+		}
+	}
+	return nil
+}
+
+//requiresLibrary checks if any version of the library is required by an action or another library
+func (wm *ActionsListByOrgModel) requiresLibrary(name string) bool {
+	scripts := make([]string, 0, len(wm.Actions)+len(wm.Libraries))
+	for _, existingAction := range wm.Actions {
+		scripts = append(scripts, existingAction.Script)
+	}
+	for _, library := range wm.Libraries {
+		if library.Name != name {
+			scripts = append(scripts, library.Script)
+		}
+	}
+	for _, script := range scripts {
+		for _, requirement := range domain.ActionLibraryRequirements(script) {
+			if requirement.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//libraryScript returns the script of the required library version
+func (wm *ActionsListByOrgModel) libraryScript(requirement domain.ActionLibraryRequirement) (string, error) {
+	library := wm.libraryByName(requirement.Name)
+	if library == nil {
+		return "", caos_errs.ThrowNotFound(nil, "COMMAND-Lb8nq", "Errors.ActionLibrary.NotFound")
+	}
+	if requirement.Version == 0 {
+		return library.Script, nil
+	}
+	script, ok := library.Versions[requirement.Version]
+	if !ok {
+		return "", caos_errs.ThrowNotFound(nil, "COMMAND-Lb9wm", "Errors.ActionLibrary.VersionNotFound")
+	}
+	return script, nil
+}
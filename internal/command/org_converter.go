@@ -22,12 +22,13 @@ func orgWriteModelToOrgIAMPolicy(wm *ORGOrgIAMPolicyWriteModel) *domain.OrgIAMPo
 
 func orgWriteModelToPasswordComplexityPolicy(wm *OrgPasswordComplexityPolicyWriteModel) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.PasswordComplexityPolicyWriteModel.WriteModel),
-		MinLength:    wm.MinLength,
-		HasLowercase: wm.HasLowercase,
-		HasUppercase: wm.HasUppercase,
-		HasNumber:    wm.HasNumber,
-		HasSymbol:    wm.HasSymbol,
+		ObjectRoot:    writeModelToObjectRoot(wm.PasswordComplexityPolicyWriteModel.WriteModel),
+		MinLength:     wm.MinLength,
+		HasLowercase:  wm.HasLowercase,
+		HasUppercase:  wm.HasUppercase,
+		HasNumber:     wm.HasNumber,
+		HasSymbol:     wm.HasSymbol,
+		CheckBreached: wm.CheckBreached,
 	}
 }
 
@@ -178,6 +178,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -270,7 +271,11 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								context.Background(),
 								&iam.NewAggregate().Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
@@ -356,6 +361,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -469,7 +475,11 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								context.Background(),
 								&iam.NewAggregate().Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
@@ -560,6 +570,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -680,7 +691,11 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								context.Background(),
 								&iam.NewAggregate().Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
@@ -774,6 +789,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -901,7 +917,11 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								context.Background(),
 								&iam.NewAggregate().Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
@@ -1051,6 +1071,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -1062,6 +1083,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1177,7 +1199,11 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
@@ -1291,6 +1317,7 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1383,7 +1410,11 @@ func TestCommandSide_SetOrgFeatures(t *testing.T) {
 								context.Background(),
 								&iam.NewAggregate().Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
@@ -1550,6 +1581,7 @@ func TestCommandSide_RemoveOrgFeatures(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1642,7 +1674,11 @@ func TestCommandSide_RemoveOrgFeatures(t *testing.T) {
 								context.Background(),
 								&iam.NewAggregate().Aggregate,
 								5,
+								0,
+								0,
 								false,
+								0,
+								0,
 							),
 						),
 					),
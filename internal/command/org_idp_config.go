@@ -17,13 +17,17 @@ func (c *Commands) AddIDPConfig(ctx context.Context, config *domain.IDPConfig, r
 	if resourceOwner == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-0j8gs", "Errors.ResourceOwnerMissing")
 	}
-	if config.OIDCConfig == nil && config.JWTConfig == nil {
+	if config.OIDCConfig == nil && config.JWTConfig == nil && config.SAMLConfig == nil && config.LDAPConfig == nil {
 		return nil, errors.ThrowInvalidArgument(nil, "Org-eUpQU", "Errors.idp.config.notset")
 	}
 
-	idpConfigID, err := c.idGenerator.Next()
-	if err != nil {
-		return nil, err
+	var err error
+	idpConfigID := config.IDPConfigID
+	if idpConfigID == "" {
+		idpConfigID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
 	}
 	addedConfig := NewOrgIDPConfigWriteModel(idpConfigID, resourceOwner)
 
@@ -66,6 +70,61 @@ func (c *Commands) AddIDPConfig(ctx context.Context, config *domain.IDPConfig, r
 			config.JWTConfig.KeysEndpoint,
 			config.JWTConfig.HeaderName,
 		))
+	} else if config.SAMLConfig != nil {
+		if err := validateSAMLConfig(config.SAMLConfig); err != nil {
+			return nil, err
+		}
+		key, certificate, err := c.generateSAMLCertificateAndKey(idpConfigID)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, org_repo.NewIDPSAMLConfigAddedEvent(
+			ctx,
+			orgAgg,
+			idpConfigID,
+			config.SAMLConfig.MetadataURL,
+			config.SAMLConfig.Metadata,
+			key,
+			certificate,
+			config.SAMLConfig.Binding,
+			config.SAMLConfig.WithSignedRequest,
+			config.SAMLConfig.NameIDFormat,
+			config.SAMLConfig.UsernameAttribute,
+			config.SAMLConfig.DisplayNameAttribute,
+			config.SAMLConfig.FirstNameAttribute,
+			config.SAMLConfig.LastNameAttribute,
+			config.SAMLConfig.EmailAttribute,
+			config.SAMLConfig.PhoneAttribute,
+		))
+	} else if config.LDAPConfig != nil {
+		if err := validateLDAPConfig(config.LDAPConfig); err != nil {
+			return nil, err
+		}
+		var bindPassword *crypto.CryptoValue
+		if config.LDAPConfig.BindPasswordString != "" {
+			bindPassword, err = crypto.Encrypt([]byte(config.LDAPConfig.BindPasswordString), c.idpConfigSecretCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		events = append(events, org_repo.NewIDPLDAPConfigAddedEvent(
+			ctx,
+			orgAgg,
+			idpConfigID,
+			config.LDAPConfig.URL,
+			config.LDAPConfig.StartTLS,
+			config.LDAPConfig.BindDN,
+			bindPassword,
+			config.LDAPConfig.BaseDN,
+			config.LDAPConfig.UserObjectClass,
+			config.LDAPConfig.IDAttribute,
+			config.LDAPConfig.UsernameAttribute,
+			config.LDAPConfig.DisplayNameAttribute,
+			config.LDAPConfig.FirstNameAttribute,
+			config.LDAPConfig.LastNameAttribute,
+			config.LDAPConfig.EmailAttribute,
+			config.LDAPConfig.PhoneAttribute,
+		))
 	}
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
 	if err != nil {
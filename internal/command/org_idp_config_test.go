@@ -26,6 +26,8 @@ func TestCommandSide_AddIDPConfig(t *testing.T) {
 		eventstore   *eventstore.Eventstore
 		idGenerator  id.Generator
 		secretCrypto crypto.EncryptionAlgorithm
+
+		samlCertificateAndKeyGenerator func(id string) ([]byte, []byte, error)
 	}
 	type args struct {
 		ctx           context.Context
@@ -221,6 +223,175 @@ func TestCommandSide_AddIDPConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "idp config saml add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								org.NewIDPConfigAddedEvent(context.Background(),
+									&org.NewAggregate("org1", "org1").Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeSAML,
+									domain.IDPConfigStylingTypeUnspecified,
+									true,
+								),
+							),
+							eventFromEventPusher(
+								org.NewIDPSAMLConfigAddedEvent(context.Background(),
+									&org.NewAggregate("org1", "org1").Aggregate,
+									"config1",
+									"https://idp.example.com/metadata",
+									nil,
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("key"),
+									},
+									[]byte("certificate"),
+									domain.SAMLBindingPost,
+									true,
+									domain.SAMLNameIDFormatPersistent,
+									"uid",
+									"displayName",
+									"givenName",
+									"sn",
+									"mail",
+									"phone",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "org1")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				samlCertificateAndKeyGenerator: func(id string) ([]byte, []byte, error) {
+					return []byte("key"), []byte("certificate"), nil
+				},
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.IDPConfig{
+					Name:         "name1",
+					Type:         domain.IDPConfigTypeSAML,
+					AutoRegister: true,
+					SAMLConfig: &domain.SAMLIDPConfig{
+						MetadataURL:          "https://idp.example.com/metadata",
+						Binding:              domain.SAMLBindingPost,
+						WithSignedRequest:    true,
+						NameIDFormat:         domain.SAMLNameIDFormatPersistent,
+						UsernameAttribute:    "uid",
+						DisplayNameAttribute: "displayName",
+						FirstNameAttribute:   "givenName",
+						LastNameAttribute:    "sn",
+						EmailAttribute:       "mail",
+						PhoneAttribute:       "phone",
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:  "config1",
+					Name:         "name1",
+					State:        domain.IDPConfigStateActive,
+					AutoRegister: true,
+				},
+			},
+		},
+		{
+			name: "idp config ldap add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								org.NewIDPConfigAddedEvent(context.Background(),
+									&org.NewAggregate("org1", "org1").Aggregate,
+									"config1",
+									"name1",
+									domain.IDPConfigTypeLDAP,
+									domain.IDPConfigStylingTypeUnspecified,
+									true,
+								),
+							),
+							eventFromEventPusher(
+								org.NewIDPLDAPConfigAddedEvent(context.Background(),
+									&org.NewAggregate("org1", "org1").Aggregate,
+									"config1",
+									"ldaps://ldap.example.com",
+									false,
+									"cn=admin,dc=example,dc=com",
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("password"),
+									},
+									"dc=example,dc=com",
+									"person",
+									"entryUUID",
+									"uid",
+									"cn",
+									"givenName",
+									"sn",
+									"mail",
+									"telephoneNumber",
+								),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(idpconfig.NewAddIDPConfigNameUniqueConstraint("name1", "org1")),
+					),
+				),
+				idGenerator:  id_mock.NewIDGeneratorExpectIDs(t, "config1"),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.IDPConfig{
+					Name:         "name1",
+					Type:         domain.IDPConfigTypeLDAP,
+					AutoRegister: true,
+					LDAPConfig: &domain.LDAPIDPConfig{
+						URL:                  "ldaps://ldap.example.com",
+						BindDN:               "cn=admin,dc=example,dc=com",
+						BindPasswordString:   "password",
+						BaseDN:               "dc=example,dc=com",
+						UserObjectClass:      "person",
+						IDAttribute:          "entryUUID",
+						UsernameAttribute:    "uid",
+						DisplayNameAttribute: "cn",
+						FirstNameAttribute:   "givenName",
+						LastNameAttribute:    "sn",
+						EmailAttribute:       "mail",
+						PhoneAttribute:       "telephoneNumber",
+					},
+				},
+			},
+			res: res{
+				want: &domain.IDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:  "config1",
+					Name:         "name1",
+					State:        domain.IDPConfigStateActive,
+					AutoRegister: true,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -228,6 +399,8 @@ func TestCommandSide_AddIDPConfig(t *testing.T) {
 				eventstore:            tt.fields.eventstore,
 				idGenerator:           tt.fields.idGenerator,
 				idpConfigSecretCrypto: tt.fields.secretCrypto,
+
+				samlCertificateAndKeyGenerator: tt.fields.samlCertificateAndKeyGenerator,
 			}
 			got, err := r.AddIDPConfig(tt.args.ctx, tt.args.config, tt.args.resourceOwner)
 			if tt.res.err == nil {
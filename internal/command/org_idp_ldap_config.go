@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeIDPLDAPConfig(ctx context.Context, config *domain.LDAPIDPConfig, resourceOwner string) (*domain.LDAPIDPConfig, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Sk2nf", "Errors.ResourceOwnerMissing")
+	}
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Wn2sk", "Errors.IDMissing")
+	}
+	if err := validateLDAPConfig(config); err != nil {
+		return nil, err
+	}
+	existingConfig := NewOrgIDPLDAPConfigWriteModel(config.IDPConfigID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "Org-Hs8wl", "Errors.Org.IDPConfig.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(ctx, orgAgg, config, c.idpConfigSecretCrypto)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Bc7sm", "Errors.Org.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToIDPLDAPConfig(&existingConfig.LDAPConfigWriteModel), nil
+}
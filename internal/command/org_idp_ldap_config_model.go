@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/crypto"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+type IDPLDAPConfigWriteModel struct {
+	LDAPConfigWriteModel
+}
+
+func NewOrgIDPLDAPConfigWriteModel(idpConfigID, orgID string) *IDPLDAPConfigWriteModel {
+	return &IDPLDAPConfigWriteModel{
+		LDAPConfigWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			IDPConfigID: idpConfigID,
+		},
+	}
+}
+
+func (wm *IDPLDAPConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.IDPLDAPConfigAddedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.LDAPConfigAddedEvent)
+		case *org.IDPLDAPConfigChangedEvent:
+			if wm.IDPConfigID != e.IDPConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.LDAPConfigChangedEvent)
+		case *org.IDPConfigReactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.IDPConfigReactivatedEvent)
+		case *org.IDPConfigDeactivatedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.IDPConfigDeactivatedEvent)
+		case *org.IDPConfigRemovedEvent:
+			if wm.IDPConfigID != e.ConfigID {
+				continue
+			}
+			wm.LDAPConfigWriteModel.AppendEvents(&e.IDPConfigRemovedEvent)
+		default:
+			wm.LDAPConfigWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *IDPLDAPConfigWriteModel) Reduce() error {
+	if err := wm.LDAPConfigWriteModel.Reduce(); err != nil {
+		return err
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IDPLDAPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.IDPLDAPConfigAddedEventType,
+			org.IDPLDAPConfigChangedEventType,
+			org.IDPConfigReactivatedEventType,
+			org.IDPConfigDeactivatedEventType,
+			org.IDPConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *IDPLDAPConfigWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	config *domain.LDAPIDPConfig,
+	passwordAlg crypto.EncryptionAlgorithm,
+) (*org.IDPLDAPConfigChangedEvent, bool, error) {
+	changes, err := wm.changes(config, passwordAlg)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewIDPLDAPConfigChangedEvent(ctx, aggregate, config.IDPConfigID, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
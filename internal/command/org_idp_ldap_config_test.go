@@ -0,0 +1,326 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/repository/idpconfig"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_ChangeIDPLDAPConfig(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		secretCrypto crypto.EncryptionAlgorithm
+	}
+	type (
+		args struct {
+			ctx           context.Context
+			config        *domain.LDAPIDPConfig
+			resourceOwner string
+		}
+	)
+	type res struct {
+		want *domain.LDAPIDPConfig
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid config, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID: "config1",
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "start tls with ldaps, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID:       "config1",
+					URL:               "ldaps://ldap.example.com",
+					StartTLS:          true,
+					BaseDN:            "dc=example,dc=com",
+					IDAttribute:       "entryUUID",
+					UsernameAttribute: "uid",
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID:       "config1",
+					URL:               "ldaps://ldap.example.com",
+					BaseDN:            "dc=example,dc=com",
+					IDAttribute:       "entryUUID",
+					UsernameAttribute: "uid",
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "idp config removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPConfigAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name1",
+								domain.IDPConfigTypeLDAP,
+								domain.IDPConfigStylingTypeUnspecified,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							newIDPLDAPConfigAddedEvent(context.Background(), "config1"),
+						),
+						eventFromEventPusher(
+							org.NewIDPConfigRemovedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID:       "config1",
+					URL:               "ldaps://ldap.example.com",
+					BaseDN:            "dc=example,dc=com",
+					IDAttribute:       "entryUUID",
+					UsernameAttribute: "uid",
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPConfigAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name1",
+								domain.IDPConfigTypeLDAP,
+								domain.IDPConfigStylingTypeUnspecified,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							newIDPLDAPConfigAddedEvent(context.Background(), "config1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID:       "config1",
+					URL:               "ldaps://ldap.example.com",
+					BindDN:            "cn=admin,dc=example,dc=com",
+					BaseDN:            "dc=example,dc=com",
+					UserObjectClass:   "person",
+					IDAttribute:       "entryUUID",
+					UsernameAttribute: "uid",
+					EmailAttribute:    "mail",
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "idp config ldap change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPConfigAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name1",
+								domain.IDPConfigTypeLDAP,
+								domain.IDPConfigStylingTypeUnspecified,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							newIDPLDAPConfigAddedEvent(context.Background(), "config1"),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newIDPLDAPConfigChangedEvent(context.Background(),
+									"config1",
+									[]idpconfig.LDAPConfigChanges{
+										idpconfig.ChangeLDAPBindPassword(&crypto.CryptoValue{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    []byte("password2"),
+										}),
+										idpconfig.ChangeLDAPURL("ldap://ldap.example.com"),
+										idpconfig.ChangeLDAPStartTLS(true),
+										idpconfig.ChangeLDAPEmailAttribute("email"),
+									},
+								),
+							),
+						},
+					),
+				),
+				secretCrypto: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.LDAPIDPConfig{
+					IDPConfigID:        "config1",
+					URL:                "ldap://ldap.example.com",
+					StartTLS:           true,
+					BindDN:             "cn=admin,dc=example,dc=com",
+					BindPasswordString: "password2",
+					BaseDN:             "dc=example,dc=com",
+					UserObjectClass:    "person",
+					IDAttribute:        "entryUUID",
+					UsernameAttribute:  "uid",
+					EmailAttribute:     "email",
+				},
+			},
+			res: res{
+				want: &domain.LDAPIDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID: "config1",
+					URL:         "ldap://ldap.example.com",
+					StartTLS:    true,
+					BindDN:      "cn=admin,dc=example,dc=com",
+					BindPassword: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("password2"),
+					},
+					BaseDN:            "dc=example,dc=com",
+					UserObjectClass:   "person",
+					IDAttribute:       "entryUUID",
+					UsernameAttribute: "uid",
+					EmailAttribute:    "email",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:            tt.fields.eventstore,
+				idpConfigSecretCrypto: tt.fields.secretCrypto,
+			}
+			got, err := r.ChangeIDPLDAPConfig(tt.args.ctx, tt.args.config, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newIDPLDAPConfigAddedEvent(ctx context.Context, configID string) *org.IDPLDAPConfigAddedEvent {
+	return org.NewIDPLDAPConfigAddedEvent(ctx,
+		&org.NewAggregate("org1", "org1").Aggregate,
+		configID,
+		"ldaps://ldap.example.com",
+		false,
+		"cn=admin,dc=example,dc=com",
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("password"),
+		},
+		"dc=example,dc=com",
+		"person",
+		"entryUUID",
+		"uid",
+		"",
+		"",
+		"",
+		"mail",
+		"",
+	)
+}
+
+func newIDPLDAPConfigChangedEvent(ctx context.Context, configID string, changes []idpconfig.LDAPConfigChanges) *org.IDPLDAPConfigChangedEvent {
+	event, _ := org.NewIDPLDAPConfigChangedEvent(ctx,
+		&org.NewAggregate("org1", "org1").Aggregate,
+		configID,
+		changes,
+	)
+	return event
+}
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func (c *Commands) ChangeIDPSAMLConfig(ctx context.Context, config *domain.SAMLIDPConfig, resourceOwner string) (*domain.SAMLIDPConfig, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Gk3s8", "Errors.ResourceOwnerMissing")
+	}
+	if config.IDPConfigID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-7Hd2k", "Errors.IDMissing")
+	}
+	if err := validateSAMLConfig(config); err != nil {
+		return nil, err
+	}
+	existingConfig := NewOrgIDPSAMLConfigWriteModel(config.IDPConfigID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingConfig.State == domain.IDPConfigStateRemoved || existingConfig.State == domain.IDPConfigStateUnspecified {
+		return nil, caos_errs.ThrowNotFound(nil, "Org-9Jf3s", "Errors.Org.IDPConfig.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingConfig.WriteModel)
+	changedEvent, hasChanged, err := existingConfig.NewChangedEvent(ctx, orgAgg, config)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-5Ks9d", "Errors.Org.IDPConfig.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingConfig, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToIDPSAMLConfig(&existingConfig.SAMLConfigWriteModel), nil
+}
@@ -0,0 +1,288 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/repository/idpconfig"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_ChangeIDPSAMLConfig(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type (
+		args struct {
+			ctx           context.Context
+			config        *domain.SAMLIDPConfig
+			resourceOwner string
+		}
+	)
+	type res struct {
+		want *domain.SAMLIDPConfig
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid config, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config:        &domain.SAMLIDPConfig{},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid metadata, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID: "config1",
+					Metadata:    []byte("metadata"),
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp config not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID: "config1",
+					MetadataURL: "https://idp.example.com/metadata",
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "idp config removed, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPConfigAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name1",
+								domain.IDPConfigTypeSAML,
+								domain.IDPConfigStylingTypeUnspecified,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							newIDPSAMLConfigAddedEvent(context.Background(), "config1"),
+						),
+						eventFromEventPusher(
+							org.NewIDPConfigRemovedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID: "config1",
+					MetadataURL: "https://idp.example.com/metadata",
+				},
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPConfigAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name1",
+								domain.IDPConfigTypeSAML,
+								domain.IDPConfigStylingTypeUnspecified,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							newIDPSAMLConfigAddedEvent(context.Background(), "config1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID:       "config1",
+					MetadataURL:       "https://idp.example.com/metadata",
+					Binding:           domain.SAMLBindingRedirect,
+					WithSignedRequest: true,
+					NameIDFormat:      domain.SAMLNameIDFormatPersistent,
+					UsernameAttribute: "uid",
+					EmailAttribute:    "mail",
+				},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "idp config saml change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPConfigAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"config1",
+								"name1",
+								domain.IDPConfigTypeSAML,
+								domain.IDPConfigStylingTypeUnspecified,
+								false,
+							),
+						),
+						eventFromEventPusher(
+							newIDPSAMLConfigAddedEvent(context.Background(), "config1"),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newIDPSAMLConfigChangedEvent(context.Background(),
+									"config1",
+									[]idpconfig.SAMLConfigChanges{
+										idpconfig.ChangeSAMLMetadataURL("https://idp.example.com/metadata-changed"),
+										idpconfig.ChangeSAMLBinding(domain.SAMLBindingPost),
+										idpconfig.ChangeSAMLWithSignedRequest(false),
+										idpconfig.ChangeSAMLEmailAttribute("email"),
+									},
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				config: &domain.SAMLIDPConfig{
+					IDPConfigID:       "config1",
+					MetadataURL:       "https://idp.example.com/metadata-changed",
+					Binding:           domain.SAMLBindingPost,
+					WithSignedRequest: false,
+					NameIDFormat:      domain.SAMLNameIDFormatPersistent,
+					UsernameAttribute: "uid",
+					EmailAttribute:    "email",
+				},
+			},
+			res: res{
+				want: &domain.SAMLIDPConfig{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					IDPConfigID:       "config1",
+					MetadataURL:       "https://idp.example.com/metadata-changed",
+					Certificate:       []byte("certificate"),
+					Binding:           domain.SAMLBindingPost,
+					WithSignedRequest: false,
+					NameIDFormat:      domain.SAMLNameIDFormatPersistent,
+					UsernameAttribute: "uid",
+					EmailAttribute:    "email",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ChangeIDPSAMLConfig(tt.args.ctx, tt.args.config, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newIDPSAMLConfigAddedEvent(ctx context.Context, configID string) *org.IDPSAMLConfigAddedEvent {
+	return org.NewIDPSAMLConfigAddedEvent(ctx,
+		&org.NewAggregate("org1", "org1").Aggregate,
+		configID,
+		"https://idp.example.com/metadata",
+		nil,
+		nil,
+		[]byte("certificate"),
+		domain.SAMLBindingRedirect,
+		true,
+		domain.SAMLNameIDFormatPersistent,
+		"uid",
+		"",
+		"",
+		"",
+		"mail",
+		"",
+	)
+}
+
+func newIDPSAMLConfigChangedEvent(ctx context.Context, configID string, changes []idpconfig.SAMLConfigChanges) *org.IDPSAMLConfigChangedEvent {
+	event, _ := org.NewIDPSAMLConfigChangedEvent(ctx,
+		&org.NewAggregate("org1", "org1").Aggregate,
+		configID,
+		changes,
+	)
+	return event
+}
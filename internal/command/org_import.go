@@ -0,0 +1,578 @@
+package command
+
+import (
+	"context"
+	"strconv"
+
+	"golang.org/x/text/language"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+const (
+	importTypeOrg           = "org"
+	importTypeDomain        = "domain"
+	importTypePolicy        = "policy"
+	importTypeIDP           = "idp"
+	importTypeUser          = "user"
+	importTypeMember        = "member"
+	importTypeProject       = "project"
+	importTypeProjectRole   = "project_role"
+	importTypeProjectMember = "project_member"
+	importTypeApp           = "app"
+	importTypeProjectGrant  = "project_grant"
+	importTypeUserGrant     = "user_grant"
+	importTypeCustomText    = "custom_text"
+	importTypeAction        = "action"
+	importTypeFlow          = "flow"
+)
+
+type orgImport struct {
+	exported *domain.ExportedOrg
+	result   *domain.OrgImportResult
+	orgAgg   *eventstore.Aggregate
+}
+
+func (i *orgImport) failed(typ, id string, err error) {
+	i.result.Errors = append(i.result.Errors, &domain.ImportError{
+		Type:    typ,
+		ID:      id,
+		Message: err.Error(),
+	})
+}
+
+//ImportOrgs creates the organisations of the export with all their objects
+//the objects are created through the same commands as the api uses, ids of the export are preserved
+//project grants and user grants are imported after all organisations so grants between the organisations of the export are possible
+//ownerUserID is added as owner of the imported projects
+func (c *Commands) ImportOrgs(ctx context.Context, export *domain.OrgExport, ownerUserID string) ([]*domain.OrgImportResult, error) {
+	if export == nil || len(export.Orgs) == 0 {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hs8d2", "Errors.Org.Invalid")
+	}
+	if export.Version != domain.OrgExportVersion {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mf0ds", "Errors.Import.VersionNotSupported")
+	}
+	imports := make([]*orgImport, len(export.Orgs))
+	results := make([]*domain.OrgImportResult, len(export.Orgs))
+	for i, exported := range export.Orgs {
+		imports[i] = &orgImport{
+			exported: exported,
+			result:   &domain.OrgImportResult{OrgID: exported.OrgID},
+		}
+		results[i] = imports[i].result
+		if err := c.importOrg(ctx, imports[i], ownerUserID); err != nil {
+			imports[i].failed(importTypeOrg, exported.OrgID, err)
+		}
+	}
+	for _, imported := range imports {
+		if imported.orgAgg == nil {
+			continue
+		}
+		c.importProjectGrants(ctx, imported)
+	}
+	for _, imported := range imports {
+		if imported.orgAgg == nil {
+			continue
+		}
+		c.importUserGrants(ctx, imported)
+	}
+	return results, nil
+}
+
+//importOrg only returns an error if the organisation itself couldn't be created
+func (c *Commands) importOrg(ctx context.Context, imported *orgImport, ownerUserID string) error {
+	exported := imported.exported
+	existing, err := c.getOrgWriteModelByID(ctx, exported.OrgID)
+	if err != nil {
+		return err
+	}
+	if existing.State != domain.OrgStateUnspecified {
+		return caos_errs.ThrowAlreadyExists(nil, "COMMAND-Kd02m", "Errors.Import.OrgAlreadyExists")
+	}
+	orgAgg, addedOrg, events, err := c.addOrg(ctx, &domain.Org{ObjectRoot: models.ObjectRoot{AggregateID: exported.OrgID}, Name: exported.Name}, nil)
+	if err != nil {
+		return err
+	}
+	if _, err = c.eventstore.Push(ctx, events...); err != nil {
+		return err
+	}
+	imported.orgAgg = orgAgg
+	imported.result.OrgID = addedOrg.AggregateID
+
+	c.importOrgDomains(ctx, imported)
+	//the org iam policy and the password complexity policy are checked on user creation
+	c.importPolicies(ctx, imported)
+	c.importIDPs(ctx, imported)
+	c.importLoginPolicy(ctx, imported)
+	c.importUsers(ctx, imported)
+	for _, member := range exported.Members {
+		if _, err := c.AddOrgMember(ctx, domain.NewMember(orgAgg.ID, member.UserID, member.Roles...)); err != nil {
+			imported.failed(importTypeMember, member.UserID, err)
+		}
+	}
+	c.importProjects(ctx, imported, ownerUserID)
+	c.importCustomTexts(ctx, imported)
+	c.importActions(ctx, imported)
+	return nil
+}
+
+func (c *Commands) importOrgDomains(ctx context.Context, imported *orgImport) {
+	for _, exported := range imported.exported.Domains {
+		orgDomain := &domain.OrgDomain{
+			Domain:   exported.Domain,
+			Verified: exported.IsVerified,
+			Primary:  exported.IsPrimary,
+		}
+		events, err := c.addOrgDomain(ctx, imported.orgAgg, NewOrgDomainWriteModel(imported.orgAgg.ID, orgDomain.Domain), orgDomain, nil)
+		if err == nil {
+			_, err = c.eventstore.Push(ctx, events...)
+		}
+		if err != nil {
+			imported.failed(importTypeDomain, exported.Domain, err)
+		}
+	}
+}
+
+func (c *Commands) importPolicies(ctx context.Context, imported *orgImport) {
+	orgID := imported.orgAgg.ID
+	exported := imported.exported
+	if policy := exported.OrgIAMPolicy; policy != nil {
+		if _, err := c.AddOrgIAMPolicy(ctx, orgID, &domain.OrgIAMPolicy{UserLoginMustBeDomain: policy.UserLoginMustBeDomain}); err != nil {
+			imported.failed(importTypePolicy, "org_iam", err)
+		}
+	}
+	if policy := exported.PasswordComplexityPolicy; policy != nil {
+		_, err := c.AddPasswordComplexityPolicy(ctx, orgID, &domain.PasswordComplexityPolicy{
+			MinLength:     policy.MinLength,
+			HasLowercase:  policy.HasLowercase,
+			HasUppercase:  policy.HasUppercase,
+			HasNumber:     policy.HasNumber,
+			HasSymbol:     policy.HasSymbol,
+			CheckBreached: policy.CheckBreached,
+		})
+		if err != nil {
+			imported.failed(importTypePolicy, "password_complexity", err)
+		}
+	}
+	if policy := exported.PasswordAgePolicy; policy != nil {
+		_, err := c.AddPasswordAgePolicy(ctx, orgID, &domain.PasswordAgePolicy{
+			MaxAgeDays:     policy.MaxAgeDays,
+			ExpireWarnDays: policy.ExpireWarnDays,
+		})
+		if err != nil {
+			imported.failed(importTypePolicy, "password_age", err)
+		}
+	}
+	if policy := exported.LockoutPolicy; policy != nil {
+		_, err := c.AddLockoutPolicy(ctx, orgID, &domain.LockoutPolicy{
+			MaxPasswordAttempts: policy.MaxPasswordAttempts,
+			MaxOTPAttempts:      policy.MaxOTPAttempts,
+			MaxU2FAttempts:      policy.MaxU2FAttempts,
+			ShowLockOutFailures: policy.ShowFailures,
+			LockoutDuration:     policy.LockoutDuration,
+			BackoffDelay:        policy.BackoffDelay,
+		})
+		if err != nil {
+			imported.failed(importTypePolicy, "lockout", err)
+		}
+	}
+	if policy := exported.PrivacyPolicy; policy != nil {
+		_, err := c.AddPrivacyPolicy(ctx, orgID, &domain.PrivacyPolicy{
+			TOSLink:     policy.TOSLink,
+			PrivacyLink: policy.PrivacyLink,
+		})
+		if err != nil {
+			imported.failed(importTypePolicy, "privacy", err)
+		}
+	}
+	if policy := exported.LabelPolicy; policy != nil {
+		_, err := c.AddLabelPolicy(ctx, orgID, &domain.LabelPolicy{
+			PrimaryColor:        policy.PrimaryColor,
+			BackgroundColor:     policy.BackgroundColor,
+			WarnColor:           policy.WarnColor,
+			FontColor:           policy.FontColor,
+			PrimaryColorDark:    policy.PrimaryColorDark,
+			BackgroundColorDark: policy.BackgroundColorDark,
+			WarnColorDark:       policy.WarnColorDark,
+			FontColorDark:       policy.FontColorDark,
+			HideLoginNameSuffix: policy.HideLoginNameSuffix,
+			ErrorMsgPopup:       policy.ErrorMsgPopup,
+			DisableWatermark:    policy.DisableWatermark,
+		})
+		if err == nil {
+			_, err = c.ActivateLabelPolicy(ctx, orgID)
+		}
+		if err != nil {
+			imported.failed(importTypePolicy, "label", err)
+		}
+	}
+}
+
+func (c *Commands) importLoginPolicy(ctx context.Context, imported *orgImport) {
+	policy := imported.exported.LoginPolicy
+	if policy == nil {
+		return
+	}
+	orgID := imported.orgAgg.ID
+	_, err := c.AddLoginPolicy(ctx, orgID, &domain.LoginPolicy{
+		AllowUsernamePassword: policy.AllowUsernamePassword,
+		AllowRegister:         policy.AllowRegister,
+		AllowExternalIDP:      policy.AllowExternalIDP,
+		ForceMFA:              policy.ForceMFA,
+		HidePasswordReset:     policy.HidePasswordReset,
+		PasswordlessType:      policy.PasswordlessType,
+	})
+	if err != nil {
+		imported.failed(importTypePolicy, "login", err)
+		return
+	}
+	for _, secondFactor := range policy.SecondFactors {
+		if _, _, err := c.AddSecondFactorToLoginPolicy(ctx, secondFactor, orgID); err != nil {
+			imported.failed(importTypePolicy, "login", err)
+		}
+	}
+	for _, multiFactor := range policy.MultiFactors {
+		if _, _, err := c.AddMultiFactorToLoginPolicy(ctx, multiFactor, orgID); err != nil {
+			imported.failed(importTypePolicy, "login", err)
+		}
+	}
+	for _, idpID := range policy.IDPIDs {
+		provider := &domain.IDPProvider{
+			Type:        domain.IdentityProviderTypeSystem,
+			IDPConfigID: idpID,
+		}
+		if imported.ownsIDP(idpID) {
+			provider.Type = domain.IdentityProviderTypeOrg
+		}
+		if _, err := c.AddIDPProviderToLoginPolicy(ctx, orgID, provider); err != nil {
+			imported.failed(importTypeIDP, idpID, err)
+		}
+	}
+}
+
+func (i *orgImport) ownsIDP(idpID string) bool {
+	for _, idp := range i.exported.IDPs {
+		if idp.IDPID == idpID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Commands) importIDPs(ctx context.Context, imported *orgImport) {
+	for _, exported := range imported.exported.IDPs {
+		if _, err := c.AddIDPConfig(ctx, exportedIDPToDomain(exported), imported.orgAgg.ID); err != nil {
+			imported.failed(importTypeIDP, exported.IDPID, err)
+		}
+	}
+}
+
+func exportedIDPToDomain(exported *domain.ExportedIDP) *domain.IDPConfig {
+	config := &domain.IDPConfig{
+		IDPConfigID:  exported.IDPID,
+		Name:         exported.Name,
+		StylingType:  exported.StylingType,
+		AutoRegister: exported.AutoRegister,
+	}
+	switch {
+	case exported.OIDC != nil:
+		config.Type = domain.IDPConfigTypeOIDC
+		config.OIDCConfig = &domain.OIDCIDPConfig{
+			ClientID:              exported.OIDC.ClientID,
+			ClientSecretString:    exported.OIDC.ClientSecret,
+			Issuer:                exported.OIDC.Issuer,
+			AuthorizationEndpoint: exported.OIDC.AuthorizationEndpoint,
+			TokenEndpoint:         exported.OIDC.TokenEndpoint,
+			Scopes:                exported.OIDC.Scopes,
+			IDPDisplayNameMapping: exported.OIDC.DisplayNameMapping,
+			UsernameMapping:       exported.OIDC.UsernameMapping,
+		}
+	case exported.JWT != nil:
+		config.Type = domain.IDPConfigTypeJWT
+		config.JWTConfig = &domain.JWTIDPConfig{
+			JWTEndpoint:  exported.JWT.JWTEndpoint,
+			Issuer:       exported.JWT.Issuer,
+			KeysEndpoint: exported.JWT.KeysEndpoint,
+			HeaderName:   exported.JWT.HeaderName,
+		}
+	case exported.SAML != nil:
+		config.Type = domain.IDPConfigTypeSAML
+		config.SAMLConfig = &domain.SAMLIDPConfig{
+			MetadataURL:          exported.SAML.MetadataURL,
+			Metadata:             exported.SAML.Metadata,
+			Binding:              exported.SAML.Binding,
+			WithSignedRequest:    exported.SAML.WithSignedRequest,
+			NameIDFormat:         exported.SAML.NameIDFormat,
+			UsernameAttribute:    exported.SAML.UsernameAttribute,
+			DisplayNameAttribute: exported.SAML.DisplayNameAttribute,
+			FirstNameAttribute:   exported.SAML.FirstNameAttribute,
+			LastNameAttribute:    exported.SAML.LastNameAttribute,
+			EmailAttribute:       exported.SAML.EmailAttribute,
+			PhoneAttribute:       exported.SAML.PhoneAttribute,
+		}
+	case exported.LDAP != nil:
+		config.Type = domain.IDPConfigTypeLDAP
+		config.LDAPConfig = &domain.LDAPIDPConfig{
+			URL:                  exported.LDAP.URL,
+			StartTLS:             exported.LDAP.StartTLS,
+			BindDN:               exported.LDAP.BindDN,
+			BindPasswordString:   exported.LDAP.BindPassword,
+			BaseDN:               exported.LDAP.BaseDN,
+			UserObjectClass:      exported.LDAP.UserObjectClass,
+			IDAttribute:          exported.LDAP.IDAttribute,
+			UsernameAttribute:    exported.LDAP.UsernameAttribute,
+			DisplayNameAttribute: exported.LDAP.DisplayNameAttribute,
+			FirstNameAttribute:   exported.LDAP.FirstNameAttribute,
+			LastNameAttribute:    exported.LDAP.LastNameAttribute,
+			EmailAttribute:       exported.LDAP.EmailAttribute,
+			PhoneAttribute:       exported.LDAP.PhoneAttribute,
+		}
+	}
+	return config
+}
+
+func (c *Commands) importUsers(ctx context.Context, imported *orgImport) {
+	orgID := imported.orgAgg.ID
+	for _, exported := range imported.exported.Humans {
+		if _, _, err := c.ImportHuman(ctx, orgID, exportedHumanToDomain(exported), false); err != nil {
+			imported.failed(importTypeUser, exported.UserID, err)
+		}
+	}
+	for _, exported := range imported.exported.Machines {
+		_, err := c.AddMachine(ctx, orgID, &domain.Machine{
+			ObjectRoot:  models.ObjectRoot{AggregateID: exported.UserID},
+			Username:    exported.Username,
+			Name:        exported.Name,
+			Description: exported.Description,
+		})
+		if err != nil {
+			imported.failed(importTypeUser, exported.UserID, err)
+		}
+	}
+}
+
+//exportedHumanToDomain keeps the password hash, so the user is able to login with the same password
+func exportedHumanToDomain(exported *domain.ExportedHuman) *domain.Human {
+	human := &domain.Human{
+		ObjectRoot: models.ObjectRoot{AggregateID: exported.UserID},
+		Username:   exported.Username,
+		Profile: &domain.Profile{
+			FirstName:   exported.FirstName,
+			LastName:    exported.LastName,
+			NickName:    exported.NickName,
+			DisplayName: exported.DisplayName,
+			Gender:      exported.Gender,
+		},
+		Email: &domain.Email{
+			EmailAddress:    exported.Email,
+			IsEmailVerified: exported.IsEmailVerified,
+		},
+	}
+	if exported.PreferredLanguage != "" {
+		human.PreferredLanguage = language.Make(exported.PreferredLanguage)
+	}
+	if exported.Phone != "" {
+		human.Phone = &domain.Phone{
+			PhoneNumber:     exported.Phone,
+			IsPhoneVerified: exported.IsPhoneVerified,
+		}
+	}
+	if exported.PasswordHash != nil {
+		human.Password = &domain.Password{
+			SecretCrypto:   exported.PasswordHash,
+			ChangeRequired: exported.PasswordChangeRequired,
+		}
+	}
+	return human
+}
+
+func (c *Commands) importProjects(ctx context.Context, imported *orgImport, ownerUserID string) {
+	orgID := imported.orgAgg.ID
+	for _, exported := range imported.exported.Projects {
+		_, err := c.AddProject(ctx, &domain.Project{
+			ObjectRoot:                 models.ObjectRoot{AggregateID: exported.ProjectID},
+			Name:                       exported.Name,
+			ProjectRoleAssertion:       exported.ProjectRoleAssertion,
+			ProjectRoleCheck:           exported.ProjectRoleCheck,
+			HasProjectCheck:            exported.HasProjectCheck,
+			PrivateLabelingSetting:     exported.PrivateLabelingSetting,
+			TokenExchangeDelegation:    exported.TokenExchangeDelegation,
+			TokenExchangeImpersonation: exported.TokenExchangeImpersonation,
+		}, orgID, ownerUserID)
+		if err != nil {
+			imported.failed(importTypeProject, exported.ProjectID, err)
+			continue
+		}
+		if len(exported.Roles) > 0 {
+			roles := make([]*domain.ProjectRole, len(exported.Roles))
+			for i, role := range exported.Roles {
+				roles[i] = &domain.ProjectRole{
+					ObjectRoot:  models.ObjectRoot{AggregateID: exported.ProjectID},
+					Key:         role.Key,
+					DisplayName: role.DisplayName,
+					Group:       role.Group,
+				}
+			}
+			if _, err = c.BulkAddProjectRole(ctx, exported.ProjectID, orgID, roles); err != nil {
+				imported.failed(importTypeProjectRole, exported.ProjectID, err)
+			}
+		}
+		for _, app := range exported.Apps {
+			c.importApp(ctx, imported, exported.ProjectID, app)
+		}
+		for _, member := range exported.Members {
+			if member.UserID == ownerUserID {
+				continue
+			}
+			if _, err = c.AddProjectMember(ctx, domain.NewMember(exported.ProjectID, member.UserID, member.Roles...), orgID); err != nil {
+				imported.failed(importTypeProjectMember, member.UserID, err)
+			}
+		}
+	}
+}
+
+//importApp preserves the client ids, confidential clients get a new secret
+func (c *Commands) importApp(ctx context.Context, imported *orgImport, projectID string, exported *domain.ExportedApp) {
+	orgID := imported.orgAgg.ID
+	projectRoot := models.ObjectRoot{AggregateID: projectID}
+	var (
+		clientID     string
+		clientSecret string
+		err          error
+	)
+	switch {
+	case exported.OIDC != nil:
+		var app *domain.OIDCApp
+		app, err = c.AddOIDCApplication(ctx, &domain.OIDCApp{
+			ObjectRoot:               projectRoot,
+			AppID:                    exported.AppID,
+			AppName:                  exported.Name,
+			ClientID:                 exported.OIDC.ClientID,
+			RedirectUris:             exported.OIDC.RedirectURIs,
+			ResponseTypes:            exported.OIDC.ResponseTypes,
+			GrantTypes:               exported.OIDC.GrantTypes,
+			ApplicationType:          exported.OIDC.AppType,
+			AuthMethodType:           exported.OIDC.AuthMethodType,
+			PostLogoutRedirectUris:   exported.OIDC.PostLogoutRedirectURIs,
+			OIDCVersion:              exported.OIDC.Version,
+			DevMode:                  exported.OIDC.DevMode,
+			AccessTokenType:          exported.OIDC.AccessTokenType,
+			AccessTokenRoleAssertion: exported.OIDC.AccessTokenRoleAssertion,
+			IDTokenRoleAssertion:     exported.OIDC.IDTokenRoleAssertion,
+			IDTokenUserinfoAssertion: exported.OIDC.IDTokenUserinfoAssertion,
+			ClockSkew:                exported.OIDC.ClockSkew,
+			AdditionalOrigins:        exported.OIDC.AdditionalOrigins,
+		}, orgID)
+		if err == nil {
+			clientID, clientSecret = app.ClientID, app.ClientSecretString
+		}
+	case exported.API != nil:
+		var app *domain.APIApp
+		app, err = c.AddAPIApplication(ctx, &domain.APIApp{
+			ObjectRoot:     projectRoot,
+			AppID:          exported.AppID,
+			AppName:        exported.Name,
+			ClientID:       exported.API.ClientID,
+			AuthMethodType: exported.API.AuthMethodType,
+		}, orgID)
+		if err == nil {
+			clientID, clientSecret = app.ClientID, app.ClientSecretString
+		}
+	case exported.SAML != nil:
+		_, err = c.AddSAMLApplication(ctx, &domain.SAMLApp{
+			ObjectRoot:  projectRoot,
+			AppID:       exported.AppID,
+			AppName:     exported.Name,
+			Metadata:    exported.SAML.Metadata,
+			MetadataURL: exported.SAML.MetadataURL,
+		}, orgID)
+	default:
+		err = caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wm2ks", "Errors.Application.Invalid")
+	}
+	if err != nil {
+		imported.failed(importTypeApp, exported.AppID, err)
+		return
+	}
+	if clientSecret != "" {
+		imported.result.ClientSecrets = append(imported.result.ClientSecrets, &domain.ImportedClientSecret{
+			ProjectID:    projectID,
+			AppID:        exported.AppID,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		})
+	}
+}
+
+func (c *Commands) importCustomTexts(ctx context.Context, imported *orgImport) {
+	if len(imported.exported.CustomTexts) == 0 {
+		return
+	}
+	events := make([]eventstore.Command, 0, len(imported.exported.CustomTexts))
+	for _, text := range imported.exported.CustomTexts {
+		lang, err := language.Parse(text.Language)
+		if err != nil || text.Template == "" || text.Key == "" {
+			imported.failed(importTypeCustomText, text.Template+"."+text.Key, caos_errs.ThrowInvalidArgument(err, "COMMAND-Lf93n", "Errors.CustomText.Invalid"))
+			continue
+		}
+		events = append(events, org.NewCustomTextSetEvent(ctx, imported.orgAgg, text.Template, text.Key, text.Text, lang))
+	}
+	if _, err := c.eventstore.Push(ctx, events...); err != nil {
+		imported.failed(importTypeCustomText, "", err)
+	}
+}
+
+func (c *Commands) importActions(ctx context.Context, imported *orgImport) {
+	orgID := imported.orgAgg.ID
+	for _, exported := range imported.exported.Actions {
+		_, _, err := c.AddAction(ctx, &domain.Action{
+			ObjectRoot:    models.ObjectRoot{AggregateID: exported.ActionID},
+			Name:          exported.Name,
+			Script:        exported.Script,
+			Timeout:       exported.Timeout,
+			AllowedToFail: exported.AllowedToFail,
+		}, orgID)
+		if err != nil {
+			imported.failed(importTypeAction, exported.ActionID, err)
+		}
+	}
+	for _, flow := range imported.exported.Flows {
+		for _, trigger := range flow.Triggers {
+			if _, err := c.SetTriggerActions(ctx, flow.Type, trigger.TriggerType, trigger.ActionIDs, orgID); err != nil {
+				imported.failed(importTypeFlow, strconv.Itoa(int(flow.Type)), err)
+			}
+		}
+	}
+}
+
+func (c *Commands) importProjectGrants(ctx context.Context, imported *orgImport) {
+	for _, exported := range imported.exported.ProjectGrants {
+		_, err := c.AddProjectGrant(ctx, &domain.ProjectGrant{
+			ObjectRoot:   models.ObjectRoot{AggregateID: exported.ProjectID},
+			GrantID:      exported.GrantID,
+			GrantedOrgID: exported.GrantedOrgID,
+			RoleKeys:     exported.RoleKeys,
+		}, imported.orgAgg.ID)
+		if err != nil {
+			imported.failed(importTypeProjectGrant, exported.GrantID, err)
+		}
+	}
+}
+
+func (c *Commands) importUserGrants(ctx context.Context, imported *orgImport) {
+	for _, exported := range imported.exported.UserGrants {
+		_, err := c.AddUserGrant(ctx, &domain.UserGrant{
+			ObjectRoot:     models.ObjectRoot{AggregateID: exported.UserGrantID},
+			UserID:         exported.UserID,
+			ProjectID:      exported.ProjectID,
+			ProjectGrantID: exported.ProjectGrantID,
+			RoleKeys:       exported.RoleKeys,
+		}, imported.orgAgg.ID)
+		if err != nil {
+			imported.failed(importTypeUserGrant, exported.UserGrantID, err)
+		}
+	}
+}
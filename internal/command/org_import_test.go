@@ -0,0 +1,200 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+func TestCommandSide_ImportOrgs(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+		iamDomain  string
+	}
+	type args struct {
+		ctx    context.Context
+		export *domain.OrgExport
+	}
+	type res struct {
+		orgIDs []string
+		//failed contains type and id of every failed object
+		failed [][2]string
+		err    func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no orgs, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx:    context.Background(),
+				export: &domain.OrgExport{Version: domain.OrgExportVersion},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unsupported version, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				export: &domain.OrgExport{
+					Version: "v0",
+					Orgs:    []*domain.ExportedOrg{{OrgID: "org1", Name: "Org"}},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "org already exists, reported",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(org.NewOrgAddedEvent(context.Background(),
+							&org.NewAggregate("org1", "org1").Aggregate,
+							"Org",
+						)),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				export: &domain.OrgExport{
+					Version: domain.OrgExportVersion,
+					Orgs:    []*domain.ExportedOrg{{OrgID: "org1", Name: "Org"}},
+				},
+			},
+			res: res{
+				orgIDs: []string{"org1"},
+				failed: [][2]string{{importTypeOrg, "org1"}},
+			},
+		},
+		{
+			name: "org with domain and custom text, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+					expectFilterOrgDomainNotFound(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"Org",
+							)),
+							eventFromEventPusher(org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org.iam-domain",
+							)),
+							eventFromEventPusher(org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org.iam-domain",
+							)),
+							eventFromEventPusher(org.NewDomainPrimarySetEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org.iam-domain",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(org.NewAddOrgNameUniqueConstraint("Org")),
+						uniqueConstraintsFromEventConstraint(org.NewAddOrgDomainUniqueConstraint("org.iam-domain")),
+					),
+					expectFilterOrgDomainNotFound(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"example.com",
+							)),
+							eventFromEventPusher(org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"example.com",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(org.NewAddOrgDomainUniqueConstraint("example.com")),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(org.NewCustomTextSetEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"Login",
+								"Login.Title",
+								"Welcome",
+								language.English,
+							)),
+						},
+					),
+				),
+				iamDomain: "iam-domain",
+			},
+			args: args{
+				ctx: context.Background(),
+				export: &domain.OrgExport{
+					Version: domain.OrgExportVersion,
+					Orgs: []*domain.ExportedOrg{
+						{
+							OrgID: "org1",
+							Name:  "Org",
+							Domains: []*domain.ExportedOrgDomain{
+								{Domain: "example.com", IsVerified: true},
+							},
+							CustomTexts: []*domain.ExportedCustomText{
+								{Template: "Login", Language: "en", Key: "Login.Title", Text: "Welcome"},
+								{Template: "Login", Language: "invalid language", Key: "Login.Title", Text: "Welcome"},
+							},
+						},
+					},
+				},
+			},
+			res: res{
+				orgIDs: []string{"org1"},
+				failed: [][2]string{{importTypeCustomText, "Login.Login.Title"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+				iamDomain:  tt.fields.iamDomain,
+			}
+			got, err := r.ImportOrgs(tt.args.ctx, tt.args.export, "user1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err != nil {
+				return
+			}
+			orgIDs := make([]string, len(got))
+			var failed [][2]string
+			for i, result := range got {
+				orgIDs[i] = result.OrgID
+				for _, importErr := range result.Errors {
+					failed = append(failed, [2]string{importErr.Type, importErr.ID})
+				}
+			}
+			assert.Equal(t, tt.res.orgIDs, orgIDs)
+			assert.Equal(t, tt.res.failed, failed)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -45,6 +46,9 @@ func (c *Commands) addOrgMember(ctx context.Context, orgAgg *eventstore.Aggregat
 	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(member.Roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 	}
+	if member.HasExpired(time.Now()) {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Ex8mp", "Errors.Member.ExpirationDateInPast")
+	}
 	err := c.eventstore.FilterToQueryReducer(ctx, addedMember)
 	if err != nil {
 		return nil, err
@@ -53,7 +57,7 @@ func (c *Commands) addOrgMember(ctx context.Context, orgAgg *eventstore.Aggregat
 		return nil, errors.ThrowAlreadyExists(nil, "Org-PtXi1", "Errors.Org.Member.AlreadyExists")
 	}
 
-	return org.NewMemberAddedEvent(ctx, orgAgg, member.UserID, member.Roles...), nil
+	return org.NewMemberAddedEvent(ctx, orgAgg, member.UserID, member.ExpirationDate, member.Roles...), nil
 }
 
 //ChangeOrgMember updates an existing member
@@ -105,6 +109,31 @@ func (c *Commands) RemoveOrgMember(ctx context.Context, orgID, userID string) (*
 	return writeModelToObjectDetails(&m.WriteModel), nil
 }
 
+//ExpireOrgMember removes the member if its expiration date is reached
+func (c *Commands) ExpireOrgMember(ctx context.Context, orgID, userID string) (*domain.ObjectDetails, error) {
+	if orgID == "" || userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Ex8oi", "Errors.Org.MemberInvalid")
+	}
+	m, err := c.orgMemberWriteModelByID(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !memberWriteModelToMember(&m.MemberWriteModel).HasExpired(time.Now()) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Ex8oe", "Errors.Member.NotExpired")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&m.MemberWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, c.removeOrgMember(ctx, orgAgg, userID, false))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(m, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&m.WriteModel), nil
+}
+
 func (c *Commands) removeOrgMember(ctx context.Context, orgAgg *eventstore.Aggregate, userID string, cascade bool) eventstore.Command {
 	if cascade {
 		return org.NewMemberCascadeRemovedEvent(
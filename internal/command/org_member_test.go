@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
@@ -116,6 +117,49 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 				err: caos_errs.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "expiration date in past, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				member: &domain.Member{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "org1",
+					},
+					UserID:         "user1",
+					Roles:          []string{"ORG_OWNER"},
+					ExpirationDate: time.Now().Add(-time.Hour),
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "member already exists, precondition error",
 			fields: fields{
@@ -142,6 +186,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 							org.NewMemberAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 							),
 						),
 					),
@@ -193,6 +238,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 							eventFromEventPusher(org.NewMemberAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"ORG_OWNER"}...,
 							)),
 						},
@@ -246,6 +292,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 							eventFromEventPusher(org.NewMemberAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"ORG_OWNER"}...,
 							)),
 						},
@@ -396,6 +443,7 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 							org.NewMemberAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"ORG_OWNER"}...,
 							),
 						),
@@ -431,6 +479,7 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 							org.NewMemberAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"ORG_OWNER"}...,
 							),
 						),
@@ -578,6 +627,7 @@ func TestCommandSide_RemoveOrgMember(t *testing.T) {
 							project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"PROJECT_OWNER"}...,
 							),
 						),
@@ -624,3 +674,137 @@ func TestCommandSide_RemoveOrgMember(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_ExpireOrgMember(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid member userid missing, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "member not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "member not expired, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"user1",
+								time.Now().Add(time.Hour),
+								[]string{"ORG_OWNER"}...,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "member expired, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"user1",
+								time.Now().Add(-time.Hour),
+								[]string{"ORG_OWNER"}...,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(org.NewMemberRemovedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"user1",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(member.NewRemoveMemberUniqueConstraint("org1", "user1")),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ExpireOrgMember(tt.args.ctx, tt.args.orgID, tt.args.userID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
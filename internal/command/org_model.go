@@ -4,6 +4,8 @@ import (
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/project"
+	"github.com/caos/zitadel/internal/repository/user"
 )
 
 type OrgWriteModel struct {
@@ -33,6 +35,8 @@ func (wm *OrgWriteModel) Reduce() error {
 			wm.State = domain.OrgStateInactive
 		case *org.OrgReactivatedEvent:
 			wm.State = domain.OrgStateActive
+		case *org.OrgRemovedEvent:
+			wm.State = domain.OrgStateRemoved
 		case *org.OrgChangedEvent:
 			wm.Name = e.Name
 		case *org.DomainPrimarySetEvent:
@@ -51,6 +55,7 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(
 			org.OrgAddedEventType,
 			org.OrgChangedEventType,
+			org.OrgRemovedEventType,
 			org.OrgDomainPrimarySetEventType).
 		Builder()
 }
@@ -58,3 +63,65 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 func OrgAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, org.AggregateType, org.AggregateVersion)
 }
+
+//OrgOwnedObjectsWriteModel collects the ids of the users and projects of an org
+type OrgOwnedObjectsWriteModel struct {
+	eventstore.WriteModel
+
+	UserIDs    []string
+	ProjectIDs []string
+}
+
+func NewOrgOwnedObjectsWriteModel(orgID string) *OrgOwnedObjectsWriteModel {
+	return &OrgOwnedObjectsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgOwnedObjectsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+			wm.UserIDs = append(wm.UserIDs, e.Aggregate().ID)
+		case *user.UserRemovedEvent:
+			wm.UserIDs = removeID(wm.UserIDs, e.Aggregate().ID)
+		case *project.ProjectAddedEvent:
+			wm.ProjectIDs = append(wm.ProjectIDs, e.Aggregate().ID)
+		case *project.ProjectRemovedEvent:
+			wm.ProjectIDs = removeID(wm.ProjectIDs, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgOwnedObjectsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.UserRemovedType).
+		Or().
+		AggregateTypes(project.AggregateType).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType).
+		Builder()
+}
+
+func removeID(ids []string, id string) []string {
+	for i, existing := range ids {
+		if existing == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+	return ids
+}
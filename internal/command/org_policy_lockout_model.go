@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -56,15 +57,31 @@ func (wm *OrgLockoutPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
 func (wm *OrgLockoutPolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	maxAttempts uint64,
-	showLockoutFailure bool) (*org.LockoutPolicyChangedEvent, bool) {
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
+	showLockoutFailure bool,
+	lockoutDuration,
+	backoffDelay time.Duration) (*org.LockoutPolicyChangedEvent, bool) {
 	changes := make([]policy.LockoutPolicyChanges, 0)
 	if wm.MaxPasswordAttempts != maxAttempts {
 		changes = append(changes, policy.ChangeMaxAttempts(maxAttempts))
 	}
+	if wm.MaxOTPAttempts != maxOTPAttempts {
+		changes = append(changes, policy.ChangeMaxOTPAttempts(maxOTPAttempts))
+	}
+	if wm.MaxU2FAttempts != maxU2FAttempts {
+		changes = append(changes, policy.ChangeMaxU2FAttempts(maxU2FAttempts))
+	}
 	if wm.ShowLockOutFailures != showLockoutFailure {
 		changes = append(changes, policy.ChangeShowLockOutFailures(showLockoutFailure))
 	}
+	if wm.LockoutDuration != lockoutDuration {
+		changes = append(changes, policy.ChangeLockoutDuration(lockoutDuration))
+	}
+	if wm.BackoffDelay != backoffDelay {
+		changes = append(changes, policy.ChangeBackoffDelay(backoffDelay))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -62,7 +62,11 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -92,7 +96,11 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 								org.NewLockoutPolicyAddedEvent(context.Background(),
 									&org.NewAggregate("org1", "org1").Aggregate,
 									10,
+									0,
+									0,
 									true,
+									0,
+									0,
 								),
 							),
 						},
@@ -205,7 +213,11 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -233,7 +245,11 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -343,7 +359,11 @@ func TestCommandSide_RemovePasswordLockoutPolicy(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								10,
+								0,
+								0,
 								true,
+								0,
+								0,
 							),
 						),
 					),
@@ -60,7 +60,8 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	hasLowercase,
 	hasUppercase,
 	hasNumber,
-	hasSymbol bool,
+	hasSymbol,
+	checkBreached bool,
 ) (*org.PasswordComplexityPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PasswordComplexityPolicyChanges, 0)
@@ -79,6 +80,9 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	if wm.HasSymbol != hasSymbol {
 		changes = append(changes, policy.ChangeHasSymbol(hasSymbol))
 	}
+	if wm.CheckBreached != checkBreached {
+		changes = append(changes, policy.ChangeCheckBreached(checkBreached))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
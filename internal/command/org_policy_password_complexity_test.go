@@ -65,7 +65,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -98,7 +98,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 								org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 									&org.NewAggregate("org1", "org1").Aggregate,
 									8,
-									true, true, true, true,
+									true, true, true, true, false,
 								),
 							),
 						},
@@ -223,7 +223,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -254,7 +254,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -370,7 +370,7 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1", "org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+func (c *Commands) getOrgPasswordHistoryPolicy(ctx context.Context, orgID string) (*domain.PasswordHistoryPolicy, error) {
+	policy := NewOrgPasswordHistoryPolicyWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if policy.State == domain.PolicyStateActive {
+		return writeModelToPasswordHistoryPolicy(&policy.PasswordHistoryPolicyWriteModel), nil
+	}
+	return c.getDefaultPasswordHistoryPolicy(ctx)
+}
+
+func (c *Commands) AddPasswordHistoryPolicy(ctx context.Context, resourceOwner string, policy *domain.PasswordHistoryPolicy) (*domain.PasswordHistoryPolicy, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Gk3ds", "Errors.ResourceOwnerMissing")
+	}
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	addedPolicy := NewOrgPasswordHistoryPolicyWriteModel(resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, addedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if addedPolicy.State == domain.PolicyStateActive {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "ORG-Hs8dq", "Errors.Org.PasswordHistoryPolicy.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&addedPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewPasswordHistoryPolicyAddedEvent(ctx, orgAgg, policy.HistoryCount))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToPasswordHistoryPolicy(&addedPolicy.PasswordHistoryPolicyWriteModel), nil
+}
+
+func (c *Commands) ChangePasswordHistoryPolicy(ctx context.Context, resourceOwner string, policy *domain.PasswordHistoryPolicy) (*domain.PasswordHistoryPolicy, error) {
+	if resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Jm2sd", "Errors.ResourceOwnerMissing")
+	}
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	existingPolicy := NewOrgPasswordHistoryPolicyWriteModel(resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "ORG-Lw9sc", "Errors.Org.PasswordHistoryPolicy.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.PasswordHistoryPolicyWriteModel.WriteModel)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.HistoryCount)
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "Org-Ks8fh", "Errors.Org.PasswordHistoryPolicy.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToPasswordHistoryPolicy(&existingPolicy.PasswordHistoryPolicyWriteModel), nil
+}
+
+func (c *Commands) RemovePasswordHistoryPolicy(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "Org-Kd0sw", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy := NewOrgPasswordHistoryPolicyWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "ORG-Pq9sh", "Errors.Org.PasswordHistoryPolicy.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewPasswordHistoryPolicyRemovedEvent(ctx, orgAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.PasswordHistoryPolicyWriteModel.WriteModel), nil
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/policy"
+)
+
+type OrgPasswordHistoryPolicyWriteModel struct {
+	PasswordHistoryPolicyWriteModel
+}
+
+func NewOrgPasswordHistoryPolicyWriteModel(orgID string) *OrgPasswordHistoryPolicyWriteModel {
+	return &OrgPasswordHistoryPolicyWriteModel{
+		PasswordHistoryPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgPasswordHistoryPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.PasswordHistoryPolicyAddedEvent:
+			wm.PasswordHistoryPolicyWriteModel.AppendEvents(&e.PasswordHistoryPolicyAddedEvent)
+		case *org.PasswordHistoryPolicyChangedEvent:
+			wm.PasswordHistoryPolicyWriteModel.AppendEvents(&e.PasswordHistoryPolicyChangedEvent)
+		case *org.PasswordHistoryPolicyRemovedEvent:
+			wm.PasswordHistoryPolicyWriteModel.AppendEvents(&e.PasswordHistoryPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgPasswordHistoryPolicyWriteModel) Reduce() error {
+	return wm.PasswordHistoryPolicyWriteModel.Reduce()
+}
+
+func (wm *OrgPasswordHistoryPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.PasswordHistoryPolicyWriteModel.AggregateID).
+		EventTypes(
+			org.PasswordHistoryPolicyAddedEventType,
+			org.PasswordHistoryPolicyChangedEventType,
+			org.PasswordHistoryPolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgPasswordHistoryPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	historyCount uint64) (*org.PasswordHistoryPolicyChangedEvent, bool) {
+	changes := make([]policy.PasswordHistoryPolicyChanges, 0)
+	if wm.HistoryCount != historyCount {
+		changes = append(changes, policy.ChangeHistoryCount(historyCount))
+	}
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := org.NewPasswordHistoryPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+//SetOrgSMSProviders selects the sms providers of the iam which are used to send sms to the users of the org
+//the providers are used in the given order, if sending with a provider fails the next one is used
+func (c *Commands) SetOrgSMSProviders(ctx context.Context, orgID string, providerIDs []string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Os92n", "Errors.ResourceOwnerMissing")
+	}
+	if len(providerIDs) == 0 {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pm93k", "Errors.SMSConfig.ProvidersMissing")
+	}
+	err := c.checkOrgExists(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]struct{}, len(providerIDs))
+	for _, id := range providerIDs {
+		if _, ok := ids[id]; ok || id == "" {
+			return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Dp02m", "Errors.SMSConfig.ProvidersInvalid")
+		}
+		ids[id] = struct{}{}
+		smsConfig, err := c.getSMSConfig(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !smsConfig.State.Exists() {
+			return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Nf82k", "Errors.SMSConfig.NotFound")
+		}
+	}
+	writeModel := NewOrgSMSProvidersWriteModel(orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.hasChanged(providerIDs) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ch92l", "Errors.NoChangesFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSProvidersSetEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel), providerIDs))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+//ResetOrgSMSProviders removes the selection of the org, so the active provider of the iam is used
+func (c *Commands) ResetOrgSMSProviders(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rs92m", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgSMSProvidersWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	if len(writeModel.ProviderIDs) == 0 {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ps03n", "Errors.SMSConfig.ProvidersNotSet")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewSMSProvidersResetEvent(ctx, OrgAggregateFromWriteModel(&writeModel.WriteModel)))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(writeModel, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
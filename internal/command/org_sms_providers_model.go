@@ -0,0 +1,57 @@
+package command
+
+import (
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+type OrgSMSProvidersWriteModel struct {
+	eventstore.WriteModel
+
+	ProviderIDs []string
+}
+
+func NewOrgSMSProvidersWriteModel(orgID string) *OrgSMSProvidersWriteModel {
+	return &OrgSMSProvidersWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgSMSProvidersWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMSProvidersSetEvent:
+			wm.ProviderIDs = e.ProviderIDs
+		case *org.SMSProvidersResetEvent:
+			wm.ProviderIDs = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMSProvidersWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMSProvidersSetEventType,
+			org.SMSProvidersResetEventType).
+		Builder()
+}
+
+func (wm *OrgSMSProvidersWriteModel) hasChanged(providerIDs []string) bool {
+	if len(wm.ProviderIDs) != len(providerIDs) {
+		return true
+	}
+	for i, id := range wm.ProviderIDs {
+		if providerIDs[i] != id {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
@@ -15,8 +16,11 @@ import (
 	"github.com/caos/zitadel/internal/eventstore/v1/models"
 	"github.com/caos/zitadel/internal/id"
 	id_mock "github.com/caos/zitadel/internal/id/mock"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/repository/iam"
 	"github.com/caos/zitadel/internal/repository/member"
 	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/project"
 	"github.com/caos/zitadel/internal/repository/user"
 )
 
@@ -148,7 +152,7 @@ func TestCommandSide_AddOrg(t *testing.T) {
 							eventFromEventPusher(org.NewMemberAddedEvent(
 								context.Background(),
 								&org.NewAggregate("org2", "org2").Aggregate,
-								"user1", domain.RoleOrgOwner)),
+								"user1", time.Time{}, domain.RoleOrgOwner)),
 						},
 						uniqueConstraintsFromEventConstraint(org.NewAddOrgNameUniqueConstraint("Org")),
 						uniqueConstraintsFromEventConstraint(org.NewAddOrgDomainUniqueConstraint("org.iam-domain")),
@@ -217,7 +221,7 @@ func TestCommandSide_AddOrg(t *testing.T) {
 							eventFromEventPusher(org.NewMemberAddedEvent(
 								context.Background(),
 								&org.NewAggregate("org2", "org2").Aggregate,
-								"user1", domain.RoleOrgOwner)),
+								"user1", time.Time{}, domain.RoleOrgOwner)),
 						},
 						uniqueConstraintsFromEventConstraint(org.NewAddOrgNameUniqueConstraint("Org")),
 						uniqueConstraintsFromEventConstraint(org.NewAddOrgDomainUniqueConstraint("org.iam-domain")),
@@ -285,6 +289,7 @@ func TestCommandSide_AddOrg(t *testing.T) {
 							eventFromEventPusher(org.NewMemberAddedEvent(context.Background(),
 								&org.NewAggregate("org2", "org2").Aggregate,
 								"user1",
+								time.Time{},
 								domain.RoleOrgOwner,
 							)),
 						},
@@ -838,3 +843,248 @@ func TestCommandSide_ReactivateOrg(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_RemoveOrg(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx                      context.Context
+		orgID                    string
+		cascadingUserMemberships []*query.Membership
+		cascadingProjectGrants   []*query.ProjectGrant
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "org id missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "default org, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewGlobalOrgSetEventEvent(context.Background(),
+								&iam.NewAggregate().Aggregate,
+								"org1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "org not found, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove org with user, project and domain, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org"),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							iam.NewOrgIAMPolicyAddedEvent(context.Background(),
+								&iam.NewAggregate().Aggregate,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+								false, false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainAddedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org.com"),
+						),
+						eventFromEventPusher(
+							org.NewDomainVerifiedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org.com"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+								false, false,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(org.NewMemberCascadeRemovedEvent(context.Background(),
+								&org.NewAggregate("org2", "org2").Aggregate,
+								"user1",
+							)),
+							eventFromEventPusher(project.NewGrantRemovedEvent(context.Background(),
+								&project.NewAggregate("project2", "org2").Aggregate,
+								"grant1",
+								"org1",
+							)),
+							eventFromEventPusher(user.NewUserRemovedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								nil,
+								true,
+							)),
+							eventFromEventPusher(project.NewProjectRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project",
+								nil,
+							)),
+							eventFromEventPusher(org.NewOrgRemovedEvent(context.Background(),
+								&org.NewAggregate("org1", "org1").Aggregate,
+								"org",
+								[]string{"org.com"},
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(member.NewRemoveMemberUniqueConstraint("org2", "user1")),
+						uniqueConstraintsFromEventConstraint(project.NewRemoveProjectGrantUniqueConstraint("org1", "project2")),
+						uniqueConstraintsFromEventConstraint(user.NewRemoveUsernameUniqueConstraint("username", "org1", true)),
+						uniqueConstraintsFromEventConstraint(project.NewRemoveProjectNameUniqueConstraint("project", "org1")),
+						uniqueConstraintsFromEventConstraint(org.NewRemoveOrgNameUniqueConstraint("org")),
+						uniqueConstraintsFromEventConstraint(org.NewRemoveOrgDomainUniqueConstraint("org.com")),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				cascadingUserMemberships: []*query.Membership{
+					{
+						UserID:        "user1",
+						ResourceOwner: "org1",
+						Org:           &query.OrgMembership{OrgID: "org1"},
+					},
+					{
+						UserID:        "user1",
+						ResourceOwner: "org2",
+						Org:           &query.OrgMembership{OrgID: "org2"},
+					},
+				},
+				cascadingProjectGrants: []*query.ProjectGrant{
+					{
+						ProjectID:     "project2",
+						GrantID:       "grant1",
+						ResourceOwner: "org2",
+						GrantedOrgID:  "org1",
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.RemoveOrg(tt.args.ctx, tt.args.orgID, tt.args.cascadingUserMemberships, tt.args.cascadingProjectGrants, nil)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/repository/policy"
@@ -10,7 +12,11 @@ type LockoutPolicyWriteModel struct {
 	eventstore.WriteModel
 
 	MaxPasswordAttempts uint64
+	MaxOTPAttempts      uint64
+	MaxU2FAttempts      uint64
 	ShowLockOutFailures bool
+	LockoutDuration     time.Duration
+	BackoffDelay        time.Duration
 	State               domain.PolicyState
 }
 
@@ -19,15 +25,31 @@ func (wm *LockoutPolicyWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *policy.LockoutPolicyAddedEvent:
 			wm.MaxPasswordAttempts = e.MaxPasswordAttempts
+			wm.MaxOTPAttempts = e.MaxOTPAttempts
+			wm.MaxU2FAttempts = e.MaxU2FAttempts
 			wm.ShowLockOutFailures = e.ShowLockOutFailures
+			wm.LockoutDuration = e.LockoutDuration
+			wm.BackoffDelay = e.BackoffDelay
 			wm.State = domain.PolicyStateActive
 		case *policy.LockoutPolicyChangedEvent:
 			if e.MaxPasswordAttempts != nil {
 				wm.MaxPasswordAttempts = *e.MaxPasswordAttempts
 			}
+			if e.MaxOTPAttempts != nil {
+				wm.MaxOTPAttempts = *e.MaxOTPAttempts
+			}
+			if e.MaxU2FAttempts != nil {
+				wm.MaxU2FAttempts = *e.MaxU2FAttempts
+			}
 			if e.ShowLockOutFailures != nil {
 				wm.ShowLockOutFailures = *e.ShowLockOutFailures
 			}
+			if e.LockoutDuration != nil {
+				wm.LockoutDuration = *e.LockoutDuration
+			}
+			if e.BackoffDelay != nil {
+				wm.BackoffDelay = *e.BackoffDelay
+			}
 		case *policy.LockoutPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
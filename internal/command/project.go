@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/logging"
 	"github.com/caos/zitadel/internal/domain"
@@ -30,9 +31,11 @@ func (c *Commands) addProject(ctx context.Context, projectAdd *domain.Project, r
 	if !projectAdd.IsValid() {
 		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-IOVCC", "Errors.Project.Invalid")
 	}
-	projectAdd.AggregateID, err = c.idGenerator.Next()
-	if err != nil {
-		return nil, nil, err
+	if projectAdd.AggregateID == "" {
+		projectAdd.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	addedProject := NewProjectWriteModel(projectAdd.AggregateID, resourceOwner)
 	projectAgg := ProjectAggregateFromWriteModel(&addedProject.WriteModel)
@@ -53,8 +56,10 @@ func (c *Commands) addProject(ctx context.Context, projectAdd *domain.Project, r
 			projectAdd.ProjectRoleAssertion,
 			projectAdd.ProjectRoleCheck,
 			projectAdd.HasProjectCheck,
-			projectAdd.PrivateLabelingSetting),
-		project.NewProjectMemberAddedEvent(ctx, projectAgg, ownerUserID, projectRole),
+			projectAdd.PrivateLabelingSetting,
+			projectAdd.TokenExchangeDelegation,
+			projectAdd.TokenExchangeImpersonation),
+		project.NewProjectMemberAddedEvent(ctx, projectAgg, ownerUserID, time.Time{}, projectRole),
 	}
 	return events, addedProject, nil
 }
@@ -102,7 +107,9 @@ func (c *Commands) ChangeProject(ctx context.Context, projectChange *domain.Proj
 		projectChange.ProjectRoleAssertion,
 		projectChange.ProjectRoleCheck,
 		projectChange.HasProjectCheck,
-		projectChange.PrivateLabelingSetting)
+		projectChange.PrivateLabelingSetting,
+		projectChange.TokenExchangeDelegation,
+		projectChange.TokenExchangeImpersonation)
 	if err != nil {
 		return nil, err
 	}
@@ -188,9 +195,14 @@ func (c *Commands) RemoveProject(ctx context.Context, projectID, resourceOwner s
 	if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
 		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.Project.NotFound")
 	}
+	samlEntityIDs := NewProjectSAMLEntityIDsWriteModel(projectID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, samlEntityIDs)
+	if err != nil {
+		return nil, err
+	}
 	projectAgg := ProjectAggregateFromWriteModel(&existingProject.WriteModel)
 	events := []eventstore.Command{
-		project.NewProjectRemovedEvent(ctx, projectAgg, existingProject.Name),
+		project.NewProjectRemovedEvent(ctx, projectAgg, existingProject.Name, samlEntityIDs.RemoveUniqueConstraints()),
 	}
 
 	for _, grantID := range cascadingUserGrantIDs {
@@ -45,9 +45,11 @@ func (c *Commands) addAPIApplication(ctx context.Context, projectAgg *eventstore
 	if !apiAppApp.IsValid() {
 		return nil, "", caos_errs.ThrowInvalidArgument(nil, "PROJECT-Bff2g", "Errors.Application.Invalid")
 	}
-	apiAppApp.AppID, err = c.idGenerator.Next()
-	if err != nil {
-		return nil, "", err
+	if apiAppApp.AppID == "" {
+		apiAppApp.AppID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
 	events = []eventstore.Command{
@@ -55,9 +57,11 @@ func (c *Commands) addAPIApplication(ctx context.Context, projectAgg *eventstore
 	}
 
 	var stringPw string
-	err = domain.SetNewClientID(apiAppApp, c.idGenerator, proj)
-	if err != nil {
-		return nil, "", err
+	if apiAppApp.ClientID == "" {
+		err = domain.SetNewClientID(apiAppApp, c.idGenerator, proj)
+		if err != nil {
+			return nil, "", err
+		}
 	}
 	stringPw, err = domain.SetNewClientSecretIfNeeded(apiAppApp, c.applicationSecretGenerator)
 	if err != nil {
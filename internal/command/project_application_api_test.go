@@ -85,7 +85,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, false, false),
 						),
 					),
 				),
@@ -115,7 +115,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, false, false),
 						),
 					),
 					expectPush(
@@ -183,7 +183,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, false, false),
 						),
 					),
 					expectPush(
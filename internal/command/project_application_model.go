@@ -9,9 +9,10 @@ import (
 type ApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID string
-	State domain.AppState
-	Name  string
+	AppID        string
+	State        domain.AppState
+	Name         string
+	SAMLEntityID string
 }
 
 func NewApplicationWriteModelWithAppIDC(projectID, appID, resourceOwner string) *ApplicationWriteModel {
@@ -60,6 +61,16 @@ func (wm *ApplicationWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.SAMLConfigAddedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.SAMLConfigChangedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.ProjectRemovedEvent:
 			wm.WriteModel.AppendEvents(e)
 		}
@@ -86,6 +97,12 @@ func (wm *ApplicationWriteModel) Reduce() error {
 			wm.State = domain.AppStateActive
 		case *project.ApplicationRemovedEvent:
 			wm.State = domain.AppStateRemoved
+		case *project.SAMLConfigAddedEvent:
+			wm.SAMLEntityID = e.EntityID
+		case *project.SAMLConfigChangedEvent:
+			if e.EntityID != "" {
+				wm.SAMLEntityID = e.EntityID
+			}
 		case *project.ProjectRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		}
@@ -105,6 +122,8 @@ func (wm *ApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.ApplicationDeactivatedType,
 			project.ApplicationReactivatedType,
 			project.ApplicationRemovedType,
+			project.SAMLConfigAddedType,
+			project.SAMLConfigChangedType,
 			project.ProjectRemovedType).
 		Builder()
 }
@@ -46,9 +46,11 @@ func (c *Commands) addOIDCApplication(ctx context.Context, projectAgg *eventstor
 	if oidcApp.AppName == "" || !oidcApp.IsValid() {
 		return nil, "", caos_errs.ThrowInvalidArgument(nil, "PROJECT-1n8df", "Errors.Application.Invalid")
 	}
-	oidcApp.AppID, err = c.idGenerator.Next()
-	if err != nil {
-		return nil, "", err
+	if oidcApp.AppID == "" {
+		oidcApp.AppID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
 	events = []eventstore.Command{
@@ -56,9 +58,11 @@ func (c *Commands) addOIDCApplication(ctx context.Context, projectAgg *eventstor
 	}
 
 	var stringPw string
-	err = domain.SetNewClientID(oidcApp, c.idGenerator, proj)
-	if err != nil {
-		return nil, "", err
+	if oidcApp.ClientID == "" {
+		err = domain.SetNewClientID(oidcApp, c.idGenerator, proj)
+		if err != nil {
+			return nil, "", err
+		}
 	}
 	stringPw, err = domain.SetNewClientSecretIfNeeded(oidcApp, c.applicationSecretGenerator)
 	if err != nil {
@@ -88,7 +88,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, false, false),
 						),
 					),
 				),
@@ -118,7 +118,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, false, false),
 						),
 					),
 					expectPush(
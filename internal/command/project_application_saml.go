@@ -0,0 +1,143 @@
+package command
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/project"
+)
+
+func (c *Commands) AddSAMLApplication(ctx context.Context, application *domain.SAMLApp, resourceOwner string) (_ *domain.SAMLApp, err error) {
+	if application == nil || application.AggregateID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-35Fn0", "Errors.Application.Invalid")
+	}
+	_, err = c.getProjectByID(ctx, application.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, caos_errs.ThrowPreconditionFailed(err, "PROJECT-3p9ss", "Errors.Project.NotFound")
+	}
+	addedApplication := NewSAMLApplicationWriteModel(application.AggregateID, resourceOwner)
+	projectAgg := ProjectAggregateFromWriteModel(&addedApplication.WriteModel)
+	events, err := c.addSAMLApplication(ctx, projectAgg, application)
+	if err != nil {
+		return nil, err
+	}
+	addedApplication.AppID = application.AppID
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedApplication, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return samlWriteModelToSAMLConfig(addedApplication), nil
+}
+
+func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstore.Aggregate, samlApp *domain.SAMLApp) (events []eventstore.Command, err error) {
+	if !samlApp.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-1n9df", "Errors.Application.Invalid")
+	}
+	if err = c.setSAMLMetadata(ctx, samlApp); err != nil {
+		return nil, err
+	}
+	if samlApp.AppID == "" {
+		samlApp.AppID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []eventstore.Command{
+		project.NewApplicationAddedEvent(ctx, projectAgg, samlApp.AppID, samlApp.AppName),
+		project.NewSAMLConfigAddedEvent(ctx,
+			projectAgg,
+			samlApp.AppID,
+			samlApp.EntityID,
+			samlApp.Metadata,
+			samlApp.MetadataURL),
+	}, nil
+}
+
+func (c *Commands) ChangeSAMLApplication(ctx context.Context, samlApp *domain.SAMLApp, resourceOwner string) (*domain.SAMLApp, error) {
+	if samlApp.AppID == "" || samlApp.AggregateID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-1n9e3", "Errors.Project.App.SAMLConfigInvalid")
+	}
+	if len(samlApp.Metadata) == 0 && samlApp.MetadataURL == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-4n0fs", "Errors.Project.App.SAMLConfigInvalid")
+	}
+
+	existingSAML, err := c.getSAMLAppWriteModel(ctx, samlApp.AggregateID, samlApp.AppID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingSAML.State == domain.AppStateUnspecified || existingSAML.State == domain.AppStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-2n9f4", "Errors.Project.App.NotExisting")
+	}
+	if !existingSAML.IsSAML() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Gwt3s", "Errors.Project.App.IsNotSAML")
+	}
+	if err = c.setSAMLMetadata(ctx, samlApp); err != nil {
+		return nil, err
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&existingSAML.WriteModel)
+	changedEvent, hasChanged, err := existingSAML.NewChangedEvent(
+		ctx,
+		projectAgg,
+		samlApp.AppID,
+		samlApp.EntityID,
+		samlApp.Metadata,
+		samlApp.MetadataURL)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-1m88f", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingSAML, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return samlWriteModelToSAMLConfig(existingSAML), nil
+}
+
+// setSAMLMetadata validates the metadata of the service provider (fetched from the url if not provided)
+// and sets the entity id of the application
+func (c *Commands) setSAMLMetadata(ctx context.Context, samlApp *domain.SAMLApp) (err error) {
+	var entityDescriptor *saml.EntityDescriptor
+	if len(samlApp.Metadata) > 0 {
+		entityDescriptor, err = samlsp.ParseMetadata(samlApp.Metadata)
+	} else {
+		var metadataURL *url.URL
+		metadataURL, err = url.Parse(samlApp.MetadataURL)
+		if err == nil {
+			entityDescriptor, err = samlsp.FetchMetadata(ctx, http.DefaultClient, *metadataURL)
+		}
+	}
+	if err != nil || entityDescriptor.EntityID == "" || len(entityDescriptor.SPSSODescriptors) == 0 {
+		return caos_errs.ThrowInvalidArgument(err, "SAML-29dk3", "Errors.Project.App.SAMLMetadataInvalid")
+	}
+	samlApp.EntityID = entityDescriptor.EntityID
+	return nil
+}
+
+func (c *Commands) getSAMLAppWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (*SAMLApplicationWriteModel, error) {
+	appWriteModel := NewSAMLApplicationWriteModelWithAppID(projectID, appID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, appWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	return appWriteModel, nil
+}
@@ -6,12 +6,14 @@ import (
 
 func projectWriteModelToProject(writeModel *ProjectWriteModel) *domain.Project {
 	return &domain.Project{
-		ObjectRoot:             writeModelToObjectRoot(writeModel.WriteModel),
-		Name:                   writeModel.Name,
-		ProjectRoleAssertion:   writeModel.ProjectRoleAssertion,
-		ProjectRoleCheck:       writeModel.ProjectRoleCheck,
-		HasProjectCheck:        writeModel.HasProjectCheck,
-		PrivateLabelingSetting: writeModel.PrivateLabelingSetting,
+		ObjectRoot:                 writeModelToObjectRoot(writeModel.WriteModel),
+		Name:                       writeModel.Name,
+		ProjectRoleAssertion:       writeModel.ProjectRoleAssertion,
+		ProjectRoleCheck:           writeModel.ProjectRoleCheck,
+		HasProjectCheck:            writeModel.HasProjectCheck,
+		PrivateLabelingSetting:     writeModel.PrivateLabelingSetting,
+		TokenExchangeDelegation:    writeModel.TokenExchangeDelegation,
+		TokenExchangeImpersonation: writeModel.TokenExchangeImpersonation,
 	}
 }
 
@@ -68,6 +70,18 @@ func apiWriteModelToAPIConfig(writeModel *APIApplicationWriteModel) *domain.APIA
 	}
 }
 
+func samlWriteModelToSAMLConfig(writeModel *SAMLApplicationWriteModel) *domain.SAMLApp {
+	return &domain.SAMLApp{
+		ObjectRoot:  writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:       writeModel.AppID,
+		AppName:     writeModel.AppName,
+		State:       writeModel.State,
+		EntityID:    writeModel.EntityID,
+		Metadata:    writeModel.Metadata,
+		MetadataURL: writeModel.MetadataURL,
+	}
+}
+
 func roleWriteModelToRole(writeModel *ProjectRoleWriteModel) *domain.ProjectRole {
 	return &domain.ProjectRole{
 		ObjectRoot:  writeModelToObjectRoot(writeModel.WriteModel),
@@ -14,6 +14,7 @@ import (
 	"github.com/caos/zitadel/internal/repository/usergrant"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestCommandSide_AddProjectGrant(t *testing.T) {
@@ -89,6 +90,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -119,6 +121,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -156,6 +159,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -346,6 +350,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -385,6 +390,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -431,6 +437,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -485,6 +492,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -565,6 +573,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -647,6 +656,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -679,7 +689,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								"user1",
 								"project1",
 								"projectgrant1",
-								[]string{"key1", "key2"}),
+								[]string{"key1", "key2"}, time.Time{}),
 						),
 					),
 					expectPush(
@@ -824,6 +834,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -851,6 +862,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -889,6 +901,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1023,6 +1036,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1050,6 +1064,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1084,6 +1099,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1223,6 +1239,7 @@ func TestCommandSide_RemoveProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1250,6 +1267,7 @@ func TestCommandSide_RemoveProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1296,6 +1314,7 @@ func TestCommandSide_RemoveProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1344,6 +1363,7 @@ func TestCommandSide_RemoveProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -1361,7 +1381,7 @@ func TestCommandSide_RemoveProjectGrant(t *testing.T) {
 							"user1",
 							"project1",
 							"projectgrant1",
-							[]string{"key1"}))),
+							[]string{"key1"}, time.Time{}))),
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(project.NewGrantRemovedEvent(context.Background(),
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -40,6 +41,9 @@ func (c *Commands) addProjectMember(ctx context.Context, projectAgg *eventstore.
 	if len(domain.CheckForInvalidRoles(member.Roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
 	}
+	if member.HasExpired(time.Now()) {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-Ex8mq", "Errors.Member.ExpirationDateInPast")
+	}
 
 	err := c.checkUserExists(ctx, addedMember.UserID, "")
 	if err != nil {
@@ -53,7 +57,7 @@ func (c *Commands) addProjectMember(ctx context.Context, projectAgg *eventstore.
 		return nil, errors.ThrowAlreadyExists(nil, "PROJECT-PtXi1", "Errors.Project.Member.AlreadyExists")
 	}
 
-	return project.NewProjectMemberAddedEvent(ctx, projectAgg, member.UserID, member.Roles...), nil
+	return project.NewProjectMemberAddedEvent(ctx, projectAgg, member.UserID, member.ExpirationDate, member.Roles...), nil
 }
 
 //ChangeProjectMember updates an existing member
@@ -112,6 +116,31 @@ func (c *Commands) RemoveProjectMember(ctx context.Context, projectID, userID, r
 	return writeModelToObjectDetails(&m.WriteModel), nil
 }
 
+//ExpireProjectMember removes the member if its expiration date is reached
+func (c *Commands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "PROJECT-Ex8pi", "Errors.Project.Member.Invalid")
+	}
+	m, err := c.projectMemberWriteModelByID(ctx, projectID, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !memberWriteModelToMember(&m.MemberWriteModel).HasExpired(time.Now()) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "PROJECT-Ex8pe", "Errors.Member.NotExpired")
+	}
+
+	projectAgg := ProjectAggregateFromWriteModel(&m.MemberWriteModel.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, c.removeProjectMember(ctx, projectAgg, userID, false))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(m, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&m.WriteModel), nil
+}
+
 func (c *Commands) removeProjectMember(ctx context.Context, projectAgg *eventstore.Aggregate, userID string, cascade bool) eventstore.Command {
 	if cascade {
 		return project.NewProjectMemberCascadeRemovedEvent(
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 	"testing"
+	"time"
 )
 
 func TestCommandSide_AddProjectMember(t *testing.T) {
@@ -132,6 +133,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 							project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 							),
 						),
 					),
@@ -184,6 +186,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 							eventFromEventPusher(project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"PROJECT_OWNER"}...,
 							)),
 						},
@@ -238,6 +241,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 							eventFromEventPusher(project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"PROJECT_OWNER"}...,
 							)),
 						},
@@ -393,6 +397,7 @@ func TestCommandSide_ChangeProjectMember(t *testing.T) {
 							project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"PROJECT_OWNER"}...,
 							),
 						),
@@ -429,6 +434,7 @@ func TestCommandSide_ChangeProjectMember(t *testing.T) {
 							project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"PROJECT_OWNER"}...,
 							),
 						),
@@ -577,6 +583,7 @@ func TestCommandSide_RemoveProjectMember(t *testing.T) {
 							project.NewProjectMemberAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{"PROJECT_OWNER"}...,
 							),
 						),
@@ -623,3 +630,142 @@ func TestCommandSide_RemoveProjectMember(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_ExpireProjectMember(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid member projectid missing, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "member not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "member without expiration, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								time.Time{},
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "member expired, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+								time.Now().Add(-time.Hour),
+								[]string{"PROJECT_OWNER"}...,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(project.NewProjectMemberRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"user1",
+							)),
+						},
+						uniqueConstraintsFromEventConstraint(member.NewRemoveMemberUniqueConstraint("project1", "user1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ExpireProjectMember(tt.args.ctx, tt.args.projectID, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
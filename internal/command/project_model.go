@@ -11,12 +11,14 @@ import (
 type ProjectWriteModel struct {
 	eventstore.WriteModel
 
-	Name                   string
-	ProjectRoleAssertion   bool
-	ProjectRoleCheck       bool
-	HasProjectCheck        bool
-	PrivateLabelingSetting domain.PrivateLabelingSetting
-	State                  domain.ProjectState
+	Name                       string
+	ProjectRoleAssertion       bool
+	ProjectRoleCheck           bool
+	HasProjectCheck            bool
+	PrivateLabelingSetting     domain.PrivateLabelingSetting
+	TokenExchangeDelegation    bool
+	TokenExchangeImpersonation bool
+	State                      domain.ProjectState
 }
 
 func NewProjectWriteModel(projectID string, resourceOwner string) *ProjectWriteModel {
@@ -37,6 +39,8 @@ func (wm *ProjectWriteModel) Reduce() error {
 			wm.ProjectRoleCheck = e.ProjectRoleCheck
 			wm.HasProjectCheck = e.HasProjectCheck
 			wm.PrivateLabelingSetting = e.PrivateLabelingSetting
+			wm.TokenExchangeDelegation = e.TokenExchangeDelegation
+			wm.TokenExchangeImpersonation = e.TokenExchangeImpersonation
 			wm.State = domain.ProjectStateActive
 		case *project.ProjectChangeEvent:
 			if e.Name != nil {
@@ -54,6 +58,12 @@ func (wm *ProjectWriteModel) Reduce() error {
 			if e.PrivateLabelingSetting != nil {
 				wm.PrivateLabelingSetting = *e.PrivateLabelingSetting
 			}
+			if e.TokenExchangeDelegation != nil {
+				wm.TokenExchangeDelegation = *e.TokenExchangeDelegation
+			}
+			if e.TokenExchangeImpersonation != nil {
+				wm.TokenExchangeImpersonation = *e.TokenExchangeImpersonation
+			}
 		case *project.ProjectDeactivatedEvent:
 			if wm.State == domain.ProjectStateRemoved {
 				continue
@@ -93,6 +103,8 @@ func (wm *ProjectWriteModel) NewChangedEvent(
 	projectRoleCheck,
 	hasProjectCheck bool,
 	privateLabelingSetting domain.PrivateLabelingSetting,
+	tokenExchangeDelegation,
+	tokenExchangeImpersonation bool,
 ) (*project.ProjectChangeEvent, bool, error) {
 	changes := make([]project.ProjectChanges, 0)
 	var err error
@@ -114,6 +126,12 @@ func (wm *ProjectWriteModel) NewChangedEvent(
 	if wm.PrivateLabelingSetting != privateLabelingSetting {
 		changes = append(changes, project.ChangePrivateLabelingSetting(privateLabelingSetting))
 	}
+	if wm.TokenExchangeDelegation != tokenExchangeDelegation {
+		changes = append(changes, project.ChangeTokenExchangeDelegation(tokenExchangeDelegation))
+	}
+	if wm.TokenExchangeImpersonation != tokenExchangeImpersonation {
+		changes = append(changes, project.ChangeTokenExchangeImpersonation(tokenExchangeImpersonation))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
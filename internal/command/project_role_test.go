@@ -11,6 +11,7 @@ import (
 	"github.com/caos/zitadel/internal/repository/usergrant"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestCommandSide_AddProjectRole(t *testing.T) {
@@ -43,12 +44,14 @@ func TestCommandSide_AddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1",
+								nil,
 							),
 						),
 					),
@@ -79,6 +82,7 @@ func TestCommandSide_AddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -108,6 +112,7 @@ func TestCommandSide_AddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -153,6 +158,7 @@ func TestCommandSide_AddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -246,12 +252,14 @@ func TestCommandSide_BulkAddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1",
+								nil,
 							),
 						),
 					),
@@ -284,6 +292,7 @@ func TestCommandSide_BulkAddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -317,6 +326,7 @@ func TestCommandSide_BulkAddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -376,6 +386,7 @@ func TestCommandSide_BulkAddProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -496,12 +507,14 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1",
+								nil,
 							),
 						),
 					),
@@ -532,6 +545,7 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -580,6 +594,7 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -622,6 +637,7 @@ func TestCommandSide_ChangeProjectRole(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -998,7 +1014,7 @@ func TestCommandSide_RemoveProjectRole(t *testing.T) {
 								"user1",
 								"project1",
 								"",
-								[]string{"key1"})),
+								[]string{"key1"}, time.Time{})),
 					),
 					expectPush(
 						[]*repository.Event{
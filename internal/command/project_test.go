@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -75,12 +76,14 @@ func TestCommandSide_AddProject(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
 								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+								false, false,
 							),
 							),
 							eventFromEventPusher(project.NewProjectMemberAddedEvent(
 								context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{domain.RoleProjectOwner}...,
 							),
 							),
@@ -127,12 +130,14 @@ func TestCommandSide_AddProject(t *testing.T) {
 								&project.NewAggregate("project1", "globalorg").Aggregate,
 								"project", true, true, true,
 								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+								false, false,
 							),
 							),
 							eventFromEventPusher(project.NewProjectMemberAddedEvent(
 								context.Background(),
 								&project.NewAggregate("project1", "globalorg").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{domain.RoleProjectOwnerGlobal}...,
 							),
 							),
@@ -189,12 +194,14 @@ func TestCommandSide_AddProject(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
 								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+								false, false,
 							),
 							),
 							eventFromEventPusher(project.NewProjectMemberAddedEvent(
 								context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"user1",
+								time.Time{},
 								[]string{domain.RoleProjectOwner}...,
 							),
 							),
@@ -341,12 +348,13 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
-								"project"),
+								"project",
+								nil),
 						),
 					),
 				),
@@ -375,7 +383,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 					),
 				),
@@ -408,7 +416,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 					),
 					expectPush(
@@ -468,7 +476,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 					),
 					expectPush(
@@ -614,12 +622,13 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
-								"project"),
+								"project",
+								nil),
 						),
 					),
 				),
@@ -643,7 +652,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 						eventFromEventPusher(
 							project.NewProjectDeactivatedEvent(context.Background(),
@@ -671,7 +680,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 					),
 					expectPush(
@@ -793,12 +802,13 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
-								"project"),
+								"project",
+								nil),
 						),
 					),
 				),
@@ -822,7 +832,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 					),
 				),
@@ -846,7 +856,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 						eventFromEventPusher(
 							project.NewProjectDeactivatedEvent(context.Background(),
@@ -972,12 +982,13 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
-								"project"),
+								"project",
+								nil),
 						),
 					),
 				),
@@ -1001,7 +1012,56 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								project.NewProjectRemovedEvent(context.Background(),
+									&project.NewAggregate("project1", "org1").Aggregate,
+									"project",
+									nil),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(project.NewRemoveProjectNameUniqueConstraint("project", "org1")),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "project remove with saml app, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, false, false),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://sp.example.com",
+								[]byte("metadata"),
+								"",
+							),
 						),
 					),
 					expectPush(
@@ -1009,10 +1069,14 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							eventFromEventPusher(
 								project.NewProjectRemovedEvent(context.Background(),
 									&project.NewAggregate("project1", "org1").Aggregate,
-									"project"),
+									"project",
+									[]*eventstore.EventUniqueConstraint{
+										project.NewRemoveSAMLConfigEntityIDUniqueConstraint("https://sp.example.com"),
+									}),
 							),
 						},
 						uniqueConstraintsFromEventConstraint(project.NewRemoveProjectNameUniqueConstraint("project", "org1")),
+						uniqueConstraintsFromEventConstraint(project.NewRemoveSAMLConfigEntityIDUniqueConstraint("https://sp.example.com")),
 					),
 				),
 			},
@@ -0,0 +1,139 @@
+package command
+
+import (
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/idpconfig"
+)
+
+type SAMLConfigWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID          string
+	MetadataURL          string
+	Metadata             []byte
+	Key                  *crypto.CryptoValue
+	Certificate          []byte
+	Binding              domain.SAMLBinding
+	WithSignedRequest    bool
+	NameIDFormat         domain.SAMLNameIDFormat
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+	State                domain.IDPConfigState
+}
+
+func (wm *SAMLConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idpconfig.SAMLConfigAddedEvent:
+			wm.reduceConfigAddedEvent(e)
+		case *idpconfig.SAMLConfigChangedEvent:
+			wm.reduceConfigChangedEvent(e)
+		case *idpconfig.IDPConfigDeactivatedEvent:
+			wm.State = domain.IDPConfigStateInactive
+		case *idpconfig.IDPConfigReactivatedEvent:
+			wm.State = domain.IDPConfigStateActive
+		case *idpconfig.IDPConfigRemovedEvent:
+			wm.State = domain.IDPConfigStateRemoved
+		}
+	}
+
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLConfigWriteModel) reduceConfigAddedEvent(e *idpconfig.SAMLConfigAddedEvent) {
+	wm.IDPConfigID = e.IDPConfigID
+	wm.MetadataURL = e.MetadataURL
+	wm.Metadata = e.Metadata
+	wm.Key = e.Key
+	wm.Certificate = e.Certificate
+	wm.Binding = e.Binding
+	wm.WithSignedRequest = e.WithSignedRequest
+	wm.NameIDFormat = e.NameIDFormat
+	wm.UsernameAttribute = e.UsernameAttribute
+	wm.DisplayNameAttribute = e.DisplayNameAttribute
+	wm.FirstNameAttribute = e.FirstNameAttribute
+	wm.LastNameAttribute = e.LastNameAttribute
+	wm.EmailAttribute = e.EmailAttribute
+	wm.PhoneAttribute = e.PhoneAttribute
+	wm.State = domain.IDPConfigStateActive
+}
+
+func (wm *SAMLConfigWriteModel) reduceConfigChangedEvent(e *idpconfig.SAMLConfigChangedEvent) {
+	if e.MetadataURL != nil {
+		wm.MetadataURL = *e.MetadataURL
+	}
+	if e.Metadata != nil {
+		wm.Metadata = e.Metadata
+	}
+	if e.Binding != nil {
+		wm.Binding = *e.Binding
+	}
+	if e.WithSignedRequest != nil {
+		wm.WithSignedRequest = *e.WithSignedRequest
+	}
+	if e.NameIDFormat != nil {
+		wm.NameIDFormat = *e.NameIDFormat
+	}
+	if e.UsernameAttribute != nil {
+		wm.UsernameAttribute = *e.UsernameAttribute
+	}
+	if e.DisplayNameAttribute != nil {
+		wm.DisplayNameAttribute = *e.DisplayNameAttribute
+	}
+	if e.FirstNameAttribute != nil {
+		wm.FirstNameAttribute = *e.FirstNameAttribute
+	}
+	if e.LastNameAttribute != nil {
+		wm.LastNameAttribute = *e.LastNameAttribute
+	}
+	if e.EmailAttribute != nil {
+		wm.EmailAttribute = *e.EmailAttribute
+	}
+	if e.PhoneAttribute != nil {
+		wm.PhoneAttribute = *e.PhoneAttribute
+	}
+}
+
+func (wm *SAMLConfigWriteModel) changes(config *domain.SAMLIDPConfig) []idpconfig.SAMLConfigChanges {
+	changes := make([]idpconfig.SAMLConfigChanges, 0)
+	if wm.MetadataURL != config.MetadataURL {
+		changes = append(changes, idpconfig.ChangeSAMLMetadataURL(config.MetadataURL))
+	}
+	if len(config.Metadata) > 0 && string(wm.Metadata) != string(config.Metadata) {
+		changes = append(changes, idpconfig.ChangeSAMLMetadata(config.Metadata))
+	}
+	if wm.Binding != config.Binding {
+		changes = append(changes, idpconfig.ChangeSAMLBinding(config.Binding))
+	}
+	if wm.WithSignedRequest != config.WithSignedRequest {
+		changes = append(changes, idpconfig.ChangeSAMLWithSignedRequest(config.WithSignedRequest))
+	}
+	if wm.NameIDFormat != config.NameIDFormat {
+		changes = append(changes, idpconfig.ChangeSAMLNameIDFormat(config.NameIDFormat))
+	}
+	if wm.UsernameAttribute != config.UsernameAttribute {
+		changes = append(changes, idpconfig.ChangeSAMLUsernameAttribute(config.UsernameAttribute))
+	}
+	if wm.DisplayNameAttribute != config.DisplayNameAttribute {
+		changes = append(changes, idpconfig.ChangeSAMLDisplayNameAttribute(config.DisplayNameAttribute))
+	}
+	if wm.FirstNameAttribute != config.FirstNameAttribute {
+		changes = append(changes, idpconfig.ChangeSAMLFirstNameAttribute(config.FirstNameAttribute))
+	}
+	if wm.LastNameAttribute != config.LastNameAttribute {
+		changes = append(changes, idpconfig.ChangeSAMLLastNameAttribute(config.LastNameAttribute))
+	}
+	if wm.EmailAttribute != config.EmailAttribute {
+		changes = append(changes, idpconfig.ChangeSAMLEmailAttribute(config.EmailAttribute))
+	}
+	if wm.PhoneAttribute != config.PhoneAttribute {
+		changes = append(changes, idpconfig.ChangeSAMLPhoneAttribute(config.PhoneAttribute))
+	}
+	return changes
+}
@@ -21,6 +21,8 @@ const (
 	OIDCGrantTypeAuthorizationCode = "AUTHORIZATION_CODE"
 	OIDCGrantTypeImplicit          = "IMPLICIT"
 	OIDCGrantTypeRefreshToken      = "REFRESH_TOKEN"
+	OIDCGrantTypeDeviceCode        = "DEVICE_CODE"
+	OIDCGrantTypeTokenExchange     = "TOKEN_EXCHANGE"
 	OIDCApplicationTypeNative      = "NATIVE"
 	OIDCApplicationTypeUserAgent   = "USER_AGENT"
 	OIDCApplicationTypeWeb         = "WEB"
@@ -282,6 +284,10 @@ func getOIDCGrantType(grantTypes string) domain.OIDCGrantType {
 		return domain.OIDCGrantTypeImplicit
 	case OIDCGrantTypeRefreshToken:
 		return domain.OIDCGrantTypeRefreshToken
+	case OIDCGrantTypeDeviceCode:
+		return domain.OIDCGrantTypeDeviceCode
+	case OIDCGrantTypeTokenExchange:
+		return domain.OIDCGrantTypeTokenExchange
 	}
 	return domain.OIDCGrantTypeAuthorizationCode
 }
@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+)
+
+type Step22 struct {
+	PasswordHistoryPolicy domain.PasswordHistoryPolicy
+}
+
+func (s *Step22) Step() domain.Step {
+	return domain.Step22
+}
+
+func (s *Step22) execute(ctx context.Context, commandSide *Commands) error {
+	return commandSide.SetupStep22(ctx, s)
+}
+
+func (c *Commands) SetupStep22(ctx context.Context, step *Step22) error {
+	fn := func(iam *IAMWriteModel) ([]eventstore.Command, error) {
+		iamAgg := IAMAggregateFromWriteModel(&iam.WriteModel)
+		addedPolicy := NewIAMPasswordHistoryPolicyWriteModel()
+		events, err := c.addDefaultPasswordHistoryPolicy(ctx, iamAgg, addedPolicy, &step.PasswordHistoryPolicy)
+		if err != nil {
+			return nil, err
+		}
+
+		logging.Log("SETUP-Nd82k").Info("default password history policy set up")
+		return []eventstore.Command{events}, nil
+	}
+	return c.setup(ctx, step, fn)
+}
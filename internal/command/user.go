@@ -173,6 +173,22 @@ func (c *Commands) UnlockUser(ctx context.Context, userID, resourceOwner string)
 	return writeModelToObjectDetails(&existingUser.WriteModel), nil
 }
 
+//UserLockoutExpired reports if the user was locked temporarily and the lockout duration passed
+//it doesn't unlock the user, the unlock event is pushed by the next check of the user (see checkLockout)
+func (c *Commands) UserLockoutExpired(ctx context.Context, userID, resourceOwner string) (bool, error) {
+	if userID == "" {
+		return false, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mfw3s", "Errors.User.UserIDMissing")
+	}
+
+	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return false, err
+	}
+	return existingUser.UserState == domain.UserStateLocked &&
+		!existingUser.LockedUntil.IsZero() &&
+		!time.Now().Before(existingUser.LockedUntil), nil
+}
+
 func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string, cascadingUserMemberships []*query.Membership, cascadingGrantIDs ...string) (*domain.ObjectDetails, error) {
 	if userID == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-2M0ds", "Errors.User.UserIDMissing")
@@ -238,6 +254,34 @@ func (c *Commands) AddUserToken(ctx context.Context, orgID, agentID, clientID, u
 	return accessToken, nil
 }
 
+// AddExchangedUserToken adds a token issued by the token exchange grant for the user of the subject token
+// and records the exchange on the user
+func (c *Commands) AddExchangedUserToken(ctx context.Context, orgID, clientID, userID, subjectTokenID string, exchangeType domain.TokenExchangeType, actor *domain.TokenActor, audience, scopes []string, lifetime time.Duration) (*domain.Token, error) {
+	if userID == "" || subjectTokenID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wn0fs", "Errors.IDMissing")
+	}
+	if actor == nil || (exchangeType != domain.TokenExchangeTypeDelegation && exchangeType != domain.TokenExchangeTypeImpersonation) {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pq2md", "Errors.User.AccessToken.Invalid")
+	}
+	userWriteModel := NewUserWriteModel(userID, orgID)
+	event, accessToken, err := c.addUserToken(ctx, userWriteModel, "", clientID, "", audience, scopes, lifetime)
+	if err != nil {
+		return nil, err
+	}
+	event.ActorSubject = actor.Subject
+	event.ActorClientID = actor.ClientID
+	accessToken.Actor = actor
+	userAgg := UserAggregateFromWriteModel(&userWriteModel.WriteModel)
+	_, err = c.eventstore.Push(ctx,
+		event,
+		user.NewUserTokenExchangedEvent(ctx, userAgg, accessToken.TokenID, subjectTokenID, clientID, exchangeType, actor.Subject, event.Audience, scopes),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return accessToken, nil
+}
+
 func (c *Commands) RevokeAccessToken(ctx context.Context, userID, orgID, tokenID string) (*domain.ObjectDetails, error) {
 	removeEvent, accessTokenWriteModel, err := c.removeAccessToken(ctx, userID, orgID, tokenID)
 	if err != nil {
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -13,6 +14,10 @@ import (
 )
 
 func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant, resourceOwner string) (_ *domain.UserGrant, err error) {
+	err = c.userGrantPreActions(ctx, domain.TriggerTypePreCreation, resourceOwner, usergrant)
+	if err != nil {
+		return nil, err
+	}
 	event, addedUserGrant, err := c.addUserGrant(ctx, usergrant, resourceOwner)
 	if err != nil {
 		return nil, err
@@ -26,20 +31,27 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	if err != nil {
 		return nil, err
 	}
-	return userGrantWriteModelToUserGrant(addedUserGrant), nil
+	grant := userGrantWriteModelToUserGrant(addedUserGrant)
+	c.userGrantPostActions(ctx, domain.TriggerTypePostCreation, resourceOwner, grant)
+	return grant, nil
 }
 
 func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (command eventstore.Command, _ *UserGrantWriteModel, err error) {
 	if !userGrant.IsValid() {
 		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-4M0fs", "Errors.UserGrant.Invalid")
 	}
+	if userGrant.HasExpired(time.Now()) {
+		return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ex8pd", "Errors.UserGrant.ExpirationDateInPast")
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant)
 	if err != nil {
 		return nil, nil, err
 	}
-	userGrant.AggregateID, err = c.idGenerator.Next()
-	if err != nil {
-		return nil, nil, err
+	if userGrant.AggregateID == "" {
+		userGrant.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	addedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, resourceOwner)
@@ -51,6 +63,7 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 		userGrant.ProjectID,
 		userGrant.ProjectGrantID,
 		userGrant.RoleKeys,
+		userGrant.ExpirationDate,
 	)
 	return command, addedUserGrant, nil
 }
@@ -68,7 +81,9 @@ func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGr
 	if err != nil {
 		return nil, err
 	}
-	return userGrantWriteModelToUserGrant(changedUserGrant), nil
+	grant := userGrantWriteModelToUserGrant(changedUserGrant)
+	c.userGrantPostActions(ctx, domain.TriggerTypePostChange, resourceOwner, grant)
+	return grant, nil
 }
 
 func (c *Commands) changeUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string, cascade bool) (_ eventstore.Command, _ *UserGrantWriteModel, err error) {
@@ -89,8 +104,15 @@ func (c *Commands) changeUserGrant(ctx context.Context, userGrant *domain.UserGr
 	if reflect.DeepEqual(existingUserGrant.RoleKeys, userGrant.RoleKeys) {
 		return nil, nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rs8fy", "Errors.UserGrant.NotChanged")
 	}
+	userGrant.UserID = existingUserGrant.UserID
 	userGrant.ProjectID = existingUserGrant.ProjectID
 	userGrant.ProjectGrantID = existingUserGrant.ProjectGrantID
+	if !cascade {
+		err = c.userGrantPreActions(ctx, domain.TriggerTypePreChange, resourceOwner, userGrant)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant)
 	if err != nil {
 		return nil, nil, err
@@ -171,6 +193,39 @@ func (c *Commands) DeactivateUserGrant(ctx context.Context, grantID, resourceOwn
 	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 }
 
+//ExpireUserGrant deactivates the user grant if its expiration date is reached
+//it's used by the expiration job and therefore doesn't check the permissions of the caller
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
+	if grantID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ex8gi", "Errors.UserGrant.IDMissing")
+	}
+
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ex8gn", "Errors.UserGrant.NotFound")
+	}
+	if existingUserGrant.State != domain.UserGrantStateActive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ex8ga", "Errors.UserGrant.NotActive")
+	}
+	if !userGrantWriteModelToUserGrant(existingUserGrant).HasExpired(time.Now()) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ex8ge", "Errors.UserGrant.NotExpired")
+	}
+
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantDeactivatedEvent(ctx, userGrantAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUserGrant, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
 func (c *Commands) ReactivateUserGrant(ctx context.Context, grantID, resourceOwner string) (objectDetails *domain.ObjectDetails, err error) {
 	if grantID == "" || resourceOwner == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qxy8v", "Errors.UserGrant.IDMissing")
@@ -208,6 +263,11 @@ func (c *Commands) RemoveUserGrant(ctx context.Context, grantID, resourceOwner s
 	if err != nil {
 		return nil, err
 	}
+	removedUserGrant := userGrantWriteModelToUserGrant(existingUserGrant)
+	err = c.userGrantPreActions(ctx, domain.TriggerTypePreRemoval, existingUserGrant.ResourceOwner, removedUserGrant)
+	if err != nil {
+		return nil, err
+	}
 
 	pushedEvents, err := c.eventstore.Push(ctx, event)
 	if err != nil {
@@ -217,6 +277,7 @@ func (c *Commands) RemoveUserGrant(ctx context.Context, grantID, resourceOwner s
 	if err != nil {
 		return nil, err
 	}
+	c.userGrantPostActions(ctx, domain.TriggerTypePostRemoval, existingUserGrant.ResourceOwner, removedUserGrant)
 	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 }
 
@@ -225,15 +286,27 @@ func (c *Commands) BulkRemoveUserGrant(ctx context.Context, grantIDs []string, r
 		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-5M0sd", "Errors.UserGrant.IDMissing")
 	}
 	events := make([]eventstore.Command, len(grantIDs))
+	removedUserGrants := make([]*domain.UserGrant, len(grantIDs))
 	for i, grantID := range grantIDs {
-		event, _, err := c.removeUserGrant(ctx, grantID, resourceOwner, false)
+		event, existingUserGrant, err := c.removeUserGrant(ctx, grantID, resourceOwner, false)
+		if err != nil {
+			return err
+		}
+		removedUserGrants[i] = userGrantWriteModelToUserGrant(existingUserGrant)
+		err = c.userGrantPreActions(ctx, domain.TriggerTypePreRemoval, existingUserGrant.ResourceOwner, removedUserGrants[i])
 		if err != nil {
 			return err
 		}
 		events[i] = event
 	}
 	_, err = c.eventstore.Push(ctx, events...)
-	return err
+	if err != nil {
+		return err
+	}
+	for _, removedUserGrant := range removedUserGrants {
+		c.userGrantPostActions(ctx, domain.TriggerTypePostRemoval, removedUserGrant.ResourceOwner, removedUserGrant)
+	}
+	return nil
 }
 
 func (c *Commands) removeUserGrant(ctx context.Context, grantID, resourceOwner string, cascade bool) (_ eventstore.Command, writeModel *UserGrantWriteModel, err error) {
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/repository/project"
@@ -15,6 +17,7 @@ type UserGrantWriteModel struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ExpirationDate time.Time
 	State          domain.UserGrantState
 }
 
@@ -35,6 +38,7 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.ProjectID = e.ProjectID
 			wm.ProjectGrantID = e.ProjectGrantID
 			wm.RoleKeys = e.RoleKeys
+			wm.ExpirationDate = e.ExpirationDate
 			wm.State = domain.UserGrantStateActive
 		case *usergrant.UserGrantChangedEvent:
 			wm.RoleKeys = e.RoleKeys
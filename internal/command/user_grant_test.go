@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/caos/zitadel/internal/api/authz"
 	"github.com/caos/zitadel/internal/domain"
@@ -57,6 +58,26 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 				err: caos_errs.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "expiration date in past, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("org", "user", []string{domain.RoleProjectOwner}),
+				userGrant: &domain.UserGrant{
+					UserID:         "user1",
+					ProjectID:      "project1",
+					ExpirationDate: time.Now().Add(-time.Hour),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "user removed, precondition error",
 			fields: fields{
@@ -126,12 +147,14 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1",
+								nil,
 							),
 						),
 					),
@@ -174,6 +197,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -217,6 +241,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -261,6 +286,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -321,6 +347,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -340,6 +367,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								"project1",
 								"",
 								[]string{"rolekey1"},
+								time.Time{},
 							)),
 						},
 						uniqueConstraintsFromEventConstraint(usergrant.NewAddUserGrantUniqueConstraint("org1", "user1", "project1", "")),
@@ -394,6 +422,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -421,6 +450,7 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 								"project1",
 								"projectgrant1",
 								[]string{"rolekey1"},
+								time.Time{},
 							)),
 						},
 						uniqueConstraintsFromEventConstraint(usergrant.NewAddUserGrantUniqueConstraint("org1", "user1", "project1", "projectgrant1")),
@@ -521,7 +551,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 				),
@@ -599,7 +629,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 				),
@@ -631,7 +661,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -687,7 +717,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -710,12 +740,14 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1",
+								nil,
 							),
 						),
 					),
@@ -747,7 +779,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -770,6 +802,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -802,7 +835,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -825,6 +858,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 					),
@@ -858,7 +892,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -881,6 +915,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -930,7 +965,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -953,6 +988,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -1018,7 +1054,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"projectgrant1", []string{"rolekey1"}),
+								"projectgrant1", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -1041,6 +1077,7 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								false, false,
 							),
 						),
 						eventFromEventPusher(
@@ -1202,7 +1239,7 @@ func TestCommandSide_DeactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantRemovedEvent(context.Background(),
@@ -1234,7 +1271,7 @@ func TestCommandSide_DeactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 				),
@@ -1259,7 +1296,7 @@ func TestCommandSide_DeactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantDeactivatedEvent(context.Background(),
@@ -1288,7 +1325,7 @@ func TestCommandSide_DeactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectPush(
@@ -1333,6 +1370,192 @@ func TestCommandSide_DeactivateUserGrant(t *testing.T) {
 	}
 }
 
+func TestCommandSide_ExpireUserGrant(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userGrantID   string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid usergrantID, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "usergrant not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "no expiration date, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"", []string{"rolekey1"}, time.Time{}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "not yet expired, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"", []string{"rolekey1"}, time.Now().Add(time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "already deactivated, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"", []string{"rolekey1"}, time.Now().Add(-time.Hour)),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantDeactivatedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "expired, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"", []string{"rolekey1"}, time.Now().Add(-time.Hour)),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								usergrant.NewUserGrantDeactivatedEvent(context.Background(),
+									&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.ExpireUserGrant(tt.args.ctx, tt.args.userGrantID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ReactivateUserGrant(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
@@ -1410,7 +1633,7 @@ func TestCommandSide_ReactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantRemovedEvent(context.Background(),
@@ -1442,7 +1665,7 @@ func TestCommandSide_ReactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantDeactivatedEvent(context.Background(),
@@ -1471,7 +1694,7 @@ func TestCommandSide_ReactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 				),
@@ -1496,7 +1719,7 @@ func TestCommandSide_ReactivateUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantDeactivatedEvent(context.Background(),
@@ -1607,7 +1830,7 @@ func TestCommandSide_RemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantRemovedEvent(context.Background(),
@@ -1639,7 +1862,7 @@ func TestCommandSide_RemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantDeactivatedEvent(context.Background(),
@@ -1668,7 +1891,7 @@ func TestCommandSide_RemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectPush(
@@ -1708,7 +1931,7 @@ func TestCommandSide_RemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"projectgrant1", []string{"rolekey1"}),
+								"projectgrant1", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectPush(
@@ -1819,7 +2042,7 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 						eventFromEventPusher(
 							usergrant.NewUserGrantRemovedEvent(context.Background(),
@@ -1851,7 +2074,7 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 				),
@@ -1876,7 +2099,7 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -1885,7 +2108,7 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
 								"user2",
 								"project2",
-								"", []string{"rolekey1"}),
+								"", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectPush(
@@ -1930,7 +2153,7 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
 								"user1",
 								"project1",
-								"projectgrant1", []string{"rolekey1"}),
+								"projectgrant1", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectFilter(
@@ -1939,7 +2162,7 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 								&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
 								"user2",
 								"project2",
-								"projectgrant2", []string{"rolekey1"}),
+								"projectgrant2", []string{"rolekey1"}, time.Time{}),
 						),
 					),
 					expectPush(
@@ -6,6 +6,7 @@ import (
 
 	"github.com/caos/zitadel/internal/eventstore"
 
+	"github.com/caos/zitadel/internal/crypto"
 	"github.com/caos/zitadel/internal/domain"
 	caos_errs "github.com/caos/zitadel/internal/errors"
 	"github.com/caos/zitadel/internal/eventstore/v1/models"
@@ -35,11 +36,19 @@ func (c *Commands) AddHuman(ctx context.Context, orgID string, human *domain.Hum
 	if err != nil {
 		return nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-M5Fsd", "Errors.Org.PasswordComplexity.NotFound")
 	}
+	metadata, err := c.humanPreCreationActions(ctx, domain.FlowTypeUserCreation, orgID, human)
+	if err != nil {
+		return nil, err
+	}
 	events, addedHuman, err := c.addHuman(ctx, orgID, human, orgIAMPolicy, pwPolicy)
 	if err != nil {
 		return nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	metadataEvents, err := c.humanMetadataEvents(ctx, addedHuman, metadata)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, append(events, metadataEvents...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +58,9 @@ func (c *Commands) AddHuman(ctx context.Context, orgID string, human *domain.Hum
 		return nil, err
 	}
 
-	return writeModelToHuman(addedHuman), nil
+	createdHuman := writeModelToHuman(addedHuman)
+	c.humanPostCreationActions(ctx, domain.FlowTypeUserCreation, orgID, createdHuman)
+	return createdHuman, nil
 }
 
 func (c *Commands) ImportHuman(ctx context.Context, orgID string, human *domain.Human, passwordless bool) (_ *domain.Human, passwordlessCode *domain.PasswordlessInitCode, err error) {
@@ -64,11 +75,19 @@ func (c *Commands) ImportHuman(ctx context.Context, orgID string, human *domain.
 	if err != nil {
 		return nil, nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-4N8gs", "Errors.Org.PasswordComplexity.NotFound")
 	}
+	metadata, err := c.humanPreCreationActions(ctx, domain.FlowTypeUserCreation, orgID, human)
+	if err != nil {
+		return nil, nil, err
+	}
 	events, addedHuman, addedCode, code, err := c.importHuman(ctx, orgID, human, passwordless, orgIAMPolicy, pwPolicy)
 	if err != nil {
 		return nil, nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	metadataEvents, err := c.humanMetadataEvents(ctx, addedHuman, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, append(events, metadataEvents...)...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +104,9 @@ func (c *Commands) ImportHuman(ctx context.Context, orgID string, human *domain.
 		passwordlessCode = writeModelToPasswordlessInitCode(addedCode, code)
 	}
 
-	return writeModelToHuman(addedHuman), passwordlessCode, nil
+	importedHuman := writeModelToHuman(addedHuman)
+	c.humanPostCreationActions(ctx, domain.FlowTypeUserCreation, orgID, importedHuman)
+	return importedHuman, passwordlessCode, nil
 }
 
 func (c *Commands) addHuman(ctx context.Context, orgID string, human *domain.Human, orgIAMPolicy *domain.OrgIAMPolicy, pwPolicy *domain.PasswordComplexityPolicy) ([]eventstore.Command, *HumanWriteModel, error) {
@@ -102,6 +123,11 @@ func (c *Commands) importHuman(ctx context.Context, orgID string, human *domain.
 	if orgID == "" || !human.IsValid() {
 		return nil, nil, nil, "", caos_errs.ThrowInvalidArgument(nil, "COMMAND-00p2b", "Errors.User.Invalid")
 	}
+	if human.Password != nil && human.EncodedHash != "" {
+		if err = c.importPasswordHash(human.Password); err != nil {
+			return nil, nil, nil, "", err
+		}
+	}
 	events, humanWriteModel, err = c.createHuman(ctx, orgID, human, nil, false, passwordless, orgIAMPolicy, pwPolicy)
 	if err != nil {
 		return nil, nil, nil, "", err
@@ -117,6 +143,24 @@ func (c *Commands) importHuman(ctx context.Context, orgID string, human *domain.
 	return events, humanWriteModel, passwordlessCodeWriteModel, code, nil
 }
 
+//importPasswordHash identifies the algorithm of a password hash created by another system
+//the password is rehashed with the current algorithm on the first login
+func (c *Commands) importPasswordHash(password *domain.Password) error {
+	if password.SecretString != "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hs8nq", "Errors.User.Password.HashAndPasswordSet")
+	}
+	hasher, ok := c.userPasswordAlg.(*crypto.PasswordHasher)
+	if !ok {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hs8mf", "Errors.User.Password.HashNotSupported")
+	}
+	secret, err := hasher.ImportHash(password.EncodedHash)
+	if err != nil {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Hs8ld", "Errors.User.Password.HashNotSupported")
+	}
+	password.SecretCrypto = secret
+	return nil
+}
+
 func (c *Commands) RegisterHuman(ctx context.Context, orgID string, human *domain.Human, link *domain.UserIDPLink, orgMemberRoles []string) (*domain.Human, error) {
 	if orgID == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-GEdf2", "Errors.ResourceOwnerMissing")
@@ -136,10 +180,19 @@ func (c *Commands) RegisterHuman(ctx context.Context, orgID string, human *domai
 	if !loginPolicy.AllowRegister {
 		return nil, caos_errs.ThrowPreconditionFailed(err, "COMMAND-SAbr3", "Errors.Org.LoginPolicy.RegistrationNotAllowed")
 	}
+	metadata, err := c.humanPreCreationActions(ctx, domain.FlowTypeRegistration, orgID, human)
+	if err != nil {
+		return nil, err
+	}
 	userEvents, registeredHuman, err := c.registerHuman(ctx, orgID, human, link, orgIAMPolicy, pwPolicy)
 	if err != nil {
 		return nil, err
 	}
+	metadataEvents, err := c.humanMetadataEvents(ctx, registeredHuman, metadata)
+	if err != nil {
+		return nil, err
+	}
+	userEvents = append(userEvents, metadataEvents...)
 
 	orgMemberWriteModel := NewOrgMemberWriteModel(orgID, registeredHuman.AggregateID)
 	orgAgg := OrgAggregateFromWriteModel(&orgMemberWriteModel.WriteModel)
@@ -167,7 +220,9 @@ func (c *Commands) RegisterHuman(ctx context.Context, orgID string, human *domai
 	if err != nil {
 		return nil, err
 	}
-	return writeModelToHuman(registeredHuman), nil
+	createdHuman := writeModelToHuman(registeredHuman)
+	c.humanPostCreationActions(ctx, domain.FlowTypeRegistration, orgID, createdHuman)
+	return createdHuman, nil
 }
 
 func (c *Commands) registerHuman(ctx context.Context, orgID string, human *domain.Human, link *domain.UserIDPLink, orgIAMPolicy *domain.OrgIAMPolicy, pwPolicy *domain.PasswordComplexityPolicy) ([]eventstore.Command, *HumanWriteModel, error) {
@@ -200,16 +255,21 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 			return nil, nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-SFd21", "Errors.User.DomainNotAllowedAsUsername")
 		}
 	}
-	userID, err := c.idGenerator.Next()
-	if err != nil {
-		return nil, nil, err
+	if human.AggregateID == "" {
+		userID, err := c.idGenerator.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		human.AggregateID = userID
 	}
-	human.AggregateID = userID
 	human.SetNamesAsDisplayname()
 	if human.Password != nil {
 		if err := human.HashPasswordIfExisting(pwPolicy, c.userPasswordAlg, human.ChangeRequired); err != nil {
 			return nil, nil, err
 		}
+		if err := c.checkPasswordBreached(ctx, pwPolicy, human.Password.SecretString); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	addedHuman := NewHumanWriteModel(human.AggregateID, orgID)
@@ -256,6 +316,19 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 	return events, addedHuman, nil
 }
 
+func (c *Commands) humanMetadataEvents(ctx context.Context, human *HumanWriteModel, metadata []*domain.Metadata) ([]eventstore.Command, error) {
+	events := make([]eventstore.Command, len(metadata))
+	userAgg := UserAggregateFromWriteModel(&human.WriteModel)
+	for i, data := range metadata {
+		event, err := c.setUserMetadata(ctx, userAgg, data)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = event
+	}
+	return events, nil
+}
+
 func (c *Commands) HumanSkipMFAInit(ctx context.Context, userID, resourceowner string) (err error) {
 	if userID == "" {
 		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-2xpX9", "Errors.User.UserIDMissing")
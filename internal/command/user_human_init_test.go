@@ -572,9 +572,12 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						[]*repository.Event{
 							eventFromEventPusher(
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/user"
+	"github.com/caos/zitadel/internal/telemetry/tracing"
+)
+
+func (c *Commands) AddHumanOTPEmail(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qe8xa", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingOTP.State == domain.MFAStateReady {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Qe8ar", "Errors.User.MFA.OTPEmail.AlreadyReady")
+	}
+	if !existingOTP.EmailVerified {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qe8pv", "Errors.User.Email.NotVerified")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPEmailAddedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingOTP, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
+}
+
+func (c *Commands) HumanRemoveOTPEmail(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qe8rx", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Qe8rn", "Errors.User.MFA.OTPEmail.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPEmailRemovedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingOTP, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
+}
+
+//HumanSendOTPEmail generates a new one-time code, which is sent to the verified email of the user by the notification handler
+func (c *Commands) HumanSendOTPEmail(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qe8sx", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qe8sr", "Errors.User.MFA.OTPEmail.NotReady")
+	}
+	if !existingOTP.EmailVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qe8sv", "Errors.User.Email.NotVerified")
+	}
+	code, _, err := crypto.NewCode(c.otpEmailCode)
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPEmailCodeAddedEvent(ctx, userAgg, code, c.otpEmailCode.Expiry(), authRequestDomainToAuthRequestInfo(authRequest)))
+	return err
+}
+
+func (c *Commands) HumanOTPEmailCodeSent(ctx context.Context, resourceOwner, userID string) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qe8cx", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qe8cr", "Errors.User.MFA.OTPEmail.NotReady")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPEmailCodeSentEvent(ctx, userAgg))
+	return err
+}
+
+func (c *Commands) HumanCheckOTPEmail(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qe8kx", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qe8ke", "Errors.User.Code.Empty")
+	}
+	existingOTP, err := c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qe8kr", "Errors.User.MFA.OTPEmail.NotReady")
+	}
+	if existingOTP.Code == nil {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qe8kn", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	events, err := checkLockout(ctx, userAgg, &existingOTP.lockoutState, lockoutPolicy)
+	if err != nil {
+		return err
+	}
+	err = crypto.VerifyCode(existingOTP.CodeCreationDate, existingOTP.CodeExpiry, existingOTP.Code, code, c.otpEmailCode)
+	if err == nil {
+		events = append(events, user.NewHumanOTPEmailCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	}
+	events = append(events, user.NewHumanOTPEmailCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	if lockoutPolicy != nil {
+		if locked := lockEvent(ctx, userAgg, &existingOTP.lockoutState, lockoutPolicy.MaxOTPAttempts, lockoutPolicy); locked != nil {
+			events = append(events, locked)
+		}
+	}
+	_, pushErr := c.eventstore.Push(ctx, events...)
+	logging.Log("COMMAND-Qe8kf").OnError(pushErr).Error("error create otp email check failed event")
+	return err
+}
+
+func (c *Commands) otpEmailWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanOTPEmailWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanOTPEmailWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
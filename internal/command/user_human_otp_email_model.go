@@ -0,0 +1,91 @@
+package command
+
+import (
+	"time"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+type HumanOTPEmailWriteModel struct {
+	eventstore.WriteModel
+
+	State         domain.MFAState
+	EmailVerified bool
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	lockoutState
+}
+
+func NewHumanOTPEmailWriteModel(userID, resourceOwner string) *HumanOTPEmailWriteModel {
+	return &HumanOTPEmailWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanOTPEmailWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanEmailChangedEvent:
+			wm.EmailVerified = false
+			wm.Code = nil
+		case *user.HumanEmailVerifiedEvent:
+			wm.EmailVerified = true
+		case *user.HumanOTPEmailAddedEvent:
+			wm.State = domain.MFAStateReady
+		case *user.HumanOTPEmailRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.Code = nil
+			wm.resetFailed()
+		case *user.HumanOTPEmailCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+		case *user.HumanOTPEmailCheckFailedEvent:
+			wm.reduceCheckFailed(e)
+		case *user.HumanOTPEmailCheckSucceededEvent:
+			wm.Code = nil
+			wm.resetFailed()
+		case *user.UserLockedEvent:
+			wm.reduceLocked(e)
+		case *user.UserUnlockedEvent:
+			wm.reduceUnlocked()
+		case *user.UserRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.EmailVerified = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanOTPEmailWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanEmailChangedType,
+			user.HumanEmailVerifiedType,
+			user.HumanMFAOTPEmailAddedType,
+			user.HumanMFAOTPEmailRemovedType,
+			user.HumanMFAOTPEmailCodeAddedType,
+			user.HumanMFAOTPEmailCheckSucceededType,
+			user.HumanMFAOTPEmailCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType,
+			user.UserV1EmailChangedType,
+			user.UserV1EmailVerifiedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -12,6 +12,7 @@ type HumanOTPWriteModel struct {
 
 	State  domain.MFAState
 	Secret *crypto.CryptoValue
+	lockoutState
 }
 
 func NewHumanOTPWriteModel(userID, resourceOwner string) *HumanOTPWriteModel {
@@ -33,6 +34,15 @@ func (wm *HumanOTPWriteModel) Reduce() error {
 			wm.State = domain.MFAStateReady
 		case *user.HumanOTPRemovedEvent:
 			wm.State = domain.MFAStateRemoved
+			wm.resetFailed()
+		case *user.HumanOTPCheckFailedEvent:
+			wm.reduceCheckFailed(e)
+		case *user.HumanOTPCheckSucceededEvent:
+			wm.resetFailed()
+		case *user.UserLockedEvent:
+			wm.reduceLocked(e)
+		case *user.UserUnlockedEvent:
+			wm.reduceUnlocked()
 		case *user.UserRemovedEvent:
 			wm.State = domain.MFAStateRemoved
 		}
@@ -48,10 +58,16 @@ func (wm *HumanOTPWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(user.HumanMFAOTPAddedType,
 			user.HumanMFAOTPVerifiedType,
 			user.HumanMFAOTPRemovedType,
+			user.HumanMFAOTPCheckSucceededType,
+			user.HumanMFAOTPCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
 			user.UserRemovedType,
 			user.UserV1MFAOTPAddedType,
 			user.UserV1MFAOTPVerifiedType,
-			user.UserV1MFAOTPRemovedType).
+			user.UserV1MFAOTPRemovedType,
+			user.UserV1MFAOTPCheckSucceededType,
+			user.UserV1MFAOTPCheckFailedType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
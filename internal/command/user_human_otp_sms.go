@@ -0,0 +1,152 @@
+package command
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/user"
+	"github.com/caos/zitadel/internal/telemetry/tracing"
+)
+
+func (c *Commands) AddHumanOTPSMS(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qs8xa", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingOTP.State == domain.MFAStateReady {
+		return nil, caos_errs.ThrowAlreadyExists(nil, "COMMAND-Qs8ar", "Errors.User.MFA.OTPSMS.AlreadyReady")
+	}
+	if !existingOTP.PhoneVerified {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qs8pv", "Errors.User.Phone.NotVerified")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPSMSAddedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingOTP, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
+}
+
+func (c *Commands) HumanRemoveOTPSMS(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qs8rx", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Qs8rn", "Errors.User.MFA.OTPSMS.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPSMSRemovedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingOTP, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
+}
+
+//HumanSendOTPSMS generates a new one-time code, which is sent to the verified phone of the user by the notification handler
+func (c *Commands) HumanSendOTPSMS(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qs8sx", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qs8sr", "Errors.User.MFA.OTPSMS.NotReady")
+	}
+	if !existingOTP.PhoneVerified {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qs8sv", "Errors.User.Phone.NotVerified")
+	}
+	code, _, err := crypto.NewCode(c.otpSMSCode)
+	if err != nil {
+		return err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPSMSCodeAddedEvent(ctx, userAgg, code, c.otpSMSCode.Expiry(), authRequestDomainToAuthRequestInfo(authRequest)))
+	return err
+}
+
+func (c *Commands) HumanOTPSMSCodeSent(ctx context.Context, resourceOwner, userID string) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qs8cx", "Errors.User.UserIDMissing")
+	}
+	existingOTP, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qs8cr", "Errors.User.MFA.OTPSMS.NotReady")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanOTPSMSCodeSentEvent(ctx, userAgg))
+	return err
+}
+
+func (c *Commands) HumanCheckOTPSMS(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qs8kx", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Qs8ke", "Errors.User.Code.Empty")
+	}
+	existingOTP, err := c.otpSMSWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingOTP.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qs8kr", "Errors.User.MFA.OTPSMS.NotReady")
+	}
+	if existingOTP.Code == nil {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Qs8kn", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	events, err := checkLockout(ctx, userAgg, &existingOTP.lockoutState, lockoutPolicy)
+	if err != nil {
+		return err
+	}
+	err = crypto.VerifyCode(existingOTP.CodeCreationDate, existingOTP.CodeExpiry, existingOTP.Code, code, c.otpSMSCode)
+	if err == nil {
+		events = append(events, user.NewHumanOTPSMSCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	}
+	events = append(events, user.NewHumanOTPSMSCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	if lockoutPolicy != nil {
+		if locked := lockEvent(ctx, userAgg, &existingOTP.lockoutState, lockoutPolicy.MaxOTPAttempts, lockoutPolicy); locked != nil {
+			events = append(events, locked)
+		}
+	}
+	_, pushErr := c.eventstore.Push(ctx, events...)
+	logging.Log("COMMAND-Qs8kf").OnError(pushErr).Error("error create otp sms check failed event")
+	return err
+}
+
+func (c *Commands) otpSMSWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanOTPSMSWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanOTPSMSWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
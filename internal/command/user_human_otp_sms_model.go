@@ -0,0 +1,99 @@
+package command
+
+import (
+	"time"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+type HumanOTPSMSWriteModel struct {
+	eventstore.WriteModel
+
+	State         domain.MFAState
+	PhoneVerified bool
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	lockoutState
+}
+
+func NewHumanOTPSMSWriteModel(userID, resourceOwner string) *HumanOTPSMSWriteModel {
+	return &HumanOTPSMSWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanOTPSMSWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanPhoneChangedEvent:
+			wm.PhoneVerified = false
+			wm.Code = nil
+		case *user.HumanPhoneVerifiedEvent:
+			wm.PhoneVerified = true
+		case *user.HumanPhoneRemovedEvent:
+			wm.PhoneVerified = false
+			wm.Code = nil
+			if wm.State == domain.MFAStateReady {
+				wm.State = domain.MFAStateRemoved
+			}
+		case *user.HumanOTPSMSAddedEvent:
+			wm.State = domain.MFAStateReady
+		case *user.HumanOTPSMSRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.Code = nil
+			wm.resetFailed()
+		case *user.HumanOTPSMSCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+		case *user.HumanOTPSMSCheckFailedEvent:
+			wm.reduceCheckFailed(e)
+		case *user.HumanOTPSMSCheckSucceededEvent:
+			wm.Code = nil
+			wm.resetFailed()
+		case *user.UserLockedEvent:
+			wm.reduceLocked(e)
+		case *user.UserUnlockedEvent:
+			wm.reduceUnlocked()
+		case *user.UserRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.PhoneVerified = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanOTPSMSWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanPhoneChangedType,
+			user.HumanPhoneVerifiedType,
+			user.HumanPhoneRemovedType,
+			user.HumanMFAOTPSMSAddedType,
+			user.HumanMFAOTPSMSRemovedType,
+			user.HumanMFAOTPSMSCodeAddedType,
+			user.HumanMFAOTPSMSCheckSucceededType,
+			user.HumanMFAOTPSMSCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType,
+			user.UserV1PhoneChangedType,
+			user.UserV1PhoneVerifiedType,
+			user.UserV1PhoneRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/logging"
 
@@ -142,12 +143,68 @@ func (c *Commands) changePassword(ctx context.Context, userAgentID string, passw
 	if err != nil {
 		return nil, err
 	}
+	//the cheap checks run before the new password is hashed
+	if err := password.CheckComplexity(pwPolicy); err != nil {
+		return nil, err
+	}
+	if err := c.checkPasswordBreached(ctx, pwPolicy, password.SecretString); err != nil {
+		return nil, err
+	}
+	if err := c.checkPasswordHistory(ctx, userAgg.ResourceOwner, password.SecretString, existingPassword); err != nil {
+		return nil, err
+	}
 	if err := password.HashPasswordIfExisting(pwPolicy, c.userPasswordAlg); err != nil {
 		return nil, err
 	}
 	return user.NewHumanPasswordChangedEvent(ctx, userAgg, password.SecretCrypto, password.ChangeRequired, userAgentID), nil
 }
 
+//checkPasswordHistory rejects passwords matching one of the last passwords
+//as defined in the password history policy of the org
+func (c *Commands) checkPasswordHistory(ctx context.Context, orgID, passwordString string, existingPassword *HumanPasswordWriteModel) (err error) {
+	if passwordString == "" {
+		return nil
+	}
+	historyPolicy, err := c.getOrgPasswordHistoryPolicy(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !historyPolicy.IsEnabled() {
+		return nil
+	}
+	//policies stored before the history count was limited may exceed the max
+	historyCount := historyPolicy.HistoryCount
+	if historyCount > domain.MaxPasswordHistoryCount {
+		historyCount = domain.MaxPasswordHistoryCount
+	}
+	ctx, spanPasswordComparison := tracing.NewNamedSpan(ctx, "crypto.CompareHash")
+	defer func() { spanPasswordComparison.EndWithError(err) }()
+	for _, secret := range existingPassword.RecentSecrets(historyCount) {
+		if crypto.CompareHash(secret, []byte(passwordString), c.userPasswordAlg) == nil {
+			return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Wq7nd", "Errors.User.Password.Reused")
+		}
+	}
+	return nil
+}
+
+//checkPasswordBreached rejects passwords found in known data breaches
+//if the complexity policy requires it and a breach checker is configured
+func (c *Commands) checkPasswordBreached(ctx context.Context, pwPolicy *domain.PasswordComplexityPolicy, passwordString string) (err error) {
+	if passwordString == "" || pwPolicy == nil || !pwPolicy.CheckBreached || c.passwordBreachChecker == nil {
+		return nil
+	}
+	ctx, span := tracing.NewNamedSpan(ctx, "breach.IsBreached")
+	defer func() { span.EndWithError(err) }()
+	breached, err := c.passwordBreachChecker.IsBreached(ctx, passwordString)
+	if err != nil {
+		return err
+	}
+	if breached {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Bq83n", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
+	return nil
+}
+
 func (c *Commands) RequestSetPassword(ctx context.Context, userID, resourceOwner string, notifyType domain.NotificationType) (objectDetails *domain.ObjectDetails, err error) {
 	if userID == "" {
 		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-M00oL", "Errors.User.UserIDMissing")
@@ -196,6 +253,48 @@ func (c *Commands) PasswordCodeSent(ctx context.Context, orgID, userID string) (
 	return err
 }
 
+//RequestPasswordExpiryNotification notifies the user once per password
+//as soon as the password is within the warn period of the password age policy
+//the notification is only added if the user didn't change in the meantime
+func (c *Commands) RequestPasswordExpiryNotification(ctx context.Context, orgID, userID string, policy *domain.PasswordAgePolicy) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Fw3gn", "Errors.User.UserIDMissing")
+	}
+
+	existingPassword := NewHumanPasswordExpiryWriteModel(userID, orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, existingPassword)
+	if err != nil {
+		return err
+	}
+	if existingPassword.UserState == domain.UserStateUnspecified || existingPassword.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Gm2sQ", "Errors.User.NotFound")
+	}
+	if existingPassword.Secret == nil || existingPassword.ExpiryNotified || !policy.IsInWarnPeriod(existingPassword.SecretChanged, time.Now()) {
+		return nil
+	}
+	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	userAgg.PreviousSequence = existingPassword.ProcessedSequence
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordExpiryNotificationAddedEvent(ctx, userAgg, policy.ExpiryDate(existingPassword.SecretChanged)))
+	return err
+}
+
+func (c *Commands) PasswordExpiryNotificationSent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-s0Pqe", "Errors.User.UserIDMissing")
+	}
+
+	existingPassword, err := c.passwordWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if existingPassword.UserState == domain.UserStateUnspecified || existingPassword.UserState == domain.UserStateDeleted {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Kd93n", "Errors.User.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordExpiryNotificationSentEvent(ctx, userAgg))
+	return err
+}
+
 func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, password string, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -228,26 +327,51 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	}
 
 	userAgg := UserAggregateFromWriteModel(&existingPassword.WriteModel)
+	events, err := checkLockout(ctx, userAgg, &existingPassword.lockoutState, lockoutPolicy)
+	if err != nil {
+		return err
+	}
 	ctx, spanPasswordComparison := tracing.NewNamedSpan(ctx, "crypto.CompareHash")
 	err = crypto.CompareHash(existingPassword.Secret, []byte(password), c.userPasswordAlg)
 	spanPasswordComparison.EndWithError(err)
 	if err == nil {
-		_, err = c.eventstore.Push(ctx, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		events = append(events, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+		var agentID string
+		if authRequest != nil {
+			agentID = authRequest.AgentID
+		}
+		if rehashed := c.rehashPassword(ctx, userAgg, existingPassword, password, agentID); rehashed != nil {
+			events = append(events, rehashed)
+		}
+		_, err = c.eventstore.Push(ctx, events...)
 		return err
 	}
-	events := make([]eventstore.Command, 0)
 	events = append(events, user.NewHumanPasswordCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
-	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 {
-		if existingPassword.PasswordCheckFailedCount+1 >= lockoutPolicy.MaxPasswordAttempts {
-			events = append(events, user.NewUserLockedEvent(ctx, userAgg))
+	if lockoutPolicy != nil {
+		if locked := lockEvent(ctx, userAgg, &existingPassword.lockoutState, lockoutPolicy.MaxPasswordAttempts, lockoutPolicy); locked != nil {
+			events = append(events, locked)
 		}
-
 	}
 	_, err = c.eventstore.Push(ctx, events...)
 	logging.Log("COMMAND-9fj7s").OnError(err).Error("error create password check failed event")
 	return caos_errs.ThrowInvalidArgument(nil, "COMMAND-452ad", "Errors.User.Password.Invalid")
 }
 
+//rehashPassword returns an event storing the password hashed with the current algorithm
+//if the existing hash uses another algorithm or outdated parameters
+func (c *Commands) rehashPassword(ctx context.Context, userAgg *eventstore.Aggregate, existingPassword *HumanPasswordWriteModel, password, userAgentID string) eventstore.Command {
+	hasher, ok := c.userPasswordAlg.(*crypto.PasswordHasher)
+	if !ok || !hasher.NeedsRehash(existingPassword.Secret) {
+		return nil
+	}
+	secret, err := crypto.Hash([]byte(password), hasher)
+	if err != nil {
+		logging.Log("COMMAND-Rh8sd").WithError(err).Warn("unable to rehash password")
+		return nil
+	}
+	return user.NewHumanPasswordRehashedEvent(ctx, userAgg, secret, existingPassword.SecretChangeRequired, userAgentID)
+}
+
 func (c *Commands) passwordWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPasswordWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
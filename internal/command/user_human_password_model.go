@@ -13,13 +13,17 @@ import (
 type HumanPasswordWriteModel struct {
 	eventstore.WriteModel
 
-	Secret               *crypto.CryptoValue
+	Secret *crypto.CryptoValue
+	//SecretHistory contains all secrets set, the current secret last
+	SecretHistory        []*crypto.CryptoValue
 	SecretChangeRequired bool
+	SecretChanged        time.Time
+	ExpiryNotified       bool
 
-	Code                     *crypto.CryptoValue
-	CodeCreationDate         time.Time
-	CodeExpiry               time.Duration
-	PasswordCheckFailedCount uint64
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	lockoutState
 
 	UserState domain.UserState
 }
@@ -38,21 +42,32 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *user.HumanAddedEvent:
 			wm.Secret = e.Secret
+			wm.appendSecretHistory(e.Secret)
 			wm.SecretChangeRequired = e.ChangeRequired
+			wm.SecretChanged = e.CreationDate()
 			wm.UserState = domain.UserStateActive
 		case *user.HumanRegisteredEvent:
 			wm.Secret = e.Secret
+			wm.appendSecretHistory(e.Secret)
 			wm.SecretChangeRequired = e.ChangeRequired
+			wm.SecretChanged = e.CreationDate()
 			wm.UserState = domain.UserStateActive
 		case *user.HumanInitialCodeAddedEvent:
 			wm.UserState = domain.UserStateInitial
 		case *user.HumanInitializedCheckSucceededEvent:
 			wm.UserState = domain.UserStateActive
 		case *user.HumanPasswordChangedEvent:
+			if e.Rehashed {
+				wm.reduceRehashed(e.Secret)
+				continue
+			}
 			wm.Secret = e.Secret
+			wm.appendSecretHistory(e.Secret)
 			wm.SecretChangeRequired = e.ChangeRequired
+			wm.SecretChanged = e.CreationDate()
+			wm.ExpiryNotified = false
 			wm.Code = nil
-			wm.PasswordCheckFailedCount = 0
+			wm.resetFailed()
 		case *user.HumanPasswordCodeAddedEvent:
 			wm.Code = e.Code
 			wm.CodeCreationDate = e.CreationDate()
@@ -62,11 +77,15 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 				wm.UserState = domain.UserStateActive
 			}
 		case *user.HumanPasswordCheckFailedEvent:
-			wm.PasswordCheckFailedCount += 1
+			wm.reduceCheckFailed(e)
 		case *user.HumanPasswordCheckSucceededEvent:
-			wm.PasswordCheckFailedCount = 0
+			wm.resetFailed()
+		case *user.UserLockedEvent:
+			wm.reduceLocked(e)
 		case *user.UserUnlockedEvent:
-			wm.PasswordCheckFailedCount = 0
+			wm.reduceUnlocked()
+		case *user.HumanPasswordExpiryNotificationAddedEvent:
+			wm.ExpiryNotified = true
 		case *user.UserRemovedEvent:
 			wm.UserState = domain.UserStateDeleted
 		}
@@ -88,7 +107,9 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanEmailVerifiedType,
 			user.HumanPasswordCheckFailedType,
 			user.HumanPasswordCheckSucceededType,
+			user.HumanPasswordExpiryNotificationAddedType,
 			user.UserRemovedType,
+			user.UserLockedType,
 			user.UserUnlockedType,
 			user.UserV1AddedType,
 			user.UserV1RegisteredType,
@@ -106,3 +127,51 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 	}
 	return query
 }
+
+//HumanPasswordExpiryWriteModel reduces all events of the user
+//so the processed sequence is the latest sequence of the user aggregate
+type HumanPasswordExpiryWriteModel struct {
+	HumanPasswordWriteModel
+}
+
+func NewHumanPasswordExpiryWriteModel(userID, resourceOwner string) *HumanPasswordExpiryWriteModel {
+	return &HumanPasswordExpiryWriteModel{
+		HumanPasswordWriteModel: *NewHumanPasswordWriteModel(userID, resourceOwner),
+	}
+}
+
+func (wm *HumanPasswordExpiryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *HumanPasswordWriteModel) appendSecretHistory(secret *crypto.CryptoValue) {
+	if secret == nil {
+		return
+	}
+	wm.SecretHistory = append(wm.SecretHistory, secret)
+}
+
+//reduceRehashed replaces the current secret without changing its history or age
+func (wm *HumanPasswordWriteModel) reduceRehashed(secret *crypto.CryptoValue) {
+	wm.Secret = secret
+	if len(wm.SecretHistory) > 0 {
+		wm.SecretHistory[len(wm.SecretHistory)-1] = secret
+	}
+}
+
+//RecentSecrets returns the last count secrets, the current secret included
+func (wm *HumanPasswordWriteModel) RecentSecrets(count uint64) []*crypto.CryptoValue {
+	if uint64(len(wm.SecretHistory)) <= count {
+		return wm.SecretHistory
+	}
+	return wm.SecretHistory[uint64(len(wm.SecretHistory))-count:]
+}
@@ -0,0 +1,149 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/caos/logging"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/user"
+	"github.com/caos/zitadel/internal/telemetry/tracing"
+)
+
+//AddHumanRecoveryCodes generates a new set of single-use recovery codes, which replaces all existing codes of the user
+//the codes are only stored hashed and therefore returned in plain text only once
+func (c *Commands) AddHumanRecoveryCodes(ctx context.Context, userID, resourceOwner string) (*domain.RecoveryCodes, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc8xa", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingCodes.UserState == domain.UserStateUnspecified || existingCodes.UserState == domain.UserStateDeleted {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rc8un", "Errors.User.NotFound")
+	}
+	hashedCodes := make([]*crypto.CryptoValue, c.multifactors.RecoveryCodes.Count)
+	plainCodes := make([]string, c.multifactors.RecoveryCodes.Count)
+	for i := range hashedCodes {
+		hashedCodes[i], plainCodes[i], err = crypto.NewCode(c.recoveryCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, hashedCodes))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingCodes, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RecoveryCodes{
+		ObjectRoot: writeModelToObjectRoot(existingCodes.WriteModel),
+		Codes:      plainCodes,
+	}, nil
+}
+
+func (c *Commands) HumanRemoveRecoveryCodes(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc8rx", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Rc8rn", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanRecoveryCodesRemovedEvent(ctx, userAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingCodes, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingCodes.WriteModel), nil
+}
+
+//HumanCheckRecoveryCode verifies the code against all unused recovery codes of the user
+//if the amount of remaining codes reaches the configured threshold, the user will be notified once per set
+func (c *Commands) HumanCheckRecoveryCode(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest, lockoutPolicy *domain.LockoutPolicy) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc8kx", "Errors.User.UserIDMissing")
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc8ke", "Errors.User.Code.Empty")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rc8kr", "Errors.User.MFA.RecoveryCodes.NotReady")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	events, err := checkLockout(ctx, userAgg, &existingCodes.lockoutState, lockoutPolicy)
+	if err != nil {
+		return err
+	}
+	for i, hashedCode := range existingCodes.Codes {
+		if existingCodes.UsedCodes[i] {
+			continue
+		}
+		if crypto.VerifyCode(time.Time{}, 0, hashedCode, code, c.recoveryCode) != nil {
+			continue
+		}
+		events = append(events, user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, i, authRequestDomainToAuthRequestInfo(authRequest)))
+		remaining := existingCodes.RemainingCodes() - 1
+		if !existingCodes.LowNotified && remaining <= c.multifactors.RecoveryCodes.LowThreshold {
+			events = append(events, user.NewHumanRecoveryCodesLowNotificationAddedEvent(ctx, userAgg, remaining))
+		}
+		_, err = c.eventstore.Push(ctx, events...)
+		return err
+	}
+	events = append(events, user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	if lockoutPolicy != nil {
+		if locked := lockEvent(ctx, userAgg, &existingCodes.lockoutState, lockoutPolicy.MaxOTPAttempts, lockoutPolicy); locked != nil {
+			events = append(events, locked)
+		}
+	}
+	_, pushErr := c.eventstore.Push(ctx, events...)
+	logging.Log("COMMAND-Rc8kf").OnError(pushErr).Error("error create recovery code check failed event")
+	return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc8ki", "Errors.User.MFA.RecoveryCodes.InvalidCode")
+}
+
+func (c *Commands) HumanRecoveryCodesLowNotificationSent(ctx context.Context, resourceOwner, userID string) error {
+	if userID == "" {
+		return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Rc8nx", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Rc8nr", "Errors.User.MFA.RecoveryCodes.NotReady")
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanRecoveryCodesLowNotificationSentEvent(ctx, userAgg))
+	return err
+}
+
+func (c *Commands) recoveryCodesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanRecoveryCodesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+type HumanRecoveryCodesWriteModel struct {
+	eventstore.WriteModel
+
+	UserState domain.UserState
+	State     domain.MFAState
+
+	Codes       []*crypto.CryptoValue
+	UsedCodes   []bool
+	LowNotified bool
+	lockoutState
+}
+
+func NewHumanRecoveryCodesWriteModel(userID, resourceOwner string) *HumanRecoveryCodesWriteModel {
+	return &HumanRecoveryCodesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRecoveryCodesAddedEvent:
+			wm.State = domain.MFAStateReady
+			wm.Codes = e.Codes
+			wm.UsedCodes = make([]bool, len(e.Codes))
+			wm.LowNotified = false
+			wm.resetFailed()
+		case *user.HumanRecoveryCodesRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.Codes = nil
+			wm.UsedCodes = nil
+			wm.resetFailed()
+		case *user.HumanRecoveryCodeCheckSucceededEvent:
+			if e.CodeIndex >= 0 && e.CodeIndex < len(wm.UsedCodes) {
+				wm.UsedCodes[e.CodeIndex] = true
+			}
+			wm.resetFailed()
+		case *user.HumanRecoveryCodeCheckFailedEvent:
+			wm.reduceCheckFailed(e)
+		case *user.HumanRecoveryCodesLowNotificationAddedEvent:
+			wm.LowNotified = true
+		case *user.UserLockedEvent:
+			wm.reduceLocked(e)
+		case *user.UserUnlockedEvent:
+			wm.reduceUnlocked()
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.State = domain.MFAStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.HumanMFARecoveryCodesAddedType,
+			user.HumanMFARecoveryCodesRemovedType,
+			user.HumanMFARecoveryCodeCheckSucceededType,
+			user.HumanMFARecoveryCodeCheckFailedType,
+			user.HumanMFARecoveryCodesLowNotificationAddedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+//RemainingCodes returns the amount of codes which were not used yet
+func (wm *HumanRecoveryCodesWriteModel) RemainingCodes() int {
+	remaining := 0
+	for _, used := range wm.UsedCodes {
+		if !used {
+			remaining++
+		}
+	}
+	return remaining
+}
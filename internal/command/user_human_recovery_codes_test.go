@@ -0,0 +1,775 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+func TestCommandSide_AddHumanRecoveryCodes(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		recoveryCode crypto.Generator
+		multifactors domain.MultifactorConfigs
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.RecoveryCodes
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "add recovery codes, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									[]*crypto.CryptoValue{
+										{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    []byte("a"),
+										},
+										{
+											CryptoType: crypto.TypeEncryption,
+											Algorithm:  "enc",
+											KeyID:      "id",
+											Crypted:    []byte("a"),
+										},
+									},
+								),
+							),
+						},
+					),
+				),
+				recoveryCode: GetMockSecretGenerator(t),
+				multifactors: domain.MultifactorConfigs{
+					RecoveryCodes: domain.RecoveryCodesConfig{Count: 2},
+				},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.RecoveryCodes{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Codes: []string{"a", "a"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				recoveryCode: tt.fields.recoveryCode,
+				multifactors: tt.fields.multifactors,
+			}
+			got, err := r.AddHumanRecoveryCodes(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_HumanRemoveRecoveryCodes(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "recovery codes not existing, not found error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: caos_errs.IsNotFound,
+			},
+		},
+		{
+			name: "remove recovery codes, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]*crypto.CryptoValue{
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+								},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodesRemovedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.HumanRemoveRecoveryCodes(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_HumanCheckRecoveryCode(t *testing.T) {
+	type fields struct {
+		eventstore   *eventstore.Eventstore
+		recoveryCode crypto.Generator
+		multifactors domain.MultifactorConfigs
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		code          string
+		resourceOwner string
+		authRequest   *domain.AuthRequest
+		lockoutPolicy *domain.LockoutPolicy
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "code missing, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          " ",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "recovery codes not existing, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "a",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "invalid code, check failed and user locked",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]*crypto.CryptoValue{
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("b"),
+									},
+								},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckFailedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+								),
+							),
+							eventFromEventPusher(
+								user.NewUserLockedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+				recoveryCode: GetMockSecretGenerator(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "c",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+				lockoutPolicy: &domain.LockoutPolicy{MaxOTPAttempts: 1},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "code already used, check failed",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]*crypto.CryptoValue{
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("b"),
+									},
+								},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckFailedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+								),
+							),
+						},
+					),
+				),
+				recoveryCode: GetMockSecretGenerator(t),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "a",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "valid code, check succeeded",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]*crypto.CryptoValue{
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("b"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("c"),
+									},
+								},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									2,
+									&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+								),
+							),
+						},
+					),
+				),
+				recoveryCode: GetMockSecretGenerator(t),
+				multifactors: domain.MultifactorConfigs{
+					RecoveryCodes: domain.RecoveryCodesConfig{LowThreshold: 0},
+				},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "c",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+			},
+			res: res{},
+		},
+		{
+			name: "valid code and few codes remaining, check succeeded and notification added",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]*crypto.CryptoValue{
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("b"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("c"),
+									},
+								},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									1,
+									&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+								),
+							),
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodesLowNotificationAddedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									1,
+								),
+							),
+						},
+					),
+				),
+				recoveryCode: GetMockSecretGenerator(t),
+				multifactors: domain.MultifactorConfigs{
+					RecoveryCodes: domain.RecoveryCodesConfig{LowThreshold: 1},
+				},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "b",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+			},
+			res: res{},
+		},
+		{
+			name: "valid code and already notified, check succeeded",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]*crypto.CryptoValue{
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("b"),
+									},
+									{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("c"),
+									},
+								},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesLowNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								user.NewHumanRecoveryCodeCheckSucceededEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate,
+									2,
+									&user.AuthRequestInfo{ID: "authRequestID", UserAgentID: "agentID"},
+								),
+							),
+						},
+					),
+				),
+				recoveryCode: GetMockSecretGenerator(t),
+				multifactors: domain.MultifactorConfigs{
+					RecoveryCodes: domain.RecoveryCodesConfig{LowThreshold: 1},
+				},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				code:          "c",
+				resourceOwner: "org1",
+				authRequest:   &domain.AuthRequest{ID: "authRequestID", AgentID: "agentID"},
+			},
+			res: res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:   tt.fields.eventstore,
+				recoveryCode: tt.fields.recoveryCode,
+				multifactors: tt.fields.multifactors,
+			}
+			err := r.HumanCheckRecoveryCode(tt.args.ctx, tt.args.userID, tt.args.code, tt.args.resourceOwner, tt.args.authRequest, tt.args.lockoutPolicy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
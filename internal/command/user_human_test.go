@@ -152,6 +152,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -193,6 +194,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -287,6 +289,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -374,6 +377,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -455,6 +459,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -554,6 +559,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -784,6 +790,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -825,6 +832,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -913,6 +921,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -995,6 +1004,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1099,6 +1109,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1207,6 +1218,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1311,6 +1323,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1388,6 +1401,195 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "add human with password hash, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgIAMPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								newAddHumanEventWithHash(&crypto.CryptoValue{
+									CryptoType: crypto.TypeHash,
+									Algorithm:  "ssha",
+									Crypted:    []byte("{SSHA}yI6cZwQadOA1e+/f+T+H3eCQQhRzYWx0"),
+								}),
+							),
+							eventFromEventPusher(
+								user.NewHumanEmailVerifiedEvent(context.Background(),
+									&user.NewAggregate("user1", "org1").Aggregate),
+							),
+						},
+						uniqueConstraintsFromEventConstraint(user.NewAddUsernameUniqueConstraint("username", "org1", true)),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+				secretGenerator: GetMockSecretGenerator(t),
+				userPasswordAlg: crypto.NewPasswordHasher(crypto.CreateMockHashAlg(gomock.NewController(t)), crypto.NewSaltedSHA1()),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &domain.Human{
+					Username: "username",
+					Password: &domain.Password{
+						EncodedHash: "{SSHA}yI6cZwQadOA1e+/f+T+H3eCQQhRzYWx0",
+					},
+					Profile: &domain.Profile{
+						FirstName: "firstname",
+						LastName:  "lastname",
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+				},
+			},
+			res: res{
+				wantHuman: &domain.Human{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "user1",
+						ResourceOwner: "org1",
+					},
+					Username: "username",
+					Profile: &domain.Profile{
+						FirstName:         "firstname",
+						LastName:          "lastname",
+						DisplayName:       "firstname lastname",
+						PreferredLanguage: language.Und,
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+					State: domain.UserStateActive,
+				},
+			},
+		},
+		{
+			name: "add human with unsupported password hash, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgIAMPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+				),
+				userPasswordAlg: crypto.NewPasswordHasher(crypto.CreateMockHashAlg(gomock.NewController(t)), crypto.NewSaltedSHA1()),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &domain.Human{
+					Username: "username",
+					Password: &domain.Password{
+						EncodedHash: "{MD5}X03MO1qnZdYdgyfeuILPmQ==",
+					},
+					Profile: &domain.Profile{
+						FirstName: "firstname",
+						LastName:  "lastname",
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add human with password and password hash, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgIAMPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								false,
+							),
+						),
+					),
+				),
+				userPasswordAlg: crypto.NewPasswordHasher(crypto.CreateMockHashAlg(gomock.NewController(t)), crypto.NewSaltedSHA1()),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &domain.Human{
+					Username: "username",
+					Password: &domain.Password{
+						SecretString: "password",
+						EncodedHash:  "{SSHA}yI6cZwQadOA1e+/f+T+H3eCQQhRzYWx0",
+					},
+					Profile: &domain.Profile{
+						FirstName: "firstname",
+						LastName:  "lastname",
+					},
+					Email: &domain.Email{
+						EmailAddress:    "email@test.ch",
+						IsEmailVerified: true,
+					},
+				},
+			},
+			res: res{
+				err: caos_errs.IsErrorInvalidArgument,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1553,6 +1755,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1599,6 +1802,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1656,6 +1860,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1713,6 +1918,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1787,6 +1993,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1919,6 +2126,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2019,6 +2227,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2113,6 +2322,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2229,6 +2439,7 @@ func TestCommandSide_RegisterHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -2679,6 +2890,12 @@ func newAddHumanEvent(password string, changeRequired bool, phone string) *user.
 	return event
 }
 
+func newAddHumanEventWithHash(hash *crypto.CryptoValue) *user.HumanAddedEvent {
+	event := newAddHumanEvent("", false, "")
+	event.AddPasswordData(hash, false)
+	return event
+}
+
 func newRegisterHumanEvent(username, password string, changeRequired bool, phone string) *user.HumanRegisteredEvent {
 	event := user.NewHumanRegisteredEvent(context.Background(),
 		&user.NewAggregate("user1", "org1").Aggregate,
@@ -357,7 +357,7 @@ func (c *Commands) beginWebAuthNLogin(ctx context.Context, userID, resourceOwner
 	return userAgg, webAuthNLogin, nil
 }
 
-func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest, isLoginUI bool) error {
+func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest, isLoginUI bool, lockoutPolicy *domain.LockoutPolicy) error {
 	webAuthNLogin, err := c.getHumanU2FLogin(ctx, userID, authRequest.ID, resourceOwner)
 	if err != nil {
 		return err
@@ -367,24 +367,35 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 		return err
 	}
 
+	lockout, events, err := c.webAuthNLockout(ctx, userID, resourceOwner, lockoutPolicy)
+	if err != nil {
+		return err
+	}
+
 	userAgg, token, signCount, err := c.finishWebAuthNLogin(ctx, userID, resourceOwner, credentialData, webAuthNLogin, u2fTokens, isLoginUI)
 	if err != nil {
 		if userAgg == nil {
 			logging.LogWithFields("EVENT-Addqd", "userID", userID, "resourceOwner", resourceOwner).WithError(err).Warn("missing userAggregate for pushing failed u2f check event")
 			return err
 		}
-		_, pushErr := c.eventstore.Push(ctx,
+		events = append(events,
 			usr_repo.NewHumanU2FCheckFailedEvent(
 				ctx,
 				userAgg,
 				authRequestDomainToAuthRequestInfo(authRequest),
 			),
 		)
+		if lockoutPolicy != nil {
+			if locked := lockEvent(ctx, userAgg, &lockout.lockoutState, lockoutPolicy.MaxU2FAttempts, lockoutPolicy); locked != nil {
+				events = append(events, locked)
+			}
+		}
+		_, pushErr := c.eventstore.Push(ctx, events...)
 		logging.LogWithFields("EVENT-Bdgd2", "userID", userID, "resourceOwner", resourceOwner).OnError(pushErr).Warn("could not push failed u2f check event")
 		return err
 	}
 
-	_, err = c.eventstore.Push(ctx,
+	events = append(events,
 		usr_repo.NewHumanU2FCheckSucceededEvent(
 			ctx,
 			userAgg,
@@ -397,11 +408,12 @@ func (c *Commands) HumanFinishU2FLogin(ctx context.Context, userID, resourceOwne
 			signCount,
 		),
 	)
+	_, err = c.eventstore.Push(ctx, events...)
 
 	return err
 }
 
-func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest, isLoginUI bool) error {
+func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, authRequest *domain.AuthRequest, isLoginUI bool, lockoutPolicy *domain.LockoutPolicy) error {
 	webAuthNLogin, err := c.getHumanPasswordlessLogin(ctx, userID, authRequest.ID, resourceOwner)
 	if err != nil {
 		return err
@@ -412,24 +424,35 @@ func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, res
 		return err
 	}
 
+	lockout, events, err := c.webAuthNLockout(ctx, userID, resourceOwner, lockoutPolicy)
+	if err != nil {
+		return err
+	}
+
 	userAgg, token, signCount, err := c.finishWebAuthNLogin(ctx, userID, resourceOwner, credentialData, webAuthNLogin, passwordlessTokens, isLoginUI)
 	if err != nil {
 		if userAgg == nil {
 			logging.LogWithFields("EVENT-Dbbbw", "userID", userID, "resourceOwner", resourceOwner).WithError(err).Warn("missing userAggregate for pushing failed passwordless check event")
 			return err
 		}
-		_, pushErr := c.eventstore.Push(ctx,
+		events = append(events,
 			usr_repo.NewHumanPasswordlessCheckFailedEvent(
 				ctx,
 				userAgg,
 				authRequestDomainToAuthRequestInfo(authRequest),
 			),
 		)
+		if lockoutPolicy != nil {
+			if locked := lockEvent(ctx, userAgg, &lockout.lockoutState, lockoutPolicy.MaxU2FAttempts, lockoutPolicy); locked != nil {
+				events = append(events, locked)
+			}
+		}
+		_, pushErr := c.eventstore.Push(ctx, events...)
 		logging.LogWithFields("EVENT-33M9f", "userID", userID, "resourceOwner", resourceOwner).OnError(pushErr).Warn("could not push failed passwordless check event")
 		return err
 	}
 
-	_, err = c.eventstore.Push(ctx,
+	events = append(events,
 		usr_repo.NewHumanPasswordlessCheckSucceededEvent(
 			ctx,
 			userAgg,
@@ -442,9 +465,23 @@ func (c *Commands) HumanFinishPasswordlessLogin(ctx context.Context, userID, res
 			signCount,
 		),
 	)
+	_, err = c.eventstore.Push(ctx, events...)
 	return err
 }
 
+func (c *Commands) webAuthNLockout(ctx context.Context, userID, resourceOwner string, lockoutPolicy *domain.LockoutPolicy) (*HumanWebAuthNLockoutWriteModel, []eventstore.Command, error) {
+	lockout := NewHumanWebAuthNLockoutWriteModel(userID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, lockout)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := checkLockout(ctx, UserAggregateFromWriteModel(&lockout.WriteModel), &lockout.lockoutState, lockoutPolicy)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lockout, events, nil
+}
+
 func (c *Commands) finishWebAuthNLogin(ctx context.Context, userID, resourceOwner string, credentialData []byte, webAuthN *domain.WebAuthNLogin, tokens []*domain.WebAuthNToken, isLoginUI bool) (*eventstore.Aggregate, *domain.WebAuthNToken, uint32, error) {
 	if userID == "" {
 		return nil, nil, 0, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-hh8K9", "Errors.IDMissing")
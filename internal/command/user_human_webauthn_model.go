@@ -536,3 +536,53 @@ func (wm *HumanPasswordlessInitCodeWriteModel) Query() *eventstore.SearchQueryBu
 			user.UserRemovedType).
 		Builder()
 }
+
+type HumanWebAuthNLockoutWriteModel struct {
+	eventstore.WriteModel
+
+	lockoutState
+}
+
+func NewHumanWebAuthNLockoutWriteModel(userID, resourceOwner string) *HumanWebAuthNLockoutWriteModel {
+	return &HumanWebAuthNLockoutWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanWebAuthNLockoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanU2FCheckFailedEvent:
+			wm.reduceCheckFailed(e)
+		case *user.HumanPasswordlessCheckFailedEvent:
+			wm.reduceCheckFailed(e)
+		case *user.HumanU2FCheckSucceededEvent,
+			*user.HumanPasswordlessCheckSucceededEvent:
+			wm.resetFailed()
+		case *user.UserLockedEvent:
+			wm.reduceLocked(e)
+		case *user.UserUnlockedEvent:
+			wm.reduceUnlocked()
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanWebAuthNLockoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanU2FTokenCheckSucceededType,
+			user.HumanU2FTokenCheckFailedType,
+			user.HumanPasswordlessTokenCheckSucceededType,
+			user.HumanPasswordlessTokenCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType).
+		Builder()
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+//lockoutState tracks the failed attempts of a single authentication factor
+//and whether the user is currently locked
+type lockoutState struct {
+	Locked      bool
+	LockedUntil time.Time
+
+	CheckFailedCount uint64
+	LastCheckFailed  time.Time
+}
+
+func (s *lockoutState) reduceLocked(e *user.UserLockedEvent) {
+	s.Locked = true
+	s.LockedUntil = e.LockedUntil
+}
+
+func (s *lockoutState) reduceUnlocked() {
+	s.Locked = false
+	s.LockedUntil = time.Time{}
+	s.resetFailed()
+}
+
+func (s *lockoutState) reduceCheckFailed(event eventstore.Event) {
+	s.CheckFailedCount++
+	s.LastCheckFailed = event.CreationDate()
+}
+
+func (s *lockoutState) resetFailed() {
+	s.CheckFailedCount = 0
+	s.LastCheckFailed = time.Time{}
+}
+
+//lockoutExpired returns true if the user was locked temporarily and the lockout duration passed
+func (s *lockoutState) lockoutExpired(now time.Time) bool {
+	return s.Locked && !s.LockedUntil.IsZero() && !now.Before(s.LockedUntil)
+}
+
+//checkLockout returns an error if the user is locked or has to wait before the next attempt
+//if a temporary lockout expired the unlock event is returned and the state is reset
+func checkLockout(ctx context.Context, userAgg *eventstore.Aggregate, state *lockoutState, policy *domain.LockoutPolicy) ([]eventstore.Command, error) {
+	now := time.Now()
+	events := make([]eventstore.Command, 0, 1)
+	if state.lockoutExpired(now) {
+		events = append(events, user.NewUserUnlockedEvent(ctx, userAgg))
+		state.reduceUnlocked()
+	}
+	if state.Locked {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Sfw3t", "Errors.User.Locked")
+	}
+	if policy != nil && now.Before(policy.NextAttemptAllowed(state.CheckFailedCount, state.LastCheckFailed)) {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Dg4qp", "Errors.User.TooManyFailedAttempts")
+	}
+	return events, nil
+}
+
+//lockEvent returns the event locking the user if the failed attempt reaches the max attempts
+func lockEvent(ctx context.Context, userAgg *eventstore.Aggregate, state *lockoutState, maxAttempts uint64, policy *domain.LockoutPolicy) eventstore.Command {
+	if maxAttempts == 0 || state.CheckFailedCount+1 < maxAttempts {
+		return nil
+	}
+	lockedUntil := policy.LockedUntil(time.Now())
+	if lockedUntil.IsZero() {
+		return user.NewUserLockedEvent(ctx, userAgg)
+	}
+	return user.NewUserLockedUntilEvent(ctx, userAgg, lockedUntil)
+}
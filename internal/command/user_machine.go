@@ -20,11 +20,12 @@ func (c *Commands) AddMachine(ctx context.Context, orgID string, machine *domain
 	if !orgIAMPolicy.UserLoginMustBeDomain {
 		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-6M0ds", "Errors.User.Invalid")
 	}
-	userID, err := c.idGenerator.Next()
-	if err != nil {
-		return nil, err
+	if machine.AggregateID == "" {
+		machine.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
 	}
-	machine.AggregateID = userID
 	addedMachine := NewMachineWriteModel(machine.AggregateID, orgID)
 	userAgg := UserAggregateFromWriteModel(&addedMachine.WriteModel)
 	events, err := c.eventstore.Push(ctx, user.NewMachineAddedEvent(
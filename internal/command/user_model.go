@@ -2,6 +2,7 @@ package command
 
 import (
 	"strings"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -13,10 +14,11 @@ import (
 type UserWriteModel struct {
 	eventstore.WriteModel
 
-	UserName  string
-	IDPLinks  []*domain.UserIDPLink
-	UserState domain.UserState
-	UserType  domain.UserType
+	UserName    string
+	IDPLinks    []*domain.UserIDPLink
+	UserState   domain.UserState
+	UserType    domain.UserType
+	LockedUntil time.Time
 }
 
 func NewUserWriteModel(userID, resourceOwner string) *UserWriteModel {
@@ -71,10 +73,12 @@ func (wm *UserWriteModel) Reduce() error {
 		case *user.UserLockedEvent:
 			if wm.UserState != domain.UserStateDeleted {
 				wm.UserState = domain.UserStateLocked
+				wm.LockedUntil = e.LockedUntil
 			}
 		case *user.UserUnlockedEvent:
 			if wm.UserState != domain.UserStateDeleted {
 				wm.UserState = domain.UserStateActive
+				wm.LockedUntil = time.Time{}
 			}
 		case *user.UserDeactivatedEvent:
 			if wm.UserState != domain.UserStateDeleted {
@@ -0,0 +1,204 @@
+package command
+
+import (
+	"context"
+	"net"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/repository/webhook"
+)
+
+//AddWebhook adds a webhook target to the organisation (or to the IAM if the resourceOwner is domain.IAMID)
+//the returned signing key is only returned once and is used to sign the deliveries
+func (c *Commands) AddWebhook(ctx context.Context, addWebhook *domain.Webhook, resourceOwner string) (_, _ string, _ *domain.ObjectDetails, err error) {
+	if resourceOwner == "" || !addWebhook.IsValid() {
+		return "", "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Hs82n", "Errors.Webhook.Invalid")
+	}
+	if err = c.checkWebhookTarget(ctx, addWebhook); err != nil {
+		return "", "", nil, err
+	}
+	addWebhook.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return "", "", nil, err
+	}
+	signingKey, plainSigningKey, err := crypto.NewCode(c.webhookSigningKeyGenerator)
+	if err != nil {
+		return "", "", nil, err
+	}
+	webhookModel := NewWebhookWriteModel(addWebhook.AggregateID, resourceOwner)
+	webhookAgg := WebhookAggregateFromWriteModel(&webhookModel.WriteModel)
+
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewAddedEvent(
+		ctx,
+		webhookAgg,
+		addWebhook.Name,
+		addWebhook.URL,
+		addWebhook.EventTypes,
+		signingKey,
+	))
+	if err != nil {
+		return "", "", nil, err
+	}
+	err = AppendAndReduce(webhookModel, pushedEvents...)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return webhookModel.AggregateID, plainSigningKey, writeModelToObjectDetails(&webhookModel.WriteModel), nil
+}
+
+func (c *Commands) ChangeWebhook(ctx context.Context, webhookChange *domain.Webhook, resourceOwner string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" || webhookChange.AggregateID == "" || !webhookChange.IsValid() {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Mq0dk", "Errors.Webhook.Invalid")
+	}
+	if err := c.checkWebhookTarget(ctx, webhookChange); err != nil {
+		return nil, err
+	}
+	existingWebhook, err := c.getExistingWebhookWriteModel(ctx, webhookChange.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	changedEvent, err := existingWebhook.NewChangedEvent(
+		ctx,
+		webhookAgg,
+		webhookChange.Name,
+		webhookChange.URL,
+		webhookChange.EventTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+//RegenerateWebhookSigningKey replaces the signing key of the webhook, the new key is only returned once
+func (c *Commands) RegenerateWebhookSigningKey(ctx context.Context, webhookID, resourceOwner string) (string, *domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return "", nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Pz9wq", "Errors.IDMissing")
+	}
+	existingWebhook, err := c.getExistingWebhookWriteModel(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	signingKey, plainSigningKey, err := crypto.NewCode(c.webhookSigningKeyGenerator)
+	if err != nil {
+		return "", nil, err
+	}
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewSigningKeyChangedEvent(ctx, webhookAgg, signingKey))
+	if err != nil {
+		return "", nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return "", nil, err
+	}
+	return plainSigningKey, writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) DeactivateWebhook(ctx context.Context, webhookID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Vb3ks", "Errors.IDMissing")
+	}
+	existingWebhook, err := c.getExistingWebhookWriteModel(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingWebhook.State != domain.WebhookStateActive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Ls0dm", "Errors.Webhook.NotActive")
+	}
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewDeactivatedEvent(ctx, webhookAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) ReactivateWebhook(ctx context.Context, webhookID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Ow8an", "Errors.IDMissing")
+	}
+	existingWebhook, err := c.getExistingWebhookWriteModel(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingWebhook.State != domain.WebhookStateInactive {
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "COMMAND-Wn2mf", "Errors.Webhook.NotInactive")
+	}
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewReactivatedEvent(ctx, webhookAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) RemoveWebhook(ctx context.Context, webhookID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if webhookID == "" || resourceOwner == "" {
+		return nil, caos_errs.ThrowInvalidArgument(nil, "COMMAND-Xm3ja", "Errors.IDMissing")
+	}
+	existingWebhook, err := c.getExistingWebhookWriteModel(ctx, webhookID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	webhookAgg := WebhookAggregateFromWriteModel(&existingWebhook.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, webhook.NewRemovedEvent(ctx, webhookAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingWebhook, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingWebhook.WriteModel), nil
+}
+
+func (c *Commands) getExistingWebhookWriteModel(ctx context.Context, webhookID, resourceOwner string) (*WebhookWriteModel, error) {
+	webhookWriteModel := NewWebhookWriteModel(webhookID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, webhookWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if !webhookWriteModel.State.Exists() {
+		return nil, caos_errs.ThrowNotFound(nil, "COMMAND-Ek93m", "Errors.Webhook.NotFound")
+	}
+	return webhookWriteModel, nil
+}
+
+//checkWebhookTarget resolves the host of the target and checks that none of its addresses is internal
+//the sender checks the address again on each connection because the dns entries might change
+func (c *Commands) checkWebhookTarget(ctx context.Context, target *domain.Webhook) error {
+	host := target.Host()
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	addrs, err := c.webhookHostResolver(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return caos_errs.ThrowInvalidArgument(err, "COMMAND-Wh8rs", "Errors.Webhook.Invalid")
+	}
+	for _, addr := range addrs {
+		if !domain.IsAllowedWebhookIP(addr.IP) {
+			return caos_errs.ThrowInvalidArgument(nil, "COMMAND-Wh8ip", "Errors.Webhook.TargetNotAllowed")
+		}
+	}
+	return nil
+}
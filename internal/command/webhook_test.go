@@ -0,0 +1,861 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/id/mock"
+	"github.com/caos/zitadel/internal/repository/webhook"
+)
+
+func TestCommands_AddWebhook(t *testing.T) {
+	type fields struct {
+		eventstore                 *eventstore.Eventstore
+		idGenerator                id.Generator
+		webhookSigningKeyGenerator crypto.Generator
+		webhookHostResolver        func(ctx context.Context, host string) ([]net.IPAddr, error)
+	}
+	type args struct {
+		ctx           context.Context
+		addWebhook    *domain.Webhook
+		resourceOwner string
+	}
+	type res struct {
+		id         string
+		signingKey string
+		details    *domain.ObjectDetails
+		err        func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no name, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"invalid url, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "ftp://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"loopback ip, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "http://127.0.0.1:8080/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"host resolves to private ip, error",
+			fields{
+				eventstore:          eventstoreExpect(t),
+				webhookHostResolver: resolveWebhookHost("93.184.216.34", "10.0.0.1"),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"host not resolvable, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+				webhookHostResolver: func(context.Context, string) ([]net.IPAddr, error) {
+					return nil, &net.DNSError{Err: "no such host", IsNotFound: true}
+				},
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"no event types, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name: "name",
+					URL:  "https://example.com/hook",
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewAddedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+									"name",
+									"https://example.com/hook",
+									[]string{"user.human.added"},
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+								),
+							),
+						},
+					),
+				),
+				idGenerator:                mock.ExpectID(t, "id1"),
+				webhookSigningKeyGenerator: GetMockSecretGenerator(t),
+				webhookHostResolver:        resolveWebhookHost("93.184.216.34"),
+			},
+			args{
+				ctx: context.Background(),
+				addWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				id:         "id1",
+				signingKey: "a",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				idGenerator:                tt.fields.idGenerator,
+				webhookSigningKeyGenerator: tt.fields.webhookSigningKeyGenerator,
+				webhookHostResolver:        tt.fields.webhookHostResolver,
+			}
+			id, signingKey, details, err := c.AddWebhook(tt.args.ctx, tt.args.addWebhook, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assert.Equal(t, tt.res.signingKey, signingKey)
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func resolveWebhookHost(ips ...string) func(context.Context, string) ([]net.IPAddr, error) {
+	return func(context.Context, string) ([]net.IPAddr, error) {
+		addrs := make([]net.IPAddr, len(ips))
+		for i, ip := range ips {
+			addrs[i] = net.IPAddr{IP: net.ParseIP(ip)}
+		}
+		return addrs, nil
+	}
+}
+
+func TestCommands_ChangeWebhook(t *testing.T) {
+	type fields struct {
+		eventstore          *eventstore.Eventstore
+		webhookHostResolver func(ctx context.Context, host string) ([]net.IPAddr, error)
+	}
+	type args struct {
+		ctx           context.Context
+		changeWebhook *domain.Webhook
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"host resolves to link-local ip, error",
+			fields{
+				eventstore:          eventstoreExpect(t),
+				webhookHostResolver: resolveWebhookHost("169.254.169.254"),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+				webhookHostResolver: resolveWebhookHost("93.184.216.34"),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"no changes, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+					),
+				),
+				webhookHostResolver: resolveWebhookHost("93.184.216.34"),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name",
+					URL:        "https://example.com/hook",
+					EventTypes: []string{"user.human.added"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								func() eventstore.Command {
+									event, _ := webhook.NewChangedEvent(context.Background(),
+										&webhook.NewAggregate("id1", "org1").Aggregate,
+										[]webhook.WebhookChanges{
+											webhook.ChangeURL("https://example.com/hook2"),
+											webhook.ChangeEventTypes([]string{"user.human.added", "user.locked"}),
+										},
+									)
+									return event
+								}(),
+							),
+						},
+					),
+				),
+				webhookHostResolver: resolveWebhookHost("93.184.216.34"),
+			},
+			args{
+				ctx: context.Background(),
+				changeWebhook: &domain.Webhook{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Name:       "name",
+					URL:        "https://example.com/hook2",
+					EventTypes: []string{"user.human.added", "user.locked"},
+				},
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:          tt.fields.eventstore,
+				webhookHostResolver: tt.fields.webhookHostResolver,
+			}
+			details, err := c.ChangeWebhook(tt.args.ctx, tt.args.changeWebhook, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RegenerateWebhookSigningKey(t *testing.T) {
+	type fields struct {
+		eventstore                 *eventstore.Eventstore
+		webhookSigningKeyGenerator crypto.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		signingKey string
+		details    *domain.ObjectDetails
+		err        func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewSigningKeyChangedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+									&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("a"),
+									},
+								),
+							),
+						},
+					),
+				),
+				webhookSigningKeyGenerator: GetMockSecretGenerator(t),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				signingKey: "a",
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                 tt.fields.eventstore,
+				webhookSigningKeyGenerator: tt.fields.webhookSigningKeyGenerator,
+			}
+			signingKey, details, err := c.RegenerateWebhookSigningKey(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.signingKey, signingKey)
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_DeactivateWebhook(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: eventstoreExpect(t),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not active, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							webhook.NewDeactivatedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewDeactivatedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.DeactivateWebhook(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ReactivateWebhook(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not inactive, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							webhook.NewDeactivatedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewReactivatedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.ReactivateWebhook(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveWebhook(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							webhook.NewRemovedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: errors.IsNotFound,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: eventstoreExpect(t,
+					expectFilter(
+						eventFromEventPusher(
+							webhook.NewAddedEvent(context.Background(),
+								&webhook.NewAggregate("id1", "org1").Aggregate,
+								"name",
+								"https://example.com/hook",
+								[]string{"user.human.added"},
+								nil,
+							),
+						),
+					),
+					expectPush(
+						[]*repository.Event{
+							eventFromEventPusher(
+								webhook.NewRemovedEvent(context.Background(),
+									&webhook.NewAggregate("id1", "org1").Aggregate,
+								),
+							),
+						},
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			details, err := c.RemoveWebhook(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package systemdefaults
 
 import (
+	"github.com/caos/zitadel/internal/breach"
 	"github.com/caos/zitadel/internal/notification/channels/log"
 	"golang.org/x/text/language"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/caos/zitadel/internal/notification/channels/fs"
 	"github.com/caos/zitadel/internal/notification/channels/smtp"
 	"github.com/caos/zitadel/internal/notification/channels/twilio"
+	"github.com/caos/zitadel/internal/notification/channels/vonage"
+	"github.com/caos/zitadel/internal/notification/channels/webhook"
 	"github.com/caos/zitadel/internal/notification/templates"
 )
 
@@ -20,6 +23,8 @@ type SystemDefaults struct {
 	SecretGenerators         SecretGenerators
 	UserVerificationKey      *crypto.KeyConfig
 	IDPConfigVerificationKey *crypto.KeyConfig
+	WebhookVerificationKey   *crypto.KeyConfig
+	NotificationProviderKey  *crypto.KeyConfig
 	Multifactors             MultifactorConfig
 	VerificationLifetimes    VerificationLifetimes
 	DomainVerification       DomainVerification
@@ -27,6 +32,8 @@ type SystemDefaults struct {
 	Notifications            Notifications
 	WebAuthN                 WebAuthN
 	KeyConfig                KeyConfig
+	PasswordBreachCheck      *breach.Config
+	PasswordHasher           crypto.PasswordHasherConfig
 }
 
 type ZitadelDocs struct {
@@ -42,12 +49,17 @@ type SecretGenerators struct {
 	PhoneVerificationCode    crypto.GeneratorConfig
 	PasswordVerificationCode crypto.GeneratorConfig
 	PasswordlessInitCode     crypto.GeneratorConfig
+	OTPSMSCode               crypto.GeneratorConfig
+	OTPEmailCode             crypto.GeneratorConfig
+	RecoveryCode             crypto.GeneratorConfig
+	WebhookSigningKey        crypto.GeneratorConfig
 	MachineKeySize           uint32
 	ApplicationKeySize       uint32
 }
 
 type MultifactorConfig struct {
-	OTP OTPConfig
+	OTP           OTPConfig
+	RecoveryCodes RecoveryCodesConfig
 }
 
 type OTPConfig struct {
@@ -55,6 +67,12 @@ type OTPConfig struct {
 	VerificationKey *crypto.KeyConfig
 }
 
+type RecoveryCodesConfig struct {
+	Count        int
+	LowThreshold int
+	HashKey      *crypto.KeyConfig
+}
+
 type VerificationLifetimes struct {
 	PasswordCheck      types.Duration
 	ExternalLoginCheck types.Duration
@@ -81,6 +99,7 @@ type Endpoints struct {
 	VerifyEmail              string
 	DomainClaimed            string
 	PasswordlessRegistration string
+	PasswordExpiry           string
 }
 
 type Channels struct {
@@ -89,6 +108,16 @@ type Channels struct {
 	Twilio     twilio.TwilioConfig
 	FileSystem fs.FSConfig
 	Log        log.LogConfig
+	//SMS are the providers used to send sms in the given order
+	//if empty Twilio is used
+	SMS []SMSProvider
+}
+
+//SMSProvider holds the configuration of exactly one sms provider
+type SMSProvider struct {
+	Twilio *twilio.TwilioConfig
+	Vonage *vonage.VonageConfig
+	HTTP   *webhook.WebhookConfig
 }
 
 type TemplateData struct {
@@ -0,0 +1,113 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var _ HashAlgorithm = (*Argon2id)(nil)
+
+const (
+	argon2idPrefix  = "$argon2id$"
+	argon2idKeyLen  = 32
+	argon2idSaltLen = 16
+)
+
+type Argon2idConfig struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+//Argon2id hashes values in the PHC string format:
+//$argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>
+type Argon2id struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+func NewArgon2id(config Argon2idConfig) *Argon2id {
+	a := &Argon2id{
+		time:    config.Time,
+		memory:  config.Memory,
+		threads: config.Threads,
+	}
+	if a.time == 0 {
+		a.time = 3
+	}
+	if a.memory == 0 {
+		a.memory = 64 * 1024
+	}
+	if a.threads == 0 {
+		a.threads = 4
+	}
+	return a
+}
+
+func (a *Argon2id) Algorithm() string {
+	return "argon2id"
+}
+
+func (a *Argon2id) Hash(value []byte) ([]byte, error) {
+	salt := make([]byte, argon2idSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	hash := argon2.IDKey(value, salt, a.time, a.memory, a.threads, argon2idKeyLen)
+	return []byte(fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		a.memory,
+		a.time,
+		a.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	)), nil
+}
+
+func (a *Argon2id) CompareHash(hashed, value []byte) error {
+	params, salt, hash, err := decodeArgon2id(hashed)
+	if err != nil {
+		return err
+	}
+	compared := argon2.IDKey(value, salt, params.time, params.memory, params.threads, uint32(len(hash)))
+	if subtle.ConstantTimeCompare(hash, compared) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ag2cm", "hash does not match")
+	}
+	return nil
+}
+
+//NeedsRehash returns true if the hash was created with different parameters
+func (a *Argon2id) NeedsRehash(hashed []byte) bool {
+	params, _, _, err := decodeArgon2id(hashed)
+	return err != nil || *params != *a
+}
+
+func decodeArgon2id(hashed []byte) (params *Argon2id, salt, hash []byte, err error) {
+	parts, err := splitEncodedHash(hashed, argon2idPrefix, 4)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var version int
+	if _, err = fmt.Sscanf(parts[0], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Ag2vs", "unsupported argon2id version")
+	}
+	params = new(Argon2id)
+	if _, err = fmt.Sscanf(parts[1], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return nil, nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Ag2pr", "invalid argon2id parameters")
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+		return nil, nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Ag2sl", "invalid argon2id salt")
+	}
+	if hash, err = base64.RawStdEncoding.DecodeString(parts[3]); err != nil {
+		return nil, nil, nil, errors.ThrowInvalidArgument(err, "CRYPT-Ag2hs", "invalid argon2id hash")
+	}
+	return params, salt, hash, nil
+}
@@ -25,3 +25,9 @@ func (b *BCrypt) Hash(value []byte) ([]byte, error) {
 func (b *BCrypt) CompareHash(hashed, value []byte) error {
 	return bcrypt.CompareHashAndPassword(hashed, value)
 }
+
+//NeedsRehash returns true if the hash was created with a different cost
+func (b *BCrypt) NeedsRehash(hashed []byte) bool {
+	cost, err := bcrypt.Cost(hashed)
+	return err != nil || cost != b.cost
+}
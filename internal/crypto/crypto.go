@@ -119,6 +119,9 @@ func Hash(value []byte, alg HashAlgorithm) (*CryptoValue, error) {
 }
 
 func CompareHash(value *CryptoValue, comparer []byte, alg HashAlgorithm) error {
+	if hasher, ok := alg.(*PasswordHasher); ok {
+		return hasher.Verify(value, comparer)
+	}
 	if value.Algorithm != alg.Algorithm() {
 		return errors.ThrowInvalidArgument(nil, "CRYPT-HF32f", "value was hashed with a different algorithm")
 	}
@@ -0,0 +1,56 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var _ HashAlgorithm = (*HMACSHA256)(nil)
+
+//HMACSHA256 hashes values with a secret key instead of a slow hash function
+//it must only be used for generated values with enough entropy (e.g. recovery codes)
+type HMACSHA256 struct {
+	keys            map[string]string
+	encryptionKeyID string
+}
+
+func NewHMACSHA256(config *KeyConfig) (*HMACSHA256, error) {
+	keys, _, err := LoadKeys(config)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := keys[config.EncryptionKeyID]; !ok {
+		return nil, errors.ThrowInternal(nil, "CRYPT-Hm8sk", "hmac key not found")
+	}
+	return &HMACSHA256{
+		keys:            keys,
+		encryptionKeyID: config.EncryptionKeyID,
+	}, nil
+}
+
+func (h *HMACSHA256) Algorithm() string {
+	return "hmac-sha256"
+}
+
+func (h *HMACSHA256) Hash(value []byte) ([]byte, error) {
+	return hmacSHA256([]byte(h.keys[h.encryptionKeyID]), value), nil
+}
+
+//CompareHash compares the value in constant time
+//the decryption keys are checked as well, so values hashed before a key rotation stay valid
+func (h *HMACSHA256) CompareHash(hashed, value []byte) error {
+	for _, key := range h.keys {
+		if hmac.Equal(hashed, hmacSHA256([]byte(key), value)) {
+			return nil
+		}
+	}
+	return errors.ThrowInvalidArgument(nil, "CRYPT-Hm8cm", "hash does not match")
+}
+
+func hmacSHA256(key, value []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(value)
+	return mac.Sum(nil)
+}
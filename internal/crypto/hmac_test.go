@@ -0,0 +1,70 @@
+package crypto
+
+import (
+	"testing"
+)
+
+func TestHMACSHA256_CompareHash(t *testing.T) {
+	hasher := &HMACSHA256{
+		keys:            map[string]string{"key1": "secret1"},
+		encryptionKeyID: "key1",
+	}
+	hashed, err := hasher.Hash([]byte("code"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	type args struct {
+		hasher *HMACSHA256
+		value  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "same value, ok",
+			args: args{
+				hasher: hasher,
+				value:  "code",
+			},
+		},
+		{
+			name: "different value, error",
+			args: args{
+				hasher: hasher,
+				value:  "other",
+			},
+			wantErr: true,
+		},
+		{
+			name: "different key, error",
+			args: args{
+				hasher: &HMACSHA256{
+					keys:            map[string]string{"key2": "secret2"},
+					encryptionKeyID: "key2",
+				},
+				value: "code",
+			},
+			wantErr: true,
+		},
+		{
+			name: "rotated key, ok",
+			args: args{
+				hasher: &HMACSHA256{
+					keys:            map[string]string{"key2": "secret2", "key1": "secret1"},
+					encryptionKeyID: "key2",
+				},
+				value: "code",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.hasher.CompareHash(hashed, []byte(tt.args.value))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompareHash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package crypto
+
+import (
+	"strings"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var _ HashAlgorithm = (*PasswordHasher)(nil)
+
+//HashVerifier verifies hashes of an algorithm
+//which is only supported for existing (e.g. imported) hashes
+type HashVerifier interface {
+	Crypto
+	CompareHash(hashed, comparer []byte) error
+}
+
+//rehasher is implemented by hash algorithms which can detect outdated parameters (e.g. cost)
+type rehasher interface {
+	NeedsRehash(hashed []byte) bool
+}
+
+type PasswordHasherConfig struct {
+	//Algorithm used to hash new passwords: bcrypt (default) or argon2id
+	Algorithm  string
+	BCryptCost int
+	Argon2id   Argon2idConfig
+}
+
+//NewHasher creates a PasswordHasher hashing with the configured algorithm
+//and verifying all supported algorithms
+func (c *PasswordHasherConfig) NewHasher() (*PasswordHasher, error) {
+	bcrypt := NewBCrypt(c.BCryptCost)
+	argon2id := NewArgon2id(c.Argon2id)
+	verifiers := []HashVerifier{
+		bcrypt,
+		argon2id,
+		&SCrypt{},
+		NewPBKDF2SHA1(),
+		NewPBKDF2SHA256(),
+		NewPBKDF2SHA512(),
+		NewSaltedSHA1(),
+		NewSaltedSHA256(),
+		NewSaltedSHA512(),
+	}
+	switch c.Algorithm {
+	case "", bcrypt.Algorithm():
+		return NewPasswordHasher(bcrypt, verifiers...), nil
+	case argon2id.Algorithm():
+		return NewPasswordHasher(argon2id, verifiers...), nil
+	}
+	return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Ph4al", "unsupported password hash algorithm")
+}
+
+//PasswordHasher hashes new values with its hash algorithm
+//and verifies values hashed by any of its verifiers
+type PasswordHasher struct {
+	hasher    HashAlgorithm
+	verifiers map[string]HashVerifier
+}
+
+func NewPasswordHasher(hasher HashAlgorithm, verifiers ...HashVerifier) *PasswordHasher {
+	p := &PasswordHasher{
+		hasher:    hasher,
+		verifiers: make(map[string]HashVerifier, len(verifiers)+1),
+	}
+	for _, verifier := range verifiers {
+		p.verifiers[verifier.Algorithm()] = verifier
+	}
+	p.verifiers[hasher.Algorithm()] = hasher
+	return p
+}
+
+func (p *PasswordHasher) Algorithm() string {
+	return p.hasher.Algorithm()
+}
+
+func (p *PasswordHasher) Hash(value []byte) ([]byte, error) {
+	return p.hasher.Hash(value)
+}
+
+func (p *PasswordHasher) CompareHash(hashed, comparer []byte) error {
+	return p.hasher.CompareHash(hashed, comparer)
+}
+
+//Verify compares the value with the algorithm it was hashed with
+func (p *PasswordHasher) Verify(value *CryptoValue, comparer []byte) error {
+	verifier, ok := p.verifiers[value.Algorithm]
+	if !ok {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ph4vf", "value was hashed with an unsupported algorithm")
+	}
+	return verifier.CompareHash(value.Crypted, comparer)
+}
+
+//NeedsRehash returns true if the value was hashed with another algorithm
+//or outdated parameters of the current algorithm
+func (p *PasswordHasher) NeedsRehash(value *CryptoValue) bool {
+	if value.Algorithm != p.hasher.Algorithm() {
+		return true
+	}
+	if r, ok := p.hasher.(rehasher); ok {
+		return r.NeedsRehash(value.Crypted)
+	}
+	return false
+}
+
+var encodedHashPrefixes = []struct {
+	prefix    string
+	algorithm string
+}{
+	{prefix: "$2a$", algorithm: "bcrypt"},
+	{prefix: "$2b$", algorithm: "bcrypt"},
+	{prefix: "$2y$", algorithm: "bcrypt"},
+	{prefix: argon2idPrefix, algorithm: "argon2id"},
+	{prefix: scryptPrefix, algorithm: "scrypt"},
+	{prefix: "$pbkdf2$", algorithm: "pbkdf2"},
+	{prefix: "$pbkdf2-sha256$", algorithm: "pbkdf2-sha256"},
+	{prefix: "$pbkdf2-sha512$", algorithm: "pbkdf2-sha512"},
+	{prefix: "{SSHA}", algorithm: "ssha"},
+	{prefix: "{SSHA256}", algorithm: "ssha256"},
+	{prefix: "{SSHA512}", algorithm: "ssha512"},
+}
+
+//ImportHash identifies the algorithm of a hash created by another system
+//so it can be verified by the PasswordHasher
+func (p *PasswordHasher) ImportHash(encoded string) (*CryptoValue, error) {
+	for _, format := range encodedHashPrefixes {
+		if !strings.HasPrefix(encoded, format.prefix) {
+			continue
+		}
+		if _, ok := p.verifiers[format.algorithm]; !ok {
+			break
+		}
+		return &CryptoValue{
+			CryptoType: TypeHash,
+			Algorithm:  format.algorithm,
+			Crypted:    []byte(encoded),
+		}, nil
+	}
+	return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Ph4im", "unsupported hash format")
+}
+
+//splitEncodedHash splits a hash of the format <prefix><part>$<part>...
+func splitEncodedHash(hashed []byte, prefix string, count int) ([]string, error) {
+	encoded := string(hashed)
+	if !strings.HasPrefix(encoded, prefix) {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Ph4pf", "invalid hash prefix")
+	}
+	parts := strings.Split(strings.TrimPrefix(encoded, prefix), "$")
+	if len(parts) != count {
+		return nil, errors.ThrowInvalidArgument(nil, "CRYPT-Ph4pt", "invalid hash format")
+	}
+	return parts, nil
+}
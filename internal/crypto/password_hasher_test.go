@@ -0,0 +1,162 @@
+package crypto
+
+import (
+	"testing"
+)
+
+func TestPasswordHasher_Verify(t *testing.T) {
+	hasher, err := (&PasswordHasherConfig{BCryptCost: 4}).NewHasher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		encoded   string
+		algorithm string
+	}{
+		{
+			name:      "bcrypt",
+			encoded:   "$2a$04$gqqVt9oY4c8HsC0Q1RA1zes8bOR3wy651LqAhUmFYJJM7v68zgrMG",
+			algorithm: "bcrypt",
+		},
+		{
+			name:      "scrypt",
+			encoded:   "$scrypt$ln=10,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$BVMRKqdiVYikKAaPR1wucsKUKvw4TuPLkdEYtoSHas4",
+			algorithm: "scrypt",
+		},
+		{
+			name:      "pbkdf2 sha1",
+			encoded:   "$pbkdf2$1000$c2FsdHNhbHRzYWx0c2FsdA$2FWw/oC7TQkskizC.81lWlmFAMM",
+			algorithm: "pbkdf2",
+		},
+		{
+			name:      "pbkdf2 sha256",
+			encoded:   "$pbkdf2-sha256$1000$c2FsdHNhbHRzYWx0c2FsdA$8nX7hwFEzIB8aPajJTYK8weHQc5Ngz0pFVAKvSu4jQA",
+			algorithm: "pbkdf2-sha256",
+		},
+		{
+			name:      "pbkdf2 sha512",
+			encoded:   "$pbkdf2-sha512$i=1000$c2FsdHNhbHRzYWx0c2FsdA$715rqIr5dXOVPpBhqqsugl037zT5bWJTWYmZtIcK8hBnisKpwfY7kokvwjDrNHqHhF50Pb7MD6HvkJwiDQw4ww",
+			algorithm: "pbkdf2-sha512",
+		},
+		{
+			name:      "salted sha1",
+			encoded:   "{SSHA}yI6cZwQadOA1e+/f+T+H3eCQQhRzYWx0",
+			algorithm: "ssha",
+		},
+		{
+			name:      "salted sha256",
+			encoded:   "{SSHA256}eje4XIkY6sGakInA+loqtNzj+QUo3N7sEIsj3fNge5lzYWx0",
+			algorithm: "ssha256",
+		},
+		{
+			name:      "salted sha512",
+			encoded:   "{SSHA512}+mohhbPgqahe9B/7Z+88H7b3SYD46/lw5OcuNT7ZU31ZMIPCAd/W5D4cinqsK8jbsRnH37fUuPExEROVvXDpf3NhbHQ=",
+			algorithm: "ssha512",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := hasher.ImportHash(tt.encoded)
+			if err != nil {
+				t.Fatalf("import failed: %v", err)
+			}
+			if value.Algorithm != tt.algorithm {
+				t.Errorf("wrong algorithm: expected: %s, got: %s", tt.algorithm, value.Algorithm)
+			}
+			if err = CompareHash(value, []byte("password"), hasher); err != nil {
+				t.Errorf("password should match: %v", err)
+			}
+			if err = CompareHash(value, []byte("passw0rd"), hasher); err == nil {
+				t.Error("wrong password should not match")
+			}
+		})
+	}
+}
+
+func TestPasswordHasher_ImportHash_unsupported(t *testing.T) {
+	hasher, err := (&PasswordHasherConfig{BCryptCost: 4}).NewHasher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = hasher.ImportHash("{MD5}X03MO1qnZdYdgyfeuILPmQ=="); err == nil {
+		t.Error("md5 should not be supported")
+	}
+}
+
+func TestPasswordHasher_NeedsRehash(t *testing.T) {
+	argon2idHasher, err := (&PasswordHasherConfig{
+		Algorithm: "argon2id",
+		Argon2id:  Argon2idConfig{Time: 1, Memory: 1024, Threads: 1},
+	}).NewHasher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	argon2id, err := Hash([]byte("password"), argon2idHasher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = CompareHash(argon2id, []byte("password"), argon2idHasher); err != nil {
+		t.Errorf("password should match: %v", err)
+	}
+	bcryptHasher, err := (&PasswordHasherConfig{BCryptCost: 4}).NewHasher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcrypt, err := Hash([]byte("password"), bcryptHasher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		hasher *PasswordHasher
+		value  *CryptoValue
+		want   bool
+	}{
+		{
+			name:   "same algorithm and parameters",
+			hasher: argon2idHasher,
+			value:  argon2id,
+			want:   false,
+		},
+		{
+			name:   "other algorithm",
+			hasher: argon2idHasher,
+			value:  bcrypt,
+			want:   true,
+		},
+		{
+			name: "outdated argon2id parameters",
+			hasher: NewPasswordHasher(NewArgon2id(Argon2idConfig{
+				Time:    2,
+				Memory:  1024,
+				Threads: 1,
+			})),
+			value: argon2id,
+			want:  true,
+		},
+		{
+			name:   "outdated bcrypt cost",
+			hasher: NewPasswordHasher(NewBCrypt(5)),
+			value:  bcrypt,
+			want:   true,
+		},
+		{
+			name:   "legacy algorithm",
+			hasher: bcryptHasher,
+			value: &CryptoValue{
+				CryptoType: TypeHash,
+				Algorithm:  "ssha",
+				Crypted:    []byte("{SSHA}yI6cZwQadOA1e+/f+T+H3eCQQhRzYWx0"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.value); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package crypto
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"hash"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var _ HashVerifier = (*PBKDF2)(nil)
+
+//PBKDF2 verifies hashes in the format of passlib:
+//$pbkdf2-<digest>$<rounds>$<salt>$<hash>
+//salt and hash are encoded in adapted base64 (. instead of +, no padding)
+type PBKDF2 struct {
+	algorithm string
+	hash      func() hash.Hash
+}
+
+func NewPBKDF2SHA1() *PBKDF2 {
+	return &PBKDF2{algorithm: "pbkdf2", hash: sha1.New}
+}
+
+func NewPBKDF2SHA256() *PBKDF2 {
+	return &PBKDF2{algorithm: "pbkdf2-sha256", hash: sha256.New}
+}
+
+func NewPBKDF2SHA512() *PBKDF2 {
+	return &PBKDF2{algorithm: "pbkdf2-sha512", hash: sha512.New}
+}
+
+func (p *PBKDF2) Algorithm() string {
+	return p.algorithm
+}
+
+func (p *PBKDF2) CompareHash(hashed, value []byte) error {
+	parts, err := splitEncodedHash(hashed, "$"+p.algorithm+"$", 3)
+	if err != nil {
+		return err
+	}
+	rounds, err := strconv.Atoi(strings.TrimPrefix(parts[0], "i="))
+	if err != nil || rounds <= 0 {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Pb3rn", "invalid pbkdf2 rounds")
+	}
+	salt, err := decodeAdaptedBase64(parts[1])
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Pb3sl", "invalid pbkdf2 salt")
+	}
+	hash, err := decodeAdaptedBase64(parts[2])
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Pb3hs", "invalid pbkdf2 hash")
+	}
+	compared := pbkdf2.Key(value, salt, rounds, len(hash), p.hash)
+	if subtle.ConstantTimeCompare(hash, compared) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Pb3cm", "hash does not match")
+	}
+	return nil
+}
+
+func decodeAdaptedBase64(value string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(strings.ReplaceAll(strings.TrimRight(value, "="), ".", "+"))
+}
@@ -3,9 +3,13 @@ package crypto
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"errors"
+	"math/big"
+	"time"
 )
 
 func GenerateKeyPair(bits int) (*rsa.PrivateKey, *rsa.PublicKey, error) {
@@ -101,3 +105,54 @@ func EncryptKeys(privateKey *rsa.PrivateKey, publicKey *rsa.PublicKey, alg Encry
 	}
 	return encryptedPrivateKey, encryptedPublicKey, nil
 }
+
+func GenerateSelfSignedCertificate(priv *rsa.PrivateKey, commonName string, lifetime time.Duration) ([]byte, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	certBytes, err := createSelfSignedCertificate(priv, serialNumber, commonName, now, now.Add(lifetime))
+	if err != nil {
+		return nil, err
+	}
+	return CertificateToBytes(certBytes), nil
+}
+
+// GenerateCertificateForKey creates a self-signed (DER encoded) certificate for an existing key.
+// The serial number is derived from the key id, so the same input always results in the same certificate.
+func GenerateCertificateForKey(priv *rsa.PrivateKey, keyID, commonName string, notBefore, notAfter time.Time) ([]byte, error) {
+	serial := sha256.Sum256([]byte(keyID))
+	return createSelfSignedCertificate(priv, new(big.Int).SetBytes(serial[:16]), commonName, notBefore, notAfter)
+}
+
+func createSelfSignedCertificate(priv *rsa.PrivateKey, serialNumber *big.Int, commonName string, notBefore, notAfter time.Time) ([]byte, error) {
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+	}
+	return x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+}
+
+func CertificateToBytes(cert []byte) []byte {
+	return pem.EncodeToMemory(
+		&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: cert,
+		},
+	)
+}
+
+func BytesToCertificate(cert []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(cert)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, ErrEmpty
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,65 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+	"time"
+)
+
+func TestGenerateSelfSignedCertificate(t *testing.T) {
+	privateKey, _, err := GenerateKeyPair(2048)
+	if err != nil {
+		t.Fatalf("unable to generate key pair: %v", err)
+	}
+	certBytes, err := GenerateSelfSignedCertificate(privateKey, "69629023906488334", time.Hour)
+	if err != nil {
+		t.Fatalf("unable to generate certificate: %v", err)
+	}
+	cert, err := BytesToCertificate(certBytes)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+	if cert.Subject.CommonName != "69629023906488334" {
+		t.Errorf("got common name %q, want %q", cert.Subject.CommonName, "69629023906488334")
+	}
+	if err = cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		t.Errorf("certificate is not self signed: %v", err)
+	}
+}
+
+func TestGenerateCertificateForKey(t *testing.T) {
+	privateKey, _, err := GenerateKeyPair(2048)
+	if err != nil {
+		t.Fatalf("unable to generate key pair: %v", err)
+	}
+	notBefore := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(6 * time.Hour)
+	first, err := GenerateCertificateForKey(privateKey, "key1", "issuer", notBefore, notAfter)
+	if err != nil {
+		t.Fatalf("unable to generate certificate: %v", err)
+	}
+	second, err := GenerateCertificateForKey(privateKey, "key1", "issuer", notBefore, notAfter)
+	if err != nil {
+		t.Fatalf("unable to generate certificate: %v", err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("certificates of the same key differ")
+	}
+	cert, err := x509.ParseCertificate(first)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+	if !cert.NotAfter.Equal(notAfter) {
+		t.Errorf("got not after %v, want %v", cert.NotAfter, notAfter)
+	}
+	if err = cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		t.Errorf("certificate is not self signed: %v", err)
+	}
+}
+
+func TestBytesToCertificate_Empty(t *testing.T) {
+	if _, err := BytesToCertificate(nil); err != ErrEmpty {
+		t.Errorf("got %v, want %v", err, ErrEmpty)
+	}
+}
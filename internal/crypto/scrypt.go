@@ -0,0 +1,51 @@
+package crypto
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var _ HashVerifier = (*SCrypt)(nil)
+
+const scryptPrefix = "$scrypt$"
+
+//SCrypt verifies hashes in the PHC string format:
+//$scrypt$ln=<log2(N)>,r=<r>,p=<p>$<salt>$<hash>
+type SCrypt struct{}
+
+func (s *SCrypt) Algorithm() string {
+	return "scrypt"
+}
+
+func (s *SCrypt) CompareHash(hashed, value []byte) error {
+	parts, err := splitEncodedHash(hashed, scryptPrefix, 3)
+	if err != nil {
+		return err
+	}
+	var ln uint
+	var r, p int
+	if _, err = fmt.Sscanf(parts[0], "ln=%d,r=%d,p=%d", &ln, &r, &p); err != nil || ln == 0 || ln > 31 {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Sc8pr", "invalid scrypt parameters")
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Sc8sl", "invalid scrypt salt")
+	}
+	hash, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Sc8hs", "invalid scrypt hash")
+	}
+	compared, err := scrypt.Key(value, salt, 1<<ln, r, p, len(hash))
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Sc8ky", "invalid scrypt parameters")
+	}
+	if subtle.ConstantTimeCompare(hash, compared) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Sc8cm", "hash does not match")
+	}
+	return nil
+}
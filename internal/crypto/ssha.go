@@ -0,0 +1,61 @@
+package crypto
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"hash"
+	"strings"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var _ HashVerifier = (*SaltedSHA)(nil)
+
+//SaltedSHA verifies salted sha hashes in the format of LDAP directories:
+//{SSHA}<base64(hash+salt)>
+type SaltedSHA struct {
+	algorithm string
+	prefix    string
+	hash      func() hash.Hash
+}
+
+func NewSaltedSHA1() *SaltedSHA {
+	return &SaltedSHA{algorithm: "ssha", prefix: "{SSHA}", hash: sha1.New}
+}
+
+func NewSaltedSHA256() *SaltedSHA {
+	return &SaltedSHA{algorithm: "ssha256", prefix: "{SSHA256}", hash: sha256.New}
+}
+
+func NewSaltedSHA512() *SaltedSHA {
+	return &SaltedSHA{algorithm: "ssha512", prefix: "{SSHA512}", hash: sha512.New}
+}
+
+func (s *SaltedSHA) Algorithm() string {
+	return s.algorithm
+}
+
+func (s *SaltedSHA) CompareHash(hashed, value []byte) error {
+	encoded := string(hashed)
+	if !strings.HasPrefix(encoded, s.prefix) {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ss5pf", "invalid salted sha hash")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(encoded, s.prefix))
+	if err != nil {
+		return errors.ThrowInvalidArgument(err, "CRYPT-Ss5dc", "invalid salted sha hash")
+	}
+	h := s.hash()
+	if len(decoded) <= h.Size() {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ss5sl", "salted sha hash without salt")
+	}
+	hash, salt := decoded[:h.Size()], decoded[h.Size():]
+	h.Write(value)
+	h.Write(salt)
+	if subtle.ConstantTimeCompare(hash, h.Sum(nil)) != 1 {
+		return errors.ThrowInvalidArgument(nil, "CRYPT-Ss5cm", "hash does not match")
+	}
+	return nil
+}
@@ -45,3 +45,31 @@ const (
 	ActionsMaxAllowed
 	ActionsAllowedUnlimited
 )
+
+type ActionExecutionOutcome int32
+
+const (
+	ActionExecutionOutcomeUnspecified ActionExecutionOutcome = iota
+	ActionExecutionOutcomeSucceeded
+	ActionExecutionOutcomeFailed
+	ActionExecutionOutcomeTimedOut
+	actionExecutionOutcomeCount
+)
+
+func (o ActionExecutionOutcome) Valid() bool {
+	return o >= 0 && o < actionExecutionOutcomeCount
+}
+
+//ActionExecution is the record of a single run of an action
+type ActionExecution struct {
+	ActionID      string
+	ActionName    string
+	ResourceOwner string
+	FlowType      FlowType
+	TriggerType   TriggerType
+	CreationDate  time.Time
+	Duration      time.Duration
+	Outcome       ActionExecutionOutcome
+	Error         string
+	Logs          []string
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+//ActionLibraryModulePrefix is the prefix of the modules actions require libraries with
+//e.g. `require("zitadel/libraries/helpers")` for the latest or `require("zitadel/libraries/helpers@2")` for a specific version
+//ES module imports like `import helpers from "zitadel/libraries/helpers"` are supported as well
+const ActionLibraryModulePrefix = "zitadel/libraries/"
+
+var (
+	actionLibraryNameRegex    = regexp.MustCompile(`^[a-zA-Z0-9_\-]{1,200}$`)
+	actionLibraryRequireRegex = regexp.MustCompile(`(?:require\(\s*["'` + "`" + `]|\b(?:import|from)\s*["'])` + regexp.QuoteMeta(ActionLibraryModulePrefix) + `([a-zA-Z0-9_\-]+)(?:@([0-9]+))?["'` + "`" + `]`)
+)
+
+type ActionLibrary struct {
+	models.ObjectRoot
+
+	Name    string
+	Script  string
+	Version uint64
+	State   ActionLibraryState
+}
+
+func (l *ActionLibrary) IsValid() bool {
+	return actionLibraryNameRegex.MatchString(l.Name) && l.Script != ""
+}
+
+type ActionLibraryState int32
+
+const (
+	ActionLibraryStateUnspecified ActionLibraryState = iota
+	ActionLibraryStateActive
+	ActionLibraryStateRemoved
+	actionLibraryStateCount
+)
+
+func (s ActionLibraryState) Valid() bool {
+	return s >= 0 && s < actionLibraryStateCount
+}
+
+func (s ActionLibraryState) Exists() bool {
+	return s != ActionLibraryStateUnspecified && s != ActionLibraryStateRemoved
+}
+
+//ActionLibraryRequirement is a library required by a script
+//Version 0 requires the latest version of the library
+type ActionLibraryRequirement struct {
+	Name    string
+	Version uint64
+}
+
+//Module returns the name of the module the requirement is registered as
+func (r ActionLibraryRequirement) Module() string {
+	if r.Version == 0 {
+		return ActionLibraryModulePrefix + r.Name
+	}
+	return ActionLibraryModulePrefix + r.Name + "@" + strconv.FormatUint(r.Version, 10)
+}
+
+//ActionLibraryRequirements returns the distinct libraries required by the script
+//only requires and imports with a string literal are detected
+func ActionLibraryRequirements(script string) []ActionLibraryRequirement {
+	matches := actionLibraryRequireRegex.FindAllStringSubmatch(script, -1)
+	requirements := make([]ActionLibraryRequirement, 0, len(matches))
+	found := make(map[ActionLibraryRequirement]bool, len(matches))
+	for _, match := range matches {
+		requirement := ActionLibraryRequirement{Name: match[1]}
+		if match[2] != "" {
+			version, err := strconv.ParseUint(match[2], 10, 64)
+			if err != nil {
+				continue
+			}
+			requirement.Version = version
+		}
+		if found[requirement] {
+			continue
+		}
+		found[requirement] = true
+		requirements = append(requirements, requirement)
+	}
+	return requirements
+}
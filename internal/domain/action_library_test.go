@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestActionLibraryRequirements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		result []ActionLibraryRequirement
+	}{
+		{
+			name:   "no requires, empty",
+			script: `function action(ctx, api) {}`,
+			result: []ActionLibraryRequirement{},
+		},
+		{
+			name:   "native modules, empty",
+			script: `let http = require("zitadel/http"); let c = require('console');`,
+			result: []ActionLibraryRequirement{},
+		},
+		{
+			name: "latest and pinned versions, ok",
+			script: `let helpers = require("zitadel/libraries/helpers");
+let mapping = require( 'zitadel/libraries/claim-mapping@2' );`,
+			result: []ActionLibraryRequirement{
+				{Name: "helpers"},
+				{Name: "claim-mapping", Version: 2},
+			},
+		},
+		{
+			name: "required twice, distinct",
+			script: `require("zitadel/libraries/helpers").a();
+require("zitadel/libraries/helpers").b();
+require("zitadel/libraries/helpers@1").c();`,
+			result: []ActionLibraryRequirement{
+				{Name: "helpers"},
+				{Name: "helpers", Version: 1},
+			},
+		},
+		{
+			name: "es module imports, ok",
+			script: `import helpers from "zitadel/libraries/helpers";
+import {upper} from 'zitadel/libraries/strings@1';
+import "zitadel/libraries/polyfills";`,
+			result: []ActionLibraryRequirement{
+				{Name: "helpers"},
+				{Name: "strings", Version: 1},
+				{Name: "polyfills"},
+			},
+		},
+		{
+			name:   "dynamic require, not detected",
+			script: `let name = "helpers"; require("zitadel/libraries/" + name);`,
+			result: []ActionLibraryRequirement{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ActionLibraryRequirements(tt.script)
+			if !reflect.DeepEqual(result, tt.result) {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, result)
+			}
+		})
+	}
+}
+
+func TestActionLibraryRequirement_Module(t *testing.T) {
+	tests := []struct {
+		name        string
+		requirement ActionLibraryRequirement
+		result      string
+	}{
+		{
+			name:        "latest",
+			requirement: ActionLibraryRequirement{Name: "helpers"},
+			result:      "zitadel/libraries/helpers",
+		},
+		{
+			name:        "pinned",
+			requirement: ActionLibraryRequirement{Name: "helpers", Version: 3},
+			result:      "zitadel/libraries/helpers@3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.requirement.Module(); result != tt.result {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, result)
+			}
+		})
+	}
+}
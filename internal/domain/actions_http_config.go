@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+//MaxActionsHTTPResponseSize is the upper bound of the response size of the http module of the actions
+const MaxActionsHTTPResponseSize = 10 << 20
+
+//ActionsHTTPConfig is the IAM wide configuration of the `zitadel/http` module of the actions
+type ActionsHTTPConfig struct {
+	models.ObjectRoot
+
+	//AllowList of the hosts the actions are allowed to call
+	//an entry starting with `*.` allows all subdomains, no entry denies all requests
+	AllowList []string
+	//MaxResponseSize in bytes, 0 uses the default of the actions
+	MaxResponseSize int64
+}
+
+func (c *ActionsHTTPConfig) IsValid() bool {
+	if c.MaxResponseSize < 0 || c.MaxResponseSize > MaxActionsHTTPResponseSize {
+		return false
+	}
+	for _, host := range c.AllowList {
+		if !isValidAllowedHost(host) {
+			return false
+		}
+	}
+	return true
+}
+
+//isValidAllowedHost checks that the entry is a plain host name
+//optionally prefixed with `*.` to allow all subdomains
+func isValidAllowedHost(host string) bool {
+	host = strings.TrimPrefix(host, "*.")
+	if host == "" {
+		return false
+	}
+	return !strings.ContainsAny(host, "*/:@?# ")
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestActionsHTTPConfig_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *ActionsHTTPConfig
+		want   bool
+	}{
+		{
+			name:   "empty config, valid",
+			config: &ActionsHTTPConfig{},
+			want:   true,
+		},
+		{
+			name: "hosts and wildcard, valid",
+			config: &ActionsHTTPConfig{
+				AllowList:       []string{"zitadel.ch", "*.zitadel.ch"},
+				MaxResponseSize: 1 << 20,
+			},
+			want: true,
+		},
+		{
+			name: "empty host, invalid",
+			config: &ActionsHTTPConfig{
+				AllowList: []string{""},
+			},
+			want: false,
+		},
+		{
+			name: "wildcard only, invalid",
+			config: &ActionsHTTPConfig{
+				AllowList: []string{"*."},
+			},
+			want: false,
+		},
+		{
+			name: "wildcard not as prefix, invalid",
+			config: &ActionsHTTPConfig{
+				AllowList: []string{"api.*.zitadel.ch"},
+			},
+			want: false,
+		},
+		{
+			name: "url instead of host, invalid",
+			config: &ActionsHTTPConfig{
+				AllowList: []string{"https://zitadel.ch/path"},
+			},
+			want: false,
+		},
+		{
+			name: "negative response size, invalid",
+			config: &ActionsHTTPConfig{
+				MaxResponseSize: -1,
+			},
+			want: false,
+		},
+		{
+			name: "response size too large, invalid",
+			config: &ActionsHTTPConfig{
+				MaxResponseSize: MaxActionsHTTPResponseSize + 1,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.IsValid(); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
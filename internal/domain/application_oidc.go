@@ -89,6 +89,8 @@ const (
 	OIDCGrantTypeAuthorizationCode OIDCGrantType = iota
 	OIDCGrantTypeImplicit
 	OIDCGrantTypeRefreshToken
+	OIDCGrantTypeDeviceCode
+	OIDCGrantTypeTokenExchange
 )
 
 type OIDCApplicationType int32
@@ -124,6 +126,9 @@ func (a *OIDCApp) IsValid() bool {
 	if a.ClockSkew > time.Second*5 || a.ClockSkew < time.Second*0 || !a.OriginsValid() {
 		return false
 	}
+	if !usesAuthorizationEndpoint(a.GrantTypes) {
+		return true
+	}
 	grantTypes := a.getRequiredGrantTypes()
 	if len(grantTypes) == 0 {
 		return false
@@ -163,6 +168,14 @@ func (a *OIDCApp) getRequiredGrantTypes() []OIDCGrantType {
 	return grantTypes
 }
 
+// usesAuthorizationEndpoint checks if the client uses the authorization endpoint and therefore needs response types and redirect uris,
+// which is not the case if it only uses the device authorization or token exchange grant (and refresh tokens)
+func usesAuthorizationEndpoint(grantTypes []OIDCGrantType) bool {
+	return containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) ||
+		containsOIDCGrantType(grantTypes, OIDCGrantTypeImplicit) ||
+		(!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeTokenExchange))
+}
+
 func containsOIDCGrantType(grantTypes []OIDCGrantType, grantType OIDCGrantType) bool {
 	for _, gt := range grantTypes {
 		if gt == grantType {
@@ -201,14 +214,16 @@ func GetOIDCV1Compliance(appType OIDCApplicationType, grantTypes []OIDCGrantType
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) &&
+		!containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) &&
+		!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
 }
 
 func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appType OIDCApplicationType, redirectUris []string) {
-	if len(redirectUris) == 0 {
+	if len(redirectUris) == 0 && usesAuthorizationEndpoint(grantTypes) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append([]string{"Application.OIDC.V1.NoRedirectUris"}, compliance.Problems...)
 	}
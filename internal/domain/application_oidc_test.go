@@ -177,6 +177,44 @@ func TestApplicationValid(t *testing.T) {
 			},
 			result: false,
 		},
+		{
+			name: "valid oidc application: device code only",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:    models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:         "AppID",
+					AppName:       "Name",
+					ResponseTypes: []OIDCResponseType{OIDCResponseTypeIDToken},
+					GrantTypes:    []OIDCGrantType{OIDCGrantTypeDeviceCode, OIDCGrantTypeRefreshToken},
+				},
+			},
+			result: true,
+		},
+		{
+			name: "valid oidc application: token exchange only",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot: models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:      "AppID",
+					AppName:    "Name",
+					GrantTypes: []OIDCGrantType{OIDCGrantTypeTokenExchange},
+				},
+			},
+			result: true,
+		},
+		{
+			name: "invalid oidc application: device code and authorization code without response type code",
+			args: args{
+				app: &OIDCApp{
+					ObjectRoot:    models.ObjectRoot{AggregateID: "AggregateID"},
+					AppID:         "AppID",
+					AppName:       "Name",
+					ResponseTypes: []OIDCResponseType{OIDCResponseTypeIDToken},
+					GrantTypes:    []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeDeviceCode},
+				},
+			},
+			result: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -241,6 +279,11 @@ func Test_checkGrantTypesCombination(t *testing.T) {
 			want:       &Compliance{},
 			grantTypes: []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeRefreshToken},
 		},
+		{
+			name:       "refresh token and device code",
+			want:       &Compliance{},
+			grantTypes: []OIDCGrantType{OIDCGrantTypeDeviceCode, OIDCGrantTypeRefreshToken},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -314,6 +357,22 @@ func Test_checkRedirectURIs(t *testing.T) {
 				appType:      OIDCApplicationTypeUserAgent,
 			},
 		},
+		{
+			name: "only device code without redirect uris",
+			want: &Compliance{},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeDeviceCode},
+				appType:    OIDCApplicationTypeNative,
+			},
+		},
+		{
+			name: "only token exchange without redirect uris",
+			want: &Compliance{},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeTokenExchange},
+				appType:    OIDCApplicationTypeWeb,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
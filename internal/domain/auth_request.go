@@ -42,6 +42,7 @@ type AuthRequest struct {
 	LinkingUsers             []*ExternalUser
 	PossibleSteps            []NextStep
 	PasswordVerified         bool
+	PasswordExpiryWarned     bool
 	MFAsVerified             []MFAType
 	Audience                 []string
 	AuthTime                 time.Time
@@ -103,6 +104,9 @@ const (
 	MFATypeOTP MFAType = iota
 	MFATypeU2F
 	MFATypeU2FUserVerification
+	MFATypeOTPEmail
+	MFATypeOTPSMS
+	MFATypeRecoveryCode
 )
 
 type MFALevel int
@@ -118,6 +122,10 @@ func NewAuthRequestFromType(requestType AuthRequestType) (*AuthRequest, error) {
 	switch requestType {
 	case AuthRequestTypeOIDC:
 		return &AuthRequest{Request: &AuthRequestOIDC{}}, nil
+	case AuthRequestTypeSAML:
+		return &AuthRequest{Request: &AuthRequestSAML{}}, nil
+	case AuthRequestTypeDevice:
+		return &AuthRequest{Request: &AuthRequestDevice{}}, nil
 	}
 	return nil, errors.ThrowInvalidArgument(nil, "DOMAIN-ds2kl", "invalid request type")
 }
@@ -152,25 +160,29 @@ func (a *AuthRequest) AppendAudIfNotExisting(aud string) {
 
 func (a *AuthRequest) GetScopeProjectIDsForAud() []string {
 	projectIDs := make([]string, 0)
-	switch request := a.Request.(type) {
-	case *AuthRequestOIDC:
-		for _, scope := range request.Scopes {
-			if strings.HasPrefix(scope, ProjectIDScope) && strings.HasSuffix(scope, AudSuffix) {
-				projectIDs = append(projectIDs, strings.TrimSuffix(strings.TrimPrefix(scope, ProjectIDScope), AudSuffix))
-			}
+	for _, scope := range a.scopes() {
+		if strings.HasPrefix(scope, ProjectIDScope) && strings.HasSuffix(scope, AudSuffix) {
+			projectIDs = append(projectIDs, strings.TrimSuffix(strings.TrimPrefix(scope, ProjectIDScope), AudSuffix))
 		}
 	}
 	return projectIDs
 }
 
 func (a *AuthRequest) GetScopeOrgPrimaryDomain() string {
-	switch request := a.Request.(type) {
-	case *AuthRequestOIDC:
-		for _, scope := range request.Scopes {
-			if strings.HasPrefix(scope, OrgDomainPrimaryScope) {
-				return strings.TrimPrefix(scope, OrgDomainPrimaryScope)
-			}
+	for _, scope := range a.scopes() {
+		if strings.HasPrefix(scope, OrgDomainPrimaryScope) {
+			return strings.TrimPrefix(scope, OrgDomainPrimaryScope)
 		}
 	}
 	return ""
 }
+
+func (a *AuthRequest) scopes() []string {
+	switch request := a.Request.(type) {
+	case *AuthRequestOIDC:
+		return request.Scopes
+	case *AuthRequestDevice:
+		return request.Scopes
+	}
+	return nil
+}
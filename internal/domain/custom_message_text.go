@@ -13,6 +13,10 @@ const (
 	VerifyPhoneMessageType              = "VerifyPhone"
 	DomainClaimedMessageType            = "DomainClaimed"
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
+	PasswordExpiryMessageType           = "PasswordExpiry"
+	VerifyEmailOTPMessageType           = "VerifyEmailOTP"
+	VerifySMSOTPMessageType             = "VerifySMSOTP"
+	RecoveryCodesLowMessageType         = "RecoveryCodesLow"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -29,6 +33,10 @@ type MessageTexts struct {
 	VerifyPhone              CustomMessageText
 	DomainClaimed            CustomMessageText
 	PasswordlessRegistration CustomMessageText
+	PasswordExpiry           CustomMessageText
+	VerifyEmailOTP           CustomMessageText
+	VerifySMSOTP             CustomMessageText
+	RecoveryCodesLow         CustomMessageText
 }
 
 type CustomMessageText struct {
@@ -65,6 +73,14 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *CustomMessageText {
 		return &m.DomainClaimed
 	case PasswordlessRegistrationMessageType:
 		return &m.PasswordlessRegistration
+	case PasswordExpiryMessageType:
+		return &m.PasswordExpiry
+	case VerifyEmailOTPMessageType:
+		return &m.VerifyEmailOTP
+	case VerifySMSOTPMessageType:
+		return &m.VerifySMSOTP
+	case RecoveryCodesLowMessageType:
+		return &m.RecoveryCodesLow
 	}
 	return nil
 }
@@ -75,5 +91,9 @@ func IsMessageTextType(textType string) bool {
 		textType == VerifyEmailMessageType ||
 		textType == VerifyPhoneMessageType ||
 		textType == DomainClaimedMessageType ||
-		textType == PasswordlessRegistrationMessageType
+		textType == PasswordlessRegistrationMessageType ||
+		textType == PasswordExpiryMessageType ||
+		textType == VerifyEmailOTPMessageType ||
+		textType == VerifySMSOTPMessageType ||
+		textType == RecoveryCodesLowMessageType
 }
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+type DeviceAuth struct {
+	models.ObjectRoot
+
+	ClientID   string
+	DeviceCode string
+	UserCode   string
+	Expires    time.Time
+	Scopes     []string
+	State      DeviceAuthState
+
+	Subject   string
+	UserOrgID string
+	Audience  []string
+	AMR       []string
+	AuthTime  time.Time
+}
+
+type DeviceAuthState int32
+
+const (
+	DeviceAuthStateUnspecified DeviceAuthState = iota
+	DeviceAuthStateInitiated
+	DeviceAuthStateApproved
+	DeviceAuthStateDenied
+	DeviceAuthStateRemoved
+)
+
+func (s DeviceAuthState) Exists() bool {
+	return !(s == DeviceAuthStateUnspecified || s == DeviceAuthStateRemoved)
+}
+
+func (a *DeviceAuth) IsExpired() bool {
+	return a.Expires.Before(time.Now())
+}
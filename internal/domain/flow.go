@@ -17,6 +17,10 @@ type FlowType int32
 const (
 	FlowTypeUnspecified FlowType = iota
 	FlowTypeExternalAuthentication
+	FlowTypeCustomiseToken
+	FlowTypeRegistration
+	FlowTypeUserCreation
+	FlowTypeUserGrant
 	flowTypeCount
 )
 
@@ -28,10 +32,21 @@ func (s FlowType) HasTrigger(triggerType TriggerType) bool {
 	switch triggerType {
 	case TriggerTypePostAuthentication:
 		return s == FlowTypeExternalAuthentication
-	case TriggerTypePreCreation:
-		return s == FlowTypeExternalAuthentication
-	case TriggerTypePostCreation:
-		return s == FlowTypeExternalAuthentication
+	case TriggerTypePreCreation,
+		TriggerTypePostCreation:
+		return s == FlowTypeExternalAuthentication ||
+			s == FlowTypeRegistration ||
+			s == FlowTypeUserCreation ||
+			s == FlowTypeUserGrant
+	case TriggerTypePreUserinfoCreation:
+		return s == FlowTypeCustomiseToken
+	case TriggerTypePreAccessTokenCreation:
+		return s == FlowTypeCustomiseToken
+	case TriggerTypePreChange,
+		TriggerTypePostChange,
+		TriggerTypePreRemoval,
+		TriggerTypePostRemoval:
+		return s == FlowTypeUserGrant
 	default:
 		return false
 	}
@@ -44,6 +59,12 @@ const (
 	TriggerTypePostAuthentication
 	TriggerTypePreCreation
 	TriggerTypePostCreation
+	TriggerTypePreUserinfoCreation
+	TriggerTypePreAccessTokenCreation
+	TriggerTypePreChange
+	TriggerTypePostChange
+	TriggerTypePreRemoval
+	TriggerTypePostRemoval
 	triggerTypeCount
 )
 
@@ -13,6 +13,8 @@ type Password struct {
 	SecretString   string
 	SecretCrypto   *crypto.CryptoValue
 	ChangeRequired bool
+	//EncodedHash is the hash of a password created by another system (e.g. on import)
+	EncodedHash string
 }
 
 func NewPassword(password string) *Password {
@@ -29,14 +31,22 @@ type PasswordCode struct {
 	NotificationType NotificationType
 }
 
-func (p *Password) HashPasswordIfExisting(policy *PasswordComplexityPolicy, passwordAlg crypto.HashAlgorithm) error {
+//CheckComplexity checks the password against the complexity policy without hashing it
+func (p *Password) CheckComplexity(policy *PasswordComplexityPolicy) error {
 	if p.SecretString == "" {
 		return nil
 	}
 	if policy == nil {
 		return caos_errs.ThrowPreconditionFailed(nil, "DOMAIN-s8ifS", "Errors.User.PasswordComplexityPolicy.NotFound")
 	}
-	if err := policy.Check(p.SecretString); err != nil {
+	return policy.Check(p.SecretString)
+}
+
+func (p *Password) HashPasswordIfExisting(policy *PasswordComplexityPolicy, passwordAlg crypto.HashAlgorithm) error {
+	if p.SecretString == "" {
+		return nil
+	}
+	if err := p.CheckComplexity(policy); err != nil {
 		return err
 	}
 	secret, err := crypto.Hash([]byte(p.SecretString), passwordAlg)
@@ -0,0 +1,12 @@
+package domain
+
+import (
+	es_models "github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+type RecoveryCodes struct {
+	es_models.ObjectRoot
+
+	//Codes are only returned in plain text once after generation
+	Codes []string
+}
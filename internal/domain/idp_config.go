@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"github.com/caos/zitadel/internal/crypto"
@@ -16,6 +17,8 @@ type IDPConfig struct {
 	State        IDPConfigState
 	OIDCConfig   *OIDCIDPConfig
 	JWTConfig    *JWTIDPConfig
+	SAMLConfig   *SAMLIDPConfig
+	LDAPConfig   *LDAPIDPConfig
 	AutoRegister bool
 }
 
@@ -69,12 +72,62 @@ type JWTIDPConfig struct {
 	HeaderName   string
 }
 
+type SAMLIDPConfig struct {
+	es_models.ObjectRoot
+	IDPConfigID          string
+	Metadata             []byte
+	MetadataURL          string
+	Key                  *crypto.CryptoValue
+	Certificate          []byte
+	Binding              SAMLBinding
+	WithSignedRequest    bool
+	NameIDFormat         SAMLNameIDFormat
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+}
+
+func (c *SAMLIDPConfig) IsValid() bool {
+	return (len(c.Metadata) > 0 || c.MetadataURL != "") && c.Binding.Valid() && c.NameIDFormat.Valid()
+}
+
+type LDAPIDPConfig struct {
+	es_models.ObjectRoot
+	IDPConfigID          string
+	URL                  string
+	StartTLS             bool
+	BindDN               string
+	BindPassword         *crypto.CryptoValue
+	BindPasswordString   string
+	BaseDN               string
+	UserObjectClass      string
+	IDAttribute          string
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+}
+
+func (c *LDAPIDPConfig) IsValid() bool {
+	return (strings.HasPrefix(c.URL, "ldap://") || strings.HasPrefix(c.URL, "ldaps://")) &&
+		!(c.StartTLS && strings.HasPrefix(c.URL, "ldaps://")) &&
+		c.BaseDN != "" &&
+		c.IDAttribute != "" &&
+		c.UsernameAttribute != ""
+}
+
 type IDPConfigType int32
 
 const (
 	IDPConfigTypeOIDC IDPConfigType = iota
 	IDPConfigTypeSAML
 	IDPConfigTypeJWT
+	IDPConfigTypeLDAP
 
 	//count is for validation
 	idpConfigTypeCount
@@ -125,3 +178,32 @@ func (st IDPConfigStylingType) GetCSSClass() string {
 		return ""
 	}
 }
+
+type SAMLBinding int32
+
+const (
+	SAMLBindingUnspecified SAMLBinding = iota
+	SAMLBindingRedirect
+	SAMLBindingPost
+
+	samlBindingCount
+)
+
+func (b SAMLBinding) Valid() bool {
+	return b >= 0 && b < samlBindingCount
+}
+
+type SAMLNameIDFormat int32
+
+const (
+	SAMLNameIDFormatUnspecified SAMLNameIDFormat = iota
+	SAMLNameIDFormatEmailAddress
+	SAMLNameIDFormatPersistent
+	SAMLNameIDFormatTransient
+
+	samlNameIDFormatCount
+)
+
+func (f SAMLNameIDFormat) Valid() bool {
+	return f >= 0 && f < samlNameIDFormatCount
+}
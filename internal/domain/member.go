@@ -1,14 +1,17 @@
 package domain
 
 import (
+	"time"
+
 	es_models "github.com/caos/zitadel/internal/eventstore/v1/models"
 )
 
 type Member struct {
 	es_models.ObjectRoot
 
-	UserID string
-	Roles  []string
+	UserID         string
+	Roles          []string
+	ExpirationDate time.Time
 }
 
 func NewMember(aggregateID, userID string, roles ...string) *Member {
@@ -29,6 +32,11 @@ func (i *Member) IsIAMValid() bool {
 	return i.UserID != "" && len(i.Roles) != 0
 }
 
+//HasExpired checks if the member has an expiration date which is reached at the given time
+func (i *Member) HasExpired(now time.Time) bool {
+	return !i.ExpirationDate.IsZero() && !i.ExpirationDate.After(now)
+}
+
 type MemberState int32
 
 const (
@@ -18,10 +18,18 @@ func (f MFAState) Valid() bool {
 }
 
 type MultifactorConfigs struct {
-	OTP OTPConfig
+	OTP           OTPConfig
+	RecoveryCodes RecoveryCodesConfig
 }
 
 type OTPConfig struct {
 	Issuer    string
 	CryptoMFA crypto.EncryptionAlgorithm
 }
+
+type RecoveryCodesConfig struct {
+	//Count is the amount of codes generated at once
+	Count int
+	//LowThreshold is the amount of remaining codes at which the user gets notified
+	LowThreshold int
+}
@@ -0,0 +1,326 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/caos/zitadel/internal/crypto"
+)
+
+//OrgExportVersion is the version of the export format written by this release
+//documents of other versions are rejected by the import
+const OrgExportVersion = "v1"
+
+//OrgExport is the document used to move organisations between ZITADEL installations
+//all ids are preserved on import, so references between the objects stay valid
+type OrgExport struct {
+	Version string         `json:"version"`
+	Orgs    []*ExportedOrg `json:"orgs"`
+}
+
+type ExportedOrg struct {
+	OrgID   string               `json:"orgId"`
+	Name    string               `json:"name"`
+	Domains []*ExportedOrgDomain `json:"domains,omitempty"`
+
+	//policies are only set if the organisation has its own policy
+	LoginPolicy              *ExportedLoginPolicy              `json:"loginPolicy,omitempty"`
+	PasswordComplexityPolicy *ExportedPasswordComplexityPolicy `json:"passwordComplexityPolicy,omitempty"`
+	PasswordAgePolicy        *ExportedPasswordAgePolicy        `json:"passwordAgePolicy,omitempty"`
+	LockoutPolicy            *ExportedLockoutPolicy            `json:"lockoutPolicy,omitempty"`
+	PrivacyPolicy            *ExportedPrivacyPolicy            `json:"privacyPolicy,omitempty"`
+	OrgIAMPolicy             *ExportedOrgIAMPolicy             `json:"orgIamPolicy,omitempty"`
+	LabelPolicy              *ExportedLabelPolicy              `json:"labelPolicy,omitempty"`
+
+	IDPs          []*ExportedIDP          `json:"idps,omitempty"`
+	Humans        []*ExportedHuman        `json:"humans,omitempty"`
+	Machines      []*ExportedMachine      `json:"machines,omitempty"`
+	Members       []*ExportedMember       `json:"members,omitempty"`
+	Projects      []*ExportedProject      `json:"projects,omitempty"`
+	ProjectGrants []*ExportedProjectGrant `json:"projectGrants,omitempty"`
+	UserGrants    []*ExportedUserGrant    `json:"userGrants,omitempty"`
+	CustomTexts   []*ExportedCustomText   `json:"customTexts,omitempty"`
+	Actions       []*ExportedAction       `json:"actions,omitempty"`
+	Flows         []*ExportedFlow         `json:"flows,omitempty"`
+}
+
+//ExportedOrgDomain doesn't contain the generated domain of the installation (e.g. org.zitadel.ch)
+//the importing installation adds its own
+type ExportedOrgDomain struct {
+	Domain     string `json:"domain"`
+	IsVerified bool   `json:"isVerified"`
+	IsPrimary  bool   `json:"isPrimary"`
+}
+
+type ExportedLoginPolicy struct {
+	AllowUsernamePassword bool               `json:"allowUsernamePassword"`
+	AllowRegister         bool               `json:"allowRegister"`
+	AllowExternalIDP      bool               `json:"allowExternalIdp"`
+	ForceMFA              bool               `json:"forceMfa"`
+	HidePasswordReset     bool               `json:"hidePasswordReset"`
+	PasswordlessType      PasswordlessType   `json:"passwordlessType"`
+	SecondFactors         []SecondFactorType `json:"secondFactors,omitempty"`
+	MultiFactors          []MultiFactorType  `json:"multiFactors,omitempty"`
+	//IDPIDs contains the linked identity providers of the organisation and of the IAM
+	IDPIDs []string `json:"idpIds,omitempty"`
+}
+
+type ExportedPasswordComplexityPolicy struct {
+	MinLength     uint64 `json:"minLength"`
+	HasLowercase  bool   `json:"hasLowercase"`
+	HasUppercase  bool   `json:"hasUppercase"`
+	HasNumber     bool   `json:"hasNumber"`
+	HasSymbol     bool   `json:"hasSymbol"`
+	CheckBreached bool   `json:"checkBreached"`
+}
+
+type ExportedPasswordAgePolicy struct {
+	MaxAgeDays     uint64 `json:"maxAgeDays"`
+	ExpireWarnDays uint64 `json:"expireWarnDays"`
+}
+
+type ExportedLockoutPolicy struct {
+	MaxPasswordAttempts uint64        `json:"maxPasswordAttempts"`
+	MaxOTPAttempts      uint64        `json:"maxOtpAttempts,omitempty"`
+	MaxU2FAttempts      uint64        `json:"maxU2fAttempts,omitempty"`
+	ShowFailures        bool          `json:"showFailures"`
+	LockoutDuration     time.Duration `json:"lockoutDuration,omitempty"`
+	BackoffDelay        time.Duration `json:"backoffDelay,omitempty"`
+}
+
+type ExportedPrivacyPolicy struct {
+	TOSLink     string `json:"tosLink"`
+	PrivacyLink string `json:"privacyLink"`
+}
+
+type ExportedOrgIAMPolicy struct {
+	UserLoginMustBeDomain bool `json:"userLoginMustBeDomain"`
+}
+
+//ExportedLabelPolicy doesn't contain the assets (logos, icons and fonts)
+type ExportedLabelPolicy struct {
+	PrimaryColor        string `json:"primaryColor"`
+	BackgroundColor     string `json:"backgroundColor"`
+	WarnColor           string `json:"warnColor"`
+	FontColor           string `json:"fontColor"`
+	PrimaryColorDark    string `json:"primaryColorDark"`
+	BackgroundColorDark string `json:"backgroundColorDark"`
+	WarnColorDark       string `json:"warnColorDark"`
+	FontColorDark       string `json:"fontColorDark"`
+	HideLoginNameSuffix bool   `json:"hideLoginNameSuffix"`
+	ErrorMsgPopup       bool   `json:"errorMsgPopup"`
+	DisableWatermark    bool   `json:"disableWatermark"`
+}
+
+//ExportedIDP doesn't contain secrets, they are encrypted with the keys of the exporting installation
+//the secrets can be provided in plain text before the import
+type ExportedIDP struct {
+	IDPID        string               `json:"idpId"`
+	Name         string               `json:"name"`
+	StylingType  IDPConfigStylingType `json:"stylingType"`
+	AutoRegister bool                 `json:"autoRegister"`
+
+	OIDC *ExportedOIDCIDP `json:"oidc,omitempty"`
+	JWT  *ExportedJWTIDP  `json:"jwt,omitempty"`
+	SAML *ExportedSAMLIDP `json:"saml,omitempty"`
+	LDAP *ExportedLDAPIDP `json:"ldap,omitempty"`
+}
+
+type ExportedOIDCIDP struct {
+	ClientID              string           `json:"clientId"`
+	ClientSecret          string           `json:"clientSecret,omitempty"`
+	Issuer                string           `json:"issuer"`
+	AuthorizationEndpoint string           `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         string           `json:"tokenEndpoint,omitempty"`
+	Scopes                []string         `json:"scopes,omitempty"`
+	DisplayNameMapping    OIDCMappingField `json:"displayNameMapping"`
+	UsernameMapping       OIDCMappingField `json:"usernameMapping"`
+}
+
+type ExportedJWTIDP struct {
+	JWTEndpoint  string `json:"jwtEndpoint"`
+	Issuer       string `json:"issuer"`
+	KeysEndpoint string `json:"keysEndpoint"`
+	HeaderName   string `json:"headerName"`
+}
+
+//ExportedSAMLIDP doesn't contain the key pair of the service provider
+//the importing installation generates a new one
+type ExportedSAMLIDP struct {
+	MetadataURL          string           `json:"metadataUrl,omitempty"`
+	Metadata             []byte           `json:"metadata,omitempty"`
+	Binding              SAMLBinding      `json:"binding"`
+	WithSignedRequest    bool             `json:"withSignedRequest"`
+	NameIDFormat         SAMLNameIDFormat `json:"nameIdFormat"`
+	UsernameAttribute    string           `json:"usernameAttribute,omitempty"`
+	DisplayNameAttribute string           `json:"displayNameAttribute,omitempty"`
+	FirstNameAttribute   string           `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute    string           `json:"lastNameAttribute,omitempty"`
+	EmailAttribute       string           `json:"emailAttribute,omitempty"`
+	PhoneAttribute       string           `json:"phoneAttribute,omitempty"`
+}
+
+type ExportedLDAPIDP struct {
+	URL                  string `json:"url"`
+	StartTLS             bool   `json:"startTls"`
+	BindDN               string `json:"bindDn,omitempty"`
+	BindPassword         string `json:"bindPassword,omitempty"`
+	BaseDN               string `json:"baseDn"`
+	UserObjectClass      string `json:"userObjectClass,omitempty"`
+	IDAttribute          string `json:"idAttribute"`
+	UsernameAttribute    string `json:"usernameAttribute"`
+	DisplayNameAttribute string `json:"displayNameAttribute,omitempty"`
+	FirstNameAttribute   string `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute    string `json:"lastNameAttribute,omitempty"`
+	EmailAttribute       string `json:"emailAttribute,omitempty"`
+	PhoneAttribute       string `json:"phoneAttribute,omitempty"`
+}
+
+type ExportedHuman struct {
+	UserID            string `json:"userId"`
+	Username          string `json:"username"`
+	FirstName         string `json:"firstName"`
+	LastName          string `json:"lastName"`
+	NickName          string `json:"nickName,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+	PreferredLanguage string `json:"preferredLanguage,omitempty"`
+	Gender            Gender `json:"gender"`
+	Email             string `json:"email"`
+	IsEmailVerified   bool   `json:"isEmailVerified"`
+	Phone             string `json:"phone,omitempty"`
+	IsPhoneVerified   bool   `json:"isPhoneVerified"`
+	//PasswordHash is the hashed password as stored in the eventstore
+	PasswordHash           *crypto.CryptoValue `json:"passwordHash,omitempty"`
+	PasswordChangeRequired bool                `json:"passwordChangeRequired"`
+}
+
+type ExportedMachine struct {
+	UserID      string `json:"userId"`
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type ExportedMember struct {
+	UserID string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+type ExportedProject struct {
+	ProjectID                  string                 `json:"projectId"`
+	Name                       string                 `json:"name"`
+	ProjectRoleAssertion       bool                   `json:"projectRoleAssertion"`
+	ProjectRoleCheck           bool                   `json:"projectRoleCheck"`
+	HasProjectCheck            bool                   `json:"hasProjectCheck"`
+	PrivateLabelingSetting     PrivateLabelingSetting `json:"privateLabelingSetting"`
+	TokenExchangeDelegation    bool                   `json:"tokenExchangeDelegation"`
+	TokenExchangeImpersonation bool                   `json:"tokenExchangeImpersonation"`
+	Roles                      []*ExportedProjectRole `json:"roles,omitempty"`
+	Apps                       []*ExportedApp         `json:"apps,omitempty"`
+	Members                    []*ExportedMember      `json:"members,omitempty"`
+}
+
+type ExportedProjectRole struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName,omitempty"`
+	Group       string `json:"group,omitempty"`
+}
+
+//ExportedApp doesn't contain client secrets
+//the import generates new secrets for confidential clients
+type ExportedApp struct {
+	AppID string           `json:"appId"`
+	Name  string           `json:"name"`
+	OIDC  *ExportedOIDCApp `json:"oidc,omitempty"`
+	API   *ExportedAPIApp  `json:"api,omitempty"`
+	SAML  *ExportedSAMLApp `json:"saml,omitempty"`
+}
+
+type ExportedOIDCApp struct {
+	ClientID                 string              `json:"clientId"`
+	RedirectURIs             []string            `json:"redirectUris,omitempty"`
+	ResponseTypes            []OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes               []OIDCGrantType     `json:"grantTypes,omitempty"`
+	AppType                  OIDCApplicationType `json:"appType"`
+	AuthMethodType           OIDCAuthMethodType  `json:"authMethodType"`
+	PostLogoutRedirectURIs   []string            `json:"postLogoutRedirectUris,omitempty"`
+	Version                  OIDCVersion         `json:"version"`
+	DevMode                  bool                `json:"devMode"`
+	AccessTokenType          OIDCTokenType       `json:"accessTokenType"`
+	AccessTokenRoleAssertion bool                `json:"accessTokenRoleAssertion"`
+	IDTokenRoleAssertion     bool                `json:"idTokenRoleAssertion"`
+	IDTokenUserinfoAssertion bool                `json:"idTokenUserinfoAssertion"`
+	ClockSkew                time.Duration       `json:"clockSkew"`
+	AdditionalOrigins        []string            `json:"additionalOrigins,omitempty"`
+}
+
+type ExportedAPIApp struct {
+	ClientID       string            `json:"clientId"`
+	AuthMethodType APIAuthMethodType `json:"authMethodType"`
+}
+
+type ExportedSAMLApp struct {
+	Metadata    []byte `json:"metadata,omitempty"`
+	MetadataURL string `json:"metadataUrl,omitempty"`
+}
+
+type ExportedProjectGrant struct {
+	GrantID      string   `json:"grantId"`
+	ProjectID    string   `json:"projectId"`
+	GrantedOrgID string   `json:"grantedOrgId"`
+	RoleKeys     []string `json:"roleKeys,omitempty"`
+}
+
+type ExportedUserGrant struct {
+	UserGrantID    string   `json:"userGrantId"`
+	UserID         string   `json:"userId"`
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+type ExportedCustomText struct {
+	Template string `json:"template"`
+	Language string `json:"language"`
+	Key      string `json:"key"`
+	Text     string `json:"text"`
+}
+
+type ExportedAction struct {
+	ActionID      string        `json:"actionId"`
+	Name          string        `json:"name"`
+	Script        string        `json:"script"`
+	Timeout       time.Duration `json:"timeout"`
+	AllowedToFail bool          `json:"allowedToFail"`
+}
+
+type ExportedFlow struct {
+	Type     FlowType                 `json:"type"`
+	Triggers []*ExportedTriggerAction `json:"triggers"`
+}
+
+type ExportedTriggerAction struct {
+	TriggerType TriggerType `json:"triggerType"`
+	ActionIDs   []string    `json:"actionIds"`
+}
+
+//OrgImportResult reports the outcome of the import of an organisation
+//objects which failed are listed in Errors, the remaining objects are imported anyway
+type OrgImportResult struct {
+	OrgID         string
+	Errors        []*ImportError
+	ClientSecrets []*ImportedClientSecret
+}
+
+type ImportError struct {
+	Type    string
+	ID      string
+	Message string
+}
+
+//ImportedClientSecret is the newly generated secret of a confidential client
+type ImportedClientSecret struct {
+	ProjectID    string
+	AppID        string
+	ClientID     string
+	ClientSecret string
+}
@@ -1,12 +1,51 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/caos/zitadel/internal/eventstore/v1/models"
 )
 
+const day = 24 * time.Hour
+
 type PasswordAgePolicy struct {
 	models.ObjectRoot
 
 	MaxAgeDays     uint64
 	ExpireWarnDays uint64
 }
+
+//ExpiryDate returns the date the password changed at passwordChanged expires
+//the zero time is returned if passwords never expire
+func (p *PasswordAgePolicy) ExpiryDate(passwordChanged time.Time) time.Time {
+	if p == nil || p.MaxAgeDays == 0 || passwordChanged.IsZero() {
+		return time.Time{}
+	}
+	return passwordChanged.Add(time.Duration(p.MaxAgeDays) * day)
+}
+
+func (p *PasswordAgePolicy) IsExpired(passwordChanged, now time.Time) bool {
+	expiry := p.ExpiryDate(passwordChanged)
+	return !expiry.IsZero() && !now.Before(expiry)
+}
+
+//IsInWarnPeriod returns true if the password is not expired yet
+//but will expire within the configured warn days
+func (p *PasswordAgePolicy) IsInWarnPeriod(passwordChanged, now time.Time) bool {
+	expiry := p.ExpiryDate(passwordChanged)
+	if expiry.IsZero() || p.ExpireWarnDays == 0 || !now.Before(expiry) {
+		return false
+	}
+	return !now.Before(expiry.Add(-time.Duration(p.ExpireWarnDays) * day))
+}
+
+//WarnPeriodPasswordChanges returns the range of password changes which are within the warn period at now
+//passwords changed after changedAfter and before or at changedUntil expire soon
+//false is returned if passwords never expire or no warning is configured
+func (p *PasswordAgePolicy) WarnPeriodPasswordChanges(now time.Time) (changedAfter, changedUntil time.Time, ok bool) {
+	if p == nil || p.MaxAgeDays == 0 || p.ExpireWarnDays == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	changedAfter = now.Add(-time.Duration(p.MaxAgeDays) * day)
+	return changedAfter, changedAfter.Add(time.Duration(p.ExpireWarnDays) * day), true
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPasswordAgePolicy_IsExpired(t *testing.T) {
+	now := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	type args struct {
+		policy          *PasswordAgePolicy
+		passwordChanged time.Time
+	}
+	tests := []struct {
+		name   string
+		args   args
+		result bool
+	}{
+		{
+			name: "no policy, not expired",
+			args: args{
+				passwordChanged: now.Add(-1000 * day),
+			},
+			result: false,
+		},
+		{
+			name: "no max age, not expired",
+			args: args{
+				policy:          &PasswordAgePolicy{},
+				passwordChanged: now.Add(-1000 * day),
+			},
+			result: false,
+		},
+		{
+			name: "younger than max age, not expired",
+			args: args{
+				policy:          &PasswordAgePolicy{MaxAgeDays: 90},
+				passwordChanged: now.Add(-89 * day),
+			},
+			result: false,
+		},
+		{
+			name: "older than max age, expired",
+			args: args{
+				policy:          &PasswordAgePolicy{MaxAgeDays: 90},
+				passwordChanged: now.Add(-90 * day),
+			},
+			result: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.args.policy.IsExpired(tt.args.passwordChanged, now)
+			if result != tt.result {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, result)
+			}
+		})
+	}
+}
+
+func TestPasswordAgePolicy_IsInWarnPeriod(t *testing.T) {
+	now := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	type args struct {
+		policy          *PasswordAgePolicy
+		passwordChanged time.Time
+	}
+	tests := []struct {
+		name   string
+		args   args
+		result bool
+	}{
+		{
+			name: "no warn days, no warning",
+			args: args{
+				policy:          &PasswordAgePolicy{MaxAgeDays: 90},
+				passwordChanged: now.Add(-89 * day),
+			},
+			result: false,
+		},
+		{
+			name: "before warn period, no warning",
+			args: args{
+				policy:          &PasswordAgePolicy{MaxAgeDays: 90, ExpireWarnDays: 10},
+				passwordChanged: now.Add(-79 * day),
+			},
+			result: false,
+		},
+		{
+			name: "in warn period, warning",
+			args: args{
+				policy:          &PasswordAgePolicy{MaxAgeDays: 90, ExpireWarnDays: 10},
+				passwordChanged: now.Add(-80 * day),
+			},
+			result: true,
+		},
+		{
+			name: "expired, no warning",
+			args: args{
+				policy:          &PasswordAgePolicy{MaxAgeDays: 90, ExpireWarnDays: 10},
+				passwordChanged: now.Add(-90 * day),
+			},
+			result: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.args.policy.IsInWarnPeriod(tt.args.passwordChanged, now)
+			if result != tt.result {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, result)
+			}
+		})
+	}
+}
+
+func TestPasswordAgePolicy_WarnPeriodPasswordChanges(t *testing.T) {
+	now := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	type res struct {
+		changedAfter time.Time
+		changedUntil time.Time
+		ok           bool
+	}
+	tests := []struct {
+		name   string
+		policy *PasswordAgePolicy
+		res    res
+	}{
+		{
+			name: "no policy, no range",
+		},
+		{
+			name:   "no max age, no range",
+			policy: &PasswordAgePolicy{ExpireWarnDays: 10},
+		},
+		{
+			name:   "no warn days, no range",
+			policy: &PasswordAgePolicy{MaxAgeDays: 90},
+		},
+		{
+			name:   "warn period",
+			policy: &PasswordAgePolicy{MaxAgeDays: 90, ExpireWarnDays: 10},
+			res: res{
+				changedAfter: now.Add(-90 * day),
+				changedUntil: now.Add(-80 * day),
+				ok:           true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changedAfter, changedUntil, ok := tt.policy.WarnPeriodPasswordChanges(now)
+			if ok != tt.res.ok || !changedAfter.Equal(tt.res.changedAfter) || !changedUntil.Equal(tt.res.changedUntil) {
+				t.Errorf("got wrong result: expected: %v %v %v, actual: %v %v %v ", tt.res.changedAfter, tt.res.changedUntil, tt.res.ok, changedAfter, changedUntil, ok)
+			}
+			if ok && (!tt.policy.IsInWarnPeriod(changedUntil, now) || tt.policy.IsInWarnPeriod(changedAfter, now)) {
+				t.Errorf("range doesn't match the warn period")
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ type PasswordComplexityPolicy struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
+	//CheckBreached rejects passwords found in known data breaches
+	CheckBreached bool
 
 	Default bool
 }
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+const (
+	//MaxPasswordHistoryCount limits the amount of previous passwords compared on a password change
+	//every previous password costs a comparison with the slow password hash
+	MaxPasswordHistoryCount = 10
+)
+
+type PasswordHistoryPolicy struct {
+	models.ObjectRoot
+
+	HistoryCount uint64
+	Default      bool
+}
+
+//IsEnabled returns true if previous passwords must not be reused
+func (p *PasswordHistoryPolicy) IsEnabled() bool {
+	return p != nil && p.HistoryCount > 0
+}
+
+func (p *PasswordHistoryPolicy) IsValid() error {
+	if p.HistoryCount > MaxPasswordHistoryCount {
+		return caos_errs.ThrowInvalidArgument(nil, "DOMAIN-Ph8sq", "Errors.Policy.PasswordHistory.InvalidCount")
+	}
+	return nil
+}
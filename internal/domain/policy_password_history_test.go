@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func TestPasswordHistoryPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *PasswordHistoryPolicy
+		err    func(error) bool
+	}{
+		{
+			name:   "history disabled, ok",
+			policy: &PasswordHistoryPolicy{},
+		},
+		{
+			name: "max history count, ok",
+			policy: &PasswordHistoryPolicy{
+				HistoryCount: MaxPasswordHistoryCount,
+			},
+		},
+		{
+			name: "history count too high, invalid argument error",
+			policy: &PasswordHistoryPolicy{
+				HistoryCount: MaxPasswordHistoryCount + 1,
+			},
+			err: caos_errs.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.IsValid()
+			if tt.err == nil && err != nil {
+				t.Errorf("got unexpected error: %v", err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -1,13 +1,71 @@
 package domain
 
 import (
+	"math"
+	"time"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
 	"github.com/caos/zitadel/internal/eventstore/v1/models"
 )
 
+const (
+	//maxBackoffShift limits the backoff to BackoffDelay * 2^10
+	maxBackoffShift = 10
+	//MaxBackoffDelay is the highest BackoffDelay a policy accepts
+	MaxBackoffDelay = time.Hour
+)
+
 type LockoutPolicy struct {
 	models.ObjectRoot
 
 	Default             bool
 	MaxPasswordAttempts uint64
+	MaxOTPAttempts      uint64
+	MaxU2FAttempts      uint64
 	ShowLockOutFailures bool
+	//LockoutDuration is the time after which a locked user is unlocked automatically
+	//zero means the user stays locked until unlocked manually
+	LockoutDuration time.Duration
+	//BackoffDelay is the delay after the first failed attempt
+	//it doubles with every further failed attempt
+	BackoffDelay time.Duration
+}
+
+func (p *LockoutPolicy) IsValid() error {
+	if p.LockoutDuration < 0 || p.BackoffDelay < 0 {
+		return caos_errs.ThrowInvalidArgument(nil, "DOMAIN-3nM9s", "Errors.Policy.Lockout.InvalidDuration")
+	}
+	if p.BackoffDelay > MaxBackoffDelay {
+		return caos_errs.ThrowInvalidArgument(nil, "DOMAIN-Bk2o9", "Errors.Policy.Lockout.BackoffDelayTooLong")
+	}
+	return nil
+}
+
+//LockedUntil returns the time until a user locked at lockedAt stays locked
+//the zero time is returned if the lock has to be removed manually
+func (p *LockoutPolicy) LockedUntil(lockedAt time.Time) time.Time {
+	if p == nil || p.LockoutDuration <= 0 {
+		return time.Time{}
+	}
+	return lockedAt.Add(p.LockoutDuration)
+}
+
+//Backoff returns the time to wait before the next attempt after the given amount of consecutive failed attempts
+func (p *LockoutPolicy) Backoff(failedAttempts uint64) time.Duration {
+	if p == nil || p.BackoffDelay <= 0 || failedAttempts == 0 {
+		return 0
+	}
+	shift := failedAttempts - 1
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	if p.BackoffDelay > math.MaxInt64>>shift {
+		return math.MaxInt64
+	}
+	return p.BackoffDelay << shift
+}
+
+//NextAttemptAllowed returns the earliest time a user is allowed to try again
+func (p *LockoutPolicy) NextAttemptAllowed(failedAttempts uint64, lastFailed time.Time) time.Time {
+	return lastFailed.Add(p.Backoff(failedAttempts))
 }
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func TestLockoutPolicy_Backoff(t *testing.T) {
+	type args struct {
+		policy         *LockoutPolicy
+		failedAttempts uint64
+	}
+	tests := []struct {
+		name   string
+		args   args
+		result time.Duration
+	}{
+		{
+			name: "no policy",
+			args: args{
+				failedAttempts: 3,
+			},
+			result: 0,
+		},
+		{
+			name: "backoff disabled",
+			args: args{
+				policy:         &LockoutPolicy{},
+				failedAttempts: 3,
+			},
+			result: 0,
+		},
+		{
+			name: "no failed attempts",
+			args: args{
+				policy:         &LockoutPolicy{BackoffDelay: time.Second},
+				failedAttempts: 0,
+			},
+			result: 0,
+		},
+		{
+			name: "first failed attempt",
+			args: args{
+				policy:         &LockoutPolicy{BackoffDelay: time.Second},
+				failedAttempts: 1,
+			},
+			result: time.Second,
+		},
+		{
+			name: "third failed attempt",
+			args: args{
+				policy:         &LockoutPolicy{BackoffDelay: time.Second},
+				failedAttempts: 3,
+			},
+			result: 4 * time.Second,
+		},
+		{
+			name: "backoff limited",
+			args: args{
+				policy:         &LockoutPolicy{BackoffDelay: time.Second},
+				failedAttempts: 100,
+			},
+			result: 1024 * time.Second,
+		},
+		{
+			name: "backoff saturated",
+			args: args{
+				policy:         &LockoutPolicy{BackoffDelay: math.MaxInt64 >> 2},
+				failedAttempts: 100,
+			},
+			result: math.MaxInt64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.args.policy.Backoff(tt.args.failedAttempts)
+			if result != tt.result {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, result)
+			}
+		})
+	}
+}
+
+func TestLockoutPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *LockoutPolicy
+		err    func(error) bool
+	}{
+		{
+			name:   "empty policy, ok",
+			policy: &LockoutPolicy{},
+		},
+		{
+			name: "negative lockout duration, invalid argument error",
+			policy: &LockoutPolicy{
+				LockoutDuration: -time.Second,
+			},
+			err: caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			name: "negative backoff delay, invalid argument error",
+			policy: &LockoutPolicy{
+				BackoffDelay: -time.Second,
+			},
+			err: caos_errs.IsErrorInvalidArgument,
+		},
+		{
+			name: "max backoff delay, ok",
+			policy: &LockoutPolicy{
+				BackoffDelay: MaxBackoffDelay,
+			},
+		},
+		{
+			name: "backoff delay too long, invalid argument error",
+			policy: &LockoutPolicy{
+				BackoffDelay: MaxBackoffDelay + time.Second,
+			},
+			err: caos_errs.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.IsValid()
+			if tt.err == nil && err != nil {
+				t.Errorf("got unexpected error: %v", err)
+			}
+			if tt.err != nil && !tt.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestLockoutPolicy_LockedUntil(t *testing.T) {
+	lockedAt := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	type args struct {
+		policy *LockoutPolicy
+	}
+	tests := []struct {
+		name   string
+		args   args
+		result time.Time
+	}{
+		{
+			name:   "no policy, locked permanently",
+			args:   args{},
+			result: time.Time{},
+		},
+		{
+			name: "no lockout duration, locked permanently",
+			args: args{
+				policy: &LockoutPolicy{},
+			},
+			result: time.Time{},
+		},
+		{
+			name: "lockout duration",
+			args: args{
+				policy: &LockoutPolicy{LockoutDuration: 15 * time.Minute},
+			},
+			result: lockedAt.Add(15 * time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.args.policy.LockedUntil(lockedAt)
+			if !result.Equal(tt.result) {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result, result)
+			}
+		})
+	}
+}
@@ -7,12 +7,14 @@ import (
 type Project struct {
 	models.ObjectRoot
 
-	State                  ProjectState
-	Name                   string
-	ProjectRoleAssertion   bool
-	ProjectRoleCheck       bool
-	HasProjectCheck        bool
-	PrivateLabelingSetting PrivateLabelingSetting
+	State                      ProjectState
+	Name                       string
+	ProjectRoleAssertion       bool
+	ProjectRoleCheck           bool
+	HasProjectCheck            bool
+	PrivateLabelingSetting     PrivateLabelingSetting
+	TokenExchangeDelegation    bool
+	TokenExchangeImpersonation bool
 }
 
 type ProjectState int32
@@ -18,6 +18,7 @@ type AuthRequestType int32
 const (
 	AuthRequestTypeOIDC AuthRequestType = iota
 	AuthRequestTypeSAML
+	AuthRequestTypeDevice
 )
 
 type AuthRequestOIDC struct {
@@ -37,6 +38,10 @@ func (a *AuthRequestOIDC) IsValid() bool {
 }
 
 type AuthRequestSAML struct {
+	ID          string
+	BindingType string
+	Issuer      string
+	Destination string
 }
 
 func (a *AuthRequestSAML) Type() AuthRequestType {
@@ -44,5 +49,19 @@ func (a *AuthRequestSAML) Type() AuthRequestType {
 }
 
 func (a *AuthRequestSAML) IsValid() bool {
-	return true
+	return a.ID != "" && a.Issuer != ""
+}
+
+type AuthRequestDevice struct {
+	ID       string
+	UserCode string
+	Scopes   []string
+}
+
+func (a *AuthRequestDevice) Type() AuthRequestType {
+	return AuthRequestTypeDevice
+}
+
+func (a *AuthRequestDevice) IsValid() bool {
+	return a.ID != "" && a.UserCode != ""
 }
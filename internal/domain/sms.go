@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+type SMSConfigTwilio struct {
+	models.ObjectRoot
+
+	SID          string
+	Token        string
+	SenderNumber string
+}
+
+func (c *SMSConfigTwilio) IsValid() bool {
+	return c.SID != "" && c.SenderNumber != ""
+}
+
+type SMSConfigVonage struct {
+	models.ObjectRoot
+
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+}
+
+func (c *SMSConfigVonage) IsValid() bool {
+	return c.APIKey != "" && c.SenderNumber != ""
+}
+
+//SMSConfigHTTP describes a generic sms gateway which is called with a request rendered from BodyTemplate
+type SMSConfigHTTP struct {
+	models.ObjectRoot
+
+	Endpoint      string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	SenderNumber  string
+	Authorization string
+}
+
+func (c *SMSConfigHTTP) IsValid() bool {
+	return c.Endpoint != "" && c.BodyTemplate != ""
+}
+
+type SMSConfigState int32
+
+const (
+	SMSConfigStateUnspecified SMSConfigState = iota
+	SMSConfigStateActive
+	SMSConfigStateInactive
+	SMSConfigStateRemoved
+	smsConfigStateCount
+)
+
+func (s SMSConfigState) Valid() bool {
+	return s >= 0 && s < smsConfigStateCount
+}
+
+func (s SMSConfigState) Exists() bool {
+	return s != SMSConfigStateUnspecified && s != SMSConfigStateRemoved
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"net"
+
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+type SMTPConfig struct {
+	models.ObjectRoot
+
+	TLS           bool
+	SenderAddress string
+	SenderName    string
+	Host          string
+	User          string
+	Password      string
+}
+
+func (c *SMTPConfig) IsValid() bool {
+	if c.SenderAddress == "" || c.SenderName == "" || c.Host == "" {
+		return false
+	}
+	_, _, err := net.SplitHostPort(c.Host)
+	return err == nil
+}
+
+type SMTPConfigState int32
+
+const (
+	SMTPConfigStateUnspecified SMTPConfigState = iota
+	SMTPConfigStateActive
+	smtpConfigStateCount
+)
+
+func (s SMTPConfigState) Valid() bool {
+	return s >= 0 && s < smtpConfigStateCount
+}
+
+func (s SMTPConfigState) Exists() bool {
+	return s == SMTPConfigStateActive
+}
@@ -18,8 +18,23 @@ type Token struct {
 	Expiration        time.Time
 	Scopes            []string
 	PreferredLanguage string
+	Actor             *TokenActor
 }
 
+// TokenActor is the acting party of a token issued by the token exchange grant (RFC 8693)
+type TokenActor struct {
+	Subject  string
+	ClientID string
+}
+
+type TokenExchangeType int32
+
+const (
+	TokenExchangeTypeUnspecified TokenExchangeType = iota
+	TokenExchangeTypeDelegation
+	TokenExchangeTypeImpersonation
+)
+
 func AddAudScopeToAudience(audience, scopes []string) []string {
 	for _, scope := range scopes {
 		if strings.HasPrefix(scope, ProjectIDScope) && strings.HasSuffix(scope, AudSuffix) {
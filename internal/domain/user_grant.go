@@ -1,6 +1,10 @@
 package domain
 
-import es_models "github.com/caos/zitadel/internal/eventstore/v1/models"
+import (
+	"time"
+
+	es_models "github.com/caos/zitadel/internal/eventstore/v1/models"
+)
 
 type UserGrant struct {
 	es_models.ObjectRoot
@@ -10,6 +14,7 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ExpirationDate time.Time
 }
 
 type UserGrantState int32
@@ -25,6 +30,11 @@ func (u *UserGrant) IsValid() bool {
 	return u.ProjectID != "" && u.UserID != ""
 }
 
+//HasExpired checks if the grant has an expiration date which is reached at the given time
+func (u *UserGrant) HasExpired(now time.Time) bool {
+	return !u.ExpirationDate.IsZero() && !u.ExpirationDate.After(now)
+}
+
 func (g *UserGrant) HasInvalidRoles(validRoles []string) bool {
 	for _, roleKey := range g.RoleKeys {
 		if !containsRoleKey(roleKey, validRoles) {
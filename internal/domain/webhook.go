@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/caos/zitadel/internal/eventstore/v1/models"
+)
+
+type Webhook struct {
+	models.ObjectRoot
+
+	Name       string
+	URL        string
+	EventTypes []string
+	State      WebhookState
+}
+
+func (w *Webhook) IsValid() bool {
+	if w.Name == "" || len(w.EventTypes) == 0 {
+		return false
+	}
+	for _, eventType := range w.EventTypes {
+		if eventType == "" {
+			return false
+		}
+	}
+	endpoint, err := url.Parse(w.URL)
+	if err != nil {
+		return false
+	}
+	if (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Hostname() == "" {
+		return false
+	}
+	return isAllowedWebhookHost(endpoint.Hostname())
+}
+
+//Host returns the host name or ip of the target
+func (w *Webhook) Host() string {
+	endpoint, err := url.Parse(w.URL)
+	if err != nil {
+		return ""
+	}
+	return endpoint.Hostname()
+}
+
+//isAllowedWebhookHost rejects the hosts which are known to be local without resolving them
+func isAllowedWebhookHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return IsAllowedWebhookIP(ip)
+	}
+	return true
+}
+
+var disallowedWebhookNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("198.18.0.0/15"),
+}
+
+//IsAllowedWebhookIP checks if webhook targets are allowed to be reached at the ip
+//loopback, link-local, private, unspecified and multicast addresses are not allowed
+//to prevent requests to internal services like the metadata endpoint of the cloud provider
+func IsAllowedWebhookIP(ip net.IP) bool {
+	if ip == nil ||
+		ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range disallowedWebhookNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+type WebhookState int32
+
+const (
+	WebhookStateUnspecified WebhookState = iota
+	WebhookStateActive
+	WebhookStateInactive
+	WebhookStateRemoved
+	webhookStateCount
+)
+
+func (s WebhookState) Valid() bool {
+	return s >= 0 && s < webhookStateCount
+}
+
+func (s WebhookState) Exists() bool {
+	return s != WebhookStateUnspecified && s != WebhookStateRemoved
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"net"
+	"testing"
+)
+
+func TestWebhook_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		result bool
+	}{
+		{
+			name:   "public host, ok",
+			url:    "https://example.com/hook",
+			result: true,
+		},
+		{
+			name:   "public ip, ok",
+			url:    "http://93.184.216.34:8080/hook",
+			result: true,
+		},
+		{
+			name:   "invalid scheme, invalid",
+			url:    "ftp://example.com/hook",
+			result: false,
+		},
+		{
+			name:   "localhost, invalid",
+			url:    "http://localhost:8080/hook",
+			result: false,
+		},
+		{
+			name:   "loopback ip, invalid",
+			url:    "http://127.0.0.1/hook",
+			result: false,
+		},
+		{
+			name:   "metadata endpoint, invalid",
+			url:    "http://169.254.169.254/latest/meta-data",
+			result: false,
+		},
+		{
+			name:   "private ip, invalid",
+			url:    "https://192.168.1.10/hook",
+			result: false,
+		},
+		{
+			name:   "loopback ipv6, invalid",
+			url:    "http://[::1]/hook",
+			result: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := &Webhook{
+				Name:       "name",
+				URL:        tt.url,
+				EventTypes: []string{"user.locked"},
+			}
+			if result := webhook.IsValid(); result != tt.result {
+				t.Errorf("got wrong result: want %t, got %t", tt.result, result)
+			}
+		})
+	}
+}
+
+func TestIsAllowedWebhookIP(t *testing.T) {
+	tests := []struct {
+		name   string
+		ip     string
+		result bool
+	}{
+		{
+			name:   "public ipv4, allowed",
+			ip:     "93.184.216.34",
+			result: true,
+		},
+		{
+			name:   "public ipv6, allowed",
+			ip:     "2606:2800:220:1:248:1893:25c8:1946",
+			result: true,
+		},
+		{
+			name:   "unspecified, not allowed",
+			ip:     "0.0.0.0",
+			result: false,
+		},
+		{
+			name:   "private, not allowed",
+			ip:     "172.16.0.1",
+			result: false,
+		},
+		{
+			name:   "shared address space, not allowed",
+			ip:     "100.64.0.1",
+			result: false,
+		},
+		{
+			name:   "ipv4 mapped loopback, not allowed",
+			ip:     "::ffff:127.0.0.1",
+			result: false,
+		},
+		{
+			name:   "unique local ipv6, not allowed",
+			ip:     "fd00::1",
+			result: false,
+		},
+		{
+			name:   "link-local ipv6, not allowed",
+			ip:     "fe80::1",
+			result: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsAllowedWebhookIP(net.ParseIP(tt.ip)); result != tt.result {
+				t.Errorf("got wrong result: want %t, got %t", tt.result, result)
+			}
+		})
+	}
+}
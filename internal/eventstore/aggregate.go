@@ -63,4 +63,7 @@ type Aggregate struct {
 	ResourceOwner string `json:"-"`
 	//Version is the semver this aggregate represents
 	Version Version `json:"-"`
+	//PreviousSequence is the sequence of the latest event of the aggregate the command was based on
+	// if it's set the push fails if another event was pushed to the aggregate in the meantime
+	PreviousSequence uint64 `json:"-"`
 }
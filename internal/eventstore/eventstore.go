@@ -60,6 +60,7 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 
 func commandsToRepository(cmds []Command) (events []*repository.Event, constraints []*repository.UniqueConstraint, err error) {
 	events = make([]*repository.Event, len(cmds))
+	checkedAggregates := make(map[repository.AggregateType]map[string]bool)
 	for i, cmd := range cmds {
 		data, err := EventData(cmd)
 		if err != nil {
@@ -87,6 +88,14 @@ func commandsToRepository(cmds []Command) (events []*repository.Event, constrain
 			Version:       repository.Version(cmd.Aggregate().Version),
 			Data:          data,
 		}
+		//the previous sequence is only checked on the first event of the aggregate
+		if cmd.Aggregate().PreviousSequence > 0 && !checkedAggregates[events[i].AggregateType][events[i].AggregateID] {
+			events[i].ExpectedPreviousAggregateSequence = cmd.Aggregate().PreviousSequence
+		}
+		if checkedAggregates[events[i].AggregateType] == nil {
+			checkedAggregates[events[i].AggregateType] = make(map[string]bool)
+		}
+		checkedAggregates[events[i].AggregateType][events[i].AggregateID] = true
 		if len(cmd.UniqueConstraints()) > 0 {
 			constraints = append(constraints, uniqueConstraintsToRepository(cmd.UniqueConstraints())...)
 		}
@@ -36,6 +36,21 @@ func newTestEvent(id, description string, data func() interface{}, checkPrevious
 	}
 }
 
+func newTestEventWithPreviousSequence(id string, previousSequence uint64) *testEvent {
+	aggregate := NewAggregate(authz.NewMockContext("caos", "adlerhurst"), id, "test.aggregate", "v1")
+	aggregate.PreviousSequence = previousSequence
+	return &testEvent{
+		data: func() interface{} {
+			return nil
+		},
+		BaseEvent: *NewBaseEventForPush(
+			service.WithService(authz.NewMockContext("resourceOwner", "editorUser"), "editorService"),
+			aggregate,
+			"test.event",
+		),
+	}
+}
+
 func (e *testEvent) Data() interface{} {
 	return e.data()
 }
@@ -430,6 +445,53 @@ func TestEventstore_aggregatesToEvents(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "previous sequence only on first event of aggregate",
+			args: args{
+				events: []Command{
+					newTestEventWithPreviousSequence("1", 5),
+					newTestEventWithPreviousSequence("1", 5),
+					newTestEventWithPreviousSequence("2", 7),
+				},
+			},
+			res: res{
+				wantErr: false,
+				events: []*repository.Event{
+					{
+						AggregateID:                       "1",
+						AggregateType:                     "test.aggregate",
+						Data:                              []byte(nil),
+						EditorService:                     "editorService",
+						EditorUser:                        "editorUser",
+						ResourceOwner:                     sql.NullString{String: "caos", Valid: true},
+						Type:                              "test.event",
+						Version:                           "v1",
+						ExpectedPreviousAggregateSequence: 5,
+					},
+					{
+						AggregateID:   "1",
+						AggregateType: "test.aggregate",
+						Data:          []byte(nil),
+						EditorService: "editorService",
+						EditorUser:    "editorUser",
+						ResourceOwner: sql.NullString{String: "caos", Valid: true},
+						Type:          "test.event",
+						Version:       "v1",
+					},
+					{
+						AggregateID:                       "2",
+						AggregateType:                     "test.aggregate",
+						Data:                              []byte(nil),
+						EditorService:                     "editorService",
+						EditorUser:                        "editorUser",
+						ResourceOwner:                     sql.NullString{String: "caos", Valid: true},
+						Type:                              "test.event",
+						Version:                           "v1",
+						ExpectedPreviousAggregateSequence: 7,
+					},
+				},
+			},
+		},
 		{
 			name: "invalid data",
 			args: args{
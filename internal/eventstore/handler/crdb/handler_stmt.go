@@ -41,8 +41,9 @@ type StatementHandler struct {
 	failureCountStmt        string
 	setFailureCountStmt     string
 
-	aggregates []eventstore.AggregateType
-	reduces    map[eventstore.EventType]handler.Reduce
+	aggregates       []eventstore.AggregateType
+	reduces          map[eventstore.EventType]handler.Reduce
+	aggregateReduces map[eventstore.AggregateType]handler.Reduce
 
 	bulkLimit uint64
 }
@@ -53,8 +54,12 @@ func NewStatementHandler(
 ) StatementHandler {
 	aggregateTypes := make([]eventstore.AggregateType, 0, len(config.Reducers))
 	reduces := make(map[eventstore.EventType]handler.Reduce, len(config.Reducers))
+	aggregateReduces := make(map[eventstore.AggregateType]handler.Reduce)
 	for _, aggReducer := range config.Reducers {
 		aggregateTypes = append(aggregateTypes, aggReducer.Aggregate)
+		if aggReducer.Reduce != nil {
+			aggregateReduces[aggReducer.Aggregate] = aggReducer.Reduce
+		}
 		for _, eventReducer := range aggReducer.EventRedusers {
 			reduces[eventReducer.Event] = eventReducer.Reduce
 		}
@@ -71,6 +76,7 @@ func NewStatementHandler(
 		setFailureCountStmt:     fmt.Sprintf(setFailureCountStmtFormat, config.FailedEventsTable),
 		aggregates:              aggregateTypes,
 		reduces:                 reduces,
+		aggregateReduces:        aggregateReduces,
 		bulkLimit:               config.BulkLimit,
 		Locker:                  NewLocker(config.Client, config.LockTable, config.ProjectionHandlerConfig.ProjectionName),
 	}
@@ -8,6 +8,9 @@ import (
 //reduce implements handler.Reduce function
 func (h *StatementHandler) reduce(event eventstore.Event) (*handler.Statement, error) {
 	reduce, ok := h.reduces[event.Type()]
+	if !ok {
+		reduce, ok = h.aggregateReduces[event.Aggregate().Type]
+	}
 	if !ok {
 		return NewNoOpStatement(event), nil
 	}
@@ -0,0 +1,138 @@
+package crdb
+
+import (
+	"testing"
+
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+)
+
+func TestStatementHandler_reduce(t *testing.T) {
+	eventReduce := func(event eventstore.Event) (*handler.Statement, error) {
+		return &handler.Statement{
+			Sequence: 1,
+			Execute:  func(handler.Executer, string) error { return nil },
+		}, nil
+	}
+	aggregateReduce := func(event eventstore.Event) (*handler.Statement, error) {
+		return &handler.Statement{
+			Sequence: 2,
+			Execute:  func(handler.Executer, string) error { return nil },
+		}, nil
+	}
+	type fields struct {
+		reducers []handler.AggregateReducer
+	}
+	type args struct {
+		event *testEvent
+	}
+	type want struct {
+		sequence uint64
+		isNoop   bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			name: "event reducer",
+			fields: fields{
+				reducers: []handler.AggregateReducer{
+					{
+						Aggregate: "agg",
+						EventRedusers: []handler.EventReducer{
+							{Event: "agg.added", Reduce: eventReduce},
+						},
+						Reduce: aggregateReduce,
+					},
+				},
+			},
+			args: args{
+				event: &testEvent{
+					BaseEvent:     eventstore.BaseEvent{EventType: "agg.added"},
+					aggregateType: "agg",
+					sequence:      5,
+				},
+			},
+			want: want{
+				sequence: 1,
+			},
+		},
+		{
+			name: "aggregate reducer",
+			fields: fields{
+				reducers: []handler.AggregateReducer{
+					{
+						Aggregate: "agg",
+						EventRedusers: []handler.EventReducer{
+							{Event: "agg.added", Reduce: eventReduce},
+						},
+						Reduce: aggregateReduce,
+					},
+				},
+			},
+			args: args{
+				event: &testEvent{
+					BaseEvent:     eventstore.BaseEvent{EventType: "agg.changed"},
+					aggregateType: "agg",
+					sequence:      5,
+				},
+			},
+			want: want{
+				sequence: 2,
+			},
+		},
+		{
+			name: "no reducer",
+			fields: fields{
+				reducers: []handler.AggregateReducer{
+					{
+						Aggregate: "agg",
+						EventRedusers: []handler.EventReducer{
+							{Event: "agg.added", Reduce: eventReduce},
+						},
+					},
+				},
+			},
+			args: args{
+				event: &testEvent{
+					BaseEvent:     eventstore.BaseEvent{EventType: "agg.changed"},
+					aggregateType: "agg",
+					sequence:      5,
+				},
+			},
+			want: want{
+				sequence: 5,
+				isNoop:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &StatementHandler{
+				reduces:          make(map[eventstore.EventType]handler.Reduce),
+				aggregateReduces: make(map[eventstore.AggregateType]handler.Reduce),
+			}
+			for _, aggReducer := range tt.fields.reducers {
+				for _, eventReducer := range aggReducer.EventRedusers {
+					h.reduces[eventReducer.Event] = eventReducer.Reduce
+				}
+				if aggReducer.Reduce != nil {
+					h.aggregateReduces[aggReducer.Aggregate] = aggReducer.Reduce
+				}
+			}
+			stmt, err := h.reduce(tt.args.event)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stmt.Sequence != tt.want.sequence {
+				t.Errorf("wrong sequence: want %d, got %d", tt.want.sequence, stmt.Sequence)
+			}
+			if stmt.IsNoop() != tt.want.isNoop {
+				t.Errorf("wrong noop: want %t, got %t", tt.want.isNoop, stmt.IsNoop())
+			}
+		})
+	}
+}
@@ -56,6 +56,10 @@ type Event struct {
 	// an aggregate can only be managed by one organisation
 	// use the ID of the org
 	ResourceOwner sql.NullString
+
+	//ExpectedPreviousAggregateSequence is the sequence the aggregate must have before the event is pushed
+	// the push fails if the aggregate changed in the meantime, 0 disables the check
+	ExpectedPreviousAggregateSequence uint64
 }
 
 //EventType is the description of the change
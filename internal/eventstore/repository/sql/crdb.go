@@ -126,6 +126,9 @@ func (db *CRDB) Push(ctx context.Context, events []*repository.Event, uniqueCons
 					"eventType", event.Type).WithError(err).Info("query failed")
 				return caos_errs.ThrowInternal(err, "SQL-SBP37", "unable to create event")
 			}
+			if event.ExpectedPreviousAggregateSequence > 0 && event.PreviousAggregateSequence != event.ExpectedPreviousAggregateSequence {
+				return caos_errs.ThrowPreconditionFailed(nil, "SQL-Sq8pc", "aggregate changed in the meantime")
+			}
 		}
 
 		err := db.handleUniqueConstraints(ctx, tx, uniqueConstraints...)
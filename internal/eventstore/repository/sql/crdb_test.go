@@ -340,6 +340,25 @@ func TestCRDB_Push_OneAggregate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "failed push because aggregate changed",
+			args: args{
+				ctx: context.Background(),
+				events: []*repository.Event{
+					generateEvent(t, "14", func(e *repository.Event) {
+						e.ExpectedPreviousAggregateSequence = 1
+					}),
+				},
+			},
+			res: res{
+				wantErr: true,
+				eventsRes: eventsRes{
+					pushedEventsCount: 0,
+					aggID:             []string{"14"},
+					aggType:           repository.AggregateType(t.Name()),
+				},
+			},
+		},
 		{
 			name: "push 1 event and add unique constraint",
 			args: args{
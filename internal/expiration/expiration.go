@@ -0,0 +1,220 @@
+package expiration
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/config/types"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/query"
+)
+
+const (
+	ExpirationUserID = "EXPIRATION"
+
+	locksTable = "projections.locks"
+	lockName   = "expiration"
+)
+
+type Config struct {
+	//Interval between two checks for expired user grants, memberships, passwords and action executions
+	Interval types.Duration
+}
+
+type commands interface {
+	ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error)
+	ExpireOrgMember(ctx context.Context, orgID, userID string) (*domain.ObjectDetails, error)
+	ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	RequestPasswordExpiryNotification(ctx context.Context, orgID, userID string, policy *domain.PasswordAgePolicy) error
+}
+
+type queries interface {
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries) (*query.UserGrants, error)
+	ExpiredOrgMembers(ctx context.Context, now time.Time) (*query.ExpiredMembers, error)
+	ExpiredProjectMembers(ctx context.Context, now time.Time) (*query.ExpiredMembers, error)
+	SearchOrgs(ctx context.Context, queries *query.OrgSearchQueries) (*query.Orgs, error)
+	PasswordAgePolicyByOrg(ctx context.Context, orgID string) (*query.PasswordAgePolicy, error)
+	PasswordExpiryNotificationCandidates(ctx context.Context, orgID string, changedAfter, changedUntil time.Time) (*query.UserPasswordAges, error)
+}
+
+type executions interface {
+	RemoveExpiredActionExecutions(ctx context.Context, now time.Time) error
+}
+
+type expirer struct {
+	commands     commands
+	queries      queries
+	executions   executions
+	locker       crdb.Locker
+	lockDuration time.Duration
+}
+
+//Start periodically deactivates the expired user grants, removes the expired org and project members,
+//notifies the users whose password expires soon and removes the action executions after their retention
+//only one instance runs the expiration at the same time
+func Start(ctx context.Context, config Config, client *sql.DB, commands commands, queries queries, executions executions) {
+	e := &expirer{
+		commands:     commands,
+		queries:      queries,
+		executions:   executions,
+		locker:       crdb.NewLocker(client, locksTable, lockName),
+		lockDuration: config.Interval.Duration,
+	}
+	go e.run(ctx, config.Interval.Duration)
+}
+
+func (e *expirer) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			e.lockAndExpire(ctx, now)
+		}
+	}
+}
+
+//lockAndExpire runs the expiration if no other instance holds the lock
+func (e *expirer) lockAndExpire(ctx context.Context, now time.Time) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := e.locker.Lock(ctx, e.lockDuration)
+	if err, ok := <-errs; err != nil || !ok {
+		if !errors.IsErrorAlreadyExists(err) {
+			logging.Log("EXPIR-Lk8se").OnError(err).Warn("initial lock failed")
+		}
+		return
+	}
+	go cancelOnErr(ctx, errs, cancel)
+
+	e.expire(ctx, now)
+
+	err := e.locker.Unlock()
+	logging.Log("EXPIR-Lk8ue").OnError(err).Warn("unable to unlock")
+}
+
+func cancelOnErr(ctx context.Context, errs <-chan error, cancel func()) {
+	for {
+		select {
+		case err := <-errs:
+			if err != nil {
+				logging.Log("EXPIR-Lk8ce").WithError(err).Warn("expiration canceled")
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			cancel()
+			return
+		}
+	}
+}
+
+func (e *expirer) expire(ctx context.Context, now time.Time) {
+	e.expireUserGrants(ctx, now)
+	e.expireOrgMembers(ctx, now)
+	e.expireProjectMembers(ctx, now)
+	e.notifyPasswordExpiry(ctx, now)
+	e.removeExpiredActionExecutions(ctx, now)
+}
+
+func (e *expirer) expireUserGrants(ctx context.Context, now time.Time) {
+	stateQuery, err := query.NewUserGrantStateQuery(domain.UserGrantStateActive)
+	if err != nil {
+		logging.Log("EXPIR-Ug8sq").WithError(err).Warn("unable to build user grant query")
+		return
+	}
+	expiredQuery, err := query.NewUserGrantExpiredQuery(now)
+	if err != nil {
+		logging.Log("EXPIR-Ug8eq").WithError(err).Warn("unable to build user grant query")
+		return
+	}
+	grants, err := e.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{stateQuery, expiredQuery},
+	})
+	if err != nil {
+		logging.Log("EXPIR-Ug8se").WithError(err).Warn("unable to search expired user grants")
+		return
+	}
+	for _, grant := range grants.UserGrants {
+		_, err = e.commands.ExpireUserGrant(expirationContext(ctx, grant.ResourceOwner), grant.ID, grant.ResourceOwner)
+		logging.LogWithFields("EXPIR-Ug8de", "grantID", grant.ID).OnError(err).Warn("unable to deactivate expired user grant")
+	}
+}
+
+func (e *expirer) expireOrgMembers(ctx context.Context, now time.Time) {
+	members, err := e.queries.ExpiredOrgMembers(ctx, now)
+	if err != nil {
+		logging.Log("EXPIR-Om8se").WithError(err).Warn("unable to search expired org members")
+		return
+	}
+	for _, member := range members.Members {
+		_, err = e.commands.ExpireOrgMember(expirationContext(ctx, member.ResourceOwner), member.AggregateID, member.UserID)
+		logging.LogWithFields("EXPIR-Om8re", "orgID", member.AggregateID, "userID", member.UserID).OnError(err).Warn("unable to remove expired org member")
+	}
+}
+
+func (e *expirer) expireProjectMembers(ctx context.Context, now time.Time) {
+	members, err := e.queries.ExpiredProjectMembers(ctx, now)
+	if err != nil {
+		logging.Log("EXPIR-Pm8se").WithError(err).Warn("unable to search expired project members")
+		return
+	}
+	for _, member := range members.Members {
+		_, err = e.commands.ExpireProjectMember(expirationContext(ctx, member.ResourceOwner), member.AggregateID, member.UserID, member.ResourceOwner)
+		logging.LogWithFields("EXPIR-Pm8re", "projectID", member.AggregateID, "userID", member.UserID).OnError(err).Warn("unable to remove expired project member")
+	}
+}
+
+func (e *expirer) notifyPasswordExpiry(ctx context.Context, now time.Time) {
+	orgs, err := e.queries.SearchOrgs(ctx, &query.OrgSearchQueries{})
+	if err != nil {
+		logging.Log("EXPIR-Pw8se").WithError(err).Warn("unable to search orgs")
+		return
+	}
+	for _, org := range orgs.Orgs {
+		e.notifyOrgPasswordExpiry(ctx, org.ID, now)
+	}
+}
+
+func (e *expirer) notifyOrgPasswordExpiry(ctx context.Context, orgID string, now time.Time) {
+	agePolicy, err := e.queries.PasswordAgePolicyByOrg(ctx, orgID)
+	if err != nil {
+		logging.LogWithFields("EXPIR-Pw8pe", "orgID", orgID).WithError(err).Warn("unable to get password age policy")
+		return
+	}
+	policy := &domain.PasswordAgePolicy{
+		MaxAgeDays:     agePolicy.MaxAgeDays,
+		ExpireWarnDays: agePolicy.ExpireWarnDays,
+	}
+	changedAfter, changedUntil, ok := policy.WarnPeriodPasswordChanges(now)
+	if !ok {
+		return
+	}
+	users, err := e.queries.PasswordExpiryNotificationCandidates(ctx, orgID, changedAfter, changedUntil)
+	if err != nil {
+		logging.LogWithFields("EXPIR-Pw8ue", "orgID", orgID).WithError(err).Warn("unable to search users to notify about password expiry")
+		return
+	}
+	for _, user := range users.Users {
+		err = e.commands.RequestPasswordExpiryNotification(expirationContext(ctx, orgID), orgID, user.UserID, policy)
+		logging.LogWithFields("EXPIR-Pw8ne", "orgID", orgID, "userID", user.UserID).OnError(err).Warn("unable to request password expiry notification")
+	}
+}
+
+func (e *expirer) removeExpiredActionExecutions(ctx context.Context, now time.Time) {
+	err := e.executions.RemoveExpiredActionExecutions(ctx, now)
+	logging.Log("EXPIR-Ax8re").OnError(err).Warn("unable to remove expired action executions")
+}
+
+func expirationContext(ctx context.Context, orgID string) context.Context {
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ExpirationUserID, OrgID: orgID})
+}
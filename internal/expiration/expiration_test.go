@@ -0,0 +1,289 @@
+package expiration
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/caos/zitadel/internal/api/authz"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type expired struct {
+	kind          string
+	aggregateID   string
+	userID        string
+	resourceOwner string
+	editor        string
+}
+
+type mockCommands struct {
+	expired []expired
+	err     error
+}
+
+func (m *mockCommands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error) {
+	m.expired = append(m.expired, expired{"grant", grantID, "", resourceOwner, authz.GetCtxData(ctx).UserID})
+	return nil, m.err
+}
+
+func (m *mockCommands) ExpireOrgMember(ctx context.Context, orgID, userID string) (*domain.ObjectDetails, error) {
+	m.expired = append(m.expired, expired{"org", orgID, userID, authz.GetCtxData(ctx).OrgID, authz.GetCtxData(ctx).UserID})
+	return nil, m.err
+}
+
+func (m *mockCommands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	m.expired = append(m.expired, expired{"project", projectID, userID, resourceOwner, authz.GetCtxData(ctx).UserID})
+	return nil, m.err
+}
+
+func (m *mockCommands) RequestPasswordExpiryNotification(ctx context.Context, orgID, userID string, _ *domain.PasswordAgePolicy) error {
+	m.expired = append(m.expired, expired{"password", "", userID, orgID, authz.GetCtxData(ctx).UserID})
+	return m.err
+}
+
+type mockQueries struct {
+	grants         []*query.UserGrant
+	orgMembers     []*query.ExpiredMember
+	projectMembers []*query.ExpiredMember
+	orgs           []*query.Org
+	agePolicy      *query.PasswordAgePolicy
+	passwordAges   []*query.UserPasswordAge
+	err            error
+}
+
+func (m *mockQueries) UserGrants(context.Context, *query.UserGrantsQueries) (*query.UserGrants, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &query.UserGrants{UserGrants: m.grants}, nil
+}
+
+func (m *mockQueries) ExpiredOrgMembers(context.Context, time.Time) (*query.ExpiredMembers, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &query.ExpiredMembers{Members: m.orgMembers}, nil
+}
+
+func (m *mockQueries) ExpiredProjectMembers(context.Context, time.Time) (*query.ExpiredMembers, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &query.ExpiredMembers{Members: m.projectMembers}, nil
+}
+
+func (m *mockQueries) SearchOrgs(context.Context, *query.OrgSearchQueries) (*query.Orgs, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &query.Orgs{Orgs: m.orgs}, nil
+}
+
+func (m *mockQueries) PasswordAgePolicyByOrg(context.Context, string) (*query.PasswordAgePolicy, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.agePolicy == nil {
+		return &query.PasswordAgePolicy{}, nil
+	}
+	return m.agePolicy, nil
+}
+
+func (m *mockQueries) PasswordExpiryNotificationCandidates(context.Context, string, time.Time, time.Time) (*query.UserPasswordAges, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &query.UserPasswordAges{Users: m.passwordAges}, nil
+}
+
+type mockExecutions struct {
+	removed []time.Time
+	err     error
+}
+
+func (m *mockExecutions) RemoveExpiredActionExecutions(_ context.Context, now time.Time) error {
+	m.removed = append(m.removed, now)
+	return m.err
+}
+
+func Test_expirer_expire(t *testing.T) {
+	type fields struct {
+		commands *mockCommands
+		queries  *mockQueries
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []expired
+	}{
+		{
+			name: "nothing expired",
+			fields: fields{
+				commands: &mockCommands{},
+				queries:  &mockQueries{},
+			},
+			want: nil,
+		},
+		{
+			name: "query failed",
+			fields: fields{
+				commands: &mockCommands{},
+				queries: &mockQueries{
+					grants: []*query.UserGrant{{ID: "grant1", ResourceOwner: "org1"}},
+					err:    errors.New("failed"),
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "expired grants and members",
+			fields: fields{
+				commands: &mockCommands{},
+				queries: &mockQueries{
+					grants:         []*query.UserGrant{{ID: "grant1", ResourceOwner: "org1"}},
+					orgMembers:     []*query.ExpiredMember{{AggregateID: "org1", UserID: "user1", ResourceOwner: "org1"}},
+					projectMembers: []*query.ExpiredMember{{AggregateID: "project1", UserID: "user2", ResourceOwner: "org2"}},
+				},
+			},
+			want: []expired{
+				{"grant", "grant1", "", "org1", ExpirationUserID},
+				{"org", "org1", "user1", "org1", ExpirationUserID},
+				{"project", "project1", "user2", "org2", ExpirationUserID},
+			},
+		},
+		{
+			name: "password expiry disabled, no notification",
+			fields: fields{
+				commands: &mockCommands{},
+				queries: &mockQueries{
+					orgs:         []*query.Org{{ID: "org1"}},
+					passwordAges: []*query.UserPasswordAge{{UserID: "user1", ResourceOwner: "org1"}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "password expires soon, notification requested",
+			fields: fields{
+				commands: &mockCommands{},
+				queries: &mockQueries{
+					orgs:         []*query.Org{{ID: "org1"}},
+					agePolicy:    &query.PasswordAgePolicy{MaxAgeDays: 90, ExpireWarnDays: 10},
+					passwordAges: []*query.UserPasswordAge{{UserID: "user1", ResourceOwner: "org1"}},
+				},
+			},
+			want: []expired{
+				{"password", "", "user1", "org1", ExpirationUserID},
+			},
+		},
+		{
+			name: "command failed, continue",
+			fields: fields{
+				commands: &mockCommands{err: errors.New("failed")},
+				queries: &mockQueries{
+					orgMembers: []*query.ExpiredMember{
+						{AggregateID: "org1", UserID: "user1", ResourceOwner: "org1"},
+						{AggregateID: "org1", UserID: "user2", ResourceOwner: "org1"},
+					},
+				},
+			},
+			want: []expired{
+				{"org", "org1", "user1", "org1", ExpirationUserID},
+				{"org", "org1", "user2", "org1", ExpirationUserID},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executions := &mockExecutions{err: tt.fields.queries.err}
+			e := &expirer{
+				commands:   tt.fields.commands,
+				queries:    tt.fields.queries,
+				executions: executions,
+			}
+			now := time.Now()
+			e.expire(context.Background(), now)
+			if !reflect.DeepEqual(tt.fields.commands.expired, tt.want) {
+				t.Errorf("expire() = %v, want %v", tt.fields.commands.expired, tt.want)
+			}
+			if !reflect.DeepEqual(executions.removed, []time.Time{now}) {
+				t.Errorf("expire() removed action executions %v, want %v", executions.removed, []time.Time{now})
+			}
+		})
+	}
+}
+
+type mockLocker struct {
+	err      error
+	unlocked bool
+}
+
+func (m *mockLocker) Lock(context.Context, time.Duration) <-chan error {
+	errs := make(chan error, 1)
+	errs <- m.err
+	return errs
+}
+
+func (m *mockLocker) Unlock() error {
+	m.unlocked = true
+	return nil
+}
+
+func Test_expirer_lockAndExpire(t *testing.T) {
+	type res struct {
+		expired  []expired
+		unlocked bool
+	}
+	tests := []struct {
+		name   string
+		locker *mockLocker
+		res    res
+	}{
+		{
+			name:   "locked by other instance, not expired",
+			locker: &mockLocker{err: caos_errs.ThrowAlreadyExists(nil, "ID", "projection already locked")},
+			res:    res{},
+		},
+		{
+			name:   "lock failed, not expired",
+			locker: &mockLocker{err: errors.New("failed")},
+			res:    res{},
+		},
+		{
+			name:   "locked, expired and unlocked",
+			locker: &mockLocker{},
+			res: res{
+				expired: []expired{
+					{"org", "org1", "user1", "org1", ExpirationUserID},
+				},
+				unlocked: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := &mockCommands{}
+			e := &expirer{
+				commands: commands,
+				queries: &mockQueries{
+					orgMembers: []*query.ExpiredMember{{AggregateID: "org1", UserID: "user1", ResourceOwner: "org1"}},
+				},
+				executions:   &mockExecutions{},
+				locker:       tt.locker,
+				lockDuration: time.Minute,
+			}
+			e.lockAndExpire(context.Background(), time.Now())
+			if !reflect.DeepEqual(commands.expired, tt.res.expired) {
+				t.Errorf("lockAndExpire() = %v, want %v", commands.expired, tt.res.expired)
+			}
+			if tt.locker.unlocked != tt.res.unlocked {
+				t.Errorf("lockAndExpire() unlocked = %v, want %v", tt.locker.unlocked, tt.res.unlocked)
+			}
+		})
+	}
+}
@@ -33,6 +33,36 @@ type IDPConfigView struct {
 	JWTIssuer                  string
 	JWTKeysEndpoint            string
 	JWTHeaderName              string
+
+	IsSAML                   bool
+	SAMLMetadataURL          string
+	SAMLMetadata             []byte
+	SAMLKey                  *crypto.CryptoValue
+	SAMLCertificate          []byte
+	SAMLBinding              domain.SAMLBinding
+	SAMLWithSignedRequest    bool
+	SAMLNameIDFormat         domain.SAMLNameIDFormat
+	SAMLUsernameAttribute    string
+	SAMLDisplayNameAttribute string
+	SAMLFirstNameAttribute   string
+	SAMLLastNameAttribute    string
+	SAMLEmailAttribute       string
+	SAMLPhoneAttribute       string
+
+	IsLDAP                   bool
+	LDAPURL                  string
+	LDAPStartTLS             bool
+	LDAPBindDN               string
+	LDAPBindPassword         *crypto.CryptoValue
+	LDAPBaseDN               string
+	LDAPUserObjectClass      string
+	LDAPIDAttribute          string
+	LDAPUsernameAttribute    string
+	LDAPDisplayNameAttribute string
+	LDAPFirstNameAttribute   string
+	LDAPLastNameAttribute    string
+	LDAPEmailAttribute       string
+	LDAPPhoneAttribute       string
 }
 
 type IDPConfigSearchRequest struct {
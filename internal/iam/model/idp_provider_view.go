@@ -102,6 +102,8 @@ func idpConfigTypeToDomain(idpType IdpConfigType) domain.IDPConfigType {
 		return domain.IDPConfigTypeSAML
 	case IDPConfigTypeJWT:
 		return domain.IDPConfigTypeJWT
+	case IDPConfigTypeLDAP:
+		return domain.IDPConfigTypeLDAP
 	default:
 		return domain.IDPConfigTypeOIDC
 	}
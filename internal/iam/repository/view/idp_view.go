@@ -57,3 +57,9 @@ func DeleteIDP(db *gorm.DB, table, idpID string) error {
 
 	return delete(db)
 }
+
+func DeleteIDPsByAggregateID(db *gorm.DB, table, aggregateID string) error {
+	delete := repository.PrepareDeleteByKey(table, model.IDPConfigSearchKey(iam_model.IDPConfigSearchKeyAggregateID), aggregateID)
+
+	return delete(db)
+}
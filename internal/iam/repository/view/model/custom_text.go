@@ -70,7 +70,11 @@ func (r *CustomTextView) IsMessageTemplate() bool {
 		r.Template == domain.VerifyEmailMessageType ||
 		r.Template == domain.VerifyPhoneMessageType ||
 		r.Template == domain.DomainClaimedMessageType ||
-		r.Template == domain.PasswordlessRegistrationMessageType
+		r.Template == domain.PasswordlessRegistrationMessageType ||
+		r.Template == domain.PasswordExpiryMessageType ||
+		r.Template == domain.VerifyEmailOTPMessageType ||
+		r.Template == domain.VerifySMSOTPMessageType ||
+		r.Template == domain.RecoveryCodesLowMessageType
 }
 
 func CustomTextViewsToLoginDomain(aggregateID, lang string, texts []*CustomTextView) *domain.CustomLoginText {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/repository/iam"
 	"github.com/caos/zitadel/internal/repository/org"
 
@@ -50,6 +51,36 @@ type IDPConfigView struct {
 	JWTKeysEndpoint            string              `json:"keysEndpoint" gorm:"jwt_keys_endpoint"`
 	JWTHeaderName              string              `json:"headerName" gorm:"jwt_header_name"`
 
+	IsSAML                   bool                `json:"-" gorm:"column:is_saml"`
+	SAMLMetadataURL          string              `json:"metadataUrl" gorm:"column:saml_metadata_url"`
+	SAMLMetadata             []byte              `json:"metadata" gorm:"column:saml_metadata"`
+	SAMLKey                  *crypto.CryptoValue `json:"key" gorm:"column:saml_key"`
+	SAMLCertificate          []byte              `json:"certificate" gorm:"column:saml_certificate"`
+	SAMLBinding              int32               `json:"binding" gorm:"column:saml_binding"`
+	SAMLWithSignedRequest    bool                `json:"withSignedRequest" gorm:"column:saml_with_signed_request"`
+	SAMLNameIDFormat         int32               `json:"nameIdFormat" gorm:"column:saml_name_id_format"`
+	SAMLUsernameAttribute    string              `json:"usernameAttribute" gorm:"column:saml_username_attribute"`
+	SAMLDisplayNameAttribute string              `json:"displayNameAttribute" gorm:"column:saml_display_name_attribute"`
+	SAMLFirstNameAttribute   string              `json:"firstNameAttribute" gorm:"column:saml_first_name_attribute"`
+	SAMLLastNameAttribute    string              `json:"lastNameAttribute" gorm:"column:saml_last_name_attribute"`
+	SAMLEmailAttribute       string              `json:"emailAttribute" gorm:"column:saml_email_attribute"`
+	SAMLPhoneAttribute       string              `json:"phoneAttribute" gorm:"column:saml_phone_attribute"`
+
+	IsLDAP                   bool                `json:"-" gorm:"column:is_ldap"`
+	LDAPURL                  string              `json:"-" gorm:"column:ldap_url"`
+	LDAPStartTLS             bool                `json:"-" gorm:"column:ldap_start_tls"`
+	LDAPBindDN               string              `json:"-" gorm:"column:ldap_bind_dn"`
+	LDAPBindPassword         *crypto.CryptoValue `json:"-" gorm:"column:ldap_bind_password"`
+	LDAPBaseDN               string              `json:"-" gorm:"column:ldap_base_dn"`
+	LDAPUserObjectClass      string              `json:"-" gorm:"column:ldap_user_object_class"`
+	LDAPIDAttribute          string              `json:"-" gorm:"column:ldap_id_attribute"`
+	LDAPUsernameAttribute    string              `json:"-" gorm:"column:ldap_username_attribute"`
+	LDAPDisplayNameAttribute string              `json:"-" gorm:"column:ldap_display_name_attribute"`
+	LDAPFirstNameAttribute   string              `json:"-" gorm:"column:ldap_first_name_attribute"`
+	LDAPLastNameAttribute    string              `json:"-" gorm:"column:ldap_last_name_attribute"`
+	LDAPEmailAttribute       string              `json:"-" gorm:"column:ldap_email_attribute"`
+	LDAPPhoneAttribute       string              `json:"-" gorm:"column:ldap_phone_attribute"`
+
 	Sequence uint64 `json:"-" gorm:"column:sequence"`
 }
 
@@ -78,6 +109,40 @@ func IDPConfigViewToModel(idp *IDPConfigView) *model.IDPConfigView {
 		view.OIDCIssuer = idp.OIDCIssuer
 		return view
 	}
+	if idp.IsSAML {
+		view.IsSAML = true
+		view.SAMLMetadataURL = idp.SAMLMetadataURL
+		view.SAMLMetadata = idp.SAMLMetadata
+		view.SAMLKey = idp.SAMLKey
+		view.SAMLCertificate = idp.SAMLCertificate
+		view.SAMLBinding = domain.SAMLBinding(idp.SAMLBinding)
+		view.SAMLWithSignedRequest = idp.SAMLWithSignedRequest
+		view.SAMLNameIDFormat = domain.SAMLNameIDFormat(idp.SAMLNameIDFormat)
+		view.SAMLUsernameAttribute = idp.SAMLUsernameAttribute
+		view.SAMLDisplayNameAttribute = idp.SAMLDisplayNameAttribute
+		view.SAMLFirstNameAttribute = idp.SAMLFirstNameAttribute
+		view.SAMLLastNameAttribute = idp.SAMLLastNameAttribute
+		view.SAMLEmailAttribute = idp.SAMLEmailAttribute
+		view.SAMLPhoneAttribute = idp.SAMLPhoneAttribute
+		return view
+	}
+	if idp.IsLDAP {
+		view.IsLDAP = true
+		view.LDAPURL = idp.LDAPURL
+		view.LDAPStartTLS = idp.LDAPStartTLS
+		view.LDAPBindDN = idp.LDAPBindDN
+		view.LDAPBindPassword = idp.LDAPBindPassword
+		view.LDAPBaseDN = idp.LDAPBaseDN
+		view.LDAPUserObjectClass = idp.LDAPUserObjectClass
+		view.LDAPIDAttribute = idp.LDAPIDAttribute
+		view.LDAPUsernameAttribute = idp.LDAPUsernameAttribute
+		view.LDAPDisplayNameAttribute = idp.LDAPDisplayNameAttribute
+		view.LDAPFirstNameAttribute = idp.LDAPFirstNameAttribute
+		view.LDAPLastNameAttribute = idp.LDAPLastNameAttribute
+		view.LDAPEmailAttribute = idp.LDAPEmailAttribute
+		view.LDAPPhoneAttribute = idp.LDAPPhoneAttribute
+		return view
+	}
 	view.JWTEndpoint = idp.JWTEndpoint
 	view.JWTIssuer = idp.OIDCIssuer
 	view.JWTKeysEndpoint = idp.JWTKeysEndpoint
@@ -108,8 +173,17 @@ func (i *IDPConfigView) AppendEvent(providerType model.IDPProviderType, event *m
 	case es_model.OIDCIDPConfigChanged, org_es_model.OIDCIDPConfigChanged,
 		es_model.IDPConfigChanged, org_es_model.IDPConfigChanged,
 		models.EventType(org.IDPJWTConfigAddedEventType), models.EventType(iam.IDPJWTConfigAddedEventType),
-		models.EventType(org.IDPJWTConfigChangedEventType), models.EventType(iam.IDPJWTConfigChangedEventType):
+		models.EventType(org.IDPJWTConfigChangedEventType), models.EventType(iam.IDPJWTConfigChangedEventType),
+		models.EventType(org.IDPSAMLConfigChangedEventType), models.EventType(iam.IDPSAMLConfigChangedEventType):
 		err = i.SetData(event)
+	case models.EventType(org.IDPSAMLConfigAddedEventType), models.EventType(iam.IDPSAMLConfigAddedEventType):
+		i.IsSAML = true
+		err = i.SetData(event)
+	case models.EventType(org.IDPLDAPConfigAddedEventType), models.EventType(iam.IDPLDAPConfigAddedEventType):
+		i.IsLDAP = true
+		err = i.setLDAPData(event)
+	case models.EventType(org.IDPLDAPConfigChangedEventType), models.EventType(iam.IDPLDAPConfigChangedEventType):
+		err = i.setLDAPData(event)
 	case es_model.IDPConfigDeactivated, org_es_model.IDPConfigDeactivated:
 		i.IDPState = int32(model.IDPConfigStateInactive)
 	case es_model.IDPConfigReactivated, org_es_model.IDPConfigReactivated:
@@ -129,3 +203,67 @@ func (r *IDPConfigView) SetData(event *models.Event) error {
 	}
 	return nil
 }
+
+// setLDAPData maps the ldap config separately,
+// because the attribute mappings share their json keys with the saml config
+func (r *IDPConfigView) setLDAPData(event *models.Event) error {
+	ldap := new(struct {
+		URL                  *string             `json:"url"`
+		StartTLS             *bool               `json:"startTls"`
+		BindDN               *string             `json:"bindDn"`
+		BindPassword         *crypto.CryptoValue `json:"bindPassword"`
+		BaseDN               *string             `json:"baseDn"`
+		UserObjectClass      *string             `json:"userObjectClass"`
+		IDAttribute          *string             `json:"idAttribute"`
+		UsernameAttribute    *string             `json:"usernameAttribute"`
+		DisplayNameAttribute *string             `json:"displayNameAttribute"`
+		FirstNameAttribute   *string             `json:"firstNameAttribute"`
+		LastNameAttribute    *string             `json:"lastNameAttribute"`
+		EmailAttribute       *string             `json:"emailAttribute"`
+		PhoneAttribute       *string             `json:"phoneAttribute"`
+	})
+	if err := json.Unmarshal(event.Data, ldap); err != nil {
+		logging.Log("EVEN-Kd92n").WithError(err).Error("could not unmarshal event data")
+		return caos_errs.ThrowInternal(err, "MODEL-Lw0sm", "Could not unmarshal data")
+	}
+	if ldap.URL != nil {
+		r.LDAPURL = *ldap.URL
+	}
+	if ldap.StartTLS != nil {
+		r.LDAPStartTLS = *ldap.StartTLS
+	}
+	if ldap.BindDN != nil {
+		r.LDAPBindDN = *ldap.BindDN
+	}
+	if ldap.BindPassword != nil {
+		r.LDAPBindPassword = ldap.BindPassword
+	}
+	if ldap.BaseDN != nil {
+		r.LDAPBaseDN = *ldap.BaseDN
+	}
+	if ldap.UserObjectClass != nil {
+		r.LDAPUserObjectClass = *ldap.UserObjectClass
+	}
+	if ldap.IDAttribute != nil {
+		r.LDAPIDAttribute = *ldap.IDAttribute
+	}
+	if ldap.UsernameAttribute != nil {
+		r.LDAPUsernameAttribute = *ldap.UsernameAttribute
+	}
+	if ldap.DisplayNameAttribute != nil {
+		r.LDAPDisplayNameAttribute = *ldap.DisplayNameAttribute
+	}
+	if ldap.FirstNameAttribute != nil {
+		r.LDAPFirstNameAttribute = *ldap.FirstNameAttribute
+	}
+	if ldap.LastNameAttribute != nil {
+		r.LDAPLastNameAttribute = *ldap.LastNameAttribute
+	}
+	if ldap.EmailAttribute != nil {
+		r.LDAPEmailAttribute = *ldap.EmailAttribute
+	}
+	if ldap.PhoneAttribute != nil {
+		r.LDAPPhoneAttribute = *ldap.PhoneAttribute
+	}
+	return nil
+}
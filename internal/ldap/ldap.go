@@ -0,0 +1,174 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"net"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const (
+	timeout = 10 * time.Second
+)
+
+type Config struct {
+	URL                  string
+	StartTLS             bool
+	BindDN               string
+	BindPassword         string
+	BaseDN               string
+	UserObjectClass      string
+	IDAttribute          string
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+}
+
+type User struct {
+	ID          string
+	Username    string
+	DisplayName string
+	FirstName   string
+	LastName    string
+	Email       string
+	Phone       string
+}
+
+// Authenticate searches the user by its username (using the bind dn of the config or an anonymous bind)
+// and checks the password by binding as the found user
+func Authenticate(config *Config, username, password string) (*User, error) {
+	if username == "" || password == "" {
+		// an empty password would result in an unauthenticated bind, which most servers accept
+		return nil, caos_errs.ThrowUnauthenticated(nil, "LDAP-Wm3sd", "Errors.ExternalIDP.LDAPInvalidCredentials")
+	}
+	conn, err := dial(config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if config.BindDN != "" {
+		if err = conn.Bind(config.BindDN, config.BindPassword); err != nil {
+			return nil, caos_errs.ThrowUnavailable(err, "LDAP-Sk2nd", "Errors.ExternalIDP.LDAPUnavailable")
+		}
+	}
+	entry, err := searchUser(conn, config, username)
+	if err != nil {
+		return nil, err
+	}
+	if err = conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, caos_errs.ThrowUnauthenticated(err, "LDAP-Ps0dm", "Errors.ExternalIDP.LDAPInvalidCredentials")
+		}
+		return nil, caos_errs.ThrowUnavailable(err, "LDAP-Nw8sk", "Errors.ExternalIDP.LDAPUnavailable")
+	}
+	user := entryToUser(config, entry)
+	if user.ID == "" {
+		// users without id would all be linked to the same external user
+		return nil, caos_errs.ThrowPreconditionFailed(nil, "LDAP-Id8sm", "Errors.ExternalIDP.LDAPUserIDMissing")
+	}
+	return user, nil
+}
+
+func dial(config *Config) (*goldap.Conn, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, caos_errs.ThrowUnavailable(err, "LDAP-Dk2ms", "Errors.ExternalIDP.LDAPUnavailable")
+	}
+	tlsConfig := &tls.Config{ServerName: u.Hostname()}
+	conn, err := goldap.DialURL(config.URL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		goldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, caos_errs.ThrowUnavailable(err, "LDAP-Ql2nf", "Errors.ExternalIDP.LDAPUnavailable")
+	}
+	conn.SetTimeout(timeout)
+	if config.StartTLS {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, caos_errs.ThrowUnavailable(err, "LDAP-Zm4sf", "Errors.ExternalIDP.LDAPUnavailable")
+		}
+	}
+	return conn, nil
+}
+
+func searchUser(conn *goldap.Conn, config *Config, username string) (*goldap.Entry, error) {
+	filter := "(" + config.UsernameAttribute + "=" + goldap.EscapeFilter(username) + ")"
+	if config.UserObjectClass != "" {
+		filter = "(&(objectClass=" + goldap.EscapeFilter(config.UserObjectClass) + ")" + filter + ")"
+	}
+	result, err := conn.Search(goldap.NewSearchRequest(
+		config.BaseDN,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		2,
+		int(timeout.Seconds()),
+		false,
+		filter,
+		userAttributes(config),
+		nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, caos_errs.ThrowUnavailable(err, "LDAP-Bv2ks", "Errors.ExternalIDP.LDAPUnavailable")
+	}
+	// the username must identify exactly one user
+	if result == nil || len(result.Entries) != 1 {
+		return nil, caos_errs.ThrowUnauthenticated(nil, "LDAP-Tn3sd", "Errors.ExternalIDP.LDAPInvalidCredentials")
+	}
+	return result.Entries[0], nil
+}
+
+func userAttributes(config *Config) []string {
+	attributes := make([]string, 0, 7)
+	for _, attribute := range []string{
+		config.IDAttribute,
+		config.UsernameAttribute,
+		config.DisplayNameAttribute,
+		config.FirstNameAttribute,
+		config.LastNameAttribute,
+		config.EmailAttribute,
+		config.PhoneAttribute,
+	} {
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+func entryToUser(config *Config, entry *goldap.Entry) *User {
+	return &User{
+		ID:          idValue(entry.GetRawAttributeValue(config.IDAttribute)),
+		Username:    attributeValue(entry, config.UsernameAttribute),
+		DisplayName: attributeValue(entry, config.DisplayNameAttribute),
+		FirstName:   attributeValue(entry, config.FirstNameAttribute),
+		LastName:    attributeValue(entry, config.LastNameAttribute),
+		Email:       attributeValue(entry, config.EmailAttribute),
+		Phone:       attributeValue(entry, config.PhoneAttribute),
+	}
+}
+
+func attributeValue(entry *goldap.Entry, attribute string) string {
+	if attribute == "" {
+		return ""
+	}
+	return entry.GetAttributeValue(attribute)
+}
+
+// idValue returns binary ids (e.g. objectGUID of Active Directory) base64 encoded
+func idValue(value []byte) string {
+	if utf8.Valid(value) {
+		return string(value)
+	}
+	return base64.RawURLEncoding.EncodeToString(value)
+}
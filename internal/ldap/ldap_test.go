@@ -0,0 +1,304 @@
+package ldap
+
+import (
+	"net"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+type testEntry struct {
+	dn         string
+	password   string
+	attributes map[string]string
+}
+
+// testServer is a minimal in-process ldap server handling simple binds and searches
+type testServer struct {
+	listener net.Listener
+	// entries are found by the exact (decompiled) filter of the search request
+	entries map[string]*testEntry
+	binds   map[string]string
+}
+
+func newTestServer(t *testing.T, bindDN, bindPassword string, entries map[string]*testEntry) *testServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &testServer{
+		listener: listener,
+		entries:  entries,
+		binds:    map[string]string{bindDN: bindPassword},
+	}
+	for _, entry := range entries {
+		server.binds[entry.dn] = entry.password
+	}
+	go server.serve()
+	t.Cleanup(func() { listener.Close() })
+	return server
+}
+
+func (s *testServer) url() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *testServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		request := packet.Children[1]
+		switch request.Tag {
+		case goldap.ApplicationBindRequest:
+			dn := request.Children[1].Data.String()
+			password := request.Children[2].Data.String()
+			resultCode := uint16(goldap.LDAPResultSuccess)
+			if expected, ok := s.binds[dn]; !ok || expected != password {
+				resultCode = goldap.LDAPResultInvalidCredentials
+			}
+			s.write(conn, messageID, result(goldap.ApplicationBindResponse, resultCode))
+		case goldap.ApplicationSearchRequest:
+			filter, err := goldap.DecompileFilter(request.Children[6])
+			if err != nil {
+				return
+			}
+			if entry, ok := s.entries[filter]; ok {
+				s.write(conn, messageID, searchEntry(entry))
+			}
+			s.write(conn, messageID, result(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+		default:
+			return
+		}
+	}
+}
+
+func (s *testServer) write(conn net.Conn, messageID int64, response *ber.Packet) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	packet.AppendChild(response)
+	conn.Write(packet.Bytes())
+}
+
+func result(application ber.Tag, resultCode uint16) *ber.Packet {
+	packet := ber.Encode(ber.ClassApplication, ber.TypeConstructed, application, nil, "Result")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "resultCode"))
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return packet
+}
+
+func searchEntry(entry *testEntry) *ber.Packet {
+	packet := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	packet.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "objectName"))
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for name, value := range entry.attributes {
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		values.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "value"))
+		attribute.AppendChild(values)
+		attributes.AppendChild(attribute)
+	}
+	packet.AppendChild(attributes)
+	return packet
+}
+
+func testConfig(url string) *Config {
+	return &Config{
+		URL:                  url,
+		BindDN:               "cn=zitadel,dc=example,dc=com",
+		BindPassword:         "service-password",
+		BaseDN:               "ou=people,dc=example,dc=com",
+		UserObjectClass:      "person",
+		IDAttribute:          "objectGUID",
+		UsernameAttribute:    "sAMAccountName",
+		DisplayNameAttribute: "displayName",
+		FirstNameAttribute:   "givenName",
+		LastNameAttribute:    "sn",
+		EmailAttribute:       "mail",
+		PhoneAttribute:       "telephoneNumber",
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	server := newTestServer(t, "cn=zitadel,dc=example,dc=com", "service-password", map[string]*testEntry{
+		"(&(objectClass=person)(sAMAccountName=alice))": {
+			dn:       "cn=alice,ou=people,dc=example,dc=com",
+			password: "alice-password",
+			attributes: map[string]string{
+				"objectGUID":      "user-id",
+				"sAMAccountName":  "alice",
+				"displayName":     "Alice Example",
+				"givenName":       "Alice",
+				"sn":              "Example",
+				"mail":            "alice@example.com",
+				"telephoneNumber": "+41 71 000 00 00",
+			},
+		},
+		"(&(objectClass=person)(sAMAccountName=bob))": {
+			dn:       "cn=bob,ou=people,dc=example,dc=com",
+			password: "bob-password",
+			attributes: map[string]string{
+				"objectGUID":     string([]byte{0xff, 0x01, 0xfe}),
+				"sAMAccountName": "bob",
+			},
+		},
+		"(&(objectClass=person)(sAMAccountName=carol))": {
+			dn:       "cn=carol,ou=people,dc=example,dc=com",
+			password: "carol-password",
+			attributes: map[string]string{
+				"sAMAccountName": "carol",
+			},
+		},
+	})
+	type args struct {
+		config   *Config
+		username string
+		password string
+	}
+	type res struct {
+		user *User
+		err  func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "empty password, unauthenticated error",
+			args: args{
+				config:   testConfig(server.url()),
+				username: "alice",
+				password: "",
+			},
+			res: res{
+				err: caos_errs.IsUnauthenticated,
+			},
+		},
+		{
+			name: "invalid service bind, unavailable error",
+			args: args{
+				config: func() *Config {
+					config := testConfig(server.url())
+					config.BindPassword = "wrong"
+					return config
+				}(),
+				username: "alice",
+				password: "alice-password",
+			},
+			res: res{
+				err: caos_errs.IsUnavailable,
+			},
+		},
+		{
+			name: "unknown user, unauthenticated error",
+			args: args{
+				config:   testConfig(server.url()),
+				username: "unknown",
+				password: "password",
+			},
+			res: res{
+				err: caos_errs.IsUnauthenticated,
+			},
+		},
+		{
+			name: "wrong password, unauthenticated error",
+			args: args{
+				config:   testConfig(server.url()),
+				username: "alice",
+				password: "wrong",
+			},
+			res: res{
+				err: caos_errs.IsUnauthenticated,
+			},
+		},
+		{
+			name: "id attribute missing, precondition failed error",
+			args: args{
+				config:   testConfig(server.url()),
+				username: "carol",
+				password: "carol-password",
+			},
+			res: res{
+				err: caos_errs.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "server not reachable, unavailable error",
+			args: args{
+				config:   testConfig("ldap://127.0.0.1:1"),
+				username: "alice",
+				password: "alice-password",
+			},
+			res: res{
+				err: caos_errs.IsUnavailable,
+			},
+		},
+		{
+			name: "authenticated, ok",
+			args: args{
+				config:   testConfig(server.url()),
+				username: "alice",
+				password: "alice-password",
+			},
+			res: res{
+				user: &User{
+					ID:          "user-id",
+					Username:    "alice",
+					DisplayName: "Alice Example",
+					FirstName:   "Alice",
+					LastName:    "Example",
+					Email:       "alice@example.com",
+					Phone:       "+41 71 000 00 00",
+				},
+			},
+		},
+		{
+			name: "binary id, ok",
+			args: args{
+				config:   testConfig(server.url()),
+				username: "bob",
+				password: "bob-password",
+			},
+			res: res{
+				user: &User{
+					ID:       "_wH-",
+					Username: "bob",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Authenticate(tt.args.config, tt.args.username, tt.args.password)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.user, got)
+			}
+		})
+	}
+}
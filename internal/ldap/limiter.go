@@ -0,0 +1,105 @@
+package ldap
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const (
+	//defaultLockoutDuration is used if the lockout policy requires a manual unlock,
+	//which is not possible for users who only exist on the ldap server
+	defaultLockoutDuration = 30 * time.Minute
+	//attemptsMaxAge is the time after which the failed attempts of a user are forgotten
+	attemptsMaxAge = 24 * time.Hour
+)
+
+//Limiter applies the lockout policy to the password checks against the ldap server:
+//every failed attempt delays the next one by the backoff of the policy
+//and the user is locked (for the lockout duration) after the max password attempts
+//
+//the users don't have to exist in ZITADEL, so the attempts are tracked per identity provider and username
+type Limiter struct {
+	mu       sync.Mutex
+	attempts map[string]*attempts
+	now      func() time.Time
+}
+
+type attempts struct {
+	failed      uint64
+	lastFailed  time.Time
+	lockedUntil time.Time
+}
+
+func NewLimiter() *Limiter {
+	return &Limiter{
+		attempts: make(map[string]*attempts),
+		now:      time.Now,
+	}
+}
+
+//Check returns an error if the user is locked or has to wait before the next attempt
+func (l *Limiter) Check(idpConfigID, username string, policy *domain.LockoutPolicy) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	a, ok := l.attempts[limiterKey(idpConfigID, username)]
+	if !ok {
+		return nil
+	}
+	now := l.now()
+	if now.Before(a.lockedUntil) {
+		return caos_errs.ThrowPreconditionFailed(nil, "LDAP-Lk3md", "Errors.User.Locked")
+	}
+	if now.Before(policy.NextAttemptAllowed(a.failed, a.lastFailed)) {
+		return caos_errs.ThrowPreconditionFailed(nil, "LDAP-Lk8sw", "Errors.ExternalIDP.LDAPTooManyFailedAttempts")
+	}
+	return nil
+}
+
+//Failed records a failed attempt and locks the user if the max password attempts are reached
+func (l *Limiter) Failed(idpConfigID, username string, policy *domain.LockoutPolicy) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.removeExpired(now)
+	key := limiterKey(idpConfigID, username)
+	a, ok := l.attempts[key]
+	if !ok || (!a.lockedUntil.IsZero() && !now.Before(a.lockedUntil)) {
+		a = new(attempts)
+		l.attempts[key] = a
+	}
+	a.failed++
+	a.lastFailed = now
+	if policy == nil || policy.MaxPasswordAttempts == 0 || a.failed < policy.MaxPasswordAttempts {
+		return
+	}
+	duration := policy.LockoutDuration
+	if duration <= 0 {
+		duration = defaultLockoutDuration
+	}
+	a.lockedUntil = now.Add(duration)
+}
+
+//Succeeded resets the failed attempts of the user
+func (l *Limiter) Succeeded(idpConfigID, username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.attempts, limiterKey(idpConfigID, username))
+}
+
+func (l *Limiter) removeExpired(now time.Time) {
+	for key, a := range l.attempts {
+		if now.Before(a.lockedUntil) || now.Sub(a.lastFailed) < attemptsMaxAge {
+			continue
+		}
+		delete(l.attempts, key)
+	}
+}
+
+//limiterKey ignores the case of the username, as most ldap servers do
+func limiterKey(idpConfigID, username string) string {
+	return idpConfigID + ":" + strings.ToLower(username)
+}
@@ -0,0 +1,128 @@
+package ldap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	type step struct {
+		after     time.Duration
+		failed    bool
+		succeeded bool
+		username  string
+		err       func(error) bool
+	}
+	tests := []struct {
+		name   string
+		policy *domain.LockoutPolicy
+		steps  []step
+	}{
+		{
+			name:   "no policy limits, ok",
+			policy: &domain.LockoutPolicy{},
+			steps: []step{
+				{failed: true},
+				{failed: true},
+				{failed: true},
+				{},
+			},
+		},
+		{
+			name: "backoff, too many attempts error",
+			policy: &domain.LockoutPolicy{
+				BackoffDelay: time.Second,
+			},
+			steps: []step{
+				{failed: true},
+				{err: caos_errs.IsPreconditionFailed},
+				{after: time.Second, failed: true},
+				{after: time.Second, err: caos_errs.IsPreconditionFailed},
+				{after: 2 * time.Second},
+			},
+		},
+		{
+			name: "max attempts reached, locked for lockout duration",
+			policy: &domain.LockoutPolicy{
+				MaxPasswordAttempts: 2,
+				LockoutDuration:     time.Hour,
+			},
+			steps: []step{
+				{failed: true},
+				{failed: true},
+				{err: caos_errs.IsPreconditionFailed},
+				{after: 59 * time.Minute, err: caos_errs.IsPreconditionFailed},
+				{after: time.Minute},
+				{failed: true},
+				{},
+			},
+		},
+		{
+			name: "manual unlock policy, locked for default duration",
+			policy: &domain.LockoutPolicy{
+				MaxPasswordAttempts: 1,
+			},
+			steps: []step{
+				{failed: true},
+				{after: defaultLockoutDuration - time.Second, err: caos_errs.IsPreconditionFailed},
+				{after: time.Second},
+			},
+		},
+		{
+			name: "username case ignored, locked",
+			policy: &domain.LockoutPolicy{
+				MaxPasswordAttempts: 1,
+			},
+			steps: []step{
+				{failed: true, username: "Alice"},
+				{username: "alice", err: caos_errs.IsPreconditionFailed},
+				{username: "bob"},
+			},
+		},
+		{
+			name: "success resets failed attempts, ok",
+			policy: &domain.LockoutPolicy{
+				MaxPasswordAttempts: 2,
+			},
+			steps: []step{
+				{failed: true},
+				{succeeded: true},
+				{failed: true},
+				{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := now
+			limiter := NewLimiter()
+			limiter.now = func() time.Time { return current }
+			for i, s := range tt.steps {
+				current = current.Add(s.after)
+				username := s.username
+				if username == "" {
+					username = "alice"
+				}
+				err := limiter.Check("idp", username, tt.policy)
+				if s.err == nil {
+					assert.NoError(t, err, "step %d", i)
+				}
+				if s.err != nil && !s.err(err) {
+					t.Errorf("step %d: got wrong err: %v ", i, err)
+				}
+				if s.failed {
+					limiter.Failed("idp", username, tt.policy)
+				}
+				if s.succeeded {
+					limiter.Succeeded("idp", username)
+				}
+			}
+		})
+	}
+}
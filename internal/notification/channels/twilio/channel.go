@@ -18,7 +18,11 @@ func InitTwilioChannel(config TwilioConfig) channels.NotificationChannel {
 		if !ok {
 			return caos_errs.ThrowInternal(nil, "TWILI-s0pLc", "message is not SMS")
 		}
-		m, err := client.Messages.SendMessage(twilioMsg.SenderPhoneNumber, twilioMsg.RecipientPhoneNumber, twilioMsg.GetContent(), nil)
+		from := config.From
+		if from == "" {
+			from = twilioMsg.SenderPhoneNumber
+		}
+		m, err := client.Messages.SendMessage(from, twilioMsg.RecipientPhoneNumber, twilioMsg.GetContent(), nil)
 		if err != nil {
 			return caos_errs.ThrowInternal(err, "TWILI-osk3S", "could not send message")
 		}
@@ -0,0 +1,64 @@
+package vonage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/caos/logging"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/notification/channels"
+	"github.com/caos/zitadel/internal/notification/messages"
+)
+
+const smsEndpoint = "https://rest.nexmo.com/sms/json"
+
+type smsResponse struct {
+	Messages []struct {
+		Status    string `json:"status"`
+		MessageID string `json:"message-id"`
+		ErrorText string `json:"error-text"`
+	} `json:"messages"`
+}
+
+func InitVonageChannel(config VonageConfig) channels.NotificationChannel {
+	logging.Log("NOTIF-Vn3ks").Debug("successfully initialized vonage sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return caos_errs.ThrowInternal(nil, "VONAG-Sm92k", "message is not SMS")
+		}
+		from := config.From
+		if from == "" {
+			from = smsMsg.SenderPhoneNumber
+		}
+		resp, err := http.PostForm(smsEndpoint, url.Values{
+			"api_key":    {config.APIKey},
+			"api_secret": {config.APISecret},
+			"from":       {from},
+			"to":         {smsMsg.RecipientPhoneNumber},
+			"text":       {smsMsg.GetContent()},
+		})
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "VONAG-Ws8dk", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return caos_errs.ThrowInternal(nil, "VONAG-Hs93n", "unexpected status code "+resp.Status)
+		}
+		response := new(smsResponse)
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return caos_errs.ThrowInternal(err, "VONAG-Jd82m", "unable to read response")
+		}
+		for _, m := range response.Messages {
+			//status 0 means the message was accepted by vonage
+			if m.Status != "0" {
+				return caos_errs.ThrowInternal(nil, "VONAG-Ks92n", "could not send message: "+m.ErrorText)
+			}
+			logging.LogWithFields("VONAG-Ls0dk", "message_id", m.MessageID).Debug("sms sent")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,7 @@
+package vonage
+
+type VonageConfig struct {
+	APIKey    string
+	APISecret string
+	From      string
+}
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/caos/logging"
+
+	caos_errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/notification/channels"
+	"github.com/caos/zitadel/internal/notification/messages"
+)
+
+const (
+	defaultContentType = "application/json"
+	requestTimeout     = 10 * time.Second
+	//maxErrorBodySize limits the response body of the gateway logged on a failed request
+	maxErrorBodySize = 4 << 10
+)
+
+var (
+	errInvalidScheme = errors.New("call url must be http or https")
+	errInvalidMethod = errors.New("method must be POST or PUT")
+
+	templateFuncs = template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+)
+
+func InitWebhookChannel(config WebhookConfig) (channels.NotificationChannel, error) {
+	if err := config.Validate(); err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "WEBH-Cf83n", "invalid webhook config")
+	}
+	body, err := config.template()
+	if err != nil {
+		return nil, caos_errs.ThrowInvalidArgument(err, "WEBH-Tm92k", "invalid body template")
+	}
+	method := config.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	contentType := config.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	client := &http.Client{Timeout: requestTimeout}
+
+	logging.Log("NOTIF-Wh92k").Debug("successfully initialized webhook sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		smsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return caos_errs.ThrowInternal(nil, "WEBH-Sm82n", "message is not SMS")
+		}
+		from := config.From
+		if from == "" {
+			from = smsMsg.SenderPhoneNumber
+		}
+		payload := new(bytes.Buffer)
+		err := body.Execute(payload, &TemplateData{
+			From:    from,
+			To:      smsMsg.RecipientPhoneNumber,
+			Content: smsMsg.GetContent(),
+		})
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "WEBH-Bd92m", "unable to render body")
+		}
+		req, err := http.NewRequest(method, config.CallURL, payload)
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "WEBH-Rq02n", "unable to create request")
+		}
+		req.Header.Set("Content-Type", contentType)
+		if config.Authorization != "" {
+			req.Header.Set("Authorization", config.Authorization)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return caos_errs.ThrowInternal(err, "WEBH-Ws93m", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			bodyBytes, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+			logging.LogWithFields("WEBH-Lx9sm", "status", resp.StatusCode, "body", string(bodyBytes)).Warn("sms gateway did not accept message")
+			return caos_errs.ThrowInternal(nil, "WEBH-Us82k", "unexpected status code "+resp.Status)
+		}
+		return nil
+	}), nil
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"net/http"
+	"net/url"
+	"text/template"
+)
+
+//WebhookConfig describes a http endpoint of an sms gateway
+//the body of the request is rendered from BodyTemplate with the fields From, To and Content,
+//the function json encodes a value as json string (e.g. {"text": {{json .Content}}})
+type WebhookConfig struct {
+	CallURL       string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	Authorization string
+	From          string
+}
+
+type TemplateData struct {
+	From    string
+	To      string
+	Content string
+}
+
+func (w *WebhookConfig) Validate() error {
+	callURL, err := url.Parse(w.CallURL)
+	if err != nil {
+		return err
+	}
+	if callURL.Scheme != "http" && callURL.Scheme != "https" {
+		return errInvalidScheme
+	}
+	if w.Method != "" && w.Method != http.MethodPost && w.Method != http.MethodPut {
+		return errInvalidMethod
+	}
+	_, err = w.template()
+	return err
+}
+
+func (w *WebhookConfig) template() (*template.Template, error) {
+	return template.New("body").Funcs(templateFuncs).Parse(w.BodyTemplate)
+}
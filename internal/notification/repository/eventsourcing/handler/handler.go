@@ -40,6 +40,10 @@ func Register(configs Configs, bulkLimit, errorCount uint64, view *view.View, es
 	if err != nil {
 		logging.Log("HANDL-s90ew").WithError(err).Debug("error create new aes crypto")
 	}
+	providerCrypto, err := crypto.NewAESCrypto(systemDefaults.NotificationProviderKey)
+	if err != nil {
+		logging.Log("HANDL-Wm3ds").WithError(err).Debug("error create new notification provider crypto")
+	}
 	return []queryv1.Handler{
 		newNotifyUser(
 			handler{view, bulkLimit, configs.cycleDuration("User"), errorCount, es},
@@ -52,6 +56,7 @@ func Register(configs Configs, bulkLimit, errorCount uint64, view *view.View, es
 			queries,
 			systemDefaults,
 			aesCrypto,
+			providerCrypto,
 			dir,
 			apiDomain,
 		),
@@ -18,6 +18,10 @@ import (
 	queryv1 "github.com/caos/zitadel/internal/eventstore/v1/query"
 	"github.com/caos/zitadel/internal/eventstore/v1/spooler"
 	"github.com/caos/zitadel/internal/i18n"
+	"github.com/caos/zitadel/internal/notification/channels/smtp"
+	"github.com/caos/zitadel/internal/notification/channels/twilio"
+	"github.com/caos/zitadel/internal/notification/channels/vonage"
+	"github.com/caos/zitadel/internal/notification/channels/webhook"
 	"github.com/caos/zitadel/internal/notification/types"
 	"github.com/caos/zitadel/internal/query"
 	user_repo "github.com/caos/zitadel/internal/repository/user"
@@ -36,6 +40,7 @@ type Notification struct {
 	command        *command.Commands
 	systemDefaults sd.SystemDefaults
 	AesCrypto      crypto.EncryptionAlgorithm
+	providerCrypto crypto.EncryptionAlgorithm
 	statikDir      http.FileSystem
 	subscription   *v1.Subscription
 	apiDomain      string
@@ -48,6 +53,7 @@ func newNotification(
 	query *query.Queries,
 	defaults sd.SystemDefaults,
 	aesCrypto crypto.EncryptionAlgorithm,
+	providerCrypto crypto.EncryptionAlgorithm,
 	statikDir http.FileSystem,
 	apiDomain string,
 ) *Notification {
@@ -57,6 +63,7 @@ func newNotification(
 		systemDefaults: defaults,
 		statikDir:      statikDir,
 		AesCrypto:      aesCrypto,
+		providerCrypto: providerCrypto,
 		apiDomain:      apiDomain,
 		queries:        query,
 	}
@@ -121,6 +128,14 @@ func (n *Notification) Reduce(event *models.Event) (err error) {
 		err = n.handleDomainClaimed(event)
 	case models.EventType(user_repo.HumanPasswordlessInitCodeRequestedType):
 		err = n.handlePasswordlessRegistrationLink(event)
+	case models.EventType(user_repo.HumanPasswordExpiryNotificationAddedType):
+		err = n.handlePasswordExpiry(event)
+	case models.EventType(user_repo.HumanMFAOTPSMSCodeAddedType):
+		err = n.handleOTPSMSCode(event)
+	case models.EventType(user_repo.HumanMFAOTPEmailCodeAddedType):
+		err = n.handleOTPEmailCode(event)
+	case models.EventType(user_repo.HumanMFARecoveryCodesLowNotificationAddedType):
+		err = n.handleRecoveryCodesLow(event)
 	}
 	if err != nil {
 		return err
@@ -160,7 +175,11 @@ func (n *Notification) handleInitUserCode(event *models.Event) (err error) {
 		return err
 	}
 
-	err = types.SendUserInitCode(string(template.Template), translator, user, initCode, n.systemDefaults, n.AesCrypto, colors, n.apiDomain)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendUserInitCode(string(template.Template), translator, user, initCode, defaults, n.AesCrypto, colors, n.apiDomain)
 	if err != nil {
 		return err
 	}
@@ -198,7 +217,11 @@ func (n *Notification) handlePasswordCode(event *models.Event) (err error) {
 	if err != nil {
 		return err
 	}
-	err = types.SendPasswordCode(string(template.Template), translator, user, pwCode, n.systemDefaults, n.AesCrypto, colors, n.apiDomain)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendPasswordCode(string(template.Template), translator, user, pwCode, defaults, n.AesCrypto, colors, n.apiDomain)
 	if err != nil {
 		return err
 	}
@@ -237,7 +260,11 @@ func (n *Notification) handleEmailVerificationCode(event *models.Event) (err err
 		return err
 	}
 
-	err = types.SendEmailVerificationCode(string(template.Template), translator, user, emailCode, n.systemDefaults, n.AesCrypto, colors, n.apiDomain)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendEmailVerificationCode(string(template.Template), translator, user, emailCode, defaults, n.AesCrypto, colors, n.apiDomain)
 	if err != nil {
 		return err
 	}
@@ -263,11 +290,16 @@ func (n *Notification) handlePhoneVerificationCode(event *models.Event) (err err
 	if err != nil {
 		return err
 	}
-	err = types.SendPhoneVerificationCode(translator, user, phoneCode, n.systemDefaults, n.AesCrypto)
+	ctx := getSetNotifyContextData(event.ResourceOwner)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendPhoneVerificationCode(translator, user, phoneCode, defaults, n.AesCrypto)
 	if err != nil {
 		return err
 	}
-	return n.command.HumanPhoneVerificationCodeSent(getSetNotifyContextData(event.ResourceOwner), event.ResourceOwner, event.AggregateID)
+	return n.command.HumanPhoneVerificationCodeSent(ctx, event.ResourceOwner, event.AggregateID)
 }
 
 func (n *Notification) handleDomainClaimed(event *models.Event) (err error) {
@@ -302,7 +334,11 @@ func (n *Notification) handleDomainClaimed(event *models.Event) (err error) {
 	if err != nil {
 		return err
 	}
-	err = types.SendDomainClaimed(string(template.Template), translator, user, data["userName"], n.systemDefaults, colors, n.apiDomain)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendDomainClaimed(string(template.Template), translator, user, data["userName"], defaults, colors, n.apiDomain)
 	if err != nil {
 		return err
 	}
@@ -348,13 +384,187 @@ func (n *Notification) handlePasswordlessRegistrationLink(event *models.Event) (
 	if err != nil {
 		return err
 	}
-	err = types.SendPasswordlessRegistrationLink(string(template.Template), translator, user, addedEvent, n.systemDefaults, n.AesCrypto, colors, n.apiDomain)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendPasswordlessRegistrationLink(string(template.Template), translator, user, addedEvent, defaults, n.AesCrypto, colors, n.apiDomain)
 	if err != nil {
 		return err
 	}
 	return n.command.HumanPasswordlessInitCodeSent(ctx, event.AggregateID, event.ResourceOwner, addedEvent.ID)
 }
 
+func (n *Notification) handlePasswordExpiry(event *models.Event) (err error) {
+	notificationAdded := new(user_repo.HumanPasswordExpiryNotificationAddedEvent)
+	if err := json.Unmarshal(event.Data, notificationAdded); err != nil {
+		return err
+	}
+	if notificationAdded.ExpiryDate.Before(time.Now().UTC()) {
+		return nil
+	}
+	alreadyHandled, err := n.checkIfAlreadyHandled(event.AggregateID, event.Sequence,
+		models.EventType(user_repo.HumanPasswordExpiryNotificationSentType),
+		models.EventType(user_repo.HumanPasswordChangedType))
+	if err != nil || alreadyHandled {
+		return err
+	}
+	user, err := n.getUserByID(event.AggregateID)
+	if err != nil {
+		return err
+	}
+	if user.LastEmail == "" {
+		return nil
+	}
+	ctx := getSetNotifyContextData(event.ResourceOwner)
+	colors, err := n.getLabelPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	template, err := n.getMailTemplate(ctx)
+	if err != nil {
+		return err
+	}
+
+	translator, err := n.getTranslatorWithOrgTexts(user.ResourceOwner, domain.PasswordExpiryMessageType)
+	if err != nil {
+		return err
+	}
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendPasswordExpiry(string(template.Template), translator, user, notificationAdded, defaults, colors, n.apiDomain)
+	if err != nil {
+		return err
+	}
+	return n.command.PasswordExpiryNotificationSent(ctx, event.ResourceOwner, event.AggregateID)
+}
+
+func (n *Notification) handleOTPSMSCode(event *models.Event) (err error) {
+	codeAdded := new(user_repo.HumanOTPSMSCodeAddedEvent)
+	if err := json.Unmarshal(event.Data, codeAdded); err != nil {
+		return err
+	}
+	alreadyHandled, err := n.checkIfCodeAlreadyHandledOrExpired(event, codeAdded.Expiry,
+		models.EventType(user_repo.HumanMFAOTPSMSCodeAddedType),
+		models.EventType(user_repo.HumanMFAOTPSMSCodeSentType))
+	if err != nil || alreadyHandled {
+		return nil
+	}
+	user, err := n.getUserByID(event.AggregateID)
+	if err != nil {
+		return err
+	}
+	if user.VerifiedPhone == "" {
+		return nil
+	}
+	translator, err := n.getTranslatorWithOrgTexts(user.ResourceOwner, domain.VerifySMSOTPMessageType)
+	if err != nil {
+		return err
+	}
+	ctx := getSetNotifyContextData(event.ResourceOwner)
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendOTPSMSCode(translator, user, codeAdded, defaults, n.AesCrypto)
+	if err != nil {
+		return err
+	}
+	return n.command.HumanOTPSMSCodeSent(ctx, event.ResourceOwner, event.AggregateID)
+}
+
+func (n *Notification) handleOTPEmailCode(event *models.Event) (err error) {
+	codeAdded := new(user_repo.HumanOTPEmailCodeAddedEvent)
+	if err := json.Unmarshal(event.Data, codeAdded); err != nil {
+		return err
+	}
+	alreadyHandled, err := n.checkIfCodeAlreadyHandledOrExpired(event, codeAdded.Expiry,
+		models.EventType(user_repo.HumanMFAOTPEmailCodeAddedType),
+		models.EventType(user_repo.HumanMFAOTPEmailCodeSentType))
+	if err != nil || alreadyHandled {
+		return nil
+	}
+	user, err := n.getUserByID(event.AggregateID)
+	if err != nil {
+		return err
+	}
+	if user.VerifiedEmail == "" {
+		return nil
+	}
+	ctx := getSetNotifyContextData(event.ResourceOwner)
+	colors, err := n.getLabelPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	template, err := n.getMailTemplate(ctx)
+	if err != nil {
+		return err
+	}
+
+	translator, err := n.getTranslatorWithOrgTexts(user.ResourceOwner, domain.VerifyEmailOTPMessageType)
+	if err != nil {
+		return err
+	}
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendOTPEmailCode(string(template.Template), translator, user, codeAdded, defaults, n.AesCrypto, colors, n.apiDomain)
+	if err != nil {
+		return err
+	}
+	return n.command.HumanOTPEmailCodeSent(ctx, event.ResourceOwner, event.AggregateID)
+}
+
+func (n *Notification) handleRecoveryCodesLow(event *models.Event) (err error) {
+	notificationAdded := new(user_repo.HumanRecoveryCodesLowNotificationAddedEvent)
+	if err := json.Unmarshal(event.Data, notificationAdded); err != nil {
+		return err
+	}
+	alreadyHandled, err := n.checkIfAlreadyHandled(event.AggregateID, event.Sequence,
+		models.EventType(user_repo.HumanMFARecoveryCodesLowNotificationSentType),
+		models.EventType(user_repo.HumanMFARecoveryCodesAddedType),
+		models.EventType(user_repo.HumanMFARecoveryCodesRemovedType))
+	if err != nil || alreadyHandled {
+		return err
+	}
+	user, err := n.getUserByID(event.AggregateID)
+	if err != nil {
+		return err
+	}
+	if user.LastEmail == "" {
+		return nil
+	}
+	ctx := getSetNotifyContextData(event.ResourceOwner)
+	colors, err := n.getLabelPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	template, err := n.getMailTemplate(ctx)
+	if err != nil {
+		return err
+	}
+
+	translator, err := n.getTranslatorWithOrgTexts(user.ResourceOwner, domain.RecoveryCodesLowMessageType)
+	if err != nil {
+		return err
+	}
+	defaults, err := n.getSystemDefaults(ctx)
+	if err != nil {
+		return err
+	}
+	err = types.SendRecoveryCodesLow(string(template.Template), translator, user, notificationAdded, defaults, colors, n.apiDomain)
+	if err != nil {
+		return err
+	}
+	return n.command.HumanRecoveryCodesLowNotificationSent(ctx, event.ResourceOwner, event.AggregateID)
+}
+
 func (n *Notification) checkIfCodeAlreadyHandledOrExpired(event *models.Event, expiry time.Duration, eventTypes ...models.EventType) (bool, error) {
 	if event.CreationDate.Add(expiry).Before(time.Now().UTC()) {
 		return true, nil
@@ -409,6 +619,120 @@ func (n *Notification) getMailTemplate(ctx context.Context) (*query.MailTemplate
 	return n.queries.MailTemplateByOrg(ctx, authz.GetCtxData(ctx).OrgID)
 }
 
+//getSystemDefaults returns the system defaults with the notification providers configured on the iam and the org,
+//the providers of the static configuration are used as long as none are configured
+func (n *Notification) getSystemDefaults(ctx context.Context) (sd.SystemDefaults, error) {
+	defaults := n.systemDefaults
+	smtpConfig, err := n.queries.SMTPConfigByAggregateID(ctx, domain.IAMID)
+	if err != nil && !errors.IsNotFound(err) {
+		return defaults, err
+	}
+	if smtpConfig != nil {
+		password, err := n.decryptProviderSecret(smtpConfig.Password)
+		if err != nil {
+			return defaults, err
+		}
+		defaults.Notifications.Providers.Email = smtp.EmailConfig{
+			SMTP: smtp.SMTP{
+				Host:     smtpConfig.Host,
+				User:     smtpConfig.User,
+				Password: password,
+			},
+			Tls:      smtpConfig.TLS,
+			From:     smtpConfig.SenderAddress,
+			FromName: smtpConfig.SenderName,
+		}
+	}
+	smsConfigs, err := n.getSMSConfigs(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return defaults, err
+	}
+	providers := make([]sd.SMSProvider, 0, len(smsConfigs))
+	for _, smsConfig := range smsConfigs {
+		provider, err := n.smsProvider(smsConfig)
+		if err != nil {
+			return defaults, err
+		}
+		providers = append(providers, provider)
+	}
+	defaults.Notifications.Providers.SMS = providers
+	return defaults, nil
+}
+
+//getSMSConfigs returns the sms providers selected by the org in the order they are used,
+//the active provider of the iam is used if the org did not select any
+func (n *Notification) getSMSConfigs(ctx context.Context, orgID string) ([]*query.SMSConfig, error) {
+	if orgID != "" {
+		orgProviders, err := n.queries.OrgSMSProvidersByOrgID(ctx, orgID)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if orgProviders != nil {
+			smsConfigs, err := n.queries.SMSProviderConfigsByIDs(ctx, orgProviders.ProviderIDs)
+			if err != nil {
+				return nil, err
+			}
+			if len(smsConfigs) > 0 {
+				return smsConfigs, nil
+			}
+		}
+	}
+	smsConfig, err := n.queries.SMSProviderConfigActive(ctx, domain.IAMID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return []*query.SMSConfig{smsConfig}, nil
+}
+
+func (n *Notification) smsProvider(smsConfig *query.SMSConfig) (sd.SMSProvider, error) {
+	switch {
+	case smsConfig.TwilioConfig != nil:
+		token, err := n.decryptProviderSecret(smsConfig.TwilioConfig.Token)
+		if err != nil {
+			return sd.SMSProvider{}, err
+		}
+		return sd.SMSProvider{Twilio: &twilio.TwilioConfig{
+			SID:   smsConfig.TwilioConfig.SID,
+			Token: token,
+			From:  smsConfig.TwilioConfig.SenderNumber,
+		}}, nil
+	case smsConfig.VonageConfig != nil:
+		apiSecret, err := n.decryptProviderSecret(smsConfig.VonageConfig.APISecret)
+		if err != nil {
+			return sd.SMSProvider{}, err
+		}
+		return sd.SMSProvider{Vonage: &vonage.VonageConfig{
+			APIKey:    smsConfig.VonageConfig.APIKey,
+			APISecret: apiSecret,
+			From:      smsConfig.VonageConfig.SenderNumber,
+		}}, nil
+	case smsConfig.HTTPConfig != nil:
+		authorization, err := n.decryptProviderSecret(smsConfig.HTTPConfig.Authorization)
+		if err != nil {
+			return sd.SMSProvider{}, err
+		}
+		return sd.SMSProvider{HTTP: &webhook.WebhookConfig{
+			CallURL:       smsConfig.HTTPConfig.Endpoint,
+			Method:        smsConfig.HTTPConfig.Method,
+			ContentType:   smsConfig.HTTPConfig.ContentType,
+			BodyTemplate:  smsConfig.HTTPConfig.BodyTemplate,
+			Authorization: authorization,
+			From:          smsConfig.HTTPConfig.SenderNumber,
+		}}, nil
+	}
+	return sd.SMSProvider{}, errors.ThrowInternal(nil, "HANDL-Sm93k", "Errors.SMSConfig.NotFound")
+}
+
+func (n *Notification) decryptProviderSecret(secret *crypto.CryptoValue) (string, error) {
+	if secret == nil {
+		return "", nil
+	}
+	return crypto.DecryptString(secret, n.providerCrypto)
+}
+
 func (n *Notification) getTranslatorWithOrgTexts(orgID, textType string) (*i18n.Translator, error) {
 	translator, err := i18n.NewTranslator(n.statikDir, i18n.TranslatorConfig{DefaultLanguage: n.systemDefaults.DefaultLanguage})
 	if err != nil {
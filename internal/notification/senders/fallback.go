@@ -0,0 +1,29 @@
+package senders
+
+import (
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/notification/channels"
+)
+
+var _ channels.NotificationChannel = (*Fallback)(nil)
+
+type Fallback struct {
+	channels []channels.NotificationChannel
+}
+
+func fallbackChannels(channel ...channels.NotificationChannel) *Fallback {
+	return &Fallback{channels: channel}
+}
+
+//HandleMessage sends the message to the first channel and only tries the next channel if sending failed
+//the error of the last channel is returned if no channel was able to send the message
+func (f *Fallback) HandleMessage(message channels.Message) (err error) {
+	for i := range f.channels {
+		if err = f.channels[i].HandleMessage(message); err == nil {
+			return nil
+		}
+		logging.LogWithFields("SENDE-Fb82n", "provider", i).WithError(err).Warn("unable to send message, trying next provider")
+	}
+	return err
+}
@@ -2,8 +2,11 @@ package senders
 
 import (
 	"github.com/caos/zitadel/internal/config/systemdefaults"
+	caos_errs "github.com/caos/zitadel/internal/errors"
 	"github.com/caos/zitadel/internal/notification/channels"
 	"github.com/caos/zitadel/internal/notification/channels/twilio"
+	"github.com/caos/zitadel/internal/notification/channels/vonage"
+	"github.com/caos/zitadel/internal/notification/channels/webhook"
 )
 
 func SMSChannels(config systemdefaults.Notifications) (channels.NotificationChannel, error) {
@@ -13,9 +16,34 @@ func SMSChannels(config systemdefaults.Notifications) (channels.NotificationChan
 		return nil, err
 	}
 
-	if !config.DebugMode {
+	if config.DebugMode {
+		return debug, nil
+	}
+
+	if len(config.Providers.SMS) == 0 {
 		return chainChannels(debug, twilio.InitTwilioChannel(config.Providers.Twilio)), nil
 	}
 
-	return debug, nil
+	providers := make([]channels.NotificationChannel, 0, len(config.Providers.SMS))
+	for _, provider := range config.Providers.SMS {
+		channel, err := SMSProviderChannel(provider)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, channel)
+	}
+	return chainChannels(debug, fallbackChannels(providers...)), nil
+}
+
+//SMSProviderChannel initializes the channel of the configured provider
+func SMSProviderChannel(provider systemdefaults.SMSProvider) (channels.NotificationChannel, error) {
+	switch {
+	case provider.Twilio != nil:
+		return twilio.InitTwilioChannel(*provider.Twilio), nil
+	case provider.Vonage != nil:
+		return vonage.InitVonageChannel(*provider.Vonage), nil
+	case provider.HTTP != nil:
+		return webhook.InitWebhookChannel(*provider.HTTP)
+	}
+	return nil, caos_errs.ThrowInternal(nil, "SENDE-Pr92n", "no sms provider configured")
 }
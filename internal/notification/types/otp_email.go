@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/i18n"
+	"github.com/caos/zitadel/internal/notification/templates"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/repository/user"
+	view_model "github.com/caos/zitadel/internal/user/repository/view/model"
+)
+
+type OTPEmailCodeData struct {
+	templates.TemplateData
+	URL string
+}
+
+func SendOTPEmailCode(mailhtml string, translator *i18n.Translator, user *view_model.NotifyUser, code *user.HumanOTPEmailCodeAddedEvent, systemDefaults systemdefaults.SystemDefaults, alg crypto.EncryptionAlgorithm, colors *query.LabelPolicy, apiDomain string) error {
+	codeString, err := crypto.DecryptString(code.Code, alg)
+	if err != nil {
+		return err
+	}
+	var args = mapNotifyUserToArgs(user)
+	args["Code"] = codeString
+
+	//the code has to be entered on the login page, so the mail contains no link
+	otpCodeData := &OTPEmailCodeData{
+		TemplateData: GetTemplateData(translator, args, apiDomain, "", domain.VerifyEmailOTPMessageType, user.PreferredLanguage, colors),
+	}
+	template, err := templates.GetParsedTemplate(mailhtml, otpCodeData)
+	if err != nil {
+		return err
+	}
+	return generateEmail(user, otpCodeData.Subject, template, systemDefaults.Notifications, false)
+}
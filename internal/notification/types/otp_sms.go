@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/i18n"
+	"github.com/caos/zitadel/internal/notification/templates"
+	"github.com/caos/zitadel/internal/repository/user"
+	view_model "github.com/caos/zitadel/internal/user/repository/view/model"
+)
+
+type OTPSMSCodeData struct {
+	UserID string
+}
+
+func SendOTPSMSCode(translator *i18n.Translator, user *view_model.NotifyUser, code *user.HumanOTPSMSCodeAddedEvent, systemDefaults systemdefaults.SystemDefaults, alg crypto.EncryptionAlgorithm) error {
+	codeString, err := crypto.DecryptString(code.Code, alg)
+	if err != nil {
+		return err
+	}
+	var args = mapNotifyUserToArgs(user)
+	args["Code"] = codeString
+
+	text := translator.Localize(fmt.Sprintf("%s.%s", domain.VerifySMSOTPMessageType, domain.MessageText), args, user.PreferredLanguage)
+
+	codeData := &OTPSMSCodeData{UserID: user.ID}
+	template, err := templates.ParseTemplateText(text, codeData)
+	if err != nil {
+		return err
+	}
+	return generateSms(user, template, systemDefaults.Notifications, false)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/i18n"
+	"github.com/caos/zitadel/internal/notification/templates"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/repository/user"
+	view_model "github.com/caos/zitadel/internal/user/repository/view/model"
+)
+
+type PasswordExpiryData struct {
+	templates.TemplateData
+	URL string
+}
+
+func SendPasswordExpiry(mailhtml string, translator *i18n.Translator, user *view_model.NotifyUser, notification *user.HumanPasswordExpiryNotificationAddedEvent, systemDefaults systemdefaults.SystemDefaults, colors *query.LabelPolicy, apiDomain string) error {
+	url, err := templates.ParseTemplateText(systemDefaults.Notifications.Endpoints.PasswordExpiry, &UrlData{UserID: user.ID})
+	if err != nil {
+		return err
+	}
+	var args = mapNotifyUserToArgs(user)
+	args["ExpiryDate"] = notification.ExpiryDate.Format("2006-01-02")
+
+	passwordExpiryData := &PasswordExpiryData{
+		TemplateData: GetTemplateData(translator, args, apiDomain, url, domain.PasswordExpiryMessageType, user.PreferredLanguage, colors),
+		URL:          url,
+	}
+	template, err := templates.GetParsedTemplate(mailhtml, passwordExpiryData)
+	if err != nil {
+		return err
+	}
+	return generateEmail(user, passwordExpiryData.Subject, template, systemDefaults.Notifications, true)
+}
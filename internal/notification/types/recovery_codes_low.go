@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/i18n"
+	"github.com/caos/zitadel/internal/notification/templates"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/repository/user"
+	view_model "github.com/caos/zitadel/internal/user/repository/view/model"
+)
+
+type RecoveryCodesLowData struct {
+	templates.TemplateData
+	URL string
+}
+
+func SendRecoveryCodesLow(mailhtml string, translator *i18n.Translator, user *view_model.NotifyUser, notification *user.HumanRecoveryCodesLowNotificationAddedEvent, systemDefaults systemdefaults.SystemDefaults, colors *query.LabelPolicy, apiDomain string) error {
+	var args = mapNotifyUserToArgs(user)
+	args["Remaining"] = notification.Remaining
+
+	recoveryCodesLowData := &RecoveryCodesLowData{
+		TemplateData: GetTemplateData(translator, args, apiDomain, "", domain.RecoveryCodesLowMessageType, user.PreferredLanguage, colors),
+	}
+	template, err := templates.GetParsedTemplate(mailhtml, recoveryCodesLowData)
+	if err != nil {
+		return err
+	}
+	return generateEmail(user, recoveryCodesLowData.Subject, template, systemDefaults.Notifications, true)
+}
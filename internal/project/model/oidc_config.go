@@ -58,6 +58,8 @@ const (
 	OIDCGrantTypeAuthorizationCode OIDCGrantType = iota
 	OIDCGrantTypeImplicit
 	OIDCGrantTypeRefreshToken
+	OIDCGrantTypeDeviceCode
+	OIDCGrantTypeTokenExchange
 )
 
 type OIDCApplicationType int32
@@ -0,0 +1,179 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/actions/repository/execution"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+)
+
+var (
+	actionExecutionTable = table{
+		name: execution.Table,
+	}
+	ActionExecutionColumnActionID = Column{
+		name:  execution.ActionIDCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnActionName = Column{
+		name:  execution.ActionNameCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnResourceOwner = Column{
+		name:  execution.ResourceOwnerCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnFlowType = Column{
+		name:  execution.FlowTypeCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnTriggerType = Column{
+		name:  execution.TriggerTypeCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnCreationDate = Column{
+		name:  execution.CreationDateCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnDuration = Column{
+		name:  execution.DurationCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnOutcome = Column{
+		name:  execution.OutcomeCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnError = Column{
+		name:  execution.ErrorCol,
+		table: actionExecutionTable,
+	}
+	ActionExecutionColumnLogs = Column{
+		name:  execution.LogsCol,
+		table: actionExecutionTable,
+	}
+)
+
+type ActionExecutions struct {
+	SearchResponse
+	Executions []*ActionExecution
+}
+
+type ActionExecution struct {
+	ActionID      string
+	ActionName    string
+	ResourceOwner string
+	FlowType      domain.FlowType
+	TriggerType   domain.TriggerType
+	CreationDate  time.Time
+	Duration      time.Duration
+	Outcome       domain.ActionExecutionOutcome
+	Error         string
+	Logs          []string
+}
+
+type ActionExecutionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ActionExecutionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchActionExecutions(ctx context.Context, queries *ActionExecutionSearchQueries) (executions *ActionExecutions, err error) {
+	query, scan := prepareActionExecutionsQuery()
+	stmt, args, err := queries.toQuery(query).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Ax2nf", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ax8sm", "Errors.Internal")
+	}
+	executions, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	executions.LatestSequence, err = q.latestSequence(ctx, actionTable)
+	return executions, err
+}
+
+func NewActionExecutionActionIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(ActionExecutionColumnActionID, id, TextEquals)
+}
+
+func NewActionExecutionResourceOwnerSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(ActionExecutionColumnResourceOwner, id, TextEquals)
+}
+
+func NewActionExecutionOutcomeSearchQuery(value domain.ActionExecutionOutcome) (SearchQuery, error) {
+	return NewNumberQuery(ActionExecutionColumnOutcome, int(value), NumberEquals)
+}
+
+func prepareActionExecutionsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*ActionExecutions, error)) {
+	return sq.Select(
+			ActionExecutionColumnActionID.identifier(),
+			ActionExecutionColumnActionName.identifier(),
+			ActionExecutionColumnResourceOwner.identifier(),
+			ActionExecutionColumnFlowType.identifier(),
+			ActionExecutionColumnTriggerType.identifier(),
+			ActionExecutionColumnCreationDate.identifier(),
+			ActionExecutionColumnDuration.identifier(),
+			ActionExecutionColumnOutcome.identifier(),
+			ActionExecutionColumnError.identifier(),
+			ActionExecutionColumnLogs.identifier(),
+			countColumn.identifier(),
+		).From(actionExecutionTable.identifier()).
+			OrderBy(ActionExecutionColumnCreationDate.identifier() + " DESC").
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ActionExecutions, error) {
+			executions := make([]*ActionExecution, 0)
+			var count uint64
+			for rows.Next() {
+				execution := new(ActionExecution)
+				errorMessage := sql.NullString{}
+				logs := pq.StringArray{}
+				err := rows.Scan(
+					&execution.ActionID,
+					&execution.ActionName,
+					&execution.ResourceOwner,
+					&execution.FlowType,
+					&execution.TriggerType,
+					&execution.CreationDate,
+					&execution.Duration,
+					&execution.Outcome,
+					&errorMessage,
+					&logs,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				execution.Error = errorMessage.String
+				execution.Logs = logs
+				executions = append(executions, execution)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ax5kd", "Errors.Query.CloseRows")
+			}
+
+			return &ActionExecutions{
+				Executions: executions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
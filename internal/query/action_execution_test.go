@@ -0,0 +1,154 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+)
+
+func Test_ActionExecutionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareActionExecutionsQuery no result",
+			prepare: prepareActionExecutionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.action_executions.action_id,`+
+						` zitadel.action_executions.action_name,`+
+						` zitadel.action_executions.resource_owner,`+
+						` zitadel.action_executions.flow_type,`+
+						` zitadel.action_executions.trigger_type,`+
+						` zitadel.action_executions.creation_date,`+
+						` zitadel.action_executions.duration,`+
+						` zitadel.action_executions.outcome,`+
+						` zitadel.action_executions.error,`+
+						` zitadel.action_executions.logs,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.action_executions`+
+						` ORDER BY zitadel.action_executions.creation_date DESC`),
+					nil,
+					nil,
+				),
+			},
+			object: &ActionExecutions{Executions: []*ActionExecution{}},
+		},
+		{
+			name:    "prepareActionExecutionsQuery one result",
+			prepare: prepareActionExecutionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.action_executions.action_id,`+
+						` zitadel.action_executions.action_name,`+
+						` zitadel.action_executions.resource_owner,`+
+						` zitadel.action_executions.flow_type,`+
+						` zitadel.action_executions.trigger_type,`+
+						` zitadel.action_executions.creation_date,`+
+						` zitadel.action_executions.duration,`+
+						` zitadel.action_executions.outcome,`+
+						` zitadel.action_executions.error,`+
+						` zitadel.action_executions.logs,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.action_executions`+
+						` ORDER BY zitadel.action_executions.creation_date DESC`),
+					[]string{
+						"action_id",
+						"action_name",
+						"resource_owner",
+						"flow_type",
+						"trigger_type",
+						"creation_date",
+						"duration",
+						"outcome",
+						"error",
+						"logs",
+						"count",
+					},
+					[][]driver.Value{
+						{
+							"action-id",
+							"action",
+							"ro",
+							domain.FlowTypeExternalAuthentication,
+							domain.TriggerTypePostAuthentication,
+							testNow,
+							int64(time.Second),
+							domain.ActionExecutionOutcomeFailed,
+							"email not verified",
+							pq.StringArray{"checking email"},
+						},
+					},
+				),
+			},
+			object: &ActionExecutions{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Executions: []*ActionExecution{
+					{
+						ActionID:      "action-id",
+						ActionName:    "action",
+						ResourceOwner: "ro",
+						FlowType:      domain.FlowTypeExternalAuthentication,
+						TriggerType:   domain.TriggerTypePostAuthentication,
+						CreationDate:  testNow,
+						Duration:      time.Second,
+						Outcome:       domain.ActionExecutionOutcomeFailed,
+						Error:         "email not verified",
+						Logs:          []string{"checking email"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareActionExecutionsQuery sql err",
+			prepare: prepareActionExecutionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(`SELECT zitadel.action_executions.action_id,`+
+						` zitadel.action_executions.action_name,`+
+						` zitadel.action_executions.resource_owner,`+
+						` zitadel.action_executions.flow_type,`+
+						` zitadel.action_executions.trigger_type,`+
+						` zitadel.action_executions.creation_date,`+
+						` zitadel.action_executions.duration,`+
+						` zitadel.action_executions.outcome,`+
+						` zitadel.action_executions.error,`+
+						` zitadel.action_executions.logs,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.action_executions`+
+						` ORDER BY zitadel.action_executions.creation_date DESC`),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -88,6 +88,29 @@ func (q *Queries) GetActiveActionsByFlowAndTriggerType(ctx context.Context, flow
 	return scan(rows)
 }
 
+//GetActiveActionsByFlowAndTriggerTypeOfUser returns the active actions of the flow in the organisation of the user
+//it doesn't require the user to be loaded before
+func (q *Queries) GetActiveActionsByFlowAndTriggerTypeOfUser(ctx context.Context, flowType domain.FlowType, triggerType domain.TriggerType, userID string) ([]*Action, error) {
+	stmt, scan := prepareTriggerActionsOfUserQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			FlowsTriggersColumnFlowType.identifier():    flowType,
+			FlowsTriggersColumnTriggerType.identifier(): triggerType,
+			UserIDCol.identifier():                      userID,
+			ActionColumnState.identifier():              domain.ActionStateActive,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Tu8sq", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Tu8qe", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
 func (q *Queries) GetFlowTypesOfActionID(ctx context.Context, actionID string) ([]domain.FlowType, error) {
 	stmt, scan := prepareFlowTypesQuery()
 	query, args, err := stmt.Where(
@@ -130,6 +153,11 @@ func prepareFlowTypesQuery() (sq.SelectBuilder, func(*sql.Rows) ([]domain.FlowTy
 
 }
 
+func prepareTriggerActionsOfUserQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*Action, error)) {
+	query, scan := prepareTriggerActionsQuery()
+	return query.Join(join(UserResourceOwnerCol, FlowsTriggersColumnResourceOwner)), scan
+}
+
 func prepareTriggerActionsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*Action, error)) {
 	return sq.Select(
 			ActionColumnID.identifier(),
@@ -140,6 +168,8 @@ func prepareTriggerActionsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*Action,
 			ActionColumnSequence.identifier(),
 			ActionColumnName.identifier(),
 			ActionColumnScript.identifier(),
+			ActionColumnTimeout.identifier(),
+			ActionColumnAllowedToFail.identifier(),
 		).
 			From(flowsTriggersTable.name).
 			LeftJoin(join(ActionColumnID, FlowsTriggersColumnActionID)).
@@ -157,6 +187,8 @@ func prepareTriggerActionsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*Action,
 					&action.Sequence,
 					&action.Name,
 					&action.Script,
+					&action.Timeout,
+					&action.AllowedToFail,
 				)
 				if err != nil {
 					return nil, err
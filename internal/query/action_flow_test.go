@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/caos/zitadel/internal/domain"
 )
@@ -297,7 +298,9 @@ func Test_FlowPrepares(t *testing.T) {
 						` zitadel.projections.actions.action_state,`+
 						` zitadel.projections.actions.sequence,`+
 						` zitadel.projections.actions.name,`+
-						` zitadel.projections.actions.script`+
+						` zitadel.projections.actions.script,`+
+						` zitadel.projections.actions.timeout,`+
+						` zitadel.projections.actions.allowed_to_fail`+
 						` FROM zitadel.projections.flows_triggers`+
 						` LEFT JOIN zitadel.projections.actions ON zitadel.projections.flows_triggers.action_id = zitadel.projections.actions.id`),
 					nil,
@@ -318,7 +321,9 @@ func Test_FlowPrepares(t *testing.T) {
 						` zitadel.projections.actions.action_state,`+
 						` zitadel.projections.actions.sequence,`+
 						` zitadel.projections.actions.name,`+
-						` zitadel.projections.actions.script`+
+						` zitadel.projections.actions.script,`+
+						` zitadel.projections.actions.timeout,`+
+						` zitadel.projections.actions.allowed_to_fail`+
 						` FROM zitadel.projections.flows_triggers`+
 						` LEFT JOIN zitadel.projections.actions ON zitadel.projections.flows_triggers.action_id = zitadel.projections.actions.id`),
 					[]string{
@@ -330,6 +335,8 @@ func Test_FlowPrepares(t *testing.T) {
 						"sequence",
 						"name",
 						"script",
+						"timeout",
+						"allowed_to_fail",
 					},
 					[][]driver.Value{
 						{
@@ -341,6 +348,8 @@ func Test_FlowPrepares(t *testing.T) {
 							uint64(20211115),
 							"action-name",
 							"script",
+							1 * time.Second,
+							true,
 						},
 					},
 				),
@@ -355,6 +364,8 @@ func Test_FlowPrepares(t *testing.T) {
 					Sequence:      20211115,
 					Name:          "action-name",
 					Script:        "script",
+					Timeout:       1 * time.Second,
+					AllowedToFail: true,
 				},
 			},
 		},
@@ -370,7 +381,9 @@ func Test_FlowPrepares(t *testing.T) {
 						` zitadel.projections.actions.action_state,`+
 						` zitadel.projections.actions.sequence,`+
 						` zitadel.projections.actions.name,`+
-						` zitadel.projections.actions.script`+
+						` zitadel.projections.actions.script,`+
+						` zitadel.projections.actions.timeout,`+
+						` zitadel.projections.actions.allowed_to_fail`+
 						` FROM zitadel.projections.flows_triggers`+
 						` LEFT JOIN zitadel.projections.actions ON zitadel.projections.flows_triggers.action_id = zitadel.projections.actions.id`),
 					[]string{
@@ -382,6 +395,8 @@ func Test_FlowPrepares(t *testing.T) {
 						"sequence",
 						"name",
 						"script",
+						"timeout",
+						"allowed_to_fail",
 					},
 					[][]driver.Value{
 						{
@@ -393,6 +408,8 @@ func Test_FlowPrepares(t *testing.T) {
 							uint64(20211115),
 							"action-name-1",
 							"script",
+							1 * time.Second,
+							true,
 						},
 						{
 							"action-id-2",
@@ -403,6 +420,8 @@ func Test_FlowPrepares(t *testing.T) {
 							uint64(20211115),
 							"action-name-2",
 							"script",
+							1 * time.Second,
+							true,
 						},
 					},
 				),
@@ -417,6 +436,8 @@ func Test_FlowPrepares(t *testing.T) {
 					Sequence:      20211115,
 					Name:          "action-name-1",
 					Script:        "script",
+					Timeout:       1 * time.Second,
+					AllowedToFail: true,
 				},
 				{
 					ID:            "action-id-2",
@@ -427,6 +448,69 @@ func Test_FlowPrepares(t *testing.T) {
 					Sequence:      20211115,
 					Name:          "action-name-2",
 					Script:        "script",
+					Timeout:       1 * time.Second,
+					AllowedToFail: true,
+				},
+			},
+		},
+		{
+			name:    "prepareTriggerActionsOfUserQuery one result",
+			prepare: prepareTriggerActionsOfUserQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.actions.id,`+
+						` zitadel.projections.actions.creation_date,`+
+						` zitadel.projections.actions.change_date,`+
+						` zitadel.projections.actions.resource_owner,`+
+						` zitadel.projections.actions.action_state,`+
+						` zitadel.projections.actions.sequence,`+
+						` zitadel.projections.actions.name,`+
+						` zitadel.projections.actions.script,`+
+						` zitadel.projections.actions.timeout,`+
+						` zitadel.projections.actions.allowed_to_fail`+
+						` FROM zitadel.projections.flows_triggers`+
+						` LEFT JOIN zitadel.projections.actions ON zitadel.projections.flows_triggers.action_id = zitadel.projections.actions.id`+
+						` JOIN zitadel.projections.users ON zitadel.projections.flows_triggers.resource_owner = zitadel.projections.users.resource_owner`),
+					[]string{
+						"id",
+						"creation_date",
+						"change_date",
+						"resource_owner",
+						"state",
+						"sequence",
+						"name",
+						"script",
+						"timeout",
+						"allowed_to_fail",
+					},
+					[][]driver.Value{
+						{
+							"action-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.AddressStateActive,
+							uint64(20211115),
+							"action-name",
+							"script",
+							1 * time.Second,
+							true,
+						},
+					},
+				),
+			},
+			object: []*Action{
+				{
+					ID:            "action-id",
+					CreationDate:  testNow,
+					ChangeDate:    testNow,
+					ResourceOwner: "ro",
+					State:         domain.ActionStateActive,
+					Sequence:      20211115,
+					Name:          "action-name",
+					Script:        "script",
+					Timeout:       1 * time.Second,
+					AllowedToFail: true,
 				},
 			},
 		},
@@ -442,7 +526,9 @@ func Test_FlowPrepares(t *testing.T) {
 						` zitadel.projections.actions.action_state,`+
 						` zitadel.projections.actions.sequence,`+
 						` zitadel.projections.actions.name,`+
-						` zitadel.projections.actions.script`+
+						` zitadel.projections.actions.script,`+
+						` zitadel.projections.actions.timeout,`+
+						` zitadel.projections.actions.allowed_to_fail`+
 						` FROM zitadel.projections.flows_triggers`+
 						` LEFT JOIN zitadel.projections.actions ON zitadel.projections.flows_triggers.action_id = zitadel.projections.actions.id`),
 					sql.ErrConnDone,
@@ -0,0 +1,321 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+var (
+	actionLibraryTable = table{
+		name: projection.ActionLibraryTable,
+	}
+	ActionLibraryColumnID = Column{
+		name:  projection.ActionLibraryIDCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnCreationDate = Column{
+		name:  projection.ActionLibraryCreationDateCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnChangeDate = Column{
+		name:  projection.ActionLibraryChangeDateCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnResourceOwner = Column{
+		name:  projection.ActionLibraryResourceOwnerCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnSequence = Column{
+		name:  projection.ActionLibrarySequenceCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnState = Column{
+		name:  projection.ActionLibraryStateCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnName = Column{
+		name:  projection.ActionLibraryNameCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnVersion = Column{
+		name:  projection.ActionLibraryVersionCol,
+		table: actionLibraryTable,
+	}
+	ActionLibraryColumnScript = Column{
+		name:  projection.ActionLibraryScriptCol,
+		table: actionLibraryTable,
+	}
+)
+
+var (
+	actionLibraryVersionTable = table{
+		name: projection.ActionLibraryVersionTable,
+	}
+	ActionLibraryVersionColumnLibraryID = Column{
+		name:  projection.ActionLibraryVersionLibraryIDCol,
+		table: actionLibraryVersionTable,
+	}
+	ActionLibraryVersionColumnVersion = Column{
+		name:  projection.ActionLibraryVersionVersionCol,
+		table: actionLibraryVersionTable,
+	}
+	ActionLibraryVersionColumnScript = Column{
+		name:  projection.ActionLibraryVersionScriptCol,
+		table: actionLibraryVersionTable,
+	}
+)
+
+type ActionLibraries struct {
+	SearchResponse
+	Libraries []*ActionLibrary
+}
+
+type ActionLibrary struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.ActionLibraryState
+	Sequence      uint64
+
+	Name    string
+	Version uint64
+	Script  string
+}
+
+//ActionLibraryDependency is an action requiring a library
+//Version is the version the action currently loads, Pinned is true if the action requires this specific version
+type ActionLibraryDependency struct {
+	ActionID   string
+	ActionName string
+	Version    uint64
+	Pinned     bool
+}
+
+type ActionLibrarySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ActionLibrarySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchActionLibraries(ctx context.Context, queries *ActionLibrarySearchQueries) (libraries *ActionLibraries, err error) {
+	query, scan := prepareActionLibrariesQuery()
+	stmt, args, err := queries.toQuery(query).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Lb4mf", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lb8dq", "Errors.Internal")
+	}
+	libraries, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	libraries.LatestSequence, err = q.latestSequence(ctx, actionLibraryTable)
+	return libraries, err
+}
+
+func (q *Queries) GetActionLibraryByID(ctx context.Context, id string, orgID string) (*ActionLibrary, error) {
+	stmt, scan := prepareActionLibraryQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			ActionLibraryColumnID.identifier():            id,
+			ActionLibraryColumnResourceOwner.identifier(): orgID,
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lb2kw", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+//ActionLibraryScript returns the script of the required library version of the organisation
+//it's used to load the libraries when running actions
+func (q *Queries) ActionLibraryScript(ctx context.Context, resourceOwner string, requirement domain.ActionLibraryRequirement) (string, error) {
+	stmt, scan := prepareActionLibraryScriptQuery()
+	stmt = stmt.Where(
+		sq.Eq{
+			ActionLibraryColumnName.identifier():          requirement.Name,
+			ActionLibraryColumnResourceOwner.identifier(): resourceOwner,
+		})
+	if requirement.Version == 0 {
+		stmt = stmt.Where(ActionLibraryVersionColumnVersion.identifier() + " = " + ActionLibraryColumnVersion.identifier())
+	} else {
+		stmt = stmt.Where(sq.Eq{ActionLibraryVersionColumnVersion.identifier(): requirement.Version})
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return "", errors.ThrowInternal(err, "QUERY-Lb6vy", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+//ActionLibraryDependencies returns the actions of the organisation which directly require the library
+func (q *Queries) ActionLibraryDependencies(ctx context.Context, id string, orgID string) ([]*ActionLibraryDependency, error) {
+	library, err := q.GetActionLibraryByID(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := NewActionResourceOwnerQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := q.SearchActions(ctx, &ActionSearchQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return actionLibraryDependencies(library, actions.Actions), nil
+}
+
+func actionLibraryDependencies(library *ActionLibrary, actions []*Action) []*ActionLibraryDependency {
+	dependencies := make([]*ActionLibraryDependency, 0)
+	for _, action := range actions {
+		for _, requirement := range domain.ActionLibraryRequirements(action.Script) {
+			if requirement.Name != library.Name {
+				continue
+			}
+			dependency := &ActionLibraryDependency{
+				ActionID:   action.ID,
+				ActionName: action.Name,
+				Version:    requirement.Version,
+				Pinned:     requirement.Version != 0,
+			}
+			if !dependency.Pinned {
+				dependency.Version = library.Version
+			}
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	return dependencies
+}
+
+func NewActionLibraryResourceOwnerQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(ActionLibraryColumnResourceOwner, id, TextEquals)
+}
+
+func NewActionLibraryNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ActionLibraryColumnName, value, method)
+}
+
+func prepareActionLibrariesQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*ActionLibraries, error)) {
+	return sq.Select(
+			ActionLibraryColumnID.identifier(),
+			ActionLibraryColumnCreationDate.identifier(),
+			ActionLibraryColumnChangeDate.identifier(),
+			ActionLibraryColumnResourceOwner.identifier(),
+			ActionLibraryColumnSequence.identifier(),
+			ActionLibraryColumnState.identifier(),
+			ActionLibraryColumnName.identifier(),
+			ActionLibraryColumnVersion.identifier(),
+			ActionLibraryColumnScript.identifier(),
+			countColumn.identifier(),
+		).From(actionLibraryTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ActionLibraries, error) {
+			libraries := make([]*ActionLibrary, 0)
+			var count uint64
+			for rows.Next() {
+				library := new(ActionLibrary)
+				err := rows.Scan(
+					&library.ID,
+					&library.CreationDate,
+					&library.ChangeDate,
+					&library.ResourceOwner,
+					&library.Sequence,
+					&library.State,
+					&library.Name,
+					&library.Version,
+					&library.Script,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				libraries = append(libraries, library)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Lb3zt", "Errors.Query.CloseRows")
+			}
+
+			return &ActionLibraries{
+				Libraries: libraries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareActionLibraryQuery() (sq.SelectBuilder, func(row *sql.Row) (*ActionLibrary, error)) {
+	return sq.Select(
+			ActionLibraryColumnID.identifier(),
+			ActionLibraryColumnCreationDate.identifier(),
+			ActionLibraryColumnChangeDate.identifier(),
+			ActionLibraryColumnResourceOwner.identifier(),
+			ActionLibraryColumnSequence.identifier(),
+			ActionLibraryColumnState.identifier(),
+			ActionLibraryColumnName.identifier(),
+			ActionLibraryColumnVersion.identifier(),
+			ActionLibraryColumnScript.identifier(),
+		).From(actionLibraryTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ActionLibrary, error) {
+			library := new(ActionLibrary)
+			err := row.Scan(
+				&library.ID,
+				&library.CreationDate,
+				&library.ChangeDate,
+				&library.ResourceOwner,
+				&library.Sequence,
+				&library.State,
+				&library.Name,
+				&library.Version,
+				&library.Script,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Lb5pg", "Errors.ActionLibrary.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Lb9hj", "Errors.Internal")
+			}
+			return library, nil
+		}
+}
+
+func prepareActionLibraryScriptQuery() (sq.SelectBuilder, func(row *sql.Row) (string, error)) {
+	return sq.Select(
+			ActionLibraryVersionColumnScript.identifier(),
+		).From(actionLibraryTable.identifier()).
+			Join(join(ActionLibraryVersionColumnLibraryID, ActionLibraryColumnID)).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (string, error) {
+			var script string
+			err := row.Scan(&script)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return "", errors.ThrowNotFound(err, "QUERY-Lb7tc", "Errors.ActionLibrary.NotFound")
+				}
+				return "", errors.ThrowInternal(err, "QUERY-Lb1fx", "Errors.Internal")
+			}
+			return script, nil
+		}
+}
@@ -0,0 +1,299 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caos/zitadel/internal/domain"
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+func Test_ActionLibraryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareActionLibrariesQuery no result",
+			prepare: prepareActionLibrariesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries.id,`+
+						` zitadel.projections.action_libraries.creation_date,`+
+						` zitadel.projections.action_libraries.change_date,`+
+						` zitadel.projections.action_libraries.resource_owner,`+
+						` zitadel.projections.action_libraries.sequence,`+
+						` zitadel.projections.action_libraries.state,`+
+						` zitadel.projections.action_libraries.name,`+
+						` zitadel.projections.action_libraries.version,`+
+						` zitadel.projections.action_libraries.script,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.action_libraries`),
+					nil,
+					nil,
+				),
+			},
+			object: &ActionLibraries{Libraries: []*ActionLibrary{}},
+		},
+		{
+			name:    "prepareActionLibrariesQuery one result",
+			prepare: prepareActionLibrariesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries.id,`+
+						` zitadel.projections.action_libraries.creation_date,`+
+						` zitadel.projections.action_libraries.change_date,`+
+						` zitadel.projections.action_libraries.resource_owner,`+
+						` zitadel.projections.action_libraries.sequence,`+
+						` zitadel.projections.action_libraries.state,`+
+						` zitadel.projections.action_libraries.name,`+
+						` zitadel.projections.action_libraries.version,`+
+						` zitadel.projections.action_libraries.script,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.action_libraries`),
+					[]string{
+						"id",
+						"creation_date",
+						"change_date",
+						"resource_owner",
+						"sequence",
+						"state",
+						"name",
+						"version",
+						"script",
+						"count",
+					},
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.ActionLibraryStateActive,
+							"helpers",
+							uint64(2),
+							"exports.a = 1;",
+						},
+					},
+				),
+			},
+			object: &ActionLibraries{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Libraries: []*ActionLibrary{
+					{
+						ID:            "id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.ActionLibraryStateActive,
+						Sequence:      20211109,
+						Name:          "helpers",
+						Version:       2,
+						Script:        "exports.a = 1;",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareActionLibrariesQuery sql err",
+			prepare: prepareActionLibrariesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries.id,`+
+						` zitadel.projections.action_libraries.creation_date,`+
+						` zitadel.projections.action_libraries.change_date,`+
+						` zitadel.projections.action_libraries.resource_owner,`+
+						` zitadel.projections.action_libraries.sequence,`+
+						` zitadel.projections.action_libraries.state,`+
+						` zitadel.projections.action_libraries.name,`+
+						` zitadel.projections.action_libraries.version,`+
+						` zitadel.projections.action_libraries.script,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.action_libraries`),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareActionLibraryQuery no result",
+			prepare: prepareActionLibraryQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries.id,`+
+						` zitadel.projections.action_libraries.creation_date,`+
+						` zitadel.projections.action_libraries.change_date,`+
+						` zitadel.projections.action_libraries.resource_owner,`+
+						` zitadel.projections.action_libraries.sequence,`+
+						` zitadel.projections.action_libraries.state,`+
+						` zitadel.projections.action_libraries.name,`+
+						` zitadel.projections.action_libraries.version,`+
+						` zitadel.projections.action_libraries.script`+
+						` FROM zitadel.projections.action_libraries`),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ActionLibrary)(nil),
+		},
+		{
+			name:    "prepareActionLibraryQuery found",
+			prepare: prepareActionLibraryQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries.id,`+
+						` zitadel.projections.action_libraries.creation_date,`+
+						` zitadel.projections.action_libraries.change_date,`+
+						` zitadel.projections.action_libraries.resource_owner,`+
+						` zitadel.projections.action_libraries.sequence,`+
+						` zitadel.projections.action_libraries.state,`+
+						` zitadel.projections.action_libraries.name,`+
+						` zitadel.projections.action_libraries.version,`+
+						` zitadel.projections.action_libraries.script`+
+						` FROM zitadel.projections.action_libraries`),
+					[]string{
+						"id",
+						"creation_date",
+						"change_date",
+						"resource_owner",
+						"sequence",
+						"state",
+						"name",
+						"version",
+						"script",
+					},
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.ActionLibraryStateActive,
+						"helpers",
+						uint64(2),
+						"exports.a = 1;",
+					},
+				),
+			},
+			object: &ActionLibrary{
+				ID:            "id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.ActionLibraryStateActive,
+				Sequence:      20211109,
+				Name:          "helpers",
+				Version:       2,
+				Script:        "exports.a = 1;",
+			},
+		},
+		{
+			name:    "prepareActionLibraryScriptQuery no result",
+			prepare: prepareActionLibraryScriptQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries_versions.script`+
+						` FROM zitadel.projections.action_libraries`+
+						` JOIN zitadel.projections.action_libraries_versions ON zitadel.projections.action_libraries.id = zitadel.projections.action_libraries_versions.library_id`),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: "",
+		},
+		{
+			name:    "prepareActionLibraryScriptQuery found",
+			prepare: prepareActionLibraryScriptQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.action_libraries_versions.script`+
+						` FROM zitadel.projections.action_libraries`+
+						` JOIN zitadel.projections.action_libraries_versions ON zitadel.projections.action_libraries.id = zitadel.projections.action_libraries_versions.library_id`),
+					[]string{
+						"script",
+					},
+					[]driver.Value{
+						"exports.a = 1;",
+					},
+				),
+			},
+			object: "exports.a = 1;",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func Test_actionLibraryDependencies(t *testing.T) {
+	library := &ActionLibrary{
+		Name:    "helpers",
+		Version: 3,
+	}
+	actions := []*Action{
+		{
+			ID:     "action1",
+			Name:   "latest",
+			Script: `const h = require("zitadel/libraries/helpers");`,
+		},
+		{
+			ID:     "action2",
+			Name:   "pinned",
+			Script: `const h = require("zitadel/libraries/helpers@2");`,
+		},
+		{
+			ID:     "action3",
+			Name:   "other",
+			Script: `const s = require("zitadel/libraries/strings");`,
+		},
+	}
+	want := []*ActionLibraryDependency{
+		{
+			ActionID:   "action1",
+			ActionName: "latest",
+			Version:    3,
+		},
+		{
+			ActionID:   "action2",
+			ActionName: "pinned",
+			Version:    2,
+			Pinned:     true,
+		},
+	}
+	assert.Equal(t, want, actionLibraryDependencies(library, actions))
+}
@@ -0,0 +1,100 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+var (
+	actionsHTTPConfigsTable = table{
+		name: projection.ActionsHTTPConfigProjectionTable,
+	}
+	ActionsHTTPConfigColumnAggregateID = Column{
+		name:  projection.ActionsHTTPConfigColumnAggregateID,
+		table: actionsHTTPConfigsTable,
+	}
+	ActionsHTTPConfigColumnChangeDate = Column{
+		name:  projection.ActionsHTTPConfigColumnChangeDate,
+		table: actionsHTTPConfigsTable,
+	}
+	ActionsHTTPConfigColumnResourceOwner = Column{
+		name:  projection.ActionsHTTPConfigColumnResourceOwner,
+		table: actionsHTTPConfigsTable,
+	}
+	ActionsHTTPConfigColumnSequence = Column{
+		name:  projection.ActionsHTTPConfigColumnSequence,
+		table: actionsHTTPConfigsTable,
+	}
+	ActionsHTTPConfigColumnAllowList = Column{
+		name:  projection.ActionsHTTPConfigColumnAllowList,
+		table: actionsHTTPConfigsTable,
+	}
+	ActionsHTTPConfigColumnMaxResponseSize = Column{
+		name:  projection.ActionsHTTPConfigColumnMaxResponseSize,
+		table: actionsHTTPConfigsTable,
+	}
+)
+
+type ActionsHTTPConfig struct {
+	AggregateID   string
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	AllowList       []string
+	MaxResponseSize int64
+}
+
+//ActionsHTTPConfig returns the configuration of the `zitadel/http` module of the actions
+func (q *Queries) ActionsHTTPConfig(ctx context.Context) (*ActionsHTTPConfig, error) {
+	stmt, scan := prepareActionsHTTPConfigQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		ActionsHTTPConfigColumnAggregateID.identifier(): domain.IAMID,
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ah7sq", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func prepareActionsHTTPConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*ActionsHTTPConfig, error)) {
+	return sq.Select(
+			ActionsHTTPConfigColumnAggregateID.identifier(),
+			ActionsHTTPConfigColumnChangeDate.identifier(),
+			ActionsHTTPConfigColumnResourceOwner.identifier(),
+			ActionsHTTPConfigColumnSequence.identifier(),
+			ActionsHTTPConfigColumnAllowList.identifier(),
+			ActionsHTTPConfigColumnMaxResponseSize.identifier(),
+		).From(actionsHTTPConfigsTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ActionsHTTPConfig, error) {
+			config := new(ActionsHTTPConfig)
+			allowList := pq.StringArray{}
+			err := row.Scan(
+				&config.AggregateID,
+				&config.ChangeDate,
+				&config.ResourceOwner,
+				&config.Sequence,
+				&allowList,
+				&config.MaxResponseSize,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Ah7nf", "Errors.IAM.ActionsHTTPConfig.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Ah7ie", "Errors.Internal")
+			}
+			config.AllowList = allowList
+			return config, nil
+		}
+}
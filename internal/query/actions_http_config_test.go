@@ -0,0 +1,112 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/lib/pq"
+
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+var (
+	prepareActionsHTTPConfigStmt = `SELECT zitadel.projections.actions_http_configs.aggregate_id,` +
+		` zitadel.projections.actions_http_configs.change_date,` +
+		` zitadel.projections.actions_http_configs.resource_owner,` +
+		` zitadel.projections.actions_http_configs.sequence,` +
+		` zitadel.projections.actions_http_configs.allow_list,` +
+		` zitadel.projections.actions_http_configs.max_response_size` +
+		` FROM zitadel.projections.actions_http_configs`
+	prepareActionsHTTPConfigCols = []string{
+		"aggregate_id",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"allow_list",
+		"max_response_size",
+	}
+)
+
+func Test_ActionsHTTPConfigPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareActionsHTTPConfigQuery no result",
+			prepare: prepareActionsHTTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareActionsHTTPConfigStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ActionsHTTPConfig)(nil),
+		},
+		{
+			name:    "prepareActionsHTTPConfigQuery found",
+			prepare: prepareActionsHTTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareActionsHTTPConfigStmt),
+					prepareActionsHTTPConfigCols,
+					[]driver.Value{
+						"IAM",
+						testNow,
+						"IAM",
+						uint64(20211108),
+						pq.StringArray{"zitadel.ch", "*.caos.ch"},
+						int64(1024),
+					},
+				),
+			},
+			object: &ActionsHTTPConfig{
+				AggregateID:     "IAM",
+				ChangeDate:      testNow,
+				ResourceOwner:   "IAM",
+				Sequence:        20211108,
+				AllowList:       []string{"zitadel.ch", "*.caos.ch"},
+				MaxResponseSize: 1024,
+			},
+		},
+		{
+			name:    "prepareActionsHTTPConfigQuery sql err",
+			prepare: prepareActionsHTTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareActionsHTTPConfigStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
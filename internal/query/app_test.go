@@ -44,10 +44,16 @@ var (
 		` zitadel.projections.apps_oidc_configs.id_token_role_assertion,` +
 		` zitadel.projections.apps_oidc_configs.id_token_userinfo_assertion,` +
 		` zitadel.projections.apps_oidc_configs.clock_skew,` +
-		` zitadel.projections.apps_oidc_configs.additional_origins` +
+		` zitadel.projections.apps_oidc_configs.additional_origins,` +
+		// saml config
+		` zitadel.projections.apps_saml_configs.app_id,` +
+		` zitadel.projections.apps_saml_configs.entity_id,` +
+		` zitadel.projections.apps_saml_configs.metadata,` +
+		` zitadel.projections.apps_saml_configs.metadata_url` +
 		` FROM zitadel.projections.apps` +
 		` LEFT JOIN zitadel.projections.apps_api_configs ON zitadel.projections.apps.id = zitadel.projections.apps_api_configs.app_id` +
-		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id`)
+		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id` +
+		` LEFT JOIN zitadel.projections.apps_saml_configs ON zitadel.projections.apps.id = zitadel.projections.apps_saml_configs.app_id`)
 	expectedAppsQuery = regexp.QuoteMeta(`SELECT zitadel.projections.apps.id,` +
 		` zitadel.projections.apps.name,` +
 		` zitadel.projections.apps.project_id,` +
@@ -77,19 +83,27 @@ var (
 		` zitadel.projections.apps_oidc_configs.id_token_userinfo_assertion,` +
 		` zitadel.projections.apps_oidc_configs.clock_skew,` +
 		` zitadel.projections.apps_oidc_configs.additional_origins,` +
+		// saml config
+		` zitadel.projections.apps_saml_configs.app_id,` +
+		` zitadel.projections.apps_saml_configs.entity_id,` +
+		` zitadel.projections.apps_saml_configs.metadata,` +
+		` zitadel.projections.apps_saml_configs.metadata_url,` +
 		` COUNT(*) OVER ()` +
 		` FROM zitadel.projections.apps` +
 		` LEFT JOIN zitadel.projections.apps_api_configs ON zitadel.projections.apps.id = zitadel.projections.apps_api_configs.app_id` +
-		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id`)
+		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id` +
+		` LEFT JOIN zitadel.projections.apps_saml_configs ON zitadel.projections.apps.id = zitadel.projections.apps_saml_configs.app_id`)
 	expectedAppIDsQuery = regexp.QuoteMeta(`SELECT zitadel.projections.apps_api_configs.client_id,` +
 		` zitadel.projections.apps_oidc_configs.client_id` +
 		` FROM zitadel.projections.apps` +
 		` LEFT JOIN zitadel.projections.apps_api_configs ON zitadel.projections.apps.id = zitadel.projections.apps_api_configs.app_id` +
-		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id`)
+		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id` +
+		` LEFT JOIN zitadel.projections.apps_saml_configs ON zitadel.projections.apps.id = zitadel.projections.apps_saml_configs.app_id`)
 	expectedProjectIDByAppQuery = regexp.QuoteMeta(`SELECT zitadel.projections.apps.project_id` +
 		` FROM zitadel.projections.apps` +
 		` LEFT JOIN zitadel.projections.apps_api_configs ON zitadel.projections.apps.id = zitadel.projections.apps_api_configs.app_id` +
-		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id`)
+		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id` +
+		` LEFT JOIN zitadel.projections.apps_saml_configs ON zitadel.projections.apps.id = zitadel.projections.apps_saml_configs.app_id`)
 	expectedProjectByAppQuery = regexp.QuoteMeta(`SELECT zitadel.projections.projects.id,` +
 		` zitadel.projections.projects.creation_date,` +
 		` zitadel.projections.projects.change_date,` +
@@ -100,11 +114,14 @@ var (
 		` zitadel.projections.projects.project_role_assertion,` +
 		` zitadel.projections.projects.project_role_check,` +
 		` zitadel.projections.projects.has_project_check,` +
-		` zitadel.projections.projects.private_labeling_setting` +
+		` zitadel.projections.projects.private_labeling_setting,` +
+		` zitadel.projections.projects.token_exchange_delegation,` +
+		` zitadel.projections.projects.token_exchange_impersonation` +
 		` FROM zitadel.projections.projects` +
 		` JOIN zitadel.projections.apps ON zitadel.projections.projects.id = zitadel.projections.apps.project_id` +
 		` LEFT JOIN zitadel.projections.apps_api_configs ON zitadel.projections.apps.id = zitadel.projections.apps_api_configs.app_id` +
-		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id`)
+		` LEFT JOIN zitadel.projections.apps_oidc_configs ON zitadel.projections.apps.id = zitadel.projections.apps_oidc_configs.app_id` +
+		` LEFT JOIN zitadel.projections.apps_saml_configs ON zitadel.projections.apps.id = zitadel.projections.apps_saml_configs.app_id`)
 
 	appCols = []string{
 		"id",
@@ -136,6 +153,11 @@ var (
 		"id_token_userinfo_assertion",
 		"clock_skew",
 		"additional_origins",
+		// saml config
+		"app_id",
+		"entity_id",
+		"metadata",
+		"metadata_url",
 	}
 	appsCols = append(appCols, "count")
 )
@@ -201,6 +223,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -261,6 +288,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -325,6 +357,11 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -404,6 +441,11 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -483,6 +525,11 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -562,6 +609,11 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -641,6 +693,11 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -720,6 +777,11 @@ func Test_AppsPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"api-app-id",
@@ -751,6 +813,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -897,6 +964,11 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -949,6 +1021,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -968,6 +1045,69 @@ func Test_AppPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareAppQuery saml app",
+			prepare: prepareAppQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedAppQuery,
+					appCols,
+					[][]driver.Value{
+						{
+							"app-id",
+							"app-name",
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.AppStateActive,
+							uint64(20211109),
+							// api config
+							nil,
+							nil,
+							nil,
+							// oidc config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// saml config
+							"app-id",
+							"https://sp.example.com/metadata",
+							[]byte("<EntityDescriptor/>"),
+							"https://sp.example.com/metadata",
+						},
+					},
+				),
+			},
+			object: &App{
+				ID:            "app-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.AppStateActive,
+				Sequence:      20211109,
+				Name:          "app-name",
+				ProjectID:     "project-id",
+				SAMLConfig: &SAMLApp{
+					EntityID:    "https://sp.example.com/metadata",
+					Metadata:    []byte("<EntityDescriptor/>"),
+					MetadataURL: "https://sp.example.com/metadata",
+				},
+			},
+		},
 		{
 			name:    "prepareAppQuery oidc app",
 			prepare: prepareAppQuery,
@@ -1006,6 +1146,11 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1078,6 +1223,11 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1150,6 +1300,11 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1222,6 +1377,11 @@ func Test_AppPrepare(t *testing.T) {
 							true,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1294,6 +1454,11 @@ func Test_AppPrepare(t *testing.T) {
 							false,
 							1 * time.Second,
 							pq.StringArray{"additional.origin"},
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1553,21 +1718,24 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						true,
+						false,
 					},
 				),
 			},
 			object: &Project{
-				ID:                     "project-id",
-				CreationDate:           testNow,
-				ChangeDate:             testNow,
-				ResourceOwner:          "ro",
-				Sequence:               20211109,
-				Name:                   "project-name",
-				State:                  domain.ProjectStateInactive,
-				ProjectRoleAssertion:   true,
-				ProjectRoleCheck:       true,
-				HasProjectCheck:        true,
-				PrivateLabelingSetting: domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				ID:                      "project-id",
+				CreationDate:            testNow,
+				ChangeDate:              testNow,
+				ResourceOwner:           "ro",
+				Sequence:                20211109,
+				Name:                    "project-name",
+				State:                   domain.ProjectStateInactive,
+				ProjectRoleAssertion:    true,
+				ProjectRoleCheck:        true,
+				HasProjectCheck:         true,
+				PrivateLabelingSetting:  domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				TokenExchangeDelegation: true,
 			},
 		},
 		{
@@ -1589,21 +1757,24 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						true,
+						false,
 					},
 				),
 			},
 			object: &Project{
-				ID:                     "project-id",
-				CreationDate:           testNow,
-				ChangeDate:             testNow,
-				ResourceOwner:          "ro",
-				Sequence:               20211109,
-				Name:                   "project-name",
-				State:                  domain.ProjectStateInactive,
-				ProjectRoleAssertion:   false,
-				ProjectRoleCheck:       true,
-				HasProjectCheck:        true,
-				PrivateLabelingSetting: domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				ID:                      "project-id",
+				CreationDate:            testNow,
+				ChangeDate:              testNow,
+				ResourceOwner:           "ro",
+				Sequence:                20211109,
+				Name:                    "project-name",
+				State:                   domain.ProjectStateInactive,
+				ProjectRoleAssertion:    false,
+				ProjectRoleCheck:        true,
+				HasProjectCheck:         true,
+				PrivateLabelingSetting:  domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				TokenExchangeDelegation: true,
 			},
 		},
 		{
@@ -1625,21 +1796,24 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						false,
 						true,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						true,
+						false,
 					},
 				),
 			},
 			object: &Project{
-				ID:                     "project-id",
-				CreationDate:           testNow,
-				ChangeDate:             testNow,
-				ResourceOwner:          "ro",
-				Sequence:               20211109,
-				Name:                   "project-name",
-				State:                  domain.ProjectStateInactive,
-				ProjectRoleAssertion:   true,
-				ProjectRoleCheck:       false,
-				HasProjectCheck:        true,
-				PrivateLabelingSetting: domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				ID:                      "project-id",
+				CreationDate:            testNow,
+				ChangeDate:              testNow,
+				ResourceOwner:           "ro",
+				Sequence:                20211109,
+				Name:                    "project-name",
+				State:                   domain.ProjectStateInactive,
+				ProjectRoleAssertion:    true,
+				ProjectRoleCheck:        false,
+				HasProjectCheck:         true,
+				PrivateLabelingSetting:  domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				TokenExchangeDelegation: true,
 			},
 		},
 		{
@@ -1661,21 +1835,24 @@ func Test_ProjectByAppPrepare(t *testing.T) {
 						true,
 						false,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						true,
+						false,
 					},
 				),
 			},
 			object: &Project{
-				ID:                     "project-id",
-				CreationDate:           testNow,
-				ChangeDate:             testNow,
-				ResourceOwner:          "ro",
-				Sequence:               20211109,
-				Name:                   "project-name",
-				State:                  domain.ProjectStateInactive,
-				ProjectRoleAssertion:   true,
-				ProjectRoleCheck:       true,
-				HasProjectCheck:        false,
-				PrivateLabelingSetting: domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				ID:                      "project-id",
+				CreationDate:            testNow,
+				ChangeDate:              testNow,
+				ResourceOwner:           "ro",
+				Sequence:                20211109,
+				Name:                    "project-name",
+				State:                   domain.ProjectStateInactive,
+				ProjectRoleAssertion:    true,
+				ProjectRoleCheck:        true,
+				HasProjectCheck:         false,
+				PrivateLabelingSetting:  domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+				TokenExchangeDelegation: true,
 			},
 		},
 		{
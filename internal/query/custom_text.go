@@ -122,6 +122,29 @@ func (q *Queries) CustomTextListByTemplate(ctx context.Context, aggregateID, tem
 	return texts, err
 }
 
+func (q *Queries) CustomTextListByAggregateID(ctx context.Context, aggregateID string) (texts *CustomTexts, err error) {
+	stmt, scan := prepareCustomTextsQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			CustomTextColAggregateID.identifier(): aggregateID,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Lw9d3", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pm2sx", "Errors.Internal")
+	}
+	texts, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	texts.LatestSequence, err = q.latestSequence(ctx, projectsTable)
+	return texts, err
+}
+
 func (q *Queries) GetDefaultLoginTexts(ctx context.Context, lang string) (*domain.CustomLoginText, error) {
 	contents, err := q.readLoginTranslationFile(lang)
 	if err != nil {
@@ -0,0 +1,192 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+var (
+	deviceAuthsTable = table{
+		name: projection.DeviceAuthProjectionTable,
+	}
+	DeviceAuthColumnID = Column{
+		name:  projection.DeviceAuthColumnID,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnCreationDate = Column{
+		name:  projection.DeviceAuthColumnCreationDate,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnChangeDate = Column{
+		name:  projection.DeviceAuthColumnChangeDate,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnResourceOwner = Column{
+		name:  projection.DeviceAuthColumnResourceOwner,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnSequence = Column{
+		name:  projection.DeviceAuthColumnSequence,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnState = Column{
+		name:  projection.DeviceAuthColumnState,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnClientID = Column{
+		name:  projection.DeviceAuthColumnClientID,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnDeviceCode = Column{
+		name:  projection.DeviceAuthColumnDeviceCode,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnUserCode = Column{
+		name:  projection.DeviceAuthColumnUserCode,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnExpires = Column{
+		name:  projection.DeviceAuthColumnExpires,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnScopes = Column{
+		name:  projection.DeviceAuthColumnScopes,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnSubject = Column{
+		name:  projection.DeviceAuthColumnSubject,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnUserOrgID = Column{
+		name:  projection.DeviceAuthColumnUserOrgID,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnAudience = Column{
+		name:  projection.DeviceAuthColumnAudience,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnAMR = Column{
+		name:  projection.DeviceAuthColumnAMR,
+		table: deviceAuthsTable,
+	}
+	DeviceAuthColumnAuthTime = Column{
+		name:  projection.DeviceAuthColumnAuthTime,
+		table: deviceAuthsTable,
+	}
+)
+
+type DeviceAuth struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	State         domain.DeviceAuthState
+
+	ClientID   string
+	DeviceCode string
+	UserCode   string
+	Expires    time.Time
+	Scopes     []string
+
+	Subject   string
+	UserOrgID string
+	Audience  []string
+	AMR       []string
+	AuthTime  time.Time
+}
+
+func (a *DeviceAuth) IsExpired() bool {
+	return a.Expires.Before(time.Now())
+}
+
+func (q *Queries) DeviceAuthByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuth, error) {
+	return q.deviceAuth(ctx, sq.Eq{DeviceAuthColumnDeviceCode.identifier(): deviceCode})
+}
+
+func (q *Queries) DeviceAuthByUserCode(ctx context.Context, userCode string) (*DeviceAuth, error) {
+	return q.deviceAuth(ctx, sq.Eq{DeviceAuthColumnUserCode.identifier(): userCode})
+}
+
+func (q *Queries) deviceAuth(ctx context.Context, condition sq.Eq) (*DeviceAuth, error) {
+	query, scan := prepareDeviceAuthQuery()
+	stmt, args, err := query.Where(condition).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-3m0Fs", "Errors.Query.SQLStatment")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func prepareDeviceAuthQuery() (sq.SelectBuilder, func(*sql.Row) (*DeviceAuth, error)) {
+	return sq.Select(
+			DeviceAuthColumnID.identifier(),
+			DeviceAuthColumnCreationDate.identifier(),
+			DeviceAuthColumnChangeDate.identifier(),
+			DeviceAuthColumnResourceOwner.identifier(),
+			DeviceAuthColumnSequence.identifier(),
+			DeviceAuthColumnState.identifier(),
+			DeviceAuthColumnClientID.identifier(),
+			DeviceAuthColumnDeviceCode.identifier(),
+			DeviceAuthColumnUserCode.identifier(),
+			DeviceAuthColumnExpires.identifier(),
+			DeviceAuthColumnScopes.identifier(),
+			DeviceAuthColumnSubject.identifier(),
+			DeviceAuthColumnUserOrgID.identifier(),
+			DeviceAuthColumnAudience.identifier(),
+			DeviceAuthColumnAMR.identifier(),
+			DeviceAuthColumnAuthTime.identifier()).
+			From(deviceAuthsTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*DeviceAuth, error) {
+			a := new(DeviceAuth)
+			scopes := pq.StringArray{}
+			audience := pq.StringArray{}
+			amr := pq.StringArray{}
+			var (
+				subject   sql.NullString
+				userOrgID sql.NullString
+				authTime  sql.NullTime
+			)
+			err := row.Scan(
+				&a.ID,
+				&a.CreationDate,
+				&a.ChangeDate,
+				&a.ResourceOwner,
+				&a.Sequence,
+				&a.State,
+				&a.ClientID,
+				&a.DeviceCode,
+				&a.UserCode,
+				&a.Expires,
+				&scopes,
+				&subject,
+				&userOrgID,
+				&audience,
+				&amr,
+				&authTime,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Wm9fs", "Errors.DeviceAuth.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-2Mf0s", "Errors.Internal")
+			}
+			a.Scopes = scopes
+			a.Subject = subject.String
+			a.UserOrgID = userOrgID.String
+			a.Audience = audience
+			a.AMR = amr
+			a.AuthTime = authTime.Time
+			return a, nil
+		}
+}
@@ -0,0 +1,196 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+var (
+	deviceAuthStmt = regexp.QuoteMeta(
+		"SELECT zitadel.projections.device_authorizations.id," +
+			" zitadel.projections.device_authorizations.creation_date," +
+			" zitadel.projections.device_authorizations.change_date," +
+			" zitadel.projections.device_authorizations.resource_owner," +
+			" zitadel.projections.device_authorizations.sequence," +
+			" zitadel.projections.device_authorizations.state," +
+			" zitadel.projections.device_authorizations.client_id," +
+			" zitadel.projections.device_authorizations.device_code," +
+			" zitadel.projections.device_authorizations.user_code," +
+			" zitadel.projections.device_authorizations.expires," +
+			" zitadel.projections.device_authorizations.scopes," +
+			" zitadel.projections.device_authorizations.subject," +
+			" zitadel.projections.device_authorizations.user_org_id," +
+			" zitadel.projections.device_authorizations.audience," +
+			" zitadel.projections.device_authorizations.amr," +
+			" zitadel.projections.device_authorizations.auth_time" +
+			" FROM zitadel.projections.device_authorizations")
+	deviceAuthCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"state",
+		"client_id",
+		"device_code",
+		"user_code",
+		"expires",
+		"scopes",
+		"subject",
+		"user_org_id",
+		"audience",
+		"amr",
+		"auth_time",
+	}
+)
+
+func Test_DeviceAuthPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareDeviceAuthQuery no result",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					deviceAuthStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*DeviceAuth)(nil),
+		},
+		{
+			name:    "prepareDeviceAuthQuery initiated",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					deviceAuthStmt,
+					deviceAuthCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211112),
+						domain.DeviceAuthStateInitiated,
+						"client-id",
+						"device-code",
+						"BCDF-GHJK",
+						time.Date(2021, 11, 12, 10, 0, 0, 0, time.UTC),
+						pq.StringArray{"openid"},
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &DeviceAuth{
+				ID:            "id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211112,
+				State:         domain.DeviceAuthStateInitiated,
+				ClientID:      "client-id",
+				DeviceCode:    "device-code",
+				UserCode:      "BCDF-GHJK",
+				Expires:       time.Date(2021, 11, 12, 10, 0, 0, 0, time.UTC),
+				Scopes:        []string{"openid"},
+			},
+		},
+		{
+			name:    "prepareDeviceAuthQuery approved",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					deviceAuthStmt,
+					deviceAuthCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211112),
+						domain.DeviceAuthStateApproved,
+						"client-id",
+						"device-code",
+						"BCDF-GHJK",
+						time.Date(2021, 11, 12, 10, 0, 0, 0, time.UTC),
+						pq.StringArray{"openid"},
+						"user-id",
+						"org-id",
+						pq.StringArray{"project-id"},
+						pq.StringArray{"password"},
+						testNow,
+					},
+				),
+			},
+			object: &DeviceAuth{
+				ID:            "id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211112,
+				State:         domain.DeviceAuthStateApproved,
+				ClientID:      "client-id",
+				DeviceCode:    "device-code",
+				UserCode:      "BCDF-GHJK",
+				Expires:       time.Date(2021, 11, 12, 10, 0, 0, 0, time.UTC),
+				Scopes:        []string{"openid"},
+				Subject:       "user-id",
+				UserOrgID:     "org-id",
+				Audience:      []string{"project-id"},
+				AMR:           []string{"password"},
+				AuthTime:      testNow,
+			},
+		},
+		{
+			name:    "prepareDeviceAuthQuery sql err",
+			prepare: prepareDeviceAuthQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					deviceAuthStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+)
+
+const (
+	defaultEventStreamBatchSize = 100
+	maxEventStreamBatchSize     = 1000
+)
+
+type StreamedEvent struct {
+	Sequence         uint64
+	CreationDate     time.Time
+	Type             string
+	AggregateType    string
+	AggregateID      string
+	AggregateVersion string
+	ResourceOwner    string
+	EditorService    string
+	EditorUser       string
+	Payload          json.RawMessage
+}
+
+type EventStreamQuery struct {
+	//Sequence is the cursor of the consumer
+	//only events with a greater sequence are returned
+	Sequence       uint64
+	AggregateTypes []string
+	EventTypes     []string
+	ResourceOwner  string
+	BatchSize      uint64
+	//AuditLogRetention hides events older than the retention of the organisation
+	AuditLogRetention time.Duration
+}
+
+func (q *EventStreamQuery) retained(event eventstore.Event) bool {
+	return q.AuditLogRetention == 0 || !event.CreationDate().Before(time.Now().Add(-q.AuditLogRetention))
+}
+
+func (q *EventStreamQuery) batchSize() uint64 {
+	if q.BatchSize == 0 {
+		return defaultEventStreamBatchSize
+	}
+	if q.BatchSize > maxEventStreamBatchSize {
+		return maxEventStreamBatchSize
+	}
+	return q.BatchSize
+}
+
+func (q *EventStreamQuery) searchQuery(sequence uint64) *eventstore.SearchQueryBuilder {
+	aggregateTypes := make([]eventstore.AggregateType, len(q.AggregateTypes))
+	for i, aggregateType := range q.AggregateTypes {
+		aggregateTypes[i] = eventstore.AggregateType(aggregateType)
+	}
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderAsc().
+		Limit(q.batchSize()).
+		ResourceOwner(q.ResourceOwner)
+	search := builder.AddQuery().
+		AggregateTypes(aggregateTypes...).
+		SequenceGreater(sequence)
+	if len(q.EventTypes) > 0 {
+		eventTypes := make([]eventstore.EventType, len(q.EventTypes))
+		for i, eventType := range q.EventTypes {
+			eventTypes[i] = eventstore.EventType(eventType)
+		}
+		search.EventTypes(eventTypes...)
+	}
+	return search.Builder()
+}
+
+//SearchEvents returns the next batch of events after the sequence of the query
+func (q *Queries) SearchEvents(ctx context.Context, query *EventStreamQuery) ([]*StreamedEvent, error) {
+	events, err := q.filterEvents(ctx, query, query.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	streamed := make([]*StreamedEvent, 0, len(events))
+	for _, event := range events {
+		if query.retained(event) {
+			streamed = append(streamed, eventToStreamed(event))
+		}
+	}
+	return streamed, nil
+}
+
+//StreamEvents tails the eventstore starting after the sequence of the query
+//every event is passed to send, the consumer is able to resume the stream with the sequence of the last received event
+//if no new events are found the eventstore is polled again after the interval
+//the stream ends if the context is done or send returns an error
+func (q *Queries) StreamEvents(ctx context.Context, query *EventStreamQuery, pollInterval time.Duration, send func(*StreamedEvent) error) error {
+	sequence := query.Sequence
+	for {
+		events, err := q.filterEvents(ctx, query, sequence)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			sequence = event.Sequence()
+			if !query.retained(event) {
+				continue
+			}
+			if err = send(eventToStreamed(event)); err != nil {
+				return err
+			}
+		}
+		if uint64(len(events)) == query.batchSize() {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (q *Queries) filterEvents(ctx context.Context, query *EventStreamQuery, sequence uint64) ([]eventstore.Event, error) {
+	if len(query.AggregateTypes) == 0 {
+		return nil, errors.ThrowInvalidArgument(nil, "QUERY-Mf9sk", "Errors.Query.InvalidRequest")
+	}
+	events, err := q.eventstore.Filter(ctx, query.searchQuery(sequence))
+	if err != nil {
+		logging.Log("QUERY-Jw8nA").WithError(err).Warn("eventstore unavailable")
+		return nil, errors.ThrowInternal(err, "QUERY-Px02m", "Errors.Internal")
+	}
+	return events, nil
+}
+
+func eventToStreamed(event eventstore.Event) *StreamedEvent {
+	return &StreamedEvent{
+		Sequence:         event.Sequence(),
+		CreationDate:     event.CreationDate(),
+		Type:             string(event.Type()),
+		AggregateType:    string(event.Aggregate().Type),
+		AggregateID:      event.Aggregate().ID,
+		AggregateVersion: string(event.Aggregate().Version),
+		ResourceOwner:    event.Aggregate().ResourceOwner,
+		EditorService:    event.EditorService(),
+		EditorUser:       event.EditorUser(),
+		Payload:          EventPayload(event.DataAsBytes()),
+	}
+}
+
+//EventPayload returns the data of the event without secrets
+//secrets like passwords or codes are always stored as crypto.CryptoValue
+func EventPayload(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	payload, err := json.Marshal(redactCryptoValues(value))
+	if err != nil {
+		return nil
+	}
+	return payload
+}
+
+func redactCryptoValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if isCryptoValue(v) {
+			return nil
+		}
+		for key, field := range v {
+			v[key] = redactCryptoValues(field)
+		}
+	case []interface{}:
+		for i, field := range v {
+			v[i] = redactCryptoValues(field)
+		}
+	}
+	return value
+}
+
+func isCryptoValue(value map[string]interface{}) bool {
+	_, hasType := value["CryptoType"]
+	_, hasCrypted := value["Crypted"]
+	return hasType && hasCrypted
+}
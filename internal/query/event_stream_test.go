@@ -0,0 +1,229 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	errs "github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/eventstore/repository/mock"
+)
+
+func streamTestEvent(sequence uint64) *repository.Event {
+	return &repository.Event{
+		Sequence:      sequence,
+		AggregateType: "user",
+		AggregateID:   "user-id",
+		ResourceOwner: sql.NullString{String: "org-id", Valid: true},
+		Version:       "v2",
+		Type:          "user.locked",
+		EditorService: "management",
+		EditorUser:    "editor-id",
+	}
+}
+
+func streamSearchQuery(sequence, limit uint64, filters ...*repository.Filter) *repository.SearchQuery {
+	return &repository.SearchQuery{
+		Columns: repository.ColumnsEvent,
+		Limit:   limit,
+		Filters: [][]*repository.Filter{
+			append([]*repository.Filter{
+				repository.NewFilter(repository.FieldAggregateType, repository.AggregateType("user"), repository.OperationEquals),
+			}, append(filters,
+				repository.NewFilter(repository.FieldSequence, sequence, repository.OperationGreater),
+				repository.NewFilter(repository.FieldResourceOwner, "org-id", repository.OperationEquals),
+			)...),
+		},
+	}
+}
+
+func TestQueries_SearchEvents(t *testing.T) {
+	type args struct {
+		query *EventStreamQuery
+	}
+	type res struct {
+		events []*StreamedEvent
+		err    func(error) bool
+	}
+	tests := []struct {
+		name string
+		repo func(*mock.MockRepository)
+		args args
+		res  res
+	}{
+		{
+			name: "no aggregate types, invalid argument error",
+			repo: func(*mock.MockRepository) {},
+			args: args{
+				query: &EventStreamQuery{},
+			},
+			res: res{
+				err: errs.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "filter failed, internal error",
+			repo: func(m *mock.MockRepository) {
+				m.ExpectFilterEventsError(errors.New("failed"))
+			},
+			args: args{
+				query: &EventStreamQuery{AggregateTypes: []string{"user"}},
+			},
+			res: res{
+				err: errs.IsInternal,
+			},
+		},
+		{
+			name: "events found",
+			repo: func(m *mock.MockRepository) {
+				m.EXPECT().Filter(gomock.Any(), streamSearchQuery(10, 50,
+					repository.NewFilter(repository.FieldEventType, repository.EventType("user.locked"), repository.OperationEquals),
+				)).Return([]*repository.Event{streamTestEvent(11)}, nil)
+			},
+			args: args{
+				query: &EventStreamQuery{
+					Sequence:       10,
+					AggregateTypes: []string{"user"},
+					EventTypes:     []string{"user.locked"},
+					ResourceOwner:  "org-id",
+					BatchSize:      50,
+				},
+			},
+			res: res{
+				events: []*StreamedEvent{
+					{
+						Sequence:         11,
+						Type:             "user.locked",
+						AggregateType:    "user",
+						AggregateID:      "user-id",
+						AggregateVersion: "v2",
+						ResourceOwner:    "org-id",
+						EditorService:    "management",
+						EditorUser:       "editor-id",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock.NewRepo(t)
+			tt.repo(repo)
+			q := &Queries{eventstore: eventstore.NewEventstore(repo)}
+			got, err := q.SearchEvents(context.Background(), tt.args.query)
+			if (err != nil || tt.res.err != nil) && !tt.res.err(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.res.events) {
+				t.Errorf("SearchEvents() = %v, want %v", got, tt.res.events)
+			}
+		})
+	}
+}
+
+func TestQueries_StreamEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := mock.NewRepo(t)
+	gomock.InOrder(
+		repo.EXPECT().Filter(gomock.Any(), streamSearchQuery(10, 2)).
+			Return([]*repository.Event{streamTestEvent(11), streamTestEvent(12)}, nil),
+		repo.EXPECT().Filter(gomock.Any(), streamSearchQuery(12, 2)).
+			Return([]*repository.Event{streamTestEvent(13)}, nil),
+	)
+	q := &Queries{eventstore: eventstore.NewEventstore(repo)}
+
+	var sequences []uint64
+	err := q.StreamEvents(ctx,
+		&EventStreamQuery{
+			Sequence:       10,
+			AggregateTypes: []string{"user"},
+			ResourceOwner:  "org-id",
+			BatchSize:      2,
+		},
+		time.Minute,
+		func(event *StreamedEvent) error {
+			sequences = append(sequences, event.Sequence)
+			if event.Sequence == 13 {
+				cancel()
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(sequences, []uint64{11, 12, 13}) {
+		t.Errorf("wrong events sent: %v", sequences)
+	}
+}
+
+func TestQueries_StreamEvents_sendFailed(t *testing.T) {
+	repo := mock.NewRepo(t)
+	repo.ExpectFilterEvents(streamTestEvent(11), streamTestEvent(12))
+	q := &Queries{eventstore: eventstore.NewEventstore(repo)}
+
+	sendErr := errors.New("connection closed")
+	sent := 0
+	err := q.StreamEvents(context.Background(),
+		&EventStreamQuery{AggregateTypes: []string{"user"}},
+		0,
+		func(*StreamedEvent) error {
+			sent++
+			return sendErr
+		},
+	)
+	if err != sendErr {
+		t.Errorf("expected send error, got: %v", err)
+	}
+	if sent != 1 {
+		t.Errorf("stream not stopped after failed send: %d events sent", sent)
+	}
+}
+
+func TestEventPayload(t *testing.T) {
+	type args struct {
+		data []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "no data",
+			args: args{},
+			want: `null`,
+		},
+		{
+			name: "crypto value redacted",
+			args: args{data: []byte(`{"userName":"user","secret":{"CryptoType":1,"Algorithm":"bcrypt","KeyID":"","Crypted":"c2VjcmV0"}}`)},
+			want: `{"secret":null,"userName":"user"}`,
+		},
+		{
+			name: "nested crypto value redacted",
+			args: args{data: []byte(`{"codes":[{"code":{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"Y29kZQ=="}}]}`)},
+			want: `{"codes":[{"code":null}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(EventPayload(tt.args.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("EventPayload() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
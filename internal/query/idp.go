@@ -8,7 +8,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
-	
+
 	"github.com/caos/zitadel/internal/crypto"
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/errors"
@@ -28,6 +28,8 @@ type IDP struct {
 	AutoRegister  bool
 	*OIDCIDP
 	*JWTIDP
+	*SAMLIDP
+	*LDAPIDP
 }
 
 type IDPs struct {
@@ -55,6 +57,39 @@ type JWTIDP struct {
 	Endpoint     string
 }
 
+type SAMLIDP struct {
+	IDPID                string
+	MetadataURL          string
+	Metadata             []byte
+	Certificate          []byte
+	Binding              domain.SAMLBinding
+	WithSignedRequest    bool
+	NameIDFormat         domain.SAMLNameIDFormat
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+}
+
+type LDAPIDP struct {
+	IDPID                string
+	URL                  string
+	StartTLS             bool
+	BindDN               string
+	BindPassword         *crypto.CryptoValue
+	BaseDN               string
+	UserObjectClass      string
+	IDAttribute          string
+	UsernameAttribute    string
+	DisplayNameAttribute string
+	FirstNameAttribute   string
+	LastNameAttribute    string
+	EmailAttribute       string
+	PhoneAttribute       string
+}
+
 var (
 	idpTable = table{
 		name: projection.IDPTable,
@@ -173,6 +208,126 @@ var (
 	}
 )
 
+var (
+	samlIDPTable = table{
+		name: projection.IDPSAMLTable,
+	}
+	SAMLIDPColIDPID = Column{
+		name:  projection.SAMLConfigIDPIDCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColMetadataURL = Column{
+		name:  projection.SAMLConfigMetadataURLCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColMetadata = Column{
+		name:  projection.SAMLConfigMetadataCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColCertificate = Column{
+		name:  projection.SAMLConfigCertificateCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColBinding = Column{
+		name:  projection.SAMLConfigBindingCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColWithSignedRequest = Column{
+		name:  projection.SAMLConfigWithSignedRequestCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColNameIDFormat = Column{
+		name:  projection.SAMLConfigNameIDFormatCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColUsernameAttribute = Column{
+		name:  projection.SAMLConfigUsernameAttributeCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColDisplayNameAttribute = Column{
+		name:  projection.SAMLConfigDisplayNameAttributeCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColFirstNameAttribute = Column{
+		name:  projection.SAMLConfigFirstNameAttributeCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColLastNameAttribute = Column{
+		name:  projection.SAMLConfigLastNameAttributeCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColEmailAttribute = Column{
+		name:  projection.SAMLConfigEmailAttributeCol,
+		table: samlIDPTable,
+	}
+	SAMLIDPColPhoneAttribute = Column{
+		name:  projection.SAMLConfigPhoneAttributeCol,
+		table: samlIDPTable,
+	}
+)
+
+var (
+	ldapIDPTable = table{
+		name: projection.IDPLDAPTable,
+	}
+	LDAPIDPColIDPID = Column{
+		name:  projection.LDAPConfigIDPIDCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColURL = Column{
+		name:  projection.LDAPConfigURLCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColStartTLS = Column{
+		name:  projection.LDAPConfigStartTLSCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColBindDN = Column{
+		name:  projection.LDAPConfigBindDNCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColBindPassword = Column{
+		name:  projection.LDAPConfigBindPasswordCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColBaseDN = Column{
+		name:  projection.LDAPConfigBaseDNCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColUserObjectClass = Column{
+		name:  projection.LDAPConfigUserObjectClassCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColIDAttribute = Column{
+		name:  projection.LDAPConfigIDAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColUsernameAttribute = Column{
+		name:  projection.LDAPConfigUsernameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColDisplayNameAttribute = Column{
+		name:  projection.LDAPConfigDisplayNameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColFirstNameAttribute = Column{
+		name:  projection.LDAPConfigFirstNameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColLastNameAttribute = Column{
+		name:  projection.LDAPConfigLastNameAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColEmailAttribute = Column{
+		name:  projection.LDAPConfigEmailAttributeCol,
+		table: ldapIDPTable,
+	}
+	LDAPIDPColPhoneAttribute = Column{
+		name:  projection.LDAPConfigPhoneAttributeCol,
+		table: ldapIDPTable,
+	}
+)
+
 //IDPByIDAndResourceOwner searches for the requested id in the context of the resource owner and IAM
 func (q *Queries) IDPByIDAndResourceOwner(ctx context.Context, id, resourceOwner string) (*IDP, error) {
 	stmt, scan := prepareIDPByIDQuery()
@@ -282,9 +437,38 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 			JWTIDPColKeysEndpoint.identifier(),
 			JWTIDPColHeaderName.identifier(),
 			JWTIDPColEndpoint.identifier(),
+			SAMLIDPColIDPID.identifier(),
+			SAMLIDPColMetadataURL.identifier(),
+			SAMLIDPColMetadata.identifier(),
+			SAMLIDPColCertificate.identifier(),
+			SAMLIDPColBinding.identifier(),
+			SAMLIDPColWithSignedRequest.identifier(),
+			SAMLIDPColNameIDFormat.identifier(),
+			SAMLIDPColUsernameAttribute.identifier(),
+			SAMLIDPColDisplayNameAttribute.identifier(),
+			SAMLIDPColFirstNameAttribute.identifier(),
+			SAMLIDPColLastNameAttribute.identifier(),
+			SAMLIDPColEmailAttribute.identifier(),
+			SAMLIDPColPhoneAttribute.identifier(),
+			LDAPIDPColIDPID.identifier(),
+			LDAPIDPColURL.identifier(),
+			LDAPIDPColStartTLS.identifier(),
+			LDAPIDPColBindDN.identifier(),
+			LDAPIDPColBindPassword.identifier(),
+			LDAPIDPColBaseDN.identifier(),
+			LDAPIDPColUserObjectClass.identifier(),
+			LDAPIDPColIDAttribute.identifier(),
+			LDAPIDPColUsernameAttribute.identifier(),
+			LDAPIDPColDisplayNameAttribute.identifier(),
+			LDAPIDPColFirstNameAttribute.identifier(),
+			LDAPIDPColLastNameAttribute.identifier(),
+			LDAPIDPColEmailAttribute.identifier(),
+			LDAPIDPColPhoneAttribute.identifier(),
 		).From(idpTable.identifier()).
 			LeftJoin(join(OIDCIDPColIDPID, IDPIDCol)).
 			LeftJoin(join(JWTIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(SAMLIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(LDAPIDPColIDPID, IDPIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*IDP, error) {
 			idp := new(IDP)
@@ -305,6 +489,35 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 			jwtHeaderName := sql.NullString{}
 			jwtEndpoint := sql.NullString{}
 
+			samlIDPID := sql.NullString{}
+			samlMetadataURL := sql.NullString{}
+			samlMetadata := []byte{}
+			samlCertificate := []byte{}
+			samlBinding := sql.NullInt32{}
+			samlWithSignedRequest := sql.NullBool{}
+			samlNameIDFormat := sql.NullInt32{}
+			samlUsernameAttribute := sql.NullString{}
+			samlDisplayNameAttribute := sql.NullString{}
+			samlFirstNameAttribute := sql.NullString{}
+			samlLastNameAttribute := sql.NullString{}
+			samlEmailAttribute := sql.NullString{}
+			samlPhoneAttribute := sql.NullString{}
+
+			ldapIDPID := sql.NullString{}
+			ldapURL := sql.NullString{}
+			ldapStartTLS := sql.NullBool{}
+			ldapBindDN := sql.NullString{}
+			ldapBindPassword := new(crypto.CryptoValue)
+			ldapBaseDN := sql.NullString{}
+			ldapUserObjectClass := sql.NullString{}
+			ldapIDAttribute := sql.NullString{}
+			ldapUsernameAttribute := sql.NullString{}
+			ldapDisplayNameAttribute := sql.NullString{}
+			ldapFirstNameAttribute := sql.NullString{}
+			ldapLastNameAttribute := sql.NullString{}
+			ldapEmailAttribute := sql.NullString{}
+			ldapPhoneAttribute := sql.NullString{}
+
 			err := row.Scan(
 				&idp.ID,
 				&idp.ResourceOwner,
@@ -330,6 +543,33 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 				&jwtKeysEndpoint,
 				&jwtHeaderName,
 				&jwtEndpoint,
+				&samlIDPID,
+				&samlMetadataURL,
+				&samlMetadata,
+				&samlCertificate,
+				&samlBinding,
+				&samlWithSignedRequest,
+				&samlNameIDFormat,
+				&samlUsernameAttribute,
+				&samlDisplayNameAttribute,
+				&samlFirstNameAttribute,
+				&samlLastNameAttribute,
+				&samlEmailAttribute,
+				&samlPhoneAttribute,
+				&ldapIDPID,
+				&ldapURL,
+				&ldapStartTLS,
+				&ldapBindDN,
+				ldapBindPassword,
+				&ldapBaseDN,
+				&ldapUserObjectClass,
+				&ldapIDAttribute,
+				&ldapUsernameAttribute,
+				&ldapDisplayNameAttribute,
+				&ldapFirstNameAttribute,
+				&ldapLastNameAttribute,
+				&ldapEmailAttribute,
+				&ldapPhoneAttribute,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -358,6 +598,39 @@ func prepareIDPByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDP, error)) {
 					HeaderName:   jwtHeaderName.String,
 					Endpoint:     jwtEndpoint.String,
 				}
+			} else if samlIDPID.Valid {
+				idp.SAMLIDP = &SAMLIDP{
+					IDPID:                samlIDPID.String,
+					MetadataURL:          samlMetadataURL.String,
+					Metadata:             samlMetadata,
+					Certificate:          samlCertificate,
+					Binding:              domain.SAMLBinding(samlBinding.Int32),
+					WithSignedRequest:    samlWithSignedRequest.Bool,
+					NameIDFormat:         domain.SAMLNameIDFormat(samlNameIDFormat.Int32),
+					UsernameAttribute:    samlUsernameAttribute.String,
+					DisplayNameAttribute: samlDisplayNameAttribute.String,
+					FirstNameAttribute:   samlFirstNameAttribute.String,
+					LastNameAttribute:    samlLastNameAttribute.String,
+					EmailAttribute:       samlEmailAttribute.String,
+					PhoneAttribute:       samlPhoneAttribute.String,
+				}
+			} else if ldapIDPID.Valid {
+				idp.LDAPIDP = &LDAPIDP{
+					IDPID:                ldapIDPID.String,
+					URL:                  ldapURL.String,
+					StartTLS:             ldapStartTLS.Bool,
+					BindDN:               ldapBindDN.String,
+					BindPassword:         ldapBindPassword,
+					BaseDN:               ldapBaseDN.String,
+					UserObjectClass:      ldapUserObjectClass.String,
+					IDAttribute:          ldapIDAttribute.String,
+					UsernameAttribute:    ldapUsernameAttribute.String,
+					DisplayNameAttribute: ldapDisplayNameAttribute.String,
+					FirstNameAttribute:   ldapFirstNameAttribute.String,
+					LastNameAttribute:    ldapLastNameAttribute.String,
+					EmailAttribute:       ldapEmailAttribute.String,
+					PhoneAttribute:       ldapPhoneAttribute.String,
+				}
 			}
 
 			return idp, nil
@@ -390,10 +663,39 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 			JWTIDPColKeysEndpoint.identifier(),
 			JWTIDPColHeaderName.identifier(),
 			JWTIDPColEndpoint.identifier(),
+			SAMLIDPColIDPID.identifier(),
+			SAMLIDPColMetadataURL.identifier(),
+			SAMLIDPColMetadata.identifier(),
+			SAMLIDPColCertificate.identifier(),
+			SAMLIDPColBinding.identifier(),
+			SAMLIDPColWithSignedRequest.identifier(),
+			SAMLIDPColNameIDFormat.identifier(),
+			SAMLIDPColUsernameAttribute.identifier(),
+			SAMLIDPColDisplayNameAttribute.identifier(),
+			SAMLIDPColFirstNameAttribute.identifier(),
+			SAMLIDPColLastNameAttribute.identifier(),
+			SAMLIDPColEmailAttribute.identifier(),
+			SAMLIDPColPhoneAttribute.identifier(),
+			LDAPIDPColIDPID.identifier(),
+			LDAPIDPColURL.identifier(),
+			LDAPIDPColStartTLS.identifier(),
+			LDAPIDPColBindDN.identifier(),
+			LDAPIDPColBindPassword.identifier(),
+			LDAPIDPColBaseDN.identifier(),
+			LDAPIDPColUserObjectClass.identifier(),
+			LDAPIDPColIDAttribute.identifier(),
+			LDAPIDPColUsernameAttribute.identifier(),
+			LDAPIDPColDisplayNameAttribute.identifier(),
+			LDAPIDPColFirstNameAttribute.identifier(),
+			LDAPIDPColLastNameAttribute.identifier(),
+			LDAPIDPColEmailAttribute.identifier(),
+			LDAPIDPColPhoneAttribute.identifier(),
 			countColumn.identifier(),
 		).From(idpTable.identifier()).
 			LeftJoin(join(OIDCIDPColIDPID, IDPIDCol)).
 			LeftJoin(join(JWTIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(SAMLIDPColIDPID, IDPIDCol)).
+			LeftJoin(join(LDAPIDPColIDPID, IDPIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*IDPs, error) {
 			idps := make([]*IDP, 0)
@@ -417,6 +719,35 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 				jwtHeaderName := sql.NullString{}
 				jwtEndpoint := sql.NullString{}
 
+				samlIDPID := sql.NullString{}
+				samlMetadataURL := sql.NullString{}
+				samlMetadata := []byte{}
+				samlCertificate := []byte{}
+				samlBinding := sql.NullInt32{}
+				samlWithSignedRequest := sql.NullBool{}
+				samlNameIDFormat := sql.NullInt32{}
+				samlUsernameAttribute := sql.NullString{}
+				samlDisplayNameAttribute := sql.NullString{}
+				samlFirstNameAttribute := sql.NullString{}
+				samlLastNameAttribute := sql.NullString{}
+				samlEmailAttribute := sql.NullString{}
+				samlPhoneAttribute := sql.NullString{}
+
+				ldapIDPID := sql.NullString{}
+				ldapURL := sql.NullString{}
+				ldapStartTLS := sql.NullBool{}
+				ldapBindDN := sql.NullString{}
+				ldapBindPassword := new(crypto.CryptoValue)
+				ldapBaseDN := sql.NullString{}
+				ldapUserObjectClass := sql.NullString{}
+				ldapIDAttribute := sql.NullString{}
+				ldapUsernameAttribute := sql.NullString{}
+				ldapDisplayNameAttribute := sql.NullString{}
+				ldapFirstNameAttribute := sql.NullString{}
+				ldapLastNameAttribute := sql.NullString{}
+				ldapEmailAttribute := sql.NullString{}
+				ldapPhoneAttribute := sql.NullString{}
+
 				err := rows.Scan(
 					&idp.ID,
 					&idp.ResourceOwner,
@@ -444,6 +775,35 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 					&jwtKeysEndpoint,
 					&jwtHeaderName,
 					&jwtEndpoint,
+					// saml config
+					&samlIDPID,
+					&samlMetadataURL,
+					&samlMetadata,
+					&samlCertificate,
+					&samlBinding,
+					&samlWithSignedRequest,
+					&samlNameIDFormat,
+					&samlUsernameAttribute,
+					&samlDisplayNameAttribute,
+					&samlFirstNameAttribute,
+					&samlLastNameAttribute,
+					&samlEmailAttribute,
+					&samlPhoneAttribute,
+					// ldap config
+					&ldapIDPID,
+					&ldapURL,
+					&ldapStartTLS,
+					&ldapBindDN,
+					ldapBindPassword,
+					&ldapBaseDN,
+					&ldapUserObjectClass,
+					&ldapIDAttribute,
+					&ldapUsernameAttribute,
+					&ldapDisplayNameAttribute,
+					&ldapFirstNameAttribute,
+					&ldapLastNameAttribute,
+					&ldapEmailAttribute,
+					&ldapPhoneAttribute,
 					&count,
 				)
 
@@ -471,6 +831,39 @@ func prepareIDPsQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPs, error)) {
 						HeaderName:   jwtHeaderName.String,
 						Endpoint:     jwtEndpoint.String,
 					}
+				} else if samlIDPID.Valid {
+					idp.SAMLIDP = &SAMLIDP{
+						IDPID:                samlIDPID.String,
+						MetadataURL:          samlMetadataURL.String,
+						Metadata:             samlMetadata,
+						Certificate:          samlCertificate,
+						Binding:              domain.SAMLBinding(samlBinding.Int32),
+						WithSignedRequest:    samlWithSignedRequest.Bool,
+						NameIDFormat:         domain.SAMLNameIDFormat(samlNameIDFormat.Int32),
+						UsernameAttribute:    samlUsernameAttribute.String,
+						DisplayNameAttribute: samlDisplayNameAttribute.String,
+						FirstNameAttribute:   samlFirstNameAttribute.String,
+						LastNameAttribute:    samlLastNameAttribute.String,
+						EmailAttribute:       samlEmailAttribute.String,
+						PhoneAttribute:       samlPhoneAttribute.String,
+					}
+				} else if ldapIDPID.Valid {
+					idp.LDAPIDP = &LDAPIDP{
+						IDPID:                ldapIDPID.String,
+						URL:                  ldapURL.String,
+						StartTLS:             ldapStartTLS.Bool,
+						BindDN:               ldapBindDN.String,
+						BindPassword:         ldapBindPassword,
+						BaseDN:               ldapBaseDN.String,
+						UserObjectClass:      ldapUserObjectClass.String,
+						IDAttribute:          ldapIDAttribute.String,
+						UsernameAttribute:    ldapUsernameAttribute.String,
+						DisplayNameAttribute: ldapDisplayNameAttribute.String,
+						FirstNameAttribute:   ldapFirstNameAttribute.String,
+						LastNameAttribute:    ldapLastNameAttribute.String,
+						EmailAttribute:       ldapEmailAttribute.String,
+						PhoneAttribute:       ldapPhoneAttribute.String,
+					}
 				}
 
 				idps = append(idps, idp)
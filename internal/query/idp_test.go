@@ -53,10 +53,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.issuer,`+
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
-						` zitadel.projections.idps_jwt_config.endpoint`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					nil,
 					nil,
 				),
@@ -97,10 +126,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.issuer,`+
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
-						` zitadel.projections.idps_jwt_config.endpoint`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -128,6 +186,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 					},
 					[]driver.Value{
 						"idp-id",
@@ -156,6 +243,35 @@ func Test_IDPPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -211,10 +327,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.issuer,`+
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
-						` zitadel.projections.idps_jwt_config.endpoint`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -242,6 +387,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 					},
 					[]driver.Value{
 						"idp-id",
@@ -270,6 +444,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"key.ch",
 						"x-header-name",
 						"jwt.endpoint.ch",
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -293,6 +496,415 @@ func Test_IDPPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareIDPByIDQuery saml config",
+			prepare: prepareIDPByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.idps.id,`+
+						` zitadel.projections.idps.resource_owner,`+
+						` zitadel.projections.idps.creation_date,`+
+						` zitadel.projections.idps.change_date,`+
+						` zitadel.projections.idps.sequence,`+
+						` zitadel.projections.idps.state,`+
+						` zitadel.projections.idps.name,`+
+						` zitadel.projections.idps.styling_type,`+
+						` zitadel.projections.idps.owner_type,`+
+						` zitadel.projections.idps.auto_register,`+
+						` zitadel.projections.idps_oidc_config.idp_id,`+
+						` zitadel.projections.idps_oidc_config.client_id,`+
+						` zitadel.projections.idps_oidc_config.client_secret,`+
+						` zitadel.projections.idps_oidc_config.issuer,`+
+						` zitadel.projections.idps_oidc_config.scopes,`+
+						` zitadel.projections.idps_oidc_config.display_name_mapping,`+
+						` zitadel.projections.idps_oidc_config.username_mapping,`+
+						` zitadel.projections.idps_oidc_config.authorization_endpoint,`+
+						` zitadel.projections.idps_oidc_config.token_endpoint,`+
+						` zitadel.projections.idps_jwt_config.idp_id,`+
+						` zitadel.projections.idps_jwt_config.issuer,`+
+						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
+						` zitadel.projections.idps_jwt_config.header_name,`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
+						` FROM zitadel.projections.idps`+
+						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
+					[]string{
+						"id",
+						"resource_owner",
+						"creation_date",
+						"change_date",
+						"sequence",
+						"state",
+						"name",
+						"styling_type",
+						"owner_type",
+						"auto_register",
+						// oidc config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"issuer",
+						"scopes",
+						"display_name_mapping",
+						"username_mapping",
+						"authorization_endpoint",
+						"token_endpoint",
+						// jwt config
+						"idp_id",
+						"issuer",
+						"keys_endpoint",
+						"header_name",
+						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+					},
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPConfigStylingTypeGoogle,
+						domain.IdentityProviderTypeOrg,
+						true,
+						// oidc config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						"idp-id",
+						"https://idp.example.com/metadata",
+						nil,
+						[]byte("certificate"),
+						domain.SAMLBindingPost,
+						true,
+						domain.SAMLNameIDFormatPersistent,
+						"uid",
+						"displayName",
+						"givenName",
+						"sn",
+						"mail",
+						"phone",
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDP{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+				ID:            "idp-id",
+				State:         domain.IDPConfigStateActive,
+				Name:          "idp-name",
+				StylingType:   domain.IDPConfigStylingTypeGoogle,
+				OwnerType:     domain.IdentityProviderTypeOrg,
+				AutoRegister:  true,
+				SAMLIDP: &SAMLIDP{
+					IDPID:                "idp-id",
+					MetadataURL:          "https://idp.example.com/metadata",
+					Certificate:          []byte("certificate"),
+					Binding:              domain.SAMLBindingPost,
+					WithSignedRequest:    true,
+					NameIDFormat:         domain.SAMLNameIDFormatPersistent,
+					UsernameAttribute:    "uid",
+					DisplayNameAttribute: "displayName",
+					FirstNameAttribute:   "givenName",
+					LastNameAttribute:    "sn",
+					EmailAttribute:       "mail",
+					PhoneAttribute:       "phone",
+				},
+			},
+		},
+		{
+			name:    "prepareIDPByIDQuery ldap config",
+			prepare: prepareIDPByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.idps.id,`+
+						` zitadel.projections.idps.resource_owner,`+
+						` zitadel.projections.idps.creation_date,`+
+						` zitadel.projections.idps.change_date,`+
+						` zitadel.projections.idps.sequence,`+
+						` zitadel.projections.idps.state,`+
+						` zitadel.projections.idps.name,`+
+						` zitadel.projections.idps.styling_type,`+
+						` zitadel.projections.idps.owner_type,`+
+						` zitadel.projections.idps.auto_register,`+
+						` zitadel.projections.idps_oidc_config.idp_id,`+
+						` zitadel.projections.idps_oidc_config.client_id,`+
+						` zitadel.projections.idps_oidc_config.client_secret,`+
+						` zitadel.projections.idps_oidc_config.issuer,`+
+						` zitadel.projections.idps_oidc_config.scopes,`+
+						` zitadel.projections.idps_oidc_config.display_name_mapping,`+
+						` zitadel.projections.idps_oidc_config.username_mapping,`+
+						` zitadel.projections.idps_oidc_config.authorization_endpoint,`+
+						` zitadel.projections.idps_oidc_config.token_endpoint,`+
+						` zitadel.projections.idps_jwt_config.idp_id,`+
+						` zitadel.projections.idps_jwt_config.issuer,`+
+						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
+						` zitadel.projections.idps_jwt_config.header_name,`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
+						` FROM zitadel.projections.idps`+
+						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
+					[]string{
+						"id",
+						"resource_owner",
+						"creation_date",
+						"change_date",
+						"sequence",
+						"state",
+						"name",
+						"styling_type",
+						"owner_type",
+						"auto_register",
+						// oidc config
+						"idp_id",
+						"client_id",
+						"client_secret",
+						"issuer",
+						"scopes",
+						"display_name_mapping",
+						"username_mapping",
+						"authorization_endpoint",
+						"token_endpoint",
+						// jwt config
+						"idp_id",
+						"issuer",
+						"keys_endpoint",
+						"header_name",
+						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+					},
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPConfigStylingTypeGoogle,
+						domain.IdentityProviderTypeOrg,
+						true,
+						// oidc config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						"idp-id",
+						"ldaps://ldap.example.com:636",
+						false,
+						"cn=admin,dc=example,dc=com",
+						nil,
+						"dc=example,dc=com",
+						"person",
+						"entryUUID",
+						"uid",
+						"cn",
+						"givenName",
+						"sn",
+						"mail",
+						"telephoneNumber",
+					},
+				),
+			},
+			object: &IDP{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+				ID:            "idp-id",
+				State:         domain.IDPConfigStateActive,
+				Name:          "idp-name",
+				StylingType:   domain.IDPConfigStylingTypeGoogle,
+				OwnerType:     domain.IdentityProviderTypeOrg,
+				AutoRegister:  true,
+				LDAPIDP: &LDAPIDP{
+					IDPID:                "idp-id",
+					URL:                  "ldaps://ldap.example.com:636",
+					BindDN:               "cn=admin,dc=example,dc=com",
+					BindPassword:         &crypto.CryptoValue{},
+					BaseDN:               "dc=example,dc=com",
+					UserObjectClass:      "person",
+					IDAttribute:          "entryUUID",
+					UsernameAttribute:    "uid",
+					DisplayNameAttribute: "cn",
+					FirstNameAttribute:   "givenName",
+					LastNameAttribute:    "sn",
+					EmailAttribute:       "mail",
+					PhoneAttribute:       "telephoneNumber",
+				},
+			},
+		},
 		{
 			name:    "prepareIDPByIDQuery no config",
 			prepare: prepareIDPByIDQuery,
@@ -321,10 +933,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.issuer,`+
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
-						` zitadel.projections.idps_jwt_config.endpoint`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -352,6 +993,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 					},
 					[]driver.Value{
 						"idp-id",
@@ -380,6 +1050,35 @@ func Test_IDPPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// saml config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -424,10 +1123,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.issuer,`+
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
-						` zitadel.projections.idps_jwt_config.endpoint`+
+						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					sql.ErrConnDone,
 				),
 				err: func(err error) (error, bool) {
@@ -468,10 +1196,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
 						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					nil,
 					nil,
 				),
@@ -513,10 +1270,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
 						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -544,6 +1330,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 						"count",
 					},
 					[][]driver.Value{
@@ -574,6 +1389,35 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -638,10 +1482,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
 						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -668,6 +1541,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 						"count",
 					},
 					[][]driver.Value{
@@ -698,6 +1600,35 @@ func Test_IDPPrepares(t *testing.T) {
 							"key.ch",
 							"x-header-name",
 							"jwt.endpoint.ch",
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -758,10 +1689,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
 						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -788,6 +1748,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 						"count",
 					},
 					[][]driver.Value{
@@ -818,6 +1807,35 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -871,10 +1889,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
 						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					[]string{
 						"id",
 						"resource_owner",
@@ -901,6 +1948,35 @@ func Test_IDPPrepares(t *testing.T) {
 						"keys_endpoint",
 						"header_name",
 						"endpoint",
+						// saml config
+						"idp_id",
+						"metadata_url",
+						"metadata",
+						"certificate",
+						"binding",
+						"with_signed_request",
+						"name_id_format",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
+						// ldap config
+						"idp_id",
+						"url",
+						"start_tls",
+						"bind_dn",
+						"bind_password",
+						"base_dn",
+						"user_object_class",
+						"id_attribute",
+						"username_attribute",
+						"display_name_attribute",
+						"first_name_attribute",
+						"last_name_attribute",
+						"email_attribute",
+						"phone_attribute",
 						"count",
 					},
 					[][]driver.Value{
@@ -931,6 +2007,35 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-2",
@@ -959,6 +2064,35 @@ func Test_IDPPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-3",
@@ -987,6 +2121,35 @@ func Test_IDPPrepares(t *testing.T) {
 							"key.ch",
 							"x-header-name",
 							"jwt.endpoint.ch",
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// ldap config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1082,10 +2245,39 @@ func Test_IDPPrepares(t *testing.T) {
 						` zitadel.projections.idps_jwt_config.keys_endpoint,`+
 						` zitadel.projections.idps_jwt_config.header_name,`+
 						` zitadel.projections.idps_jwt_config.endpoint,`+
+						` zitadel.projections.idps_saml_config.idp_id,`+
+						` zitadel.projections.idps_saml_config.metadata_url,`+
+						` zitadel.projections.idps_saml_config.metadata,`+
+						` zitadel.projections.idps_saml_config.certificate,`+
+						` zitadel.projections.idps_saml_config.binding,`+
+						` zitadel.projections.idps_saml_config.with_signed_request,`+
+						` zitadel.projections.idps_saml_config.name_id_format,`+
+						` zitadel.projections.idps_saml_config.username_attribute,`+
+						` zitadel.projections.idps_saml_config.display_name_attribute,`+
+						` zitadel.projections.idps_saml_config.first_name_attribute,`+
+						` zitadel.projections.idps_saml_config.last_name_attribute,`+
+						` zitadel.projections.idps_saml_config.email_attribute,`+
+						` zitadel.projections.idps_saml_config.phone_attribute,`+
+						` zitadel.projections.idps_ldap_config.idp_id,`+
+						` zitadel.projections.idps_ldap_config.url,`+
+						` zitadel.projections.idps_ldap_config.start_tls,`+
+						` zitadel.projections.idps_ldap_config.bind_dn,`+
+						` zitadel.projections.idps_ldap_config.bind_password,`+
+						` zitadel.projections.idps_ldap_config.base_dn,`+
+						` zitadel.projections.idps_ldap_config.user_object_class,`+
+						` zitadel.projections.idps_ldap_config.id_attribute,`+
+						` zitadel.projections.idps_ldap_config.username_attribute,`+
+						` zitadel.projections.idps_ldap_config.display_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.first_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.last_name_attribute,`+
+						` zitadel.projections.idps_ldap_config.email_attribute,`+
+						` zitadel.projections.idps_ldap_config.phone_attribute,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.idps`+
 						` LEFT JOIN zitadel.projections.idps_oidc_config ON zitadel.projections.idps.id = zitadel.projections.idps_oidc_config.idp_id`+
-						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`),
+						` LEFT JOIN zitadel.projections.idps_jwt_config ON zitadel.projections.idps.id = zitadel.projections.idps_jwt_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_saml_config ON zitadel.projections.idps.id = zitadel.projections.idps_saml_config.idp_id`+
+						` LEFT JOIN zitadel.projections.idps_ldap_config ON zitadel.projections.idps.id = zitadel.projections.idps_ldap_config.idp_id`),
 					sql.ErrConnDone,
 				),
 				err: func(err error) (error, bool) {
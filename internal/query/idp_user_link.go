@@ -99,6 +99,14 @@ func NewIDPUserLinkIDPIDSearchQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(IDPUserLinkIDPIDCol, value, TextEquals)
 }
 
+func NewIDPUserLinkIDPIDsSearchQuery(values []string) (SearchQuery, error) {
+	list := make([]interface{}, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+	return NewListQuery(IDPUserLinkIDPIDCol, list, ListIn)
+}
+
 func NewIDPUserLinksUserIDSearchQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(IDPUserLinkUserIDCol, value, TextEquals)
 }
@@ -21,7 +21,11 @@ type LockoutPolicy struct {
 	State         domain.PolicyState
 
 	MaxPasswordAttempts uint64
+	MaxOTPAttempts      uint64
+	MaxU2FAttempts      uint64
 	ShowFailures        bool
+	LockoutDuration     time.Duration
+	BackoffDelay        time.Duration
 
 	IsDefault bool
 }
@@ -58,6 +62,22 @@ var (
 		name:  projection.LockoutPolicyMaxPasswordAttemptsCol,
 		table: lockoutTable,
 	}
+	LockoutColMaxOTPAttempts = Column{
+		name:  projection.LockoutPolicyMaxOTPAttemptsCol,
+		table: lockoutTable,
+	}
+	LockoutColMaxU2FAttempts = Column{
+		name:  projection.LockoutPolicyMaxU2FAttemptsCol,
+		table: lockoutTable,
+	}
+	LockoutColLockoutDuration = Column{
+		name:  projection.LockoutPolicyLockoutDurationCol,
+		table: lockoutTable,
+	}
+	LockoutColBackoffDelay = Column{
+		name:  projection.LockoutPolicyBackoffDelayCol,
+		table: lockoutTable,
+	}
 	LockoutColIsDefault = Column{
 		name:  projection.LockoutPolicyIsDefaultCol,
 		table: lockoutTable,
@@ -113,6 +133,10 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 			LockoutColResourceOwner.identifier(),
 			LockoutColShowFailures.identifier(),
 			LockoutColMaxPasswordAttempts.identifier(),
+			LockoutColMaxOTPAttempts.identifier(),
+			LockoutColMaxU2FAttempts.identifier(),
+			LockoutColLockoutDuration.identifier(),
+			LockoutColBackoffDelay.identifier(),
 			LockoutColIsDefault.identifier(),
 			LockoutColState.identifier(),
 		).
@@ -127,6 +151,10 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 				&policy.ResourceOwner,
 				&policy.ShowFailures,
 				&policy.MaxPasswordAttempts,
+				&policy.MaxOTPAttempts,
+				&policy.MaxU2FAttempts,
+				&policy.LockoutDuration,
+				&policy.BackoffDelay,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/caos/zitadel/internal/errors"
+)
+
+//ExpiredMember is a member of an org or project whose expiration date is reached
+type ExpiredMember struct {
+	AggregateID   string
+	UserID        string
+	ResourceOwner string
+}
+
+type ExpiredMembers struct {
+	Members []*ExpiredMember
+}
+
+func (q *Queries) ExpiredOrgMembers(ctx context.Context, now time.Time) (*ExpiredMembers, error) {
+	query, scan := prepareExpiredOrgMembersQuery()
+	return q.expiredMembers(ctx, query.Where(sq.LtOrEq{OrgMemberExpirationDate.identifier(): now}), scan)
+}
+
+func (q *Queries) ExpiredProjectMembers(ctx context.Context, now time.Time) (*ExpiredMembers, error) {
+	query, scan := prepareExpiredProjectMembersQuery()
+	return q.expiredMembers(ctx, query.Where(sq.LtOrEq{ProjectMemberExpirationDate.identifier(): now}), scan)
+}
+
+func (q *Queries) expiredMembers(ctx context.Context, query sq.SelectBuilder, scan func(*sql.Rows) (*ExpiredMembers, error)) (*ExpiredMembers, error) {
+	stmt, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ex8qs", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ex8qe", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func prepareExpiredOrgMembersQuery() (sq.SelectBuilder, func(*sql.Rows) (*ExpiredMembers, error)) {
+	return sq.Select(
+			OrgMemberOrgID.identifier(),
+			OrgMemberUserID.identifier(),
+			OrgMemberResourceOwner.identifier(),
+		).From(orgMemberTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		scanExpiredMembers
+}
+
+func prepareExpiredProjectMembersQuery() (sq.SelectBuilder, func(*sql.Rows) (*ExpiredMembers, error)) {
+	return sq.Select(
+			ProjectMemberProjectID.identifier(),
+			ProjectMemberUserID.identifier(),
+			ProjectMemberResourceOwner.identifier(),
+		).From(projectMemberTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		scanExpiredMembers
+}
+
+func scanExpiredMembers(rows *sql.Rows) (*ExpiredMembers, error) {
+	members := make([]*ExpiredMember, 0)
+	for rows.Next() {
+		member := new(ExpiredMember)
+		err := rows.Scan(
+			&member.AggregateID,
+			&member.UserID,
+			&member.ResourceOwner,
+		)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ex8qc", "Errors.Query.CloseRows")
+	}
+
+	return &ExpiredMembers{
+		Members: members,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	expiredOrgMembersQuery = regexp.QuoteMeta("SELECT" +
+		" members.org_id" +
+		", members.user_id" +
+		", members.resource_owner" +
+		" FROM zitadel.projections.org_members as members")
+	expiredProjectMembersQuery = regexp.QuoteMeta("SELECT" +
+		" members.project_id" +
+		", members.user_id" +
+		", members.resource_owner" +
+		" FROM zitadel.projections.project_members as members")
+	expiredOrgMembersColumns = []string{
+		"org_id",
+		"user_id",
+		"resource_owner",
+	}
+	expiredProjectMembersColumns = []string{
+		"project_id",
+		"user_id",
+		"resource_owner",
+	}
+)
+
+func Test_ExpiredMemberPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareExpiredOrgMembersQuery no result",
+			prepare: prepareExpiredOrgMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expiredOrgMembersQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &ExpiredMembers{
+				Members: []*ExpiredMember{},
+			},
+		},
+		{
+			name:    "prepareExpiredOrgMembersQuery found",
+			prepare: prepareExpiredOrgMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expiredOrgMembersQuery,
+					expiredOrgMembersColumns,
+					[][]driver.Value{
+						{
+							"org-id",
+							"user-id",
+							"ro",
+						},
+					},
+				),
+			},
+			object: &ExpiredMembers{
+				Members: []*ExpiredMember{
+					{
+						AggregateID:   "org-id",
+						UserID:        "user-id",
+						ResourceOwner: "ro",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareExpiredProjectMembersQuery found",
+			prepare: prepareExpiredProjectMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expiredProjectMembersQuery,
+					expiredProjectMembersColumns,
+					[][]driver.Value{
+						{
+							"project-id",
+							"user-id-1",
+							"ro",
+						},
+						{
+							"project-id",
+							"user-id-2",
+							"ro",
+						},
+					},
+				),
+			},
+			object: &ExpiredMembers{
+				Members: []*ExpiredMember{
+					{
+						AggregateID:   "project-id",
+						UserID:        "user-id-1",
+						ResourceOwner: "ro",
+					},
+					{
+						AggregateID:   "project-id",
+						UserID:        "user-id-2",
+						ResourceOwner: "ro",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareExpiredProjectMembersQuery sql err",
+			prepare: prepareExpiredProjectMembersQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expiredProjectMembersQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -27,6 +27,10 @@ type MessageTexts struct {
 	VerifyPhone              MessageText
 	DomainClaimed            MessageText
 	PasswordlessRegistration MessageText
+	PasswordExpiry           MessageText
+	VerifyEmailOTP           MessageText
+	VerifySMSOTP             MessageText
+	RecoveryCodesLow         MessageText
 }
 
 type MessageText struct {
@@ -331,6 +335,14 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.DomainClaimed
 	case domain.PasswordlessRegistrationMessageType:
 		return &m.PasswordlessRegistration
+	case domain.PasswordExpiryMessageType:
+		return &m.PasswordExpiry
+	case domain.VerifyEmailOTPMessageType:
+		return &m.VerifyEmailOTP
+	case domain.VerifySMSOTPMessageType:
+		return &m.VerifySMSOTP
+	case domain.RecoveryCodesLowMessageType:
+		return &m.RecoveryCodesLow
 	}
 	return nil
 }
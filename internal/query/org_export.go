@@ -0,0 +1,561 @@
+package query
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/caos/zitadel/internal/domain"
+)
+
+//ExportOrg returns everything owned by the organisation in the versioned export format
+//the generated domain of the installation (iamDomain) isn't exported
+func (q *Queries) ExportOrg(ctx context.Context, orgID, iamDomain string) (_ *domain.ExportedOrg, err error) {
+	org, err := q.OrgByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	exported := &domain.ExportedOrg{
+		OrgID: org.ID,
+		Name:  org.Name,
+	}
+	if exported.Domains, err = q.exportOrgDomains(ctx, org, iamDomain); err != nil {
+		return nil, err
+	}
+	if err = q.exportPolicies(ctx, exported); err != nil {
+		return nil, err
+	}
+	if exported.IDPs, err = q.exportIDPs(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if exported.Humans, exported.Machines, err = q.exportUsers(ctx, orgID); err != nil {
+		return nil, err
+	}
+	orgMembers, err := q.OrgMembers(ctx, &OrgMembersQuery{OrgID: orgID})
+	if err != nil {
+		return nil, err
+	}
+	exported.Members = membersToExport(orgMembers)
+	if exported.Projects, err = q.exportProjects(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if exported.ProjectGrants, err = q.exportProjectGrants(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if exported.UserGrants, err = q.exportUserGrants(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if exported.CustomTexts, err = q.exportCustomTexts(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if exported.Actions, err = q.exportActions(ctx, orgID); err != nil {
+		return nil, err
+	}
+	if exported.Flows, err = q.exportFlows(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportOrgDomains(ctx context.Context, org *Org, iamDomain string) ([]*domain.ExportedOrgDomain, error) {
+	orgIDQuery, err := NewOrgDomainOrgIDSearchQuery(org.ID)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := q.SearchOrgDomains(ctx, &OrgDomainSearchQueries{Queries: []SearchQuery{orgIDQuery}})
+	if err != nil {
+		return nil, err
+	}
+	generated := domain.NewIAMDomainName(org.Name, iamDomain)
+	exported := make([]*domain.ExportedOrgDomain, 0, len(domains.Domains))
+	for _, orgDomain := range domains.Domains {
+		if orgDomain.Domain == generated {
+			continue
+		}
+		exported = append(exported, &domain.ExportedOrgDomain{
+			Domain:     orgDomain.Domain,
+			IsVerified: orgDomain.IsVerified,
+			IsPrimary:  orgDomain.IsPrimary,
+		})
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportPolicies(ctx context.Context, exported *domain.ExportedOrg) error {
+	orgID := exported.OrgID
+	loginPolicy, err := q.LoginPolicyByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !loginPolicy.IsDefault {
+		links, err := q.IDPLoginPolicyLinks(ctx, orgID, &IDPLoginPolicyLinksSearchQuery{})
+		if err != nil {
+			return err
+		}
+		exported.LoginPolicy = &domain.ExportedLoginPolicy{
+			AllowUsernamePassword: loginPolicy.AllowUsernamePassword,
+			AllowRegister:         loginPolicy.AllowRegister,
+			AllowExternalIDP:      loginPolicy.AllowExternalIDPs,
+			ForceMFA:              loginPolicy.ForceMFA,
+			HidePasswordReset:     loginPolicy.HidePasswordReset,
+			PasswordlessType:      loginPolicy.PasswordlessType,
+			SecondFactors:         loginPolicy.SecondFactors,
+			MultiFactors:          loginPolicy.MultiFactors,
+			IDPIDs:                make([]string, len(links.Links)),
+		}
+		for i, link := range links.Links {
+			exported.LoginPolicy.IDPIDs[i] = link.IDPID
+		}
+	}
+	complexityPolicy, err := q.PasswordComplexityPolicyByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !complexityPolicy.IsDefault {
+		exported.PasswordComplexityPolicy = &domain.ExportedPasswordComplexityPolicy{
+			MinLength:     complexityPolicy.MinLength,
+			HasLowercase:  complexityPolicy.HasLowercase,
+			HasUppercase:  complexityPolicy.HasUppercase,
+			HasNumber:     complexityPolicy.HasNumber,
+			HasSymbol:     complexityPolicy.HasSymbol,
+			CheckBreached: complexityPolicy.CheckBreached,
+		}
+	}
+	agePolicy, err := q.PasswordAgePolicyByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !agePolicy.IsDefault {
+		exported.PasswordAgePolicy = &domain.ExportedPasswordAgePolicy{
+			MaxAgeDays:     agePolicy.MaxAgeDays,
+			ExpireWarnDays: agePolicy.ExpireWarnDays,
+		}
+	}
+	lockoutPolicy, err := q.LockoutPolicyByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !lockoutPolicy.IsDefault {
+		exported.LockoutPolicy = &domain.ExportedLockoutPolicy{
+			MaxPasswordAttempts: lockoutPolicy.MaxPasswordAttempts,
+			MaxOTPAttempts:      lockoutPolicy.MaxOTPAttempts,
+			MaxU2FAttempts:      lockoutPolicy.MaxU2FAttempts,
+			ShowFailures:        lockoutPolicy.ShowFailures,
+			LockoutDuration:     lockoutPolicy.LockoutDuration,
+			BackoffDelay:        lockoutPolicy.BackoffDelay,
+		}
+	}
+	privacyPolicy, err := q.PrivacyPolicyByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !privacyPolicy.IsDefault {
+		exported.PrivacyPolicy = &domain.ExportedPrivacyPolicy{
+			TOSLink:     privacyPolicy.TOSLink,
+			PrivacyLink: privacyPolicy.PrivacyLink,
+		}
+	}
+	orgIAMPolicy, err := q.OrgIAMPolicyByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !orgIAMPolicy.IsDefault {
+		exported.OrgIAMPolicy = &domain.ExportedOrgIAMPolicy{
+			UserLoginMustBeDomain: orgIAMPolicy.UserLoginMustBeDomain,
+		}
+	}
+	labelPolicy, err := q.ActiveLabelPolicyByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !labelPolicy.IsDefault {
+		exported.LabelPolicy = &domain.ExportedLabelPolicy{
+			PrimaryColor:        labelPolicy.Light.PrimaryColor,
+			BackgroundColor:     labelPolicy.Light.BackgroundColor,
+			WarnColor:           labelPolicy.Light.WarnColor,
+			FontColor:           labelPolicy.Light.FontColor,
+			PrimaryColorDark:    labelPolicy.Dark.PrimaryColor,
+			BackgroundColorDark: labelPolicy.Dark.BackgroundColor,
+			WarnColorDark:       labelPolicy.Dark.WarnColor,
+			FontColorDark:       labelPolicy.Dark.FontColor,
+			HideLoginNameSuffix: labelPolicy.HideLoginNameSuffix,
+			ErrorMsgPopup:       labelPolicy.ShouldErrorPopup,
+			DisableWatermark:    labelPolicy.WatermarkDisabled,
+		}
+	}
+	return nil
+}
+
+func (q *Queries) exportIDPs(ctx context.Context, orgID string) ([]*domain.ExportedIDP, error) {
+	resourceOwnerQuery, err := NewIDPResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	idps, err := q.IDPs(ctx, &IDPSearchQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedIDP, len(idps.IDPs))
+	for i, idp := range idps.IDPs {
+		exported[i] = idpToExport(idp)
+	}
+	return exported, nil
+}
+
+func idpToExport(idp *IDP) *domain.ExportedIDP {
+	exported := &domain.ExportedIDP{
+		IDPID:        idp.ID,
+		Name:         idp.Name,
+		StylingType:  idp.StylingType,
+		AutoRegister: idp.AutoRegister,
+	}
+	switch {
+	case idp.OIDCIDP != nil && idp.OIDCIDP.IDPID != "":
+		exported.OIDC = &domain.ExportedOIDCIDP{
+			ClientID:              idp.OIDCIDP.ClientID,
+			Issuer:                idp.OIDCIDP.Issuer,
+			AuthorizationEndpoint: idp.OIDCIDP.AuthorizationEndpoint,
+			TokenEndpoint:         idp.OIDCIDP.TokenEndpoint,
+			Scopes:                idp.OIDCIDP.Scopes,
+			DisplayNameMapping:    idp.OIDCIDP.DisplayNameMapping,
+			UsernameMapping:       idp.OIDCIDP.UsernameMapping,
+		}
+	case idp.JWTIDP != nil && idp.JWTIDP.IDPID != "":
+		exported.JWT = &domain.ExportedJWTIDP{
+			JWTEndpoint:  idp.JWTIDP.Endpoint,
+			Issuer:       idp.JWTIDP.Issuer,
+			KeysEndpoint: idp.JWTIDP.KeysEndpoint,
+			HeaderName:   idp.JWTIDP.HeaderName,
+		}
+	case idp.SAMLIDP != nil && idp.SAMLIDP.IDPID != "":
+		exported.SAML = &domain.ExportedSAMLIDP{
+			MetadataURL:          idp.SAMLIDP.MetadataURL,
+			Metadata:             idp.SAMLIDP.Metadata,
+			Binding:              idp.SAMLIDP.Binding,
+			WithSignedRequest:    idp.SAMLIDP.WithSignedRequest,
+			NameIDFormat:         idp.SAMLIDP.NameIDFormat,
+			UsernameAttribute:    idp.SAMLIDP.UsernameAttribute,
+			DisplayNameAttribute: idp.SAMLIDP.DisplayNameAttribute,
+			FirstNameAttribute:   idp.SAMLIDP.FirstNameAttribute,
+			LastNameAttribute:    idp.SAMLIDP.LastNameAttribute,
+			EmailAttribute:       idp.SAMLIDP.EmailAttribute,
+			PhoneAttribute:       idp.SAMLIDP.PhoneAttribute,
+		}
+	case idp.LDAPIDP != nil && idp.LDAPIDP.IDPID != "":
+		exported.LDAP = &domain.ExportedLDAPIDP{
+			URL:                  idp.LDAPIDP.URL,
+			StartTLS:             idp.LDAPIDP.StartTLS,
+			BindDN:               idp.LDAPIDP.BindDN,
+			BaseDN:               idp.LDAPIDP.BaseDN,
+			UserObjectClass:      idp.LDAPIDP.UserObjectClass,
+			IDAttribute:          idp.LDAPIDP.IDAttribute,
+			UsernameAttribute:    idp.LDAPIDP.UsernameAttribute,
+			DisplayNameAttribute: idp.LDAPIDP.DisplayNameAttribute,
+			FirstNameAttribute:   idp.LDAPIDP.FirstNameAttribute,
+			LastNameAttribute:    idp.LDAPIDP.LastNameAttribute,
+			EmailAttribute:       idp.LDAPIDP.EmailAttribute,
+			PhoneAttribute:       idp.LDAPIDP.PhoneAttribute,
+		}
+	}
+	return exported
+}
+
+func (q *Queries) exportUsers(ctx context.Context, orgID string) ([]*domain.ExportedHuman, []*domain.ExportedMachine, error) {
+	resourceOwnerQuery, err := NewUserResourceOwnerSearchQuery(orgID, TextEquals)
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := q.SearchUsers(ctx, &UserSearchQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, nil, err
+	}
+	passwords, err := q.UserPasswordsByOrg(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	humans := make([]*domain.ExportedHuman, 0, len(users.Users))
+	machines := make([]*domain.ExportedMachine, 0)
+	for _, user := range users.Users {
+		if user.Machine != nil {
+			machines = append(machines, &domain.ExportedMachine{
+				UserID:      user.ID,
+				Username:    user.Username,
+				Name:        user.Machine.Name,
+				Description: user.Machine.Description,
+			})
+			continue
+		}
+		if user.Human == nil {
+			continue
+		}
+		humans = append(humans, humanToExport(user, passwords[user.ID]))
+	}
+	return humans, machines, nil
+}
+
+func humanToExport(user *User, password *UserPassword) *domain.ExportedHuman {
+	human := &domain.ExportedHuman{
+		UserID:          user.ID,
+		Username:        user.Username,
+		FirstName:       user.Human.FirstName,
+		LastName:        user.Human.LastName,
+		NickName:        user.Human.NickName,
+		DisplayName:     user.Human.DisplayName,
+		Gender:          user.Human.Gender,
+		Email:           user.Human.Email,
+		IsEmailVerified: user.Human.IsEmailVerified,
+		Phone:           user.Human.Phone,
+		IsPhoneVerified: user.Human.IsPhoneVerified,
+	}
+	if user.Human.PreferredLanguage != language.Und {
+		human.PreferredLanguage = user.Human.PreferredLanguage.String()
+	}
+	if password != nil {
+		human.PasswordHash = password.Secret
+		human.PasswordChangeRequired = password.ChangeRequired
+	}
+	return human
+}
+
+func membersToExport(members *Members) []*domain.ExportedMember {
+	exported := make([]*domain.ExportedMember, len(members.Members))
+	for i, member := range members.Members {
+		exported[i] = &domain.ExportedMember{
+			UserID: member.UserID,
+			Roles:  member.Roles,
+		}
+	}
+	return exported
+}
+
+func (q *Queries) exportProjects(ctx context.Context, orgID string) ([]*domain.ExportedProject, error) {
+	resourceOwnerQuery, err := NewProjectResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := q.SearchProjects(ctx, &ProjectSearchQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedProject, len(projects.Projects))
+	for i, project := range projects.Projects {
+		exported[i] = &domain.ExportedProject{
+			ProjectID:                  project.ID,
+			Name:                       project.Name,
+			ProjectRoleAssertion:       project.ProjectRoleAssertion,
+			ProjectRoleCheck:           project.ProjectRoleCheck,
+			HasProjectCheck:            project.HasProjectCheck,
+			PrivateLabelingSetting:     project.PrivateLabelingSetting,
+			TokenExchangeDelegation:    project.TokenExchangeDelegation,
+			TokenExchangeImpersonation: project.TokenExchangeImpersonation,
+		}
+		if exported[i].Roles, err = q.exportProjectRoles(ctx, project.ID, orgID); err != nil {
+			return nil, err
+		}
+		if exported[i].Apps, err = q.exportApps(ctx, project.ID); err != nil {
+			return nil, err
+		}
+		members, err := q.ProjectMembers(ctx, &ProjectMembersQuery{ProjectID: project.ID})
+		if err != nil {
+			return nil, err
+		}
+		exported[i].Members = membersToExport(members)
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportProjectRoles(ctx context.Context, projectID, orgID string) ([]*domain.ExportedProjectRole, error) {
+	projectIDQuery, err := NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := NewProjectRoleResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := q.SearchProjectRoles(ctx, &ProjectRoleSearchQueries{Queries: []SearchQuery{projectIDQuery, resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedProjectRole, len(roles.ProjectRoles))
+	for i, role := range roles.ProjectRoles {
+		exported[i] = &domain.ExportedProjectRole{
+			Key:         role.Key,
+			DisplayName: role.DisplayName,
+			Group:       role.Group,
+		}
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportApps(ctx context.Context, projectID string) ([]*domain.ExportedApp, error) {
+	projectIDQuery, err := NewAppProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := q.SearchApps(ctx, &AppSearchQueries{Queries: []SearchQuery{projectIDQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedApp, len(apps.Apps))
+	for i, app := range apps.Apps {
+		exported[i] = appToExport(app)
+	}
+	return exported, nil
+}
+
+func appToExport(app *App) *domain.ExportedApp {
+	exported := &domain.ExportedApp{
+		AppID: app.ID,
+		Name:  app.Name,
+	}
+	switch {
+	case app.OIDCConfig != nil:
+		exported.OIDC = &domain.ExportedOIDCApp{
+			ClientID:                 app.OIDCConfig.ClientID,
+			RedirectURIs:             app.OIDCConfig.RedirectURIs,
+			ResponseTypes:            app.OIDCConfig.ResponseTypes,
+			GrantTypes:               app.OIDCConfig.GrantTypes,
+			AppType:                  app.OIDCConfig.AppType,
+			AuthMethodType:           app.OIDCConfig.AuthMethodType,
+			PostLogoutRedirectURIs:   app.OIDCConfig.PostLogoutRedirectURIs,
+			Version:                  app.OIDCConfig.Version,
+			DevMode:                  app.OIDCConfig.IsDevMode,
+			AccessTokenType:          app.OIDCConfig.AccessTokenType,
+			AccessTokenRoleAssertion: app.OIDCConfig.AssertAccessTokenRole,
+			IDTokenRoleAssertion:     app.OIDCConfig.AssertIDTokenRole,
+			IDTokenUserinfoAssertion: app.OIDCConfig.AssertIDTokenUserinfo,
+			ClockSkew:                app.OIDCConfig.ClockSkew,
+			AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
+		}
+	case app.APIConfig != nil:
+		exported.API = &domain.ExportedAPIApp{
+			ClientID:       app.APIConfig.ClientID,
+			AuthMethodType: app.APIConfig.AuthMethodType,
+		}
+	case app.SAMLConfig != nil:
+		exported.SAML = &domain.ExportedSAMLApp{
+			Metadata:    app.SAMLConfig.Metadata,
+			MetadataURL: app.SAMLConfig.MetadataURL,
+		}
+	}
+	return exported
+}
+
+func (q *Queries) exportProjectGrants(ctx context.Context, orgID string) ([]*domain.ExportedProjectGrant, error) {
+	resourceOwnerQuery, err := NewProjectGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := q.SearchProjectGrants(ctx, &ProjectGrantSearchQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedProjectGrant, len(grants.ProjectGrants))
+	for i, grant := range grants.ProjectGrants {
+		exported[i] = &domain.ExportedProjectGrant{
+			GrantID:      grant.GrantID,
+			ProjectID:    grant.ProjectID,
+			GrantedOrgID: grant.GrantedOrgID,
+			RoleKeys:     grant.GrantedRoleKeys,
+		}
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportUserGrants(ctx context.Context, orgID string) ([]*domain.ExportedUserGrant, error) {
+	resourceOwnerQuery, err := NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := q.UserGrants(ctx, &UserGrantsQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedUserGrant, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		exported[i] = &domain.ExportedUserGrant{
+			UserGrantID:    grant.ID,
+			UserID:         grant.UserID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.GrantID,
+			RoleKeys:       grant.Roles,
+		}
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportCustomTexts(ctx context.Context, orgID string) ([]*domain.ExportedCustomText, error) {
+	texts, err := q.CustomTextListByAggregateID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedCustomText, len(texts.CustomTexts))
+	for i, text := range texts.CustomTexts {
+		exported[i] = &domain.ExportedCustomText{
+			Template: text.Template,
+			Language: text.Language.String(),
+			Key:      text.Key,
+			Text:     text.Text,
+		}
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportActions(ctx context.Context, orgID string) ([]*domain.ExportedAction, error) {
+	resourceOwnerQuery, err := NewActionResourceOwnerQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := q.SearchActions(ctx, &ActionSearchQueries{Queries: []SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]*domain.ExportedAction, len(actions.Actions))
+	for i, action := range actions.Actions {
+		exported[i] = &domain.ExportedAction{
+			ActionID:      action.ID,
+			Name:          action.Name,
+			Script:        action.Script,
+			Timeout:       action.Timeout,
+			AllowedToFail: action.AllowedToFail,
+		}
+	}
+	return exported, nil
+}
+
+func (q *Queries) exportFlows(ctx context.Context, orgID string) ([]*domain.ExportedFlow, error) {
+	exported := make([]*domain.ExportedFlow, 0)
+	for flowType := domain.FlowTypeUnspecified + 1; flowType.Valid(); flowType++ {
+		flow, err := q.GetFlow(ctx, flowType, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if exportedFlow := flowToExport(flowType, flow); exportedFlow != nil {
+			exported = append(exported, exportedFlow)
+		}
+	}
+	return exported, nil
+}
+
+func flowToExport(flowType domain.FlowType, flow *Flow) *domain.ExportedFlow {
+	exported := &domain.ExportedFlow{Type: flowType}
+	for triggerType := domain.TriggerTypeUnspecified + 1; triggerType.Valid(); triggerType++ {
+		actions := flow.TriggerActions[triggerType]
+		if len(actions) == 0 {
+			continue
+		}
+		trigger := &domain.ExportedTriggerAction{
+			TriggerType: triggerType,
+			ActionIDs:   make([]string, len(actions)),
+		}
+		for i, action := range actions {
+			trigger.ActionIDs[i] = action.ID
+		}
+		exported.Triggers = append(exported.Triggers, trigger)
+	}
+	if len(exported.Triggers) == 0 {
+		return nil
+	}
+	return exported
+}
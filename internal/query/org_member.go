@@ -40,6 +40,10 @@ var (
 		name:  projection.MemberResourceOwner,
 		table: orgMemberTable,
 	}
+	OrgMemberExpirationDate = Column{
+		name:  projection.MemberExpirationDateCol,
+		table: orgMemberTable,
+	}
 	OrgMemberOrgID = Column{
 		name:  projection.OrgMemberOrgIDCol,
 		table: orgMemberTable,
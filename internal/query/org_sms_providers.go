@@ -0,0 +1,114 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+//OrgSMSProviders are the sms providers selected by the org in the order they are used
+type OrgSMSProviders struct {
+	OrgID       string
+	ChangeDate  time.Time
+	Sequence    uint64
+	ProviderIDs []string
+}
+
+var (
+	orgSMSProvidersTable = table{
+		name: projection.OrgSMSProvidersProjectionTable,
+	}
+	OrgSMSProvidersColumnOrgID = Column{
+		name:  projection.OrgSMSProvidersColumnOrgID,
+		table: orgSMSProvidersTable,
+	}
+	OrgSMSProvidersColumnChangeDate = Column{
+		name:  projection.OrgSMSProvidersColumnChangeDate,
+		table: orgSMSProvidersTable,
+	}
+	OrgSMSProvidersColumnSequence = Column{
+		name:  projection.OrgSMSProvidersColumnSequence,
+		table: orgSMSProvidersTable,
+	}
+	OrgSMSProvidersColumnProviderIDs = Column{
+		name:  projection.OrgSMSProvidersColumnProviderIDs,
+		table: orgSMSProvidersTable,
+	}
+)
+
+func (q *Queries) OrgSMSProvidersByOrgID(ctx context.Context, orgID string) (*OrgSMSProviders, error) {
+	query, scan := prepareOrgSMSProvidersQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			OrgSMSProvidersColumnOrgID.identifier(): orgID,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Op93m", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+//SMSProviderConfigsByIDs returns the sms configs in the order of the ids,
+//ids of removed configs are ignored
+func (q *Queries) SMSProviderConfigsByIDs(ctx context.Context, ids []string) ([]*SMSConfig, error) {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	idQuery, err := NewListQuery(SMSConfigColumnID, values, ListIn)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := q.SearchSMSConfigs(ctx, &SMSConfigsSearchQueries{Queries: []SearchQuery{idQuery}})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*SMSConfig, len(configs.Configs))
+	for _, config := range configs.Configs {
+		byID[config.ID] = config
+	}
+	ordered := make([]*SMSConfig, 0, len(ids))
+	for _, id := range ids {
+		if config, ok := byID[id]; ok {
+			ordered = append(ordered, config)
+		}
+	}
+	return ordered, nil
+}
+
+func prepareOrgSMSProvidersQuery() (sq.SelectBuilder, func(*sql.Row) (*OrgSMSProviders, error)) {
+	return sq.Select(
+			OrgSMSProvidersColumnOrgID.identifier(),
+			OrgSMSProvidersColumnChangeDate.identifier(),
+			OrgSMSProvidersColumnSequence.identifier(),
+			OrgSMSProvidersColumnProviderIDs.identifier(),
+		).From(orgSMSProvidersTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*OrgSMSProviders, error) {
+			providers := new(OrgSMSProviders)
+			providerIDs := pq.StringArray{}
+			err := row.Scan(
+				&providers.OrgID,
+				&providers.ChangeDate,
+				&providers.Sequence,
+				&providerIDs,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Pn93k", "Errors.SMSConfig.ProvidersNotSet")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Ps02m", "Errors.Internal")
+			}
+			providers.ProviderIDs = providerIDs
+			return providers, nil
+		}
+}
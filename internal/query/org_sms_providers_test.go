@@ -0,0 +1,107 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/lib/pq"
+
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+func Test_OrgSMSProvidersPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareOrgSMSProvidersQuery no result",
+			prepare: prepareOrgSMSProvidersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.org_sms_providers.org_id,`+
+						` zitadel.projections.org_sms_providers.change_date,`+
+						` zitadel.projections.org_sms_providers.sequence,`+
+						` zitadel.projections.org_sms_providers.provider_ids`+
+						` FROM zitadel.projections.org_sms_providers`),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*OrgSMSProviders)(nil),
+		},
+		{
+			name:    "prepareOrgSMSProvidersQuery found",
+			prepare: prepareOrgSMSProvidersQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.org_sms_providers.org_id,`+
+						` zitadel.projections.org_sms_providers.change_date,`+
+						` zitadel.projections.org_sms_providers.sequence,`+
+						` zitadel.projections.org_sms_providers.provider_ids`+
+						` FROM zitadel.projections.org_sms_providers`),
+					[]string{
+						"org_id",
+						"change_date",
+						"sequence",
+						"provider_ids",
+					},
+					[]driver.Value{
+						"org-id",
+						testNow,
+						uint64(20211109),
+						pq.StringArray{"provider1", "provider2"},
+					},
+				),
+			},
+			object: &OrgSMSProviders{
+				OrgID:       "org-id",
+				ChangeDate:  testNow,
+				Sequence:    20211109,
+				ProviderIDs: []string{"provider1", "provider2"},
+			},
+		},
+		{
+			name:    "prepareOrgSMSProvidersQuery sql err",
+			prepare: prepareOrgSMSProvidersQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(`SELECT zitadel.projections.org_sms_providers.org_id,`+
+						` zitadel.projections.org_sms_providers.change_date,`+
+						` zitadel.projections.org_sms_providers.sequence,`+
+						` zitadel.projections.org_sms_providers.provider_ids`+
+						` FROM zitadel.projections.org_sms_providers`),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -20,11 +20,12 @@ type PasswordComplexityPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	MinLength    uint64
-	HasLowercase bool
-	HasUppercase bool
-	HasNumber    bool
-	HasSymbol    bool
+	MinLength     uint64
+	HasLowercase  bool
+	HasUppercase  bool
+	HasNumber     bool
+	HasSymbol     bool
+	CheckBreached bool
 
 	IsDefault bool
 }
@@ -109,6 +110,10 @@ var (
 		name:  projection.ComplexityPolicyHasSymbolCol,
 		table: passwordComplexityTable,
 	}
+	PasswordComplexityColCheckBreached = Column{
+		name:  projection.ComplexityPolicyCheckBreachedCol,
+		table: passwordComplexityTable,
+	}
 	PasswordComplexityColIsDefault = Column{
 		name:  projection.ComplexityPolicyIsDefaultCol,
 		table: passwordComplexityTable,
@@ -131,6 +136,7 @@ func preparePasswordComplexityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*
 			PasswordComplexityColHasUpperCase.identifier(),
 			PasswordComplexityColHasNumber.identifier(),
 			PasswordComplexityColHasSymbol.identifier(),
+			PasswordComplexityColCheckBreached.identifier(),
 			PasswordComplexityColIsDefault.identifier(),
 			PasswordComplexityColState.identifier(),
 		).
@@ -148,6 +154,7 @@ func preparePasswordComplexityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*
 				&policy.HasUppercase,
 				&policy.HasNumber,
 				&policy.HasSymbol,
+				&policy.CheckBreached,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -38,6 +38,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						` zitadel.projections.password_complexity_policies.has_uppercase,`+
 						` zitadel.projections.password_complexity_policies.has_number,`+
 						` zitadel.projections.password_complexity_policies.has_symbol,`+
+						` zitadel.projections.password_complexity_policies.check_breached,`+
 						` zitadel.projections.password_complexity_policies.is_default,`+
 						` zitadel.projections.password_complexity_policies.state`+
 						` FROM zitadel.projections.password_complexity_policies`),
@@ -68,6 +69,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						` zitadel.projections.password_complexity_policies.has_uppercase,`+
 						` zitadel.projections.password_complexity_policies.has_number,`+
 						` zitadel.projections.password_complexity_policies.has_symbol,`+
+						` zitadel.projections.password_complexity_policies.check_breached,`+
 						` zitadel.projections.password_complexity_policies.is_default,`+
 						` zitadel.projections.password_complexity_policies.state`+
 						` FROM zitadel.projections.password_complexity_policies`),
@@ -82,6 +84,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						"has_uppercase",
 						"has_number",
 						"has_symbol",
+						"check_breached",
 						"is_default",
 						"state",
 					},
@@ -97,6 +100,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						true,
 						true,
 						true,
+						true,
 						domain.PolicyStateActive,
 					},
 				),
@@ -113,6 +117,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 				HasUppercase:  true,
 				HasNumber:     true,
 				HasSymbol:     true,
+				CheckBreached: true,
 				IsDefault:     true,
 			},
 		},
@@ -131,6 +136,7 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						` zitadel.projections.password_complexity_policies.has_uppercase,`+
 						` zitadel.projections.password_complexity_policies.has_number,`+
 						` zitadel.projections.password_complexity_policies.has_symbol,`+
+						` zitadel.projections.password_complexity_policies.check_breached,`+
 						` zitadel.projections.password_complexity_policies.is_default,`+
 						` zitadel.projections.password_complexity_policies.state`+
 						` FROM zitadel.projections.password_complexity_policies`),
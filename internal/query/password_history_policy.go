@@ -0,0 +1,134 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+type PasswordHistoryPolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.PolicyState
+
+	HistoryCount uint64
+
+	IsDefault bool
+}
+
+var (
+	passwordHistoryTable = table{
+		name: projection.PasswordHistoryTable,
+	}
+	PasswordHistoryColID = Column{
+		name:  projection.HistoryPolicyIDCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColSequence = Column{
+		name:  projection.HistoryPolicySequenceCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColCreationDate = Column{
+		name:  projection.HistoryPolicyCreationDateCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColChangeDate = Column{
+		name:  projection.HistoryPolicyChangeDateCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColResourceOwner = Column{
+		name:  projection.HistoryPolicyResourceOwnerCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColHistoryCount = Column{
+		name:  projection.HistoryPolicyHistoryCountCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColIsDefault = Column{
+		name:  projection.HistoryPolicyIsDefaultCol,
+		table: passwordHistoryTable,
+	}
+	PasswordHistoryColState = Column{
+		name:  projection.HistoryPolicyStateCol,
+		table: passwordHistoryTable,
+	}
+)
+
+func (q *Queries) PasswordHistoryPolicyByOrg(ctx context.Context, orgID string) (*PasswordHistoryPolicy, error) {
+	stmt, scan := preparePasswordHistoryPolicyQuery()
+	query, args, err := stmt.Where(
+		sq.Or{
+			sq.Eq{
+				PasswordHistoryColID.identifier(): orgID,
+			},
+			sq.Eq{
+				PasswordHistoryColID.identifier(): q.iamID,
+			},
+		}).
+		OrderBy(PasswordHistoryColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Jh8rw", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func (q *Queries) DefaultPasswordHistoryPolicy(ctx context.Context) (*PasswordHistoryPolicy, error) {
+	stmt, scan := preparePasswordHistoryPolicyQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		PasswordHistoryColID.identifier(): q.iamID,
+	}).
+		OrderBy(PasswordHistoryColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Bv5pk", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+func preparePasswordHistoryPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*PasswordHistoryPolicy, error)) {
+	return sq.Select(
+			PasswordHistoryColID.identifier(),
+			PasswordHistoryColSequence.identifier(),
+			PasswordHistoryColCreationDate.identifier(),
+			PasswordHistoryColChangeDate.identifier(),
+			PasswordHistoryColResourceOwner.identifier(),
+			PasswordHistoryColHistoryCount.identifier(),
+			PasswordHistoryColIsDefault.identifier(),
+			PasswordHistoryColState.identifier(),
+		).
+			From(passwordHistoryTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*PasswordHistoryPolicy, error) {
+			policy := new(PasswordHistoryPolicy)
+			err := row.Scan(
+				&policy.ID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.HistoryCount,
+				&policy.IsDefault,
+				&policy.State,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Tr3nd", "Errors.Org.PasswordHistoryPolicy.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Wq2lf", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
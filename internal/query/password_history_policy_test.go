@@ -0,0 +1,130 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/caos/zitadel/internal/domain"
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+func Test_PasswordHistoryPolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "preparePasswordHistoryPolicyQuery no result",
+			prepare: preparePasswordHistoryPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.password_history_policies.id,`+
+						` zitadel.projections.password_history_policies.sequence,`+
+						` zitadel.projections.password_history_policies.creation_date,`+
+						` zitadel.projections.password_history_policies.change_date,`+
+						` zitadel.projections.password_history_policies.resource_owner,`+
+						` zitadel.projections.password_history_policies.history_count,`+
+						` zitadel.projections.password_history_policies.is_default,`+
+						` zitadel.projections.password_history_policies.state`+
+						` FROM zitadel.projections.password_history_policies`),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*PasswordHistoryPolicy)(nil),
+		},
+		{
+			name:    "preparePasswordHistoryPolicyQuery found",
+			prepare: preparePasswordHistoryPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.password_history_policies.id,`+
+						` zitadel.projections.password_history_policies.sequence,`+
+						` zitadel.projections.password_history_policies.creation_date,`+
+						` zitadel.projections.password_history_policies.change_date,`+
+						` zitadel.projections.password_history_policies.resource_owner,`+
+						` zitadel.projections.password_history_policies.history_count,`+
+						` zitadel.projections.password_history_policies.is_default,`+
+						` zitadel.projections.password_history_policies.state`+
+						` FROM zitadel.projections.password_history_policies`),
+					[]string{
+						"id",
+						"sequence",
+						"creation_date",
+						"change_date",
+						"resource_owner",
+						"history_count",
+						"is_default",
+						"state",
+					},
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						5,
+						true,
+						domain.PolicyStateActive,
+					},
+				),
+			},
+			object: &PasswordHistoryPolicy{
+				ID:            "pol-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				ResourceOwner: "ro",
+				State:         domain.PolicyStateActive,
+				HistoryCount:  5,
+				IsDefault:     true,
+			},
+		},
+		{
+			name:    "preparePasswordHistoryPolicyQuery sql err",
+			prepare: preparePasswordHistoryPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(`SELECT zitadel.projections.password_history_policies.id,`+
+						` zitadel.projections.password_history_policies.sequence,`+
+						` zitadel.projections.password_history_policies.creation_date,`+
+						` zitadel.projections.password_history_policies.change_date,`+
+						` zitadel.projections.password_history_policies.resource_owner,`+
+						` zitadel.projections.password_history_policies.history_count,`+
+						` zitadel.projections.password_history_policies.is_default,`+
+						` zitadel.projections.password_history_policies.state`+
+						` FROM zitadel.projections.password_history_policies`),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -42,6 +42,14 @@ var (
 		name:  projection.ProjectColumnPrivateLabelingSetting,
 		table: projectsTable,
 	}
+	ProjectColumnTokenExchangeDelegation = Column{
+		name:  projection.ProjectColumnTokenExchangeDelegation,
+		table: projectsTable,
+	}
+	ProjectColumnTokenExchangeImpersonation = Column{
+		name:  projection.ProjectColumnTokenExchangeImpersonation,
+		table: projectsTable,
+	}
 	ProjectColumnCreationDate = Column{
 		name:  projection.ProjectColumnCreationDate,
 		table: projectsTable,
@@ -81,11 +89,13 @@ type Project struct {
 	State         domain.ProjectState
 	Sequence      uint64
 
-	Name                   string
-	ProjectRoleAssertion   bool
-	ProjectRoleCheck       bool
-	HasProjectCheck        bool
-	PrivateLabelingSetting domain.PrivateLabelingSetting
+	Name                       string
+	ProjectRoleAssertion       bool
+	ProjectRoleCheck           bool
+	HasProjectCheck            bool
+	PrivateLabelingSetting     domain.PrivateLabelingSetting
+	TokenExchangeDelegation    bool
+	TokenExchangeImpersonation bool
 }
 
 type ProjectSearchQueries struct {
@@ -187,7 +197,9 @@ func prepareProjectQuery() (sq.SelectBuilder, func(*sql.Row) (*Project, error))
 			ProjectColumnProjectRoleAssertion.identifier(),
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
-			ProjectColumnPrivateLabelingSetting.identifier()).
+			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnTokenExchangeDelegation.identifier(),
+			ProjectColumnTokenExchangeImpersonation.identifier()).
 			From(projectsTable.identifier()).PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Project, error) {
 			p := new(Project)
@@ -203,6 +215,8 @@ func prepareProjectQuery() (sq.SelectBuilder, func(*sql.Row) (*Project, error))
 				&p.ProjectRoleCheck,
 				&p.HasProjectCheck,
 				&p.PrivateLabelingSetting,
+				&p.TokenExchangeDelegation,
+				&p.TokenExchangeImpersonation,
 			)
 			if err != nil {
 				if errs.Is(err, sql.ErrNoRows) {
@@ -227,6 +241,8 @@ func prepareProjectsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Projects, error
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
 			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnTokenExchangeDelegation.identifier(),
+			ProjectColumnTokenExchangeImpersonation.identifier(),
 			countColumn.identifier()).
 			From(projectsTable.identifier()).PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*Projects, error) {
@@ -246,6 +262,8 @@ func prepareProjectsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Projects, error
 					&project.ProjectRoleCheck,
 					&project.HasProjectCheck,
 					&project.PrivateLabelingSetting,
+					&project.TokenExchangeDelegation,
+					&project.TokenExchangeImpersonation,
 					&count,
 				)
 				if err != nil {
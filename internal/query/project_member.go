@@ -40,6 +40,10 @@ var (
 		name:  projection.MemberResourceOwner,
 		table: projectMemberTable,
 	}
+	ProjectMemberExpirationDate = Column{
+		name:  projection.MemberExpirationDateCol,
+		table: projectMemberTable,
+	}
 	ProjectMemberProjectID = Column{
 		name:  projection.ProjectMemberProjectIDCol,
 		table: projectMemberTable,
@@ -25,6 +25,8 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"token_exchange_delegation",
+		"token_exchange_impersonation",
 	}
 )
 
@@ -55,6 +57,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
 						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.projects`),
 					nil,
@@ -79,6 +83,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
 						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.projects`),
 					[]string{
@@ -93,6 +99,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						"project_role_check",
 						"has_project_check",
 						"private_labeling_setting",
+						"token_exchange_delegation",
+						"token_exchange_impersonation",
 						"count",
 					},
 					[][]driver.Value{
@@ -108,6 +116,8 @@ func Test_ProjectPrepares(t *testing.T) {
 							true,
 							true,
 							domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+							true,
+							false,
 						},
 					},
 				),
@@ -118,17 +128,18 @@ func Test_ProjectPrepares(t *testing.T) {
 				},
 				Projects: []*Project{
 					{
-						ID:                     "id",
-						CreationDate:           testNow,
-						ChangeDate:             testNow,
-						ResourceOwner:          "ro",
-						State:                  domain.ProjectStateActive,
-						Sequence:               20211108,
-						Name:                   "project-name",
-						ProjectRoleAssertion:   true,
-						ProjectRoleCheck:       true,
-						HasProjectCheck:        true,
-						PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						ID:                      "id",
+						CreationDate:            testNow,
+						ChangeDate:              testNow,
+						ResourceOwner:           "ro",
+						State:                   domain.ProjectStateActive,
+						Sequence:                20211108,
+						Name:                    "project-name",
+						ProjectRoleAssertion:    true,
+						ProjectRoleCheck:        true,
+						HasProjectCheck:         true,
+						PrivateLabelingSetting:  domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						TokenExchangeDelegation: true,
 					},
 				},
 			},
@@ -149,6 +160,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
 						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.projects`),
 					[]string{
@@ -163,6 +176,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						"project_role_check",
 						"has_project_check",
 						"private_labeling_setting",
+						"token_exchange_delegation",
+						"token_exchange_impersonation",
 						"count",
 					},
 					[][]driver.Value{
@@ -178,6 +193,8 @@ func Test_ProjectPrepares(t *testing.T) {
 							true,
 							true,
 							domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+							true,
+							false,
 						},
 						{
 							"id-2",
@@ -191,6 +208,8 @@ func Test_ProjectPrepares(t *testing.T) {
 							false,
 							false,
 							domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+							true,
+							false,
 						},
 					},
 				),
@@ -201,30 +220,32 @@ func Test_ProjectPrepares(t *testing.T) {
 				},
 				Projects: []*Project{
 					{
-						ID:                     "id-1",
-						CreationDate:           testNow,
-						ChangeDate:             testNow,
-						ResourceOwner:          "ro",
-						State:                  domain.ProjectStateActive,
-						Sequence:               20211108,
-						Name:                   "project-name-1",
-						ProjectRoleAssertion:   true,
-						ProjectRoleCheck:       true,
-						HasProjectCheck:        true,
-						PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						ID:                      "id-1",
+						CreationDate:            testNow,
+						ChangeDate:              testNow,
+						ResourceOwner:           "ro",
+						State:                   domain.ProjectStateActive,
+						Sequence:                20211108,
+						Name:                    "project-name-1",
+						ProjectRoleAssertion:    true,
+						ProjectRoleCheck:        true,
+						HasProjectCheck:         true,
+						PrivateLabelingSetting:  domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						TokenExchangeDelegation: true,
 					},
 					{
-						ID:                     "id-2",
-						CreationDate:           testNow,
-						ChangeDate:             testNow,
-						ResourceOwner:          "ro",
-						State:                  domain.ProjectStateActive,
-						Sequence:               20211108,
-						Name:                   "project-name-2",
-						ProjectRoleAssertion:   false,
-						ProjectRoleCheck:       false,
-						HasProjectCheck:        false,
-						PrivateLabelingSetting: domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						ID:                      "id-2",
+						CreationDate:            testNow,
+						ChangeDate:              testNow,
+						ResourceOwner:           "ro",
+						State:                   domain.ProjectStateActive,
+						Sequence:                20211108,
+						Name:                    "project-name-2",
+						ProjectRoleAssertion:    false,
+						ProjectRoleCheck:        false,
+						HasProjectCheck:         false,
+						PrivateLabelingSetting:  domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						TokenExchangeDelegation: true,
 					},
 				},
 			},
@@ -245,6 +266,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
 						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation,`+
 						` COUNT(*) OVER ()`+
 						` FROM zitadel.projections.projects`),
 					sql.ErrConnDone,
@@ -273,7 +296,9 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_assertion,`+
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
-						` zitadel.projections.projects.private_labeling_setting`+
+						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation`+
 						` FROM zitadel.projections.projects`,
 					nil,
 					nil,
@@ -302,7 +327,9 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_assertion,`+
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
-						` zitadel.projections.projects.private_labeling_setting`+
+						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation`+
 						` FROM zitadel.projections.projects`),
 					[]string{
 						"id",
@@ -316,6 +343,8 @@ func Test_ProjectPrepares(t *testing.T) {
 						"project_role_check",
 						"has_project_check",
 						"private_labeling_setting",
+						"token_exchange_delegation",
+						"token_exchange_impersonation",
 					},
 					[]driver.Value{
 						"id",
@@ -329,21 +358,24 @@ func Test_ProjectPrepares(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						true,
+						false,
 					},
 				),
 			},
 			object: &Project{
-				ID:                     "id",
-				CreationDate:           testNow,
-				ChangeDate:             testNow,
-				ResourceOwner:          "ro",
-				State:                  domain.ProjectStateActive,
-				Sequence:               20211108,
-				Name:                   "project-name",
-				ProjectRoleAssertion:   true,
-				ProjectRoleCheck:       true,
-				HasProjectCheck:        true,
-				PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+				ID:                      "id",
+				CreationDate:            testNow,
+				ChangeDate:              testNow,
+				ResourceOwner:           "ro",
+				State:                   domain.ProjectStateActive,
+				Sequence:                20211108,
+				Name:                    "project-name",
+				ProjectRoleAssertion:    true,
+				ProjectRoleCheck:        true,
+				HasProjectCheck:         true,
+				PrivateLabelingSetting:  domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+				TokenExchangeDelegation: true,
 			},
 		},
 		{
@@ -361,7 +393,9 @@ func Test_ProjectPrepares(t *testing.T) {
 						` zitadel.projections.projects.project_role_assertion,`+
 						` zitadel.projections.projects.project_role_check,`+
 						` zitadel.projections.projects.has_project_check,`+
-						` zitadel.projections.projects.private_labeling_setting`+
+						` zitadel.projections.projects.private_labeling_setting,`+
+						` zitadel.projections.projects.token_exchange_delegation,`+
+						` zitadel.projections.projects.token_exchange_impersonation`+
 						` FROM zitadel.projections.projects`),
 					sql.ErrConnDone,
 				),
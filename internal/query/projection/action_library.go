@@ -0,0 +1,173 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/action"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+const (
+	ActionLibraryTable            = "zitadel.projections.action_libraries"
+	ActionLibraryVersionTable     = ActionLibraryTable + "_" + actionLibraryVersionTableSuffix
+	ActionLibraryIDCol            = "id"
+	ActionLibraryCreationDateCol  = "creation_date"
+	ActionLibraryChangeDateCol    = "change_date"
+	ActionLibraryResourceOwnerCol = "resource_owner"
+	ActionLibraryStateCol         = "state"
+	ActionLibrarySequenceCol      = "sequence"
+	ActionLibraryNameCol          = "name"
+	ActionLibraryVersionCol       = "version"
+	ActionLibraryScriptCol        = "script"
+
+	actionLibraryVersionTableSuffix      = "versions"
+	ActionLibraryVersionLibraryIDCol     = "library_id"
+	ActionLibraryVersionVersionCol       = "version"
+	ActionLibraryVersionScriptCol        = "script"
+	ActionLibraryVersionCreationDateCol  = "creation_date"
+	ActionLibraryVersionResourceOwnerCol = "resource_owner"
+)
+
+type ActionLibraryProjection struct {
+	crdb.StatementHandler
+}
+
+func NewActionLibraryProjection(ctx context.Context, config crdb.StatementHandlerConfig) *ActionLibraryProjection {
+	p := &ActionLibraryProjection{}
+	config.ProjectionName = ActionLibraryTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *ActionLibraryProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: action.LibraryAggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  action.LibraryAddedEventType,
+					Reduce: p.reduceLibraryAdded,
+				},
+				{
+					Event:  action.LibraryChangedEventType,
+					Reduce: p.reduceLibraryChanged,
+				},
+				{
+					Event:  action.LibraryRemovedEventType,
+					Reduce: p.reduceLibraryRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *ActionLibraryProjection) reduceLibraryAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*action.LibraryAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Lb3qa", "seq", event.Sequence(), "expectedType", action.LibraryAddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Lb7ws", "reduce.wrong.event.type")
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(ActionLibraryIDCol, e.Aggregate().ID),
+				handler.NewCol(ActionLibraryCreationDateCol, e.CreationDate()),
+				handler.NewCol(ActionLibraryChangeDateCol, e.CreationDate()),
+				handler.NewCol(ActionLibraryResourceOwnerCol, e.Aggregate().ResourceOwner),
+				handler.NewCol(ActionLibrarySequenceCol, e.Sequence()),
+				handler.NewCol(ActionLibraryStateCol, domain.ActionLibraryStateActive),
+				handler.NewCol(ActionLibraryNameCol, e.Name),
+				handler.NewCol(ActionLibraryVersionCol, 1),
+				handler.NewCol(ActionLibraryScriptCol, e.Script),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(ActionLibraryVersionLibraryIDCol, e.Aggregate().ID),
+				handler.NewCol(ActionLibraryVersionVersionCol, 1),
+				handler.NewCol(ActionLibraryVersionScriptCol, e.Script),
+				handler.NewCol(ActionLibraryVersionCreationDateCol, e.CreationDate()),
+				handler.NewCol(ActionLibraryVersionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			},
+			crdb.WithTableSuffix(actionLibraryVersionTableSuffix),
+		),
+	), nil
+}
+
+func (p *ActionLibraryProjection) reduceLibraryChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*action.LibraryChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Lb5kd", "seq", event.Sequence(), "expectedType", action.LibraryChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Lb2xs", "reduce.wrong.event.type")
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(ActionLibraryChangeDateCol, e.CreationDate()),
+				handler.NewCol(ActionLibrarySequenceCol, e.Sequence()),
+				handler.NewCol(ActionLibraryVersionCol, e.Version),
+				handler.NewCol(ActionLibraryScriptCol, e.Script),
+			},
+			[]handler.Condition{
+				handler.NewCond(ActionLibraryIDCol, e.Aggregate().ID),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(ActionLibraryVersionLibraryIDCol, e.Aggregate().ID),
+				handler.NewCol(ActionLibraryVersionVersionCol, e.Version),
+				handler.NewCol(ActionLibraryVersionScriptCol, e.Script),
+				handler.NewCol(ActionLibraryVersionCreationDateCol, e.CreationDate()),
+				handler.NewCol(ActionLibraryVersionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			},
+			crdb.WithTableSuffix(actionLibraryVersionTableSuffix),
+		),
+	), nil
+}
+
+func (p *ActionLibraryProjection) reduceLibraryRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*action.LibraryRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Lb6nw", "seq", event.Sequence(), "expectedType", action.LibraryRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Lb4cz", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ActionLibraryIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *ActionLibraryProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Lb9rt", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Lb1vo", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ActionLibraryResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
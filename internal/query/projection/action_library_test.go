@@ -0,0 +1,179 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/action"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+func TestActionLibraryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceLibraryAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(action.LibraryAddedEventType),
+					action.LibraryAggregateType,
+					[]byte(`{"name": "helpers", "script":"exports.a = 1;"}`),
+				), action.LibraryAddedEventMapper),
+			},
+			reduce: (&ActionLibraryProjection{}).reduceLibraryAdded,
+			want: wantReduce{
+				projection:       ActionLibraryTable,
+				aggregateType:    eventstore.AggregateType("action_library"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.action_libraries (id, creation_date, change_date, resource_owner, sequence, state, name, version, script) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								domain.ActionLibraryStateActive,
+								"helpers",
+								1,
+								"exports.a = 1;",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.action_libraries_versions (library_id, version, script, creation_date, resource_owner) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								1,
+								"exports.a = 1;",
+								anyArg{},
+								"ro-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLibraryChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(action.LibraryChangedEventType),
+					action.LibraryAggregateType,
+					[]byte(`{"script":"exports.a = 2;", "version": 2}`),
+				), action.LibraryChangedEventMapper),
+			},
+			reduce: (&ActionLibraryProjection{}).reduceLibraryChanged,
+			want: wantReduce{
+				projection:       ActionLibraryTable,
+				aggregateType:    eventstore.AggregateType("action_library"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.action_libraries SET (change_date, sequence, version, script) = ($1, $2, $3, $4) WHERE (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								uint64(2),
+								"exports.a = 2;",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.action_libraries_versions (library_id, version, script, creation_date, resource_owner) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								uint64(2),
+								"exports.a = 2;",
+								anyArg{},
+								"ro-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceLibraryRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(action.LibraryRemovedEventType),
+					action.LibraryAggregateType,
+					[]byte(`{"name": "helpers"}`),
+				), action.LibraryRemovedEventMapper),
+			},
+			reduce: (&ActionLibraryProjection{}).reduceLibraryRemoved,
+			want: wantReduce{
+				projection:       ActionLibraryTable,
+				aggregateType:    eventstore.AggregateType("action_library"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.action_libraries WHERE (id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.OrgRemovedEventType",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&ActionLibraryProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType:    org.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       ActionLibraryTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.action_libraries WHERE (resource_owner = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
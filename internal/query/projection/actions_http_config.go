@@ -0,0 +1,70 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+const (
+	ActionsHTTPConfigProjectionTable = "zitadel.projections.actions_http_configs"
+
+	ActionsHTTPConfigColumnAggregateID     = "aggregate_id"
+	ActionsHTTPConfigColumnChangeDate      = "change_date"
+	ActionsHTTPConfigColumnResourceOwner   = "resource_owner"
+	ActionsHTTPConfigColumnSequence        = "sequence"
+	ActionsHTTPConfigColumnAllowList       = "allow_list"
+	ActionsHTTPConfigColumnMaxResponseSize = "max_response_size"
+)
+
+type ActionsHTTPConfigProjection struct {
+	crdb.StatementHandler
+}
+
+func NewActionsHTTPConfigProjection(ctx context.Context, config crdb.StatementHandlerConfig) *ActionsHTTPConfigProjection {
+	p := &ActionsHTTPConfigProjection{}
+	config.ProjectionName = ActionsHTTPConfigProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *ActionsHTTPConfigProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: iam.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  iam.ActionsHTTPConfigSetEventType,
+					Reduce: p.reduceActionsHTTPConfigSet,
+				},
+			},
+		},
+	}
+}
+
+func (p *ActionsHTTPConfigProjection) reduceActionsHTTPConfigSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.ActionsHTTPConfigSetEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Ah3ks", "seq", event.Sequence(), "expectedType", iam.ActionsHTTPConfigSetEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Ah9wq", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ActionsHTTPConfigColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(ActionsHTTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ActionsHTTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(ActionsHTTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(ActionsHTTPConfigColumnAllowList, pq.StringArray(e.AllowList)),
+			handler.NewCol(ActionsHTTPConfigColumnMaxResponseSize, e.MaxResponseSize),
+		},
+	), nil
+}
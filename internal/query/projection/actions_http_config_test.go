@@ -0,0 +1,103 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func TestActionsHTTPConfigProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceActionsHTTPConfigSet",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.ActionsHTTPConfigSetEventType),
+					iam.AggregateType,
+					[]byte(`{"allowList": ["zitadel.ch", "*.caos.ch"], "maxResponseSize": 1024}`),
+				), iam.ActionsHTTPConfigSetEventMapper),
+			},
+			reduce: (&ActionsHTTPConfigProjection{}).reduceActionsHTTPConfigSet,
+			want: wantReduce{
+				projection:       ActionsHTTPConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPSERT INTO zitadel.projections.actions_http_configs (aggregate_id, change_date, resource_owner, sequence, allow_list, max_response_size) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								pq.StringArray{"zitadel.ch", "*.caos.ch"},
+								int64(1024),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceActionsHTTPConfigSet empty",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.ActionsHTTPConfigSetEventType),
+					iam.AggregateType,
+					[]byte(`{}`),
+				), iam.ActionsHTTPConfigSetEventMapper),
+			},
+			reduce: (&ActionsHTTPConfigProjection{}).reduceActionsHTTPConfigSet,
+			want: wantReduce{
+				projection:       ActionsHTTPConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPSERT INTO zitadel.projections.actions_http_configs (aggregate_id, change_date, resource_owner, sequence, allow_list, max_response_size) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								pq.StringArray(nil),
+								int64(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
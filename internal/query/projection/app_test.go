@@ -554,6 +554,112 @@ func TestAppProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "project.reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.SAMLConfigAddedType),
+					project.AggregateType,
+					[]byte(`{
+		            "appId": "app-id",
+					"entityId": "https://sp.example.com",
+					"metadata": "PHhtbD4=",
+				    "metadataUrl": "https://sp.example.com/metadata"
+				}`),
+				), project.SAMLConfigAddedEventMapper),
+			},
+			reduce: (&AppProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       AppProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.apps_saml_configs (app_id, entity_id, metadata, metadata_url) VALUES ($1, $2, $3, $4)",
+							expectedArgs: []interface{}{
+								"app-id",
+								"https://sp.example.com",
+								[]byte("<xml>"),
+								"https://sp.example.com/metadata",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.apps SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project.reduceSAMLConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.SAMLConfigChangedType),
+					project.AggregateType,
+					[]byte(`{
+		            "appId": "app-id",
+					"entityId": "https://sp.example.com",
+					"metadata": "PHhtbD4="
+				}`),
+				), project.SAMLConfigChangedEventMapper),
+			},
+			reduce: (&AppProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       AppProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.apps_saml_configs SET (entity_id, metadata) = ($1, $2) WHERE (app_id = $3)",
+							expectedArgs: []interface{}{
+								"https://sp.example.com",
+								[]byte("<xml>"),
+								"app-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.apps SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project.reduceSAMLConfigChanged noop",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(project.SAMLConfigChangedType),
+					project.AggregateType,
+					[]byte(`{
+		            "appId": "app-id"
+				}`),
+				), project.SAMLConfigChangedEventMapper),
+			},
+			reduce: (&AppProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("project"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       AppProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -57,6 +57,10 @@ func (p *CustomTextProjection) reducers() []handler.AggregateReducer {
 					Event:  org.CustomTextTemplateRemovedEventType,
 					Reduce: p.reduceTemplateRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -148,3 +152,17 @@ func (p *CustomTextProjection) reduceTemplateRemoved(event eventstore.Event) (*h
 			handler.NewCond(CustomTextLanguageCol, customTextEvent.Language.String()),
 		}), nil
 }
+
+func (p *CustomTextProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-GPGwr", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-KSjzE", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(CustomTextAggregateIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,155 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
+)
+
+const (
+	DeviceAuthProjectionTable = "zitadel.projections.device_authorizations"
+
+	DeviceAuthColumnID            = "id"
+	DeviceAuthColumnCreationDate  = "creation_date"
+	DeviceAuthColumnChangeDate    = "change_date"
+	DeviceAuthColumnResourceOwner = "resource_owner"
+	DeviceAuthColumnSequence      = "sequence"
+	DeviceAuthColumnState         = "state"
+	DeviceAuthColumnClientID      = "client_id"
+	DeviceAuthColumnDeviceCode    = "device_code"
+	DeviceAuthColumnUserCode      = "user_code"
+	DeviceAuthColumnExpires       = "expires"
+	DeviceAuthColumnScopes        = "scopes"
+	DeviceAuthColumnSubject       = "subject"
+	DeviceAuthColumnUserOrgID     = "user_org_id"
+	DeviceAuthColumnAudience      = "audience"
+	DeviceAuthColumnAMR           = "amr"
+	DeviceAuthColumnAuthTime      = "auth_time"
+)
+
+type DeviceAuthProjection struct {
+	crdb.StatementHandler
+}
+
+func NewDeviceAuthProjection(ctx context.Context, config crdb.StatementHandlerConfig) *DeviceAuthProjection {
+	p := &DeviceAuthProjection{}
+	config.ProjectionName = DeviceAuthProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *DeviceAuthProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: deviceauth.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  deviceauth.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  deviceauth.ApprovedEventType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  deviceauth.DeniedEventType,
+					Reduce: p.reduceDenied,
+				},
+				{
+					Event:  deviceauth.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *DeviceAuthProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.AddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-2m9fs", "seq", event.Sequence(), "expectedType", deviceauth.AddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Hm0sd", "reduce.wrong.event.type")
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(DeviceAuthColumnID, e.Aggregate().ID),
+			handler.NewCol(DeviceAuthColumnCreationDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+			handler.NewCol(DeviceAuthColumnState, domain.DeviceAuthStateInitiated),
+			handler.NewCol(DeviceAuthColumnClientID, e.ClientID),
+			handler.NewCol(DeviceAuthColumnDeviceCode, e.DeviceCode),
+			handler.NewCol(DeviceAuthColumnUserCode, e.UserCode),
+			handler.NewCol(DeviceAuthColumnExpires, e.Expires),
+			handler.NewCol(DeviceAuthColumnScopes, pq.StringArray(e.Scopes)),
+		},
+	), nil
+}
+
+func (p *DeviceAuthProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.ApprovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Wm0fs", "seq", event.Sequence(), "expectedType", deviceauth.ApprovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-3Nfs0", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+			handler.NewCol(DeviceAuthColumnState, domain.DeviceAuthStateApproved),
+			handler.NewCol(DeviceAuthColumnSubject, e.Subject),
+			handler.NewCol(DeviceAuthColumnUserOrgID, e.UserOrgID),
+			handler.NewCol(DeviceAuthColumnAudience, pq.StringArray(e.Audience)),
+			handler.NewCol(DeviceAuthColumnAMR, pq.StringArray(e.AMR)),
+			handler.NewCol(DeviceAuthColumnAuthTime, e.AuthTime),
+		},
+		[]handler.Condition{
+			handler.NewCond(DeviceAuthColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *DeviceAuthProjection) reduceDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.DeniedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pm2fs", "seq", event.Sequence(), "expectedType", deviceauth.DeniedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Km0ds", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(DeviceAuthColumnChangeDate, e.CreationDate()),
+			handler.NewCol(DeviceAuthColumnSequence, e.Sequence()),
+			handler.NewCol(DeviceAuthColumnState, domain.DeviceAuthStateDenied),
+		},
+		[]handler.Condition{
+			handler.NewCond(DeviceAuthColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *DeviceAuthProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*deviceauth.RemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Xm2ds", "seq", event.Sequence(), "expectedType", deviceauth.RemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-2Mfs9", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(DeviceAuthColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
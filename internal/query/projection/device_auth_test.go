@@ -0,0 +1,169 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
+)
+
+func TestDeviceAuthProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.AddedEventType),
+					deviceauth.AggregateType,
+					[]byte(`{"clientId": "client-id", "deviceCode": "device-code", "userCode": "user-code", "expires": "2021-11-12T10:00:00Z", "scopes": ["openid"]}`),
+				), deviceauth.AddedEventMapper),
+			},
+			reduce: (&DeviceAuthProjection{}).reduceAdded,
+			want: wantReduce{
+				projection:       DeviceAuthProjectionTable,
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.device_authorizations (id, creation_date, change_date, resource_owner, sequence, state, client_id, device_code, user_code, expires, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								domain.DeviceAuthStateInitiated,
+								"client-id",
+								"device-code",
+								"user-code",
+								anyArg{},
+								pq.StringArray{"openid"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApproved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.ApprovedEventType),
+					deviceauth.AggregateType,
+					[]byte(`{"subject": "user-id", "userOrgId": "org-id", "audience": ["project-id"], "amr": ["password"], "authTime": "2021-11-12T10:00:00Z"}`),
+				), deviceauth.ApprovedEventMapper),
+			},
+			reduce: (&DeviceAuthProjection{}).reduceApproved,
+			want: wantReduce{
+				projection:       DeviceAuthProjectionTable,
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.device_authorizations SET (change_date, sequence, state, subject, user_org_id, audience, amr, auth_time) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.DeviceAuthStateApproved,
+								"user-id",
+								"org-id",
+								pq.StringArray{"project-id"},
+								pq.StringArray{"password"},
+								anyArg{},
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDenied",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.DeniedEventType),
+					deviceauth.AggregateType,
+					nil,
+				), deviceauth.DeniedEventMapper),
+			},
+			reduce: (&DeviceAuthProjection{}).reduceDenied,
+			want: wantReduce{
+				projection:       DeviceAuthProjectionTable,
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.device_authorizations SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.DeviceAuthStateDenied,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(deviceauth.RemovedEventType),
+					deviceauth.AggregateType,
+					nil,
+				), deviceauth.RemovedEventMapper),
+			},
+			reduce: (&DeviceAuthProjection{}).reduceRemoved,
+			want: wantReduce{
+				projection:       DeviceAuthProjectionTable,
+				aggregateType:    deviceauth.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.device_authorizations WHERE (id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -45,6 +45,10 @@ func (p *FeatureProjection) reducers() []handler.AggregateReducer {
 					Event:  org.FeaturesRemovedEventType,
 					Reduce: p.reduceFeatureRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -206,3 +210,17 @@ func (p *FeatureProjection) reduceFeatureRemoved(event eventstore.Event) (*handl
 		},
 	), nil
 }
+
+func (p *FeatureProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-15Ubm", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-BgSWb", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(FeatureAggregateIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
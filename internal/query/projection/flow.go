@@ -46,6 +46,10 @@ func (p *FlowProjection) reducers() []handler.AggregateReducer {
 					Event:  org.FlowClearedEventType,
 					Reduce: p.reduceFlowClearedEventType,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 	}
@@ -91,3 +95,17 @@ func (p *FlowProjection) reduceFlowClearedEventType(event eventstore.Event) (*ha
 		},
 	), nil
 }
+
+func (p *FlowProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-kjB3U", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-4ng1U", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(FlowResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
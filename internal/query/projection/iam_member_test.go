@@ -1,6 +1,7 @@
 package projection
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/caos/zitadel/internal/errors"
@@ -43,7 +44,7 @@ func TestIAMMemberProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.iam_members (user_id, roles, creation_date, change_date, sequence, resource_owner, iam_id) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedStmt: "INSERT INTO zitadel.projections.iam_members (user_id, roles, creation_date, change_date, sequence, resource_owner, expiration_date, iam_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
 							expectedArgs: []interface{}{
 								"user-id",
 								pq.StringArray{"role"},
@@ -51,6 +52,7 @@ func TestIAMMemberProjection_reduces(t *testing.T) {
 								anyArg{},
 								uint64(15),
 								"ro-id",
+								sql.NullTime{},
 								"agg-id",
 							},
 						},
@@ -23,6 +23,8 @@ const (
 	IDPTable     = "zitadel.projections.idps"
 	IDPOIDCTable = IDPTable + "_" + IDPOIDCSuffix
 	IDPJWTTable  = IDPTable + "_" + IDPJWTSuffix
+	IDPSAMLTable = IDPTable + "_" + IDPSAMLSuffix
+	IDPLDAPTable = IDPTable + "_" + IDPLDAPSuffix
 )
 
 func NewIDPProjection(ctx context.Context, config crdb.StatementHandlerConfig) *IDPProjection {
@@ -74,6 +76,22 @@ func (p *IDPProjection) reducers() []handler.AggregateReducer {
 					Event:  iam.IDPJWTConfigChangedEventType,
 					Reduce: p.reduceJWTConfigChanged,
 				},
+				{
+					Event:  iam.IDPSAMLConfigAddedEventType,
+					Reduce: p.reduceSAMLConfigAdded,
+				},
+				{
+					Event:  iam.IDPSAMLConfigChangedEventType,
+					Reduce: p.reduceSAMLConfigChanged,
+				},
+				{
+					Event:  iam.IDPLDAPConfigAddedEventType,
+					Reduce: p.reduceLDAPConfigAdded,
+				},
+				{
+					Event:  iam.IDPLDAPConfigChangedEventType,
+					Reduce: p.reduceLDAPConfigChanged,
+				},
 			},
 		},
 		{
@@ -115,6 +133,26 @@ func (p *IDPProjection) reducers() []handler.AggregateReducer {
 					Event:  org.IDPJWTConfigChangedEventType,
 					Reduce: p.reduceJWTConfigChanged,
 				},
+				{
+					Event:  org.IDPSAMLConfigAddedEventType,
+					Reduce: p.reduceSAMLConfigAdded,
+				},
+				{
+					Event:  org.IDPSAMLConfigChangedEventType,
+					Reduce: p.reduceSAMLConfigChanged,
+				},
+				{
+					Event:  org.IDPLDAPConfigAddedEventType,
+					Reduce: p.reduceLDAPConfigAdded,
+				},
+				{
+					Event:  org.IDPLDAPConfigChangedEventType,
+					Reduce: p.reduceLDAPConfigChanged,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 	}
@@ -123,6 +161,8 @@ func (p *IDPProjection) reducers() []handler.AggregateReducer {
 const (
 	IDPOIDCSuffix = "oidc_config"
 	IDPJWTSuffix  = "jwt_config"
+	IDPSAMLSuffix = "saml_config"
+	IDPLDAPSuffix = "ldap_config"
 
 	IDPIDCol            = "id"
 	IDPCreationDateCol  = "creation_date"
@@ -151,6 +191,36 @@ const (
 	JWTConfigKeysEndpointCol = "keys_endpoint"
 	JWTConfigHeaderNameCol   = "header_name"
 	JWTConfigEndpointCol     = "endpoint"
+
+	SAMLConfigIDPIDCol                = "idp_id"
+	SAMLConfigMetadataURLCol          = "metadata_url"
+	SAMLConfigMetadataCol             = "metadata"
+	SAMLConfigKeyCol                  = "key"
+	SAMLConfigCertificateCol          = "certificate"
+	SAMLConfigBindingCol              = "binding"
+	SAMLConfigWithSignedRequestCol    = "with_signed_request"
+	SAMLConfigNameIDFormatCol         = "name_id_format"
+	SAMLConfigUsernameAttributeCol    = "username_attribute"
+	SAMLConfigDisplayNameAttributeCol = "display_name_attribute"
+	SAMLConfigFirstNameAttributeCol   = "first_name_attribute"
+	SAMLConfigLastNameAttributeCol    = "last_name_attribute"
+	SAMLConfigEmailAttributeCol       = "email_attribute"
+	SAMLConfigPhoneAttributeCol       = "phone_attribute"
+
+	LDAPConfigIDPIDCol                = "idp_id"
+	LDAPConfigURLCol                  = "url"
+	LDAPConfigStartTLSCol             = "start_tls"
+	LDAPConfigBindDNCol               = "bind_dn"
+	LDAPConfigBindPasswordCol         = "bind_password"
+	LDAPConfigBaseDNCol               = "base_dn"
+	LDAPConfigUserObjectClassCol      = "user_object_class"
+	LDAPConfigIDAttributeCol          = "id_attribute"
+	LDAPConfigUsernameAttributeCol    = "username_attribute"
+	LDAPConfigDisplayNameAttributeCol = "display_name_attribute"
+	LDAPConfigFirstNameAttributeCol   = "first_name_attribute"
+	LDAPConfigLastNameAttributeCol    = "last_name_attribute"
+	LDAPConfigEmailAttributeCol       = "email_attribute"
+	LDAPConfigPhoneAttributeCol       = "phone_attribute"
 )
 
 func (p *IDPProjection) reduceIDPAdded(event eventstore.Event) (*handler.Statement, error) {
@@ -485,3 +555,257 @@ func (p *IDPProjection) reduceJWTConfigChanged(event eventstore.Event) (*handler
 		),
 	), nil
 }
+
+func (p *IDPProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.SAMLConfigAddedEvent
+	switch e := event.(type) {
+	case *org.IDPSAMLConfigAddedEvent:
+		idpEvent = e.SAMLConfigAddedEvent
+	case *iam.IDPSAMLConfigAddedEvent:
+		idpEvent = e.SAMLConfigAddedEvent
+	default:
+		logging.LogWithFields("HANDL-7Gd2s", "seq", event.Sequence(), "expectedTypes", []eventstore.EventType{org.IDPSAMLConfigAddedEventType, iam.IDPSAMLConfigAddedEventType}).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-3kFs9", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTypeCol, domain.IDPConfigTypeSAML),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SAMLConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCol(SAMLConfigMetadataURLCol, idpEvent.MetadataURL),
+				handler.NewCol(SAMLConfigMetadataCol, idpEvent.Metadata),
+				handler.NewCol(SAMLConfigKeyCol, idpEvent.Key),
+				handler.NewCol(SAMLConfigCertificateCol, idpEvent.Certificate),
+				handler.NewCol(SAMLConfigBindingCol, idpEvent.Binding),
+				handler.NewCol(SAMLConfigWithSignedRequestCol, idpEvent.WithSignedRequest),
+				handler.NewCol(SAMLConfigNameIDFormatCol, idpEvent.NameIDFormat),
+				handler.NewCol(SAMLConfigUsernameAttributeCol, idpEvent.UsernameAttribute),
+				handler.NewCol(SAMLConfigDisplayNameAttributeCol, idpEvent.DisplayNameAttribute),
+				handler.NewCol(SAMLConfigFirstNameAttributeCol, idpEvent.FirstNameAttribute),
+				handler.NewCol(SAMLConfigLastNameAttributeCol, idpEvent.LastNameAttribute),
+				handler.NewCol(SAMLConfigEmailAttributeCol, idpEvent.EmailAttribute),
+				handler.NewCol(SAMLConfigPhoneAttributeCol, idpEvent.PhoneAttribute),
+			},
+			crdb.WithTableSuffix(IDPSAMLSuffix),
+		),
+	), nil
+}
+
+func (p *IDPProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.SAMLConfigChangedEvent
+	switch e := event.(type) {
+	case *org.IDPSAMLConfigChangedEvent:
+		idpEvent = e.SAMLConfigChangedEvent
+	case *iam.IDPSAMLConfigChangedEvent:
+		idpEvent = e.SAMLConfigChangedEvent
+	default:
+		logging.LogWithFields("HANDL-9Ks2d", "seq", event.Sequence(), "expectedTypes", []eventstore.EventType{org.IDPSAMLConfigChangedEventType, iam.IDPSAMLConfigChangedEventType}).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Lf8s3", "reduce.wrong.event.type")
+	}
+
+	cols := make([]handler.Column, 0, 11)
+
+	if idpEvent.MetadataURL != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigMetadataURLCol, *idpEvent.MetadataURL))
+	}
+	if idpEvent.Metadata != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigMetadataCol, idpEvent.Metadata))
+	}
+	if idpEvent.Binding != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigBindingCol, *idpEvent.Binding))
+	}
+	if idpEvent.WithSignedRequest != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigWithSignedRequestCol, *idpEvent.WithSignedRequest))
+	}
+	if idpEvent.NameIDFormat != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigNameIDFormatCol, *idpEvent.NameIDFormat))
+	}
+	if idpEvent.UsernameAttribute != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigUsernameAttributeCol, *idpEvent.UsernameAttribute))
+	}
+	if idpEvent.DisplayNameAttribute != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigDisplayNameAttributeCol, *idpEvent.DisplayNameAttribute))
+	}
+	if idpEvent.FirstNameAttribute != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigFirstNameAttributeCol, *idpEvent.FirstNameAttribute))
+	}
+	if idpEvent.LastNameAttribute != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigLastNameAttributeCol, *idpEvent.LastNameAttribute))
+	}
+	if idpEvent.EmailAttribute != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigEmailAttributeCol, *idpEvent.EmailAttribute))
+	}
+	if idpEvent.PhoneAttribute != nil {
+		cols = append(cols, handler.NewCol(SAMLConfigPhoneAttributeCol, *idpEvent.PhoneAttribute))
+	}
+
+	if len(cols) == 0 {
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+			},
+		),
+		crdb.AddUpdateStatement(
+			cols,
+			[]handler.Condition{
+				handler.NewCond(SAMLConfigIDPIDCol, idpEvent.IDPConfigID),
+			},
+			crdb.WithTableSuffix(IDPSAMLSuffix),
+		),
+	), nil
+}
+
+func (p *IDPProjection) reduceLDAPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.LDAPConfigAddedEvent
+	switch e := event.(type) {
+	case *org.IDPLDAPConfigAddedEvent:
+		idpEvent = e.LDAPConfigAddedEvent
+	case *iam.IDPLDAPConfigAddedEvent:
+		idpEvent = e.LDAPConfigAddedEvent
+	default:
+		logging.LogWithFields("HANDL-Vn3ks", "seq", event.Sequence(), "expectedTypes", []eventstore.EventType{org.IDPLDAPConfigAddedEventType, iam.IDPLDAPConfigAddedEventType}).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Qk2md", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTypeCol, domain.IDPConfigTypeLDAP),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(LDAPConfigIDPIDCol, idpEvent.IDPConfigID),
+				handler.NewCol(LDAPConfigURLCol, idpEvent.URL),
+				handler.NewCol(LDAPConfigStartTLSCol, idpEvent.StartTLS),
+				handler.NewCol(LDAPConfigBindDNCol, idpEvent.BindDN),
+				handler.NewCol(LDAPConfigBindPasswordCol, idpEvent.BindPassword),
+				handler.NewCol(LDAPConfigBaseDNCol, idpEvent.BaseDN),
+				handler.NewCol(LDAPConfigUserObjectClassCol, idpEvent.UserObjectClass),
+				handler.NewCol(LDAPConfigIDAttributeCol, idpEvent.IDAttribute),
+				handler.NewCol(LDAPConfigUsernameAttributeCol, idpEvent.UsernameAttribute),
+				handler.NewCol(LDAPConfigDisplayNameAttributeCol, idpEvent.DisplayNameAttribute),
+				handler.NewCol(LDAPConfigFirstNameAttributeCol, idpEvent.FirstNameAttribute),
+				handler.NewCol(LDAPConfigLastNameAttributeCol, idpEvent.LastNameAttribute),
+				handler.NewCol(LDAPConfigEmailAttributeCol, idpEvent.EmailAttribute),
+				handler.NewCol(LDAPConfigPhoneAttributeCol, idpEvent.PhoneAttribute),
+			},
+			crdb.WithTableSuffix(IDPLDAPSuffix),
+		),
+	), nil
+}
+
+func (p *IDPProjection) reduceLDAPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idpconfig.LDAPConfigChangedEvent
+	switch e := event.(type) {
+	case *org.IDPLDAPConfigChangedEvent:
+		idpEvent = e.LDAPConfigChangedEvent
+	case *iam.IDPLDAPConfigChangedEvent:
+		idpEvent = e.LDAPConfigChangedEvent
+	default:
+		logging.LogWithFields("HANDL-Mw9sk", "seq", event.Sequence(), "expectedTypes", []eventstore.EventType{org.IDPLDAPConfigChangedEventType, iam.IDPLDAPConfigChangedEventType}).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Xs0dm", "reduce.wrong.event.type")
+	}
+
+	cols := make([]handler.Column, 0, 13)
+
+	if idpEvent.URL != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigURLCol, *idpEvent.URL))
+	}
+	if idpEvent.StartTLS != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigStartTLSCol, *idpEvent.StartTLS))
+	}
+	if idpEvent.BindDN != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigBindDNCol, *idpEvent.BindDN))
+	}
+	if idpEvent.BindPassword != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigBindPasswordCol, idpEvent.BindPassword))
+	}
+	if idpEvent.BaseDN != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigBaseDNCol, *idpEvent.BaseDN))
+	}
+	if idpEvent.UserObjectClass != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigUserObjectClassCol, *idpEvent.UserObjectClass))
+	}
+	if idpEvent.IDAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigIDAttributeCol, *idpEvent.IDAttribute))
+	}
+	if idpEvent.UsernameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigUsernameAttributeCol, *idpEvent.UsernameAttribute))
+	}
+	if idpEvent.DisplayNameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigDisplayNameAttributeCol, *idpEvent.DisplayNameAttribute))
+	}
+	if idpEvent.FirstNameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigFirstNameAttributeCol, *idpEvent.FirstNameAttribute))
+	}
+	if idpEvent.LastNameAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigLastNameAttributeCol, *idpEvent.LastNameAttribute))
+	}
+	if idpEvent.EmailAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigEmailAttributeCol, *idpEvent.EmailAttribute))
+	}
+	if idpEvent.PhoneAttribute != nil {
+		cols = append(cols, handler.NewCol(LDAPConfigPhoneAttributeCol, *idpEvent.PhoneAttribute))
+	}
+
+	if len(cols) == 0 {
+		return crdb.NewNoOpStatement(&idpEvent), nil
+	}
+
+	return crdb.NewMultiStatement(&idpEvent,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPSequenceCol, idpEvent.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(IDPIDCol, idpEvent.IDPConfigID),
+			},
+		),
+		crdb.AddUpdateStatement(
+			cols,
+			[]handler.Condition{
+				handler.NewCond(LDAPConfigIDPIDCol, idpEvent.IDPConfigID),
+			},
+			crdb.WithTableSuffix(IDPLDAPSuffix),
+		),
+	), nil
+}
+
+func (p *IDPProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-92O7S", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-beIdM", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(IDPResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
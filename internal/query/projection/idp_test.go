@@ -441,6 +441,260 @@ func TestIDPProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "iam.reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.IDPSAMLConfigAddedEventType),
+					iam.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadataUrl": "https://idp.example.com/metadata",
+	"key": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"certificate": "Y2VydGlmaWNhdGU=",
+	"binding": 1,
+	"withSignedRequest": true,
+	"nameIdFormat": 2,
+	"usernameAttribute": "uid",
+	"emailAttribute": "mail"
+}`),
+				), iam.IDPSAMLConfigAddedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeSAML,
+								"idp-config-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.idps_saml_config (idp_id, metadata_url, metadata, key, certificate, binding, with_signed_request, name_id_format, username_attribute, display_name_attribute, first_name_attribute, last_name_attribute, email_attribute, phone_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"https://idp.example.com/metadata",
+								anyArg{},
+								anyArg{},
+								[]byte("certificate"),
+								domain.SAMLBindingRedirect,
+								true,
+								domain.SAMLNameIDFormatPersistent,
+								"uid",
+								"",
+								"",
+								"",
+								"mail",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "iam.reduceSAMLConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.IDPSAMLConfigChangedEventType),
+					iam.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadataUrl": "https://idp.example.com/metadata",
+	"binding": 2,
+	"withSignedRequest": false,
+	"emailAttribute": "email"
+}`),
+				), iam.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps_saml_config SET (metadata_url, binding, with_signed_request, email_attribute) = ($1, $2, $3, $4) WHERE (idp_id = $5)",
+							expectedArgs: []interface{}{
+								"https://idp.example.com/metadata",
+								domain.SAMLBindingPost,
+								false,
+								"email",
+								"idp-config-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "iam.reduceSAMLConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.IDPSAMLConfigChangedEventType),
+					iam.AggregateType,
+					[]byte(`{}`),
+				), iam.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "iam.reduceLDAPConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.IDPLDAPConfigAddedEventType),
+					iam.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"url": "ldaps://ldap.example.com",
+	"bindDn": "cn=admin,dc=example,dc=com",
+	"bindPassword": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"baseDn": "dc=example,dc=com",
+	"userObjectClass": "person",
+	"idAttribute": "entryUUID",
+	"usernameAttribute": "uid",
+	"emailAttribute": "mail"
+}`),
+				), iam.IDPLDAPConfigAddedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceLDAPConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeLDAP,
+								"idp-config-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.idps_ldap_config (idp_id, url, start_tls, bind_dn, bind_password, base_dn, user_object_class, id_attribute, username_attribute, display_name_attribute, first_name_attribute, last_name_attribute, email_attribute, phone_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"ldaps://ldap.example.com",
+								false,
+								"cn=admin,dc=example,dc=com",
+								anyArg{},
+								"dc=example,dc=com",
+								"person",
+								"entryUUID",
+								"uid",
+								"",
+								"",
+								"",
+								"mail",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "iam.reduceLDAPConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.IDPLDAPConfigChangedEventType),
+					iam.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"url": "ldap://ldap.example.com",
+	"startTls": true,
+	"emailAttribute": "email"
+}`),
+				), iam.IDPLDAPConfigChangedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceLDAPConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps_ldap_config SET (url, start_tls, email_attribute) = ($1, $2, $3) WHERE (idp_id = $4)",
+							expectedArgs: []interface{}{
+								"ldap://ldap.example.com",
+								true,
+								"email",
+								"idp-config-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "iam.reduceLDAPConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.IDPLDAPConfigChangedEventType),
+					iam.AggregateType,
+					[]byte(`{}`),
+				), iam.IDPLDAPConfigChangedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceLDAPConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
 		{
 			name: "org.reduceIDPAdded",
 			args: args{
@@ -859,6 +1113,161 @@ func TestIDPProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org.reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSAMLConfigAddedEventType),
+					org.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadataUrl": "https://idp.example.com/metadata",
+	"key": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"certificate": "Y2VydGlmaWNhdGU=",
+	"binding": 1,
+	"withSignedRequest": true,
+	"nameIdFormat": 2,
+	"usernameAttribute": "uid",
+	"emailAttribute": "mail"
+}`),
+				), org.IDPSAMLConfigAddedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps SET (change_date, sequence, type) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.IDPConfigTypeSAML,
+								"idp-config-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.idps_saml_config (idp_id, metadata_url, metadata, key, certificate, binding, with_signed_request, name_id_format, username_attribute, display_name_attribute, first_name_attribute, last_name_attribute, email_attribute, phone_attribute) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"idp-config-id",
+								"https://idp.example.com/metadata",
+								anyArg{},
+								anyArg{},
+								[]byte("certificate"),
+								domain.SAMLBindingRedirect,
+								true,
+								domain.SAMLNameIDFormatPersistent,
+								"uid",
+								"",
+								"",
+								"",
+								"mail",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.reduceSAMLConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSAMLConfigChangedEventType),
+					org.AggregateType,
+					[]byte(`{
+	"idpConfigId": "idp-config-id",
+	"metadataUrl": "https://idp.example.com/metadata",
+	"binding": 2,
+	"withSignedRequest": false,
+	"emailAttribute": "email"
+}`),
+				), org.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"idp-config-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.idps_saml_config SET (metadata_url, binding, with_signed_request, email_attribute) = ($1, $2, $3, $4) WHERE (idp_id = $5)",
+							expectedArgs: []interface{}{
+								"https://idp.example.com/metadata",
+								domain.SAMLBindingPost,
+								false,
+								"email",
+								"idp-config-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.reduceSAMLConfigChanged: no op",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.IDPSAMLConfigChangedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.IDPSAMLConfigChangedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "org.OrgRemovedEventType",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&IDPProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType:    org.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       IDPTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.idps WHERE (resource_owner = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
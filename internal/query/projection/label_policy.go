@@ -95,6 +95,10 @@ func (p *LabelPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  org.LabelPolicyAssetsRemovedEventType,
 					Reduce: p.reduceAssetsRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -529,3 +533,17 @@ const (
 	LabelPolicyDarkLogoURLCol         = "dark_logo_url"
 	LabelPolicyDarkIconURLCol         = "dark_icon_url"
 )
+
+func (p *LabelPolicyProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-WKLds", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-3mo4e", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LabelPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -27,7 +27,11 @@ const (
 	LockoutPolicyIDCol                  = "id"
 	LockoutPolicyStateCol               = "state"
 	LockoutPolicyMaxPasswordAttemptsCol = "max_password_attempts"
+	LockoutPolicyMaxOTPAttemptsCol      = "max_otp_attempts"
+	LockoutPolicyMaxU2FAttemptsCol      = "max_u2f_attempts"
 	LockoutPolicyShowLockOutFailuresCol = "show_failure"
+	LockoutPolicyLockoutDurationCol     = "lockout_duration"
+	LockoutPolicyBackoffDelayCol        = "backoff_delay"
 	LockoutPolicyIsDefaultCol           = "is_default"
 	LockoutPolicyResourceOwnerCol       = "resource_owner"
 )
@@ -57,6 +61,10 @@ func (p *LockoutPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  org.LockoutPolicyRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -98,7 +106,11 @@ func (p *LockoutPolicyProjection) reduceAdded(event eventstore.Event) (*handler.
 			handler.NewCol(LockoutPolicyIDCol, policyEvent.Aggregate().ID),
 			handler.NewCol(LockoutPolicyStateCol, domain.PolicyStateActive),
 			handler.NewCol(LockoutPolicyMaxPasswordAttemptsCol, policyEvent.MaxPasswordAttempts),
+			handler.NewCol(LockoutPolicyMaxOTPAttemptsCol, policyEvent.MaxOTPAttempts),
+			handler.NewCol(LockoutPolicyMaxU2FAttemptsCol, policyEvent.MaxU2FAttempts),
 			handler.NewCol(LockoutPolicyShowLockOutFailuresCol, policyEvent.ShowLockOutFailures),
+			handler.NewCol(LockoutPolicyLockoutDurationCol, policyEvent.LockoutDuration),
+			handler.NewCol(LockoutPolicyBackoffDelayCol, policyEvent.BackoffDelay),
 			handler.NewCol(LockoutPolicyIsDefaultCol, isDefault),
 			handler.NewCol(LockoutPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 		}), nil
@@ -122,9 +134,21 @@ func (p *LockoutPolicyProjection) reduceChanged(event eventstore.Event) (*handle
 	if policyEvent.MaxPasswordAttempts != nil {
 		cols = append(cols, handler.NewCol(LockoutPolicyMaxPasswordAttemptsCol, *policyEvent.MaxPasswordAttempts))
 	}
+	if policyEvent.MaxOTPAttempts != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxOTPAttemptsCol, *policyEvent.MaxOTPAttempts))
+	}
+	if policyEvent.MaxU2FAttempts != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxU2FAttemptsCol, *policyEvent.MaxU2FAttempts))
+	}
 	if policyEvent.ShowLockOutFailures != nil {
 		cols = append(cols, handler.NewCol(LockoutPolicyShowLockOutFailuresCol, *policyEvent.ShowLockOutFailures))
 	}
+	if policyEvent.LockoutDuration != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyLockoutDurationCol, *policyEvent.LockoutDuration))
+	}
+	if policyEvent.BackoffDelay != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyBackoffDelayCol, *policyEvent.BackoffDelay))
+	}
 	return crdb.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -145,3 +169,17 @@ func (p *LockoutPolicyProjection) reduceRemoved(event eventstore.Event) (*handle
 			handler.NewCond(LockoutPolicyIDCol, policyEvent.Aggregate().ID),
 		}), nil
 }
+
+func (p *LockoutPolicyProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-oRsKt", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-p1HXn", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LockoutPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
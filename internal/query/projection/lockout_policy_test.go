@@ -2,6 +2,7 @@ package projection
 
 import (
 	"testing"
+	"time"
 
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/errors"
@@ -30,7 +31,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 					org.AggregateType,
 					[]byte(`{
 						"maxPasswordAttempts": 10,
-						"showLockOutFailures": true
+						"maxOtpAttempts": 5,
+						"maxU2fAttempts": 3,
+						"showLockOutFailures": true,
+						"lockoutDuration": 900000000000,
+						"backoffDelay": 1000000000
 }`),
 				), org.LockoutPolicyAddedEventMapper),
 			},
@@ -43,7 +48,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, show_failure, is_default, resource_owner) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO zitadel.projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, max_u2f_attempts, show_failure, lockout_duration, backoff_delay, is_default, resource_owner) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -51,7 +56,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								"agg-id",
 								domain.PolicyStateActive,
 								uint64(10),
+								uint64(5),
+								uint64(3),
 								true,
+								15 * time.Minute,
+								time.Second,
 								false,
 								"ro-id",
 							},
@@ -69,7 +78,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 					org.AggregateType,
 					[]byte(`{
 						"maxPasswordAttempts": 10,
-						"showLockOutFailures": true
+						"maxOtpAttempts": 5,
+						"maxU2fAttempts": 3,
+						"showLockOutFailures": true,
+						"lockoutDuration": 900000000000,
+						"backoffDelay": 1000000000
 		}`),
 				), org.LockoutPolicyChangedEventMapper),
 			},
@@ -81,12 +94,16 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE zitadel.projections.lockout_policies SET (change_date, sequence, max_password_attempts, show_failure) = ($1, $2, $3, $4) WHERE (id = $5)",
+							expectedStmt: "UPDATE zitadel.projections.lockout_policies SET (change_date, sequence, max_password_attempts, max_otp_attempts, max_u2f_attempts, show_failure, lockout_duration, backoff_delay) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								uint64(10),
+								uint64(5),
+								uint64(3),
 								true,
+								15 * time.Minute,
+								time.Second,
 								"agg-id",
 							},
 						},
@@ -142,7 +159,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, show_failure, is_default, resource_owner) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO zitadel.projections.lockout_policies (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, max_u2f_attempts, show_failure, lockout_duration, backoff_delay, is_default, resource_owner) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -150,7 +167,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								"agg-id",
 								domain.PolicyStateActive,
 								uint64(10),
+								uint64(0),
+								uint64(0),
 								true,
+								time.Duration(0),
+								time.Duration(0),
 								true,
 								"ro-id",
 							},
@@ -193,6 +214,33 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org.OrgRemovedEventType",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&LockoutPolicyProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType:    org.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       LockoutPolicyTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.lockout_policies WHERE (id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
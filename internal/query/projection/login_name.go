@@ -102,6 +102,10 @@ func (p *LoginNameProjection) reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainVerifiedEventType,
 					Reduce: p.reduceDomainVerified,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -358,3 +362,32 @@ func (p *LoginNameProjection) reduceDomainRemoved(event eventstore.Event) (*hand
 		crdb.WithTableSuffix(loginNameDomainSuffix),
 	), nil
 }
+
+func (p *LoginNameProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-pZnqO", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-zYZ72", "reduce.wrong.event.type")
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginNameUserResourceOwnerCol, e.Aggregate().ID),
+			},
+			crdb.WithTableSuffix(loginNameUserSuffix),
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginNamePoliciesResourceOwnerCol, e.Aggregate().ID),
+			},
+			crdb.WithTableSuffix(loginNamePolicySuffix),
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginNameDomainResourceOwnerCol, e.Aggregate().ID),
+			},
+			crdb.WithTableSuffix(loginNameDomainSuffix),
+		),
+	), nil
+}
@@ -63,6 +63,10 @@ func (p *LoginPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  org.LoginPolicySecondFactorRemovedEventType,
 					Reduce: p.reduce2FARemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -299,3 +303,17 @@ func (p *LoginPolicyProjection) reduce2FARemoved(event eventstore.Event) (*handl
 		},
 	), nil
 }
+
+func (p *LoginPolicyProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-viu9P", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-fREE9", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LoginPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -55,6 +55,10 @@ func (p *MailTemplateProjection) reducers() []handler.AggregateReducer {
 					Event:  org.MailTemplateRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -138,3 +142,17 @@ func (p *MailTemplateProjection) reduceRemoved(event eventstore.Event) (*handler
 			handler.NewCond(MailTemplateAggregateIDCol, policyEvent.Aggregate().ID),
 		}), nil
 }
+
+func (p *MailTemplateProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-VsVTj", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-jG6lY", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(MailTemplateAggregateIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -1,6 +1,8 @@
 package projection
 
 import (
+	"database/sql"
+
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/eventstore/handler"
 	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
@@ -9,8 +11,9 @@ import (
 )
 
 const (
-	MemberUserIDCol = "user_id"
-	MemberRolesCol  = "roles"
+	MemberUserIDCol         = "user_id"
+	MemberRolesCol          = "roles"
+	MemberExpirationDateCol = "expiration_date"
 
 	MemberCreationDate  = "creation_date"
 	MemberChangeDate    = "change_date"
@@ -48,6 +51,7 @@ func reduceMemberAdded(e member.MemberAddedEvent, opts ...reduceMemberOpt) (*han
 			handler.NewCol(MemberChangeDate, e.CreationDate()),
 			handler.NewCol(MemberSequence, e.Sequence()),
 			handler.NewCol(MemberResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(MemberExpirationDateCol, sql.NullTime{Time: e.ExpirationDate, Valid: !e.ExpirationDate.IsZero()}),
 		}}
 
 	for _, opt := range opts {
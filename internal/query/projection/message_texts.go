@@ -63,6 +63,10 @@ func (p *MessageTextProjection) reducers() []handler.AggregateReducer {
 					Event:  org.CustomTextTemplateRemovedEventType,
 					Reduce: p.reduceTemplateRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -215,7 +219,11 @@ func isMessageTemplate(template string) bool {
 		template == domain.VerifyEmailMessageType ||
 		template == domain.VerifyPhoneMessageType ||
 		template == domain.DomainClaimedMessageType ||
-		template == domain.PasswordlessRegistrationMessageType
+		template == domain.PasswordlessRegistrationMessageType ||
+		template == domain.PasswordExpiryMessageType ||
+		template == domain.VerifyEmailOTPMessageType ||
+		template == domain.VerifySMSOTPMessageType ||
+		template == domain.RecoveryCodesLowMessageType
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
@@ -238,3 +246,17 @@ func isButtonText(key string) bool {
 func isFooterText(key string) bool {
 	return key == domain.MessageFooterText
 }
+
+func (p *MessageTextProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-MXUxH", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-eaHHJ", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(MessageTextAggregateIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -54,6 +54,10 @@ func (p *OrgProjection) reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainPrimarySetEventType,
 					Reduce: p.reducePrimaryDomainSet,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 	}
@@ -170,3 +174,17 @@ func (p *OrgProjection) reducePrimaryDomainSet(event eventstore.Event) (*handler
 		},
 	), nil
 }
+
+func (p *OrgProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-gGalR", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-WfN9H", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
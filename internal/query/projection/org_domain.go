@@ -54,6 +54,10 @@ func (p *OrgDomainProjection) reducers() []handler.AggregateReducer {
 					Event:  org.OrgDomainRemovedEventType,
 					Reduce: p.reduceDomainRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 	}
@@ -178,3 +182,17 @@ func (p *OrgDomainProjection) reduceDomainRemoved(event eventstore.Event) (*hand
 		},
 	), nil
 }
+
+func (p *OrgDomainProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-Wm3oq", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType, "gottenType", fmt.Sprintf("%T", event)).Error("unexpected event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-Rd82n", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgDomainOrgIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
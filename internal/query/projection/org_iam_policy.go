@@ -56,6 +56,10 @@ func (p *OrgIAMPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  org.OrgIAMPolicyRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -140,3 +144,17 @@ func (p *OrgIAMPolicyProjection) reduceRemoved(event eventstore.Event) (*handler
 			handler.NewCond(OrgIAMPolicyIDCol, policyEvent.Aggregate().ID),
 		}), nil
 }
+
+func (p *OrgIAMPolicyProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-ycxle", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-NoUQ2", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgIAMPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -123,10 +123,7 @@ func (p *OrgMemberProjection) reduceUserRemoved(event eventstore.Event) (*handle
 }
 
 func (p *OrgMemberProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
-	//TODO: as soon as org deletion is implemented:
-	// Case: The user has resource owner A and an org has resource owner B
-	// if org B deleted it works
-	// if org A is deleted, the membership wouldn't be deleted
+	//memberships of the users of the removed org on other orgs are removed by cascading member events
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
 		logging.LogWithFields("HANDL-E5lDs", "seq", event.Sequence(), "expected", org.OrgRemovedEventType).Error("wrong event type")
@@ -0,0 +1,102 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+const (
+	OrgSMSProvidersProjectionTable = "zitadel.projections.org_sms_providers"
+
+	OrgSMSProvidersColumnOrgID       = "org_id"
+	OrgSMSProvidersColumnChangeDate  = "change_date"
+	OrgSMSProvidersColumnSequence    = "sequence"
+	OrgSMSProvidersColumnProviderIDs = "provider_ids"
+)
+
+type OrgSMSProvidersProjection struct {
+	crdb.StatementHandler
+}
+
+func NewOrgSMSProvidersProjection(ctx context.Context, config crdb.StatementHandlerConfig) *OrgSMSProvidersProjection {
+	p := &OrgSMSProvidersProjection{}
+	config.ProjectionName = OrgSMSProvidersProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *OrgSMSProvidersProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.SMSProvidersSetEventType,
+					Reduce: p.reduceSMSProvidersSet,
+				},
+				{
+					Event:  org.SMSProvidersResetEventType,
+					Reduce: p.reduceSMSProvidersReset,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *OrgSMSProvidersProjection) reduceSMSProvidersSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.SMSProvidersSetEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Op83n", "seq", event.Sequence(), "expectedType", org.SMSProvidersSetEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Sp92m", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgSMSProvidersColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(OrgSMSProvidersColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgSMSProvidersColumnSequence, e.Sequence()),
+			handler.NewCol(OrgSMSProvidersColumnProviderIDs, pq.StringArray(e.ProviderIDs)),
+		},
+	), nil
+}
+
+func (p *OrgSMSProvidersProjection) reduceSMSProvidersReset(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.SMSProvidersResetEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Or02m", "seq", event.Sequence(), "expectedType", org.SMSProvidersResetEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Rp83k", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgSMSProvidersColumnOrgID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *OrgSMSProvidersProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Om92k", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Xp02n", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgSMSProvidersColumnOrgID, e.Aggregate().ID),
+		},
+	), nil
+}
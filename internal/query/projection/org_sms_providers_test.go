@@ -0,0 +1,123 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/org"
+)
+
+func TestOrgSMSProvidersProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSMSProvidersSet",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.SMSProvidersSetEventType),
+					org.AggregateType,
+					[]byte(`{"providerIds": ["provider1", "provider2"]}`),
+				), org.SMSProvidersSetEventMapper),
+			},
+			reduce: (&OrgSMSProvidersProjection{}).reduceSMSProvidersSet,
+			want: wantReduce{
+				projection:       OrgSMSProvidersProjectionTable,
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPSERT INTO zitadel.projections.org_sms_providers (org_id, change_date, sequence, provider_ids) VALUES ($1, $2, $3, $4)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								uint64(15),
+								pq.StringArray{"provider1", "provider2"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSProvidersReset",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.SMSProvidersResetEventType),
+					org.AggregateType,
+					nil,
+				), org.SMSProvidersResetEventMapper),
+			},
+			reduce: (&OrgSMSProvidersProjection{}).reduceSMSProvidersReset,
+			want: wantReduce{
+				projection:       OrgSMSProvidersProjectionTable,
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.org_sms_providers WHERE (org_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOrgRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&OrgSMSProvidersProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				projection:       OrgSMSProvidersProjectionTable,
+				aggregateType:    eventstore.AggregateType("org"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.org_sms_providers WHERE (org_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
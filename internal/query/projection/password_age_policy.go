@@ -47,6 +47,10 @@ func (p *PasswordAgeProjection) reducers() []handler.AggregateReducer {
 					Event:  org.PasswordAgePolicyRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -147,3 +151,17 @@ const (
 	AgePolicyIsDefaultCol      = "is_default"
 	AgePolicyResourceOwnerCol  = "resource_owner"
 )
+
+func (p *PasswordAgeProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-C3rbg", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-cyNHh", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AgePolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
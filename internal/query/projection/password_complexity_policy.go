@@ -47,6 +47,10 @@ func (p *PasswordComplexityProjection) reducers() []handler.AggregateReducer {
 					Event:  org.PasswordComplexityPolicyRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -92,6 +96,7 @@ func (p *PasswordComplexityProjection) reduceAdded(event eventstore.Event) (*han
 			handler.NewCol(ComplexityPolicyHasUppercaseCol, policyEvent.HasUppercase),
 			handler.NewCol(ComplexityPolicyHasSymbolCol, policyEvent.HasSymbol),
 			handler.NewCol(ComplexityPolicyHasNumberCol, policyEvent.HasNumber),
+			handler.NewCol(ComplexityPolicyCheckBreachedCol, policyEvent.CheckBreached),
 			handler.NewCol(ComplexityPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(ComplexityPolicyIsDefaultCol, isDefault),
 		}), nil
@@ -127,6 +132,9 @@ func (p *PasswordComplexityProjection) reduceChanged(event eventstore.Event) (*h
 	if policyEvent.HasNumber != nil {
 		cols = append(cols, handler.NewCol(ComplexityPolicyHasNumberCol, *policyEvent.HasNumber))
 	}
+	if policyEvent.CheckBreached != nil {
+		cols = append(cols, handler.NewCol(ComplexityPolicyCheckBreachedCol, *policyEvent.CheckBreached))
+	}
 	return crdb.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -159,6 +167,21 @@ const (
 	ComplexityPolicyHasUppercaseCol  = "has_uppercase"
 	ComplexityPolicyHasSymbolCol     = "has_symbol"
 	ComplexityPolicyHasNumberCol     = "has_number"
+	ComplexityPolicyCheckBreachedCol = "check_breached"
 	ComplexityPolicyIsDefaultCol     = "is_default"
 	ComplexityPolicyResourceOwnerCol = "resource_owner"
 )
+
+func (p *PasswordComplexityProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-3vZ1n", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-5pdg8", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ComplexityPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -33,7 +33,8 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 	"hasLowercase": true,
 	"hasUppercase": true,
 	"HasNumber": true,
-	"HasSymbol": true
+	"HasSymbol": true,
+	"checkBreached": true
 }`),
 				), org.PasswordComplexityPolicyAddedEventMapper),
 			},
@@ -46,7 +47,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.password_complexity_policies (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, resource_owner, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO zitadel.projections.password_complexity_policies (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, check_breached, resource_owner, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -58,6 +59,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 								true,
 								true,
 								true,
+								true,
 								"ro-id",
 								false,
 							},
@@ -78,7 +80,8 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 			"hasLowercase": true,
 			"hasUppercase": true,
 			"HasNumber": true,
-			"HasSymbol": true
+			"HasSymbol": true,
+			"checkBreached": true
 		}`),
 				), org.PasswordComplexityPolicyChangedEventMapper),
 			},
@@ -90,7 +93,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE zitadel.projections.password_complexity_policies SET (change_date, sequence, min_length, has_lowercase, has_uppercase, has_symbol, has_number) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8)",
+							expectedStmt: "UPDATE zitadel.projections.password_complexity_policies SET (change_date, sequence, min_length, has_lowercase, has_uppercase, has_symbol, has_number, check_breached) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -99,6 +102,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 								true,
 								true,
 								true,
+								true,
 								"agg-id",
 							},
 						},
@@ -157,7 +161,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.password_complexity_policies (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, resource_owner, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO zitadel.projections.password_complexity_policies (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, check_breached, resource_owner, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -169,6 +173,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 								"ro-id",
 								true,
 							},
@@ -217,6 +222,33 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org.OrgRemovedEventType",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&PasswordComplexityProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType:    org.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       PasswordComplexityTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.password_complexity_policies WHERE (id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,162 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/iam"
+	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/policy"
+)
+
+type PasswordHistoryProjection struct {
+	crdb.StatementHandler
+}
+
+const (
+	PasswordHistoryTable = "zitadel.projections.password_history_policies"
+)
+
+func NewPasswordHistoryProjection(ctx context.Context, config crdb.StatementHandlerConfig) *PasswordHistoryProjection {
+	p := &PasswordHistoryProjection{}
+	config.ProjectionName = PasswordHistoryTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *PasswordHistoryProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.PasswordHistoryPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.PasswordHistoryPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.PasswordHistoryPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: iam.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  iam.PasswordHistoryPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  iam.PasswordHistoryPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+			},
+		},
+	}
+}
+
+func (p *PasswordHistoryProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.PasswordHistoryPolicyAddedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.PasswordHistoryPolicyAddedEvent:
+		policyEvent = e.PasswordHistoryPolicyAddedEvent
+		isDefault = false
+	case *iam.PasswordHistoryPolicyAddedEvent:
+		policyEvent = e.PasswordHistoryPolicyAddedEvent
+		isDefault = true
+	default:
+		logging.LogWithFields("PROJE-Hq2nd", "seq", event.Sequence(), "expectedTypes", []eventstore.EventType{org.PasswordHistoryPolicyAddedEventType, iam.PasswordHistoryPolicyAddedEventType}).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-Vb4sk", "reduce.wrong.event.type")
+	}
+	return crdb.NewCreateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(HistoryPolicyCreationDateCol, policyEvent.CreationDate()),
+			handler.NewCol(HistoryPolicyChangeDateCol, policyEvent.CreationDate()),
+			handler.NewCol(HistoryPolicySequenceCol, policyEvent.Sequence()),
+			handler.NewCol(HistoryPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCol(HistoryPolicyStateCol, domain.PolicyStateActive),
+			handler.NewCol(HistoryPolicyHistoryCountCol, policyEvent.HistoryCount),
+			handler.NewCol(HistoryPolicyIsDefaultCol, isDefault),
+			handler.NewCol(HistoryPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
+		}), nil
+}
+
+func (p *PasswordHistoryProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.PasswordHistoryPolicyChangedEvent
+	switch e := event.(type) {
+	case *org.PasswordHistoryPolicyChangedEvent:
+		policyEvent = e.PasswordHistoryPolicyChangedEvent
+	case *iam.PasswordHistoryPolicyChangedEvent:
+		policyEvent = e.PasswordHistoryPolicyChangedEvent
+	default:
+		logging.LogWithFields("PROJE-Pw8fn", "seq", event.Sequence(), "expectedTypes", []eventstore.EventType{org.PasswordHistoryPolicyChangedEventType, iam.PasswordHistoryPolicyChangedEventType}).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-Zt3mw", "reduce.wrong.event.type")
+	}
+	cols := []handler.Column{
+		handler.NewCol(HistoryPolicyChangeDateCol, policyEvent.CreationDate()),
+		handler.NewCol(HistoryPolicySequenceCol, policyEvent.Sequence()),
+	}
+	if policyEvent.HistoryCount != nil {
+		cols = append(cols, handler.NewCol(HistoryPolicyHistoryCountCol, *policyEvent.HistoryCount))
+	}
+	return crdb.NewUpdateStatement(
+		&policyEvent,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(HistoryPolicyIDCol, policyEvent.Aggregate().ID),
+		}), nil
+}
+
+func (p *PasswordHistoryProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	policyEvent, ok := event.(*org.PasswordHistoryPolicyRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-Rk5nc", "seq", event.Sequence(), "expectedType", org.PasswordHistoryPolicyRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-Lm7dq", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		policyEvent,
+		[]handler.Condition{
+			handler.NewCond(HistoryPolicyIDCol, policyEvent.Aggregate().ID),
+		}), nil
+}
+
+const (
+	HistoryPolicyCreationDateCol  = "creation_date"
+	HistoryPolicyChangeDateCol    = "change_date"
+	HistoryPolicySequenceCol      = "sequence"
+	HistoryPolicyIDCol            = "id"
+	HistoryPolicyStateCol         = "state"
+	HistoryPolicyHistoryCountCol  = "history_count"
+	HistoryPolicyIsDefaultCol     = "is_default"
+	HistoryPolicyResourceOwnerCol = "resource_owner"
+)
+
+func (p *PasswordHistoryProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-Yn6rt", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-Ub2xs", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(HistoryPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -57,6 +57,10 @@ func (p *PrivacyPolicyProjection) reducers() []handler.AggregateReducer {
 					Event:  org.PrivacyPolicyRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
 			},
 		},
 		{
@@ -145,3 +149,17 @@ func (p *PrivacyPolicyProjection) reduceRemoved(event eventstore.Event) (*handle
 			handler.NewCond(PrivacyPolicyIDCol, policyEvent.Aggregate().ID),
 		}), nil
 }
+
+func (p *PrivacyPolicyProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("PROJE-6F3C4", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "PROJE-6wctU", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(PrivacyPolicyIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -59,18 +59,20 @@ func (p *ProjectProjection) reducers() []handler.AggregateReducer {
 }
 
 const (
-	ProjectColumnID                     = "id"
-	ProjectColumnName                   = "name"
-	ProjectColumnProjectRoleAssertion   = "project_role_assertion"
-	ProjectColumnProjectRoleCheck       = "project_role_check"
-	ProjectColumnHasProjectCheck        = "has_project_check"
-	ProjectColumnPrivateLabelingSetting = "private_labeling_setting"
-	ProjectColumnCreationDate           = "creation_date"
-	ProjectColumnChangeDate             = "change_date"
-	ProjectColumnResourceOwner          = "resource_owner"
-	ProjectColumnCreator                = "creator_id"
-	ProjectColumnState                  = "state"
-	ProjectColumnSequence               = "sequence"
+	ProjectColumnID                         = "id"
+	ProjectColumnName                       = "name"
+	ProjectColumnProjectRoleAssertion       = "project_role_assertion"
+	ProjectColumnProjectRoleCheck           = "project_role_check"
+	ProjectColumnHasProjectCheck            = "has_project_check"
+	ProjectColumnPrivateLabelingSetting     = "private_labeling_setting"
+	ProjectColumnTokenExchangeDelegation    = "token_exchange_delegation"
+	ProjectColumnTokenExchangeImpersonation = "token_exchange_impersonation"
+	ProjectColumnCreationDate               = "creation_date"
+	ProjectColumnChangeDate                 = "change_date"
+	ProjectColumnResourceOwner              = "resource_owner"
+	ProjectColumnCreator                    = "creator_id"
+	ProjectColumnState                      = "state"
+	ProjectColumnSequence                   = "sequence"
 )
 
 func (p *ProjectProjection) reduceProjectAdded(event eventstore.Event) (*handler.Statement, error) {
@@ -92,6 +94,8 @@ func (p *ProjectProjection) reduceProjectAdded(event eventstore.Event) (*handler
 			handler.NewCol(ProjectColumnProjectRoleCheck, e.ProjectRoleCheck),
 			handler.NewCol(ProjectColumnHasProjectCheck, e.HasProjectCheck),
 			handler.NewCol(ProjectColumnPrivateLabelingSetting, e.PrivateLabelingSetting),
+			handler.NewCol(ProjectColumnTokenExchangeDelegation, e.TokenExchangeDelegation),
+			handler.NewCol(ProjectColumnTokenExchangeImpersonation, e.TokenExchangeImpersonation),
 			handler.NewCol(ProjectColumnState, domain.ProjectStateActive),
 			handler.NewCol(ProjectColumnCreator, e.EditorUser()),
 		},
@@ -104,11 +108,12 @@ func (p *ProjectProjection) reduceProjectChanged(event eventstore.Event) (*handl
 		logging.LogWithFields("HANDL-dk2iF", "seq", event.Sequence(), "expected", project.ProjectChangedType).Error("wrong event type")
 		return nil, errors.ThrowInvalidArgument(nil, "HANDL-s00Fs", "reduce.wrong.event.type")
 	}
-	if e.Name == nil && e.HasProjectCheck == nil && e.ProjectRoleAssertion == nil && e.ProjectRoleCheck == nil && e.PrivateLabelingSetting == nil {
+	if e.Name == nil && e.HasProjectCheck == nil && e.ProjectRoleAssertion == nil && e.ProjectRoleCheck == nil && e.PrivateLabelingSetting == nil &&
+		e.TokenExchangeDelegation == nil && e.TokenExchangeImpersonation == nil {
 		return crdb.NewNoOpStatement(e), nil
 	}
 
-	columns := make([]handler.Column, 0, 7)
+	columns := make([]handler.Column, 0, 9)
 	columns = append(columns, handler.NewCol(ProjectColumnChangeDate, e.CreationDate()),
 		handler.NewCol(ProjectColumnSequence, e.Sequence()))
 	if e.Name != nil {
@@ -126,6 +131,12 @@ func (p *ProjectProjection) reduceProjectChanged(event eventstore.Event) (*handl
 	if e.PrivateLabelingSetting != nil {
 		columns = append(columns, handler.NewCol(ProjectColumnPrivateLabelingSetting, *e.PrivateLabelingSetting))
 	}
+	if e.TokenExchangeDelegation != nil {
+		columns = append(columns, handler.NewCol(ProjectColumnTokenExchangeDelegation, *e.TokenExchangeDelegation))
+	}
+	if e.TokenExchangeImpersonation != nil {
+		columns = append(columns, handler.NewCol(ProjectColumnTokenExchangeImpersonation, *e.TokenExchangeImpersonation))
+	}
 	return crdb.NewUpdateStatement(
 		e,
 		columns,
@@ -2,6 +2,7 @@ package projection
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/logging"
 
@@ -97,7 +98,7 @@ func (p *ProjectGrantMemberProjection) reduceAdded(event eventstore.Event) (*han
 		return nil, errors.ThrowInvalidArgument(nil, "HANDL-0EBQf", "reduce.wrong.event.type")
 	}
 	return reduceMemberAdded(
-		*member.NewMemberAddedEvent(&e.BaseEvent, e.UserID, e.Roles...),
+		*member.NewMemberAddedEvent(&e.BaseEvent, e.UserID, time.Time{}, e.Roles...),
 		withMemberCol(ProjectGrantMemberProjectIDCol, e.Aggregate().ID),
 		withMemberCol(ProjectGrantMemberGrantIDCol, e.GrantID),
 	)
@@ -152,10 +153,7 @@ func (p *ProjectGrantMemberProjection) reduceUserRemoved(event eventstore.Event)
 }
 
 func (p *ProjectGrantMemberProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
-	//TODO: as soon as org deletion is implemented:
-	// Case: The user has resource owner A and project has resource owner B
-	// if org B deleted it works
-	// if org A is deleted, the membership wouldn't be deleted
+	//memberships of the users of the removed org on project grants of other orgs are removed by cascading member events
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
 		logging.LogWithFields("HANDL-Sq9FV", "seq", event.Sequence(), "expected", org.OrgRemovedEventType).Error("wrong event type")
@@ -92,7 +92,7 @@ func (p *ProjectMemberProjection) reduceAdded(event eventstore.Event) (*handler.
 		return nil, errors.ThrowInvalidArgument(nil, "HANDL-bgx5Q", "reduce.wrong.event.type")
 	}
 	return reduceMemberAdded(
-		*member.NewMemberAddedEvent(&e.BaseEvent, e.UserID, e.Roles...),
+		*member.NewMemberAddedEvent(&e.BaseEvent, e.UserID, e.ExpirationDate, e.Roles...),
 		withMemberCol(ProjectMemberProjectIDCol, e.Aggregate().ID),
 	)
 }
@@ -143,10 +143,7 @@ func (p *ProjectMemberProjection) reduceUserRemoved(event eventstore.Event) (*ha
 }
 
 func (p *ProjectMemberProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
-	//TODO: as soon as org deletion is implemented:
-	// Case: The user has resource owner A and project has resource owner B
-	// if org B deleted it works
-	// if org A is deleted, the membership wouldn't be deleted
+	//memberships of the users of the removed org on projects of other orgs are removed by cascading member events
 	e, ok := event.(*org.OrgRemovedEvent)
 	if !ok {
 		logging.LogWithFields("HANDL-q7H8D", "seq", event.Sequence(), "expected", org.OrgRemovedEventType).Error("wrong event type")
@@ -114,7 +114,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 				event: getEvent(testEvent(
 					repository.EventType(project.ProjectChangedType),
 					project.AggregateType,
-					[]byte(`{"name": "new name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1}`),
+					[]byte(`{"name": "new name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1, "tokenExchangeDelegation": true, "tokenExchangeImpersonation": true}`),
 				), project.ProjectChangeEventMapper),
 			},
 			reduce: (&ProjectProjection{}).reduceProjectChanged,
@@ -126,7 +126,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE zitadel.projections.projects SET (change_date, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8)",
+							expectedStmt: "UPDATE zitadel.projections.projects SET (change_date, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting, token_exchange_delegation, token_exchange_impersonation) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (id = $10)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -135,6 +135,8 @@ func TestProjectProjection_reduces(t *testing.T) {
 								true,
 								true,
 								domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+								true,
+								true,
 								"agg-id",
 							},
 						},
@@ -166,7 +168,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 				event: getEvent(testEvent(
 					repository.EventType(project.ProjectAddedType),
 					project.AggregateType,
-					[]byte(`{"name": "name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1}`),
+					[]byte(`{"name": "name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1, "tokenExchangeDelegation": true, "tokenExchangeImpersonation": true}`),
 				), project.ProjectAddedEventMapper),
 			},
 			reduce: (&ProjectProjection{}).reduceProjectAdded,
@@ -178,7 +180,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.projects (id, creation_date, change_date, resource_owner, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting, state, creator_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO zitadel.projections.projects (id, creation_date, change_date, resource_owner, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting, token_exchange_delegation, token_exchange_impersonation, state, creator_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								anyArg{},
@@ -190,6 +192,8 @@ func TestProjectProjection_reduces(t *testing.T) {
 								true,
 								true,
 								domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+								true,
+								true,
 								domain.ProjectStateActive,
 								"editor-user",
 							},
@@ -0,0 +1,495 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+const (
+	SMSConfigProjectionTable = "zitadel.projections.sms_configs"
+	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
+	SMSVonageTable           = SMSConfigProjectionTable + "_" + smsVonageTableSuffix
+	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
+
+	SMSColumnID            = "id"
+	SMSColumnAggregateID   = "aggregate_id"
+	SMSColumnCreationDate  = "creation_date"
+	SMSColumnChangeDate    = "change_date"
+	SMSColumnSequence      = "sequence"
+	SMSColumnState         = "state"
+	SMSColumnResourceOwner = "resource_owner"
+
+	smsTwilioTableSuffix              = "twilio"
+	SMSTwilioConfigColumnSMSID        = "sms_id"
+	SMSTwilioConfigColumnSID          = "sid"
+	SMSTwilioConfigColumnToken        = "token"
+	SMSTwilioConfigColumnSenderNumber = "sender_number"
+
+	smsVonageTableSuffix              = "vonage"
+	SMSVonageConfigColumnSMSID        = "sms_id"
+	SMSVonageConfigColumnAPIKey       = "api_key"
+	SMSVonageConfigColumnAPISecret    = "api_secret"
+	SMSVonageConfigColumnSenderNumber = "sender_number"
+
+	smsHTTPTableSuffix               = "http"
+	SMSHTTPConfigColumnSMSID         = "sms_id"
+	SMSHTTPConfigColumnEndpoint      = "endpoint"
+	SMSHTTPConfigColumnMethod        = "method"
+	SMSHTTPConfigColumnContentType   = "content_type"
+	SMSHTTPConfigColumnBodyTemplate  = "body_template"
+	SMSHTTPConfigColumnSenderNumber  = "sender_number"
+	SMSHTTPConfigColumnAuthorization = "auth_header"
+)
+
+type SMSConfigProjection struct {
+	crdb.StatementHandler
+}
+
+func NewSMSConfigProjection(ctx context.Context, config crdb.StatementHandlerConfig) *SMSConfigProjection {
+	p := &SMSConfigProjection{}
+	config.ProjectionName = SMSConfigProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *SMSConfigProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: iam.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  iam.SMSConfigTwilioAddedEventType,
+					Reduce: p.reduceSMSConfigTwilioAdded,
+				},
+				{
+					Event:  iam.SMSConfigTwilioChangedEventType,
+					Reduce: p.reduceSMSConfigTwilioChanged,
+				},
+				{
+					Event:  iam.SMSConfigTwilioTokenChangedEventType,
+					Reduce: p.reduceSMSConfigTwilioTokenChanged,
+				},
+				{
+					Event:  iam.SMSConfigVonageAddedEventType,
+					Reduce: p.reduceSMSConfigVonageAdded,
+				},
+				{
+					Event:  iam.SMSConfigVonageChangedEventType,
+					Reduce: p.reduceSMSConfigVonageChanged,
+				},
+				{
+					Event:  iam.SMSConfigVonageSecretChangedEventType,
+					Reduce: p.reduceSMSConfigVonageSecretChanged,
+				},
+				{
+					Event:  iam.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPAdded,
+				},
+				{
+					Event:  iam.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPChanged,
+				},
+				{
+					Event:  iam.SMSConfigHTTPAuthorizationChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPAuthorizationChanged,
+				},
+				{
+					Event:  iam.SMSConfigActivatedEventType,
+					Reduce: p.reduceSMSConfigActivated,
+				},
+				{
+					Event:  iam.SMSConfigDeactivatedEventType,
+					Reduce: p.reduceSMSConfigDeactivated,
+				},
+				{
+					Event:  iam.SMSConfigRemovedEventType,
+					Reduce: p.reduceSMSConfigRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigTwilioAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Hc8sk", "seq", event.Sequence(), "expectedType", iam.SMSConfigTwilioAddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-s8efs", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSTwilioConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSTwilioConfigColumnSID, e.SID),
+				handler.NewCol(SMSTwilioConfigColumnToken, e.Token),
+				handler.NewCol(SMSTwilioConfigColumnSenderNumber, e.SenderNumber),
+			},
+			crdb.WithTableSuffix(smsTwilioTableSuffix),
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigTwilioChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigTwilioChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Gw8sm", "seq", event.Sequence(), "expectedType", iam.SMSConfigTwilioChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-fi99F", "reduce.wrong.event.type")
+	}
+	columns := make([]handler.Column, 0, 2)
+	if e.SID != nil {
+		columns = append(columns, handler.NewCol(SMSTwilioConfigColumnSID, *e.SID))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSTwilioConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSTwilioConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsTwilioTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigTwilioTokenChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigTwilioTokenChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Tk93m", "seq", event.Sequence(), "expectedType", iam.SMSConfigTwilioTokenChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-fi9Wx", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSTwilioConfigColumnToken, e.Token),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSTwilioConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsTwilioTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigVonageAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigVonageAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Vn82m", "seq", event.Sequence(), "expectedType", iam.SMSConfigVonageAddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Va93n", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSVonageConfigColumnAPIKey, e.APIKey),
+				handler.NewCol(SMSVonageConfigColumnAPISecret, e.APISecret),
+				handler.NewCol(SMSVonageConfigColumnSenderNumber, e.SenderNumber),
+			},
+			crdb.WithTableSuffix(smsVonageTableSuffix),
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigVonageChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigVonageChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Vc92k", "seq", event.Sequence(), "expectedType", iam.SMSConfigVonageChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Vx02m", "reduce.wrong.event.type")
+	}
+	columns := make([]handler.Column, 0, 2)
+	if e.APIKey != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnAPIKey, *e.APIKey))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSVonageConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSVonageConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsVonageTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigVonageSecretChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigVonageSecretChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Vs92m", "seq", event.Sequence(), "expectedType", iam.SMSConfigVonageSecretChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Vq83n", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageConfigColumnAPISecret, e.APISecret),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSVonageConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsVonageTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigHTTPAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Ht92m", "seq", event.Sequence(), "expectedType", iam.SMSConfigHTTPAddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Hu83n", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+		),
+		crdb.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnSMSID, e.ID),
+				handler.NewCol(SMSHTTPConfigColumnEndpoint, e.Endpoint),
+				handler.NewCol(SMSHTTPConfigColumnMethod, e.Method),
+				handler.NewCol(SMSHTTPConfigColumnContentType, e.ContentType),
+				handler.NewCol(SMSHTTPConfigColumnBodyTemplate, e.BodyTemplate),
+				handler.NewCol(SMSHTTPConfigColumnSenderNumber, e.SenderNumber),
+				handler.NewCol(SMSHTTPConfigColumnAuthorization, e.Authorization),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigHTTPChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Hc02n", "seq", event.Sequence(), "expectedType", iam.SMSConfigHTTPChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Hd92m", "reduce.wrong.event.type")
+	}
+	columns := make([]handler.Column, 0, 5)
+	if e.Endpoint != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnEndpoint, *e.Endpoint))
+	}
+	if e.Method != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnMethod, *e.Method))
+	}
+	if e.ContentType != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnContentType, *e.ContentType))
+	}
+	if e.BodyTemplate != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnBodyTemplate, *e.BodyTemplate))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSHTTPConfigColumnSenderNumber, *e.SenderNumber))
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			columns,
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigHTTPAuthorizationChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigHTTPAuthorizationChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Ha03m", "seq", event.Sequence(), "expectedType", iam.SMSConfigHTTPAuthorizationChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Hw82k", "reduce.wrong.event.type")
+	}
+
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPConfigColumnAuthorization, e.Authorization),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		crdb.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigActivatedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Ac83m", "seq", event.Sequence(), "expectedType", iam.SMSConfigActivatedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-fj9Ef", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMSColumnState, domain.SMSConfigStateActive),
+			handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMSColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+		},
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigDeactivatedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Dq92n", "seq", event.Sequence(), "expectedType", iam.SMSConfigDeactivatedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-dj9Js", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+			handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMSColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+		},
+	), nil
+}
+
+func (p *SMSConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMSConfigRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Rm82n", "seq", event.Sequence(), "expectedType", iam.SMSConfigRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-s9JJf", "reduce.wrong.event.type")
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SMSTwilioConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsTwilioTableSuffix),
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SMSVonageConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsVonageTableSuffix),
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPConfigColumnSMSID, e.ID),
+			},
+			crdb.WithTableSuffix(smsHTTPTableSuffix),
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+			},
+		),
+	), nil
+}
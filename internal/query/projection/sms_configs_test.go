@@ -0,0 +1,397 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func TestSMSConfigProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSMSConfigTwilioAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigTwilioAddedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id", "sid": "sid", "token": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "dG9rZW4="}, "senderNumber": "sender-number"}`),
+				), iam.SMSConfigTwilioAddedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigTwilioAdded,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.sms_configs (id, aggregate_id, creation_date, change_date, resource_owner, state, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.sms_configs_twilio (sms_id, sid, token, sender_number) VALUES ($1, $2, $3, $4)",
+							expectedArgs: []interface{}{
+								"id",
+								"sid",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("token"),
+								},
+								"sender-number",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigTwilioChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigTwilioChangedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id", "sid": "sid", "senderNumber": "sender-number"}`),
+				), iam.SMSConfigTwilioChangedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigTwilioChanged,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs_twilio SET (sid, sender_number) = ($1, $2) WHERE (sms_id = $3)",
+							expectedArgs: []interface{}{
+								"sid",
+								"sender-number",
+								"id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigActivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigActivatedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id"}`),
+				), iam.SMSConfigActivatedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigActivated,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs SET (state, change_date, sequence) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								domain.SMSConfigStateActive,
+								anyArg{},
+								uint64(15),
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigDeactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigDeactivatedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id"}`),
+				), iam.SMSConfigDeactivatedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigDeactivated,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs SET (state, change_date, sequence) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								domain.SMSConfigStateInactive,
+								anyArg{},
+								uint64(15),
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigVonageAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigVonageAddedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id", "apiKey": "key", "apiSecret": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "c2VjcmV0"}, "senderNumber": "sender-number"}`),
+				), iam.SMSConfigVonageAddedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigVonageAdded,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.sms_configs (id, aggregate_id, creation_date, change_date, resource_owner, state, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.sms_configs_vonage (sms_id, api_key, api_secret, sender_number) VALUES ($1, $2, $3, $4)",
+							expectedArgs: []interface{}{
+								"id",
+								"key",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("secret"),
+								},
+								"sender-number",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigVonageChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigVonageChangedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id", "apiKey": "key"}`),
+				), iam.SMSConfigVonageChangedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigVonageChanged,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs_vonage SET (api_key) = ($1) WHERE (sms_id = $2)",
+							expectedArgs: []interface{}{
+								"key",
+								"id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigHTTPAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigHTTPAddedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id", "endpoint": "https://sms.example.com", "method": "POST", "contentType": "application/json", "bodyTemplate": "{\"to\": {{json .To}}}", "senderNumber": "sender-number"}`),
+				), iam.SMSConfigHTTPAddedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigHTTPAdded,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.sms_configs (id, aggregate_id, creation_date, change_date, resource_owner, state, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.sms_configs_http (sms_id, endpoint, method, content_type, body_template, sender_number, auth_header) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"id",
+								"https://sms.example.com",
+								"POST",
+								"application/json",
+								`{"to": {{json .To}}}`,
+								"sender-number",
+								(*crypto.CryptoValue)(nil),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigHTTPAuthorizationChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigHTTPAuthorizationChangedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id", "authorization": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "YXV0aA=="}}`),
+				), iam.SMSConfigHTTPAuthorizationChangedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigHTTPAuthorizationChanged,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs_http SET (auth_header) = ($1) WHERE (sms_id = $2)",
+							expectedArgs: []interface{}{
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("auth"),
+								},
+								"id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE zitadel.projections.sms_configs SET (change_date, sequence) = ($1, $2) WHERE (id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMSConfigRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMSConfigRemovedEventType),
+					iam.AggregateType,
+					[]byte(`{"id": "id"}`),
+				), iam.SMSConfigRemovedEventMapper),
+			},
+			reduce: (&SMSConfigProjection{}).reduceSMSConfigRemoved,
+			want: wantReduce{
+				projection:       SMSConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.sms_configs_twilio WHERE (sms_id = $1)",
+							expectedArgs: []interface{}{
+								"id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.sms_configs_vonage WHERE (sms_id = $1)",
+							expectedArgs: []interface{}{
+								"id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.sms_configs_http WHERE (sms_id = $1)",
+							expectedArgs: []interface{}{
+								"id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.sms_configs WHERE (id = $1)",
+							expectedArgs: []interface{}{
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+const (
+	SMTPConfigProjectionTable = "zitadel.projections.smtp_configs"
+
+	SMTPConfigColumnAggregateID   = "aggregate_id"
+	SMTPConfigColumnCreationDate  = "creation_date"
+	SMTPConfigColumnChangeDate    = "change_date"
+	SMTPConfigColumnSequence      = "sequence"
+	SMTPConfigColumnResourceOwner = "resource_owner"
+	SMTPConfigColumnTLS           = "tls"
+	SMTPConfigColumnSenderAddress = "sender_address"
+	SMTPConfigColumnSenderName    = "sender_name"
+	SMTPConfigColumnSMTPHost      = "host"
+	SMTPConfigColumnSMTPUser      = "username"
+	SMTPConfigColumnSMTPPassword  = "password"
+)
+
+type SMTPConfigProjection struct {
+	crdb.StatementHandler
+}
+
+func NewSMTPConfigProjection(ctx context.Context, config crdb.StatementHandlerConfig) *SMTPConfigProjection {
+	p := &SMTPConfigProjection{}
+	config.ProjectionName = SMTPConfigProjectionTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *SMTPConfigProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: iam.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  iam.SMTPConfigAddedEventType,
+					Reduce: p.reduceSMTPConfigAdded,
+				},
+				{
+					Event:  iam.SMTPConfigChangedEventType,
+					Reduce: p.reduceSMTPConfigChanged,
+				},
+				{
+					Event:  iam.SMTPConfigPasswordChangedEventType,
+					Reduce: p.reduceSMTPConfigPasswordChanged,
+				},
+			},
+		},
+	}
+}
+
+func (p *SMTPConfigProjection) reduceSMTPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMTPConfigAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-sk99F", "seq", event.Sequence(), "expectedType", iam.SMTPConfigAddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Ms9dk", "reduce.wrong.event.type")
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnTLS, e.TLS),
+			handler.NewCol(SMTPConfigColumnSenderAddress, e.SenderAddress),
+			handler.NewCol(SMTPConfigColumnSenderName, e.SenderName),
+			handler.NewCol(SMTPConfigColumnSMTPHost, e.Host),
+			handler.NewCol(SMTPConfigColumnSMTPUser, e.User),
+			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
+		},
+	), nil
+}
+
+func (p *SMTPConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMTPConfigChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-wl0wd", "seq", event.Sequence(), "expectedType", iam.SMTPConfigChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Pw83n", "reduce.wrong.event.type")
+	}
+	columns := []handler.Column{
+		handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+	}
+	if e.TLS != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnTLS, *e.TLS))
+	}
+	if e.SenderAddress != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderAddress, *e.SenderAddress))
+	}
+	if e.SenderName != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSenderName, *e.SenderName))
+	}
+	if e.Host != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPHost, *e.Host))
+	}
+	if e.User != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnSMTPUser, *e.User))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *SMTPConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*iam.SMTPConfigPasswordChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-f92jS", "seq", event.Sequence(), "expectedType", iam.SMTPConfigPasswordChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-fk02f", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			handler.NewCol(SMTPConfigColumnSMTPPassword, e.Password),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,147 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/iam"
+)
+
+func TestSMTPConfigProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSMTPConfigAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMTPConfigAddedEventType),
+					iam.AggregateType,
+					[]byte(`{"tls": true, "senderAddress": "sender", "senderName": "name", "host": "host", "user": "user", "password": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "cGFzc3dvcmQ="}}`),
+				), iam.SMTPConfigAddedEventMapper),
+			},
+			reduce: (&SMTPConfigProjection{}).reduceSMTPConfigAdded,
+			want: wantReduce{
+				projection:       SMTPConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.smtp_configs (aggregate_id, creation_date, change_date, resource_owner, sequence, tls, sender_address, sender_name, host, username, password) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								true,
+								"sender",
+								"name",
+								"host",
+								"user",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMTPConfigChangedEventType),
+					iam.AggregateType,
+					[]byte(`{"tls": false, "senderAddress": "sender2", "host": "host2"}`),
+				), iam.SMTPConfigChangedEventMapper),
+			},
+			reduce: (&SMTPConfigProjection{}).reduceSMTPConfigChanged,
+			want: wantReduce{
+				projection:       SMTPConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.smtp_configs SET (change_date, sequence, tls, sender_address, host) = ($1, $2, $3, $4, $5) WHERE (aggregate_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								false,
+								"sender2",
+								"host2",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigPasswordChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(iam.SMTPConfigPasswordChangedEventType),
+					iam.AggregateType,
+					[]byte(`{"password": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "cGFzc3dvcmQ="}}`),
+				), iam.SMTPConfigPasswordChangedEventMapper),
+			},
+			reduce: (&SMTPConfigProjection{}).reduceSMTPConfigPasswordChanged,
+			want: wantReduce{
+				projection:       SMTPConfigProjectionTable,
+				aggregateType:    eventstore.AggregateType("iam"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.smtp_configs SET (change_date, sequence, password) = ($1, $2, $3) WHERE (aggregate_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/caos/logging"
 	"github.com/lib/pq"
@@ -107,16 +108,17 @@ func (p *UserGrantProjection) reducers() []handler.AggregateReducer {
 type UserGrantColumn string
 
 const (
-	UserGrantID            = "id"
-	UserGrantResourceOwner = "resource_owner"
-	UserGrantCreationDate  = "creation_date"
-	UserGrantChangeDate    = "change_date"
-	UserGrantSequence      = "sequence"
-	UserGrantUserID        = "user_id"
-	UserGrantProjectID     = "project_id"
-	UserGrantGrantID       = "grant_id"
-	UserGrantRoles         = "roles"
-	UserGrantState         = "state"
+	UserGrantID             = "id"
+	UserGrantResourceOwner  = "resource_owner"
+	UserGrantCreationDate   = "creation_date"
+	UserGrantChangeDate     = "change_date"
+	UserGrantSequence       = "sequence"
+	UserGrantUserID         = "user_id"
+	UserGrantProjectID      = "project_id"
+	UserGrantGrantID        = "grant_id"
+	UserGrantRoles          = "roles"
+	UserGrantState          = "state"
+	UserGrantExpirationDate = "expiration_date"
 )
 
 func (p *UserGrantProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
@@ -138,6 +140,7 @@ func (p *UserGrantProjection) reduceAdded(event eventstore.Event) (*handler.Stat
 			handler.NewCol(UserGrantGrantID, e.ProjectGrantID),
 			handler.NewCol(UserGrantRoles, pq.StringArray(e.RoleKeys)),
 			handler.NewCol(UserGrantState, domain.UserGrantStateActive),
+			handler.NewCol(UserGrantExpirationDate, sql.NullTime{Time: e.ExpirationDate, Valid: !e.ExpirationDate.IsZero()}),
 		},
 	), nil
 }
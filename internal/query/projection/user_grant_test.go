@@ -1,7 +1,9 @@
 package projection
 
 import (
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/lib/pq"
 
@@ -47,7 +49,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO zitadel.projections.user_grants (id, resource_owner, creation_date, change_date, sequence, user_id, project_id, grant_id, roles, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO zitadel.projections.user_grants (id, resource_owner, creation_date, change_date, sequence, user_id, project_id, grant_id, roles, state, expiration_date) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"ro-id",
@@ -59,6 +61,49 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 								"",
 								pq.StringArray{"role"},
 								domain.UserGrantStateActive,
+								sql.NullTime{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAdded with expiration",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(usergrant.UserGrantAddedType),
+					usergrant.AggregateType,
+					[]byte(`{
+						"userId": "user-id",
+						"projectId": "project-id",
+						"roleKeys": ["role"],
+						"expirationDate": "2021-12-31T23:00:00Z"
+					}`),
+				), usergrant.UserGrantAddedEventMapper),
+			},
+			reduce: (&UserGrantProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType:    usergrant.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserGrantProjectionTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.user_grants (id, resource_owner, creation_date, change_date, sequence, user_id, project_id, grant_id, roles, state, expiration_date) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"user-id",
+								"project-id",
+								"",
+								pq.StringArray{"role"},
+								domain.UserGrantStateActive,
+								sql.NullTime{Time: time.Date(2021, 12, 31, 23, 0, 0, 0, time.UTC), Valid: true},
 							},
 						},
 					},
@@ -0,0 +1,161 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+const (
+	UserPasswordAgeTable              = "zitadel.projections.user_password_ages"
+	UserPasswordAgeUserIDCol          = "user_id"
+	UserPasswordAgeResourceOwnerCol   = "resource_owner"
+	UserPasswordAgeChangeDateCol      = "change_date"
+	UserPasswordAgeSequenceCol        = "sequence"
+	UserPasswordAgePasswordChangedCol = "password_changed"
+	UserPasswordAgeExpiryNotifiedCol  = "expiry_notified"
+)
+
+//UserPasswordAgeProjection keeps the date of the last password change of each human
+//it's used to notify users about the expiry of their password
+type UserPasswordAgeProjection struct {
+	crdb.StatementHandler
+}
+
+func NewUserPasswordAgeProjection(ctx context.Context, config crdb.StatementHandlerConfig) *UserPasswordAgeProjection {
+	p := &UserPasswordAgeProjection{}
+	config.ProjectionName = UserPasswordAgeTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *UserPasswordAgeProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  user.UserV1AddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanAddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1RegisteredType,
+					Reduce: p.reduceHumanRegistered,
+				},
+				{
+					Event:  user.HumanRegisteredType,
+					Reduce: p.reduceHumanRegistered,
+				},
+				{
+					Event:  user.UserV1PasswordChangedType,
+					Reduce: p.reducePasswordChanged,
+				},
+				{
+					Event:  user.HumanPasswordChangedType,
+					Reduce: p.reducePasswordChanged,
+				},
+				{
+					Event:  user.HumanPasswordExpiryNotificationAddedType,
+					Reduce: p.reduceExpiryNotificationAdded,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *UserPasswordAgeProjection) reduceHumanAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pa8sd", "seq", event.Sequence(), "expectedType", user.HumanAddedType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Pa2we", "reduce.wrong.event.type")
+	}
+	return p.passwordChanged(e, e.Secret), nil
+}
+
+func (p *UserPasswordAgeProjection) reduceHumanRegistered(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRegisteredEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pa4kf", "seq", event.Sequence(), "expectedType", user.HumanRegisteredType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Pa9sj", "reduce.wrong.event.type")
+	}
+	return p.passwordChanged(e, e.Secret), nil
+}
+
+func (p *UserPasswordAgeProjection) reducePasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pa6ne", "seq", event.Sequence(), "expectedType", user.HumanPasswordChangedType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Pa1xo", "reduce.wrong.event.type")
+	}
+	//a rehashed password keeps its age
+	if e.Rehashed {
+		return crdb.NewNoOpStatement(e), nil
+	}
+	return p.passwordChanged(e, e.Secret), nil
+}
+
+func (p *UserPasswordAgeProjection) passwordChanged(event eventstore.Event, secret *crypto.CryptoValue) *handler.Statement {
+	if secret == nil {
+		return crdb.NewNoOpStatement(event)
+	}
+	return crdb.NewUpsertStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserPasswordAgeUserIDCol, event.Aggregate().ID),
+			handler.NewCol(UserPasswordAgeResourceOwnerCol, event.Aggregate().ResourceOwner),
+			handler.NewCol(UserPasswordAgeChangeDateCol, event.CreationDate()),
+			handler.NewCol(UserPasswordAgeSequenceCol, event.Sequence()),
+			handler.NewCol(UserPasswordAgePasswordChangedCol, event.CreationDate()),
+			handler.NewCol(UserPasswordAgeExpiryNotifiedCol, false),
+		},
+	)
+}
+
+func (p *UserPasswordAgeProjection) reduceExpiryNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordExpiryNotificationAddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pa3mv", "seq", event.Sequence(), "expectedType", user.HumanPasswordExpiryNotificationAddedType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Pa7gq", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserPasswordAgeChangeDateCol, e.CreationDate()),
+			handler.NewCol(UserPasswordAgeSequenceCol, e.Sequence()),
+			handler.NewCol(UserPasswordAgeExpiryNotifiedCol, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserPasswordAgeUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *UserPasswordAgeProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pa5bt", "seq", event.Sequence(), "expectedType", user.UserRemovedType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Pa0rz", "reduce.wrong.event.type")
+	}
+	return crdb.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserPasswordAgeUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
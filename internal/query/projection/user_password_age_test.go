@@ -0,0 +1,230 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+func TestUserPasswordAgeProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceHumanAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanAddedType),
+					user.AggregateType,
+					[]byte(`{"username": "user-name", "secret": {"cryptoType": 1, "algorithm": "bcrypt", "crypted": "cGFzc3dvcmQ="}}`),
+				), user.HumanAddedEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reduceHumanAdded,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPSERT INTO zitadel.projections.user_password_ages (user_id, resource_owner, change_date, sequence, password_changed, expiry_notified) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceHumanAdded without password",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanAddedType),
+					user.AggregateType,
+					[]byte(`{"username": "user-name"}`),
+				), user.HumanAddedEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reduceHumanAdded,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceHumanRegistered",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanRegisteredType),
+					user.AggregateType,
+					[]byte(`{"username": "user-name", "secret": {"cryptoType": 1, "algorithm": "bcrypt", "crypted": "cGFzc3dvcmQ="}}`),
+				), user.HumanRegisteredEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reduceHumanRegistered,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPSERT INTO zitadel.projections.user_password_ages (user_id, resource_owner, change_date, sequence, password_changed, expiry_notified) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePasswordChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordChangedType),
+					user.AggregateType,
+					[]byte(`{"secret": {"cryptoType": 1, "algorithm": "bcrypt", "crypted": "cGFzc3dvcmQ="}}`),
+				), user.HumanPasswordChangedEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reducePasswordChanged,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPSERT INTO zitadel.projections.user_password_ages (user_id, resource_owner, change_date, sequence, password_changed, expiry_notified) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePasswordChanged rehashed",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordChangedType),
+					user.AggregateType,
+					[]byte(`{"secret": {"cryptoType": 1, "algorithm": "bcrypt", "crypted": "cGFzc3dvcmQ="}, "rehashed": true}`),
+				), user.HumanPasswordChangedEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reducePasswordChanged,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceExpiryNotificationAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.HumanPasswordExpiryNotificationAddedType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.HumanPasswordExpiryNotificationAddedEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reduceExpiryNotificationAdded,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.user_password_ages SET (change_date, sequence, expiry_notified) = ($1, $2, $3) WHERE (user_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								true,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(user.UserRemovedType),
+					user.AggregateType,
+					[]byte(`{}`),
+				), user.UserRemovedEventMapper),
+			},
+			reduce: (&UserPasswordAgeProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType:    user.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       UserPasswordAgeTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.user_password_ages WHERE (user_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/webhook"
+)
+
+const (
+	WebhookTable            = "zitadel.projections.webhooks"
+	WebhookIDCol            = "id"
+	WebhookCreationDateCol  = "creation_date"
+	WebhookChangeDateCol    = "change_date"
+	WebhookResourceOwnerCol = "resource_owner"
+	WebhookSequenceCol      = "sequence"
+	WebhookStateCol         = "state"
+	WebhookNameCol          = "name"
+	WebhookURLCol           = "url"
+	WebhookEventTypesCol    = "event_types"
+	WebhookSigningKeyCol    = "signing_key"
+
+	webhookFailedDeliveriesTableSuffix = "failed_deliveries"
+	WebhookFailedDeliveriesTable       = WebhookTable + "_" + webhookFailedDeliveriesTableSuffix
+
+	WebhookFailedDeliveryWebhookIDCol      = "webhook_id"
+	WebhookFailedDeliveryResourceOwnerCol  = "resource_owner"
+	WebhookFailedDeliveryFailedSequenceCol = "failed_sequence"
+	WebhookFailedDeliveryAggregateTypeCol  = "aggregate_type"
+	WebhookFailedDeliveryAggregateIDCol    = "aggregate_id"
+	WebhookFailedDeliveryEventTypeCol      = "event_type"
+	WebhookFailedDeliveryFailureCountCol   = "failure_count"
+	WebhookFailedDeliveryErrorCol          = "error"
+	WebhookFailedDeliveryLastFailedCol     = "last_failed"
+)
+
+type WebhookProjection struct {
+	crdb.StatementHandler
+}
+
+func NewWebhookProjection(ctx context.Context, config crdb.StatementHandlerConfig) *WebhookProjection {
+	p := &WebhookProjection{}
+	config.ProjectionName = WebhookTable
+	config.Reducers = p.reducers()
+	p.StatementHandler = crdb.NewStatementHandler(ctx, config)
+	return p
+}
+
+func (p *WebhookProjection) reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: webhook.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  webhook.AddedEventType,
+					Reduce: p.reduceWebhookAdded,
+				},
+				{
+					Event:  webhook.ChangedEventType,
+					Reduce: p.reduceWebhookChanged,
+				},
+				{
+					Event:  webhook.SigningKeyChangedEventType,
+					Reduce: p.reduceWebhookSigningKeyChanged,
+				},
+				{
+					Event:  webhook.DeactivatedEventType,
+					Reduce: p.reduceWebhookDeactivated,
+				},
+				{
+					Event:  webhook.ReactivatedEventType,
+					Reduce: p.reduceWebhookReactivated,
+				},
+				{
+					Event:  webhook.RemovedEventType,
+					Reduce: p.reduceWebhookRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventRedusers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *WebhookProjection) reduceWebhookAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.AddedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Pq8fn", "seq", event.Sequence(), "expectedType", webhook.AddedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Mz3jd", "reduce.wrong.event.type")
+	}
+	return crdb.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookIDCol, e.Aggregate().ID),
+			handler.NewCol(WebhookCreationDateCol, e.CreationDate()),
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookStateCol, domain.WebhookStateActive),
+			handler.NewCol(WebhookNameCol, e.Name),
+			handler.NewCol(WebhookURLCol, e.URL),
+			handler.NewCol(WebhookEventTypesCol, pq.StringArray(e.EventTypes)),
+			handler.NewCol(WebhookSigningKeyCol, e.SigningKey),
+		},
+	), nil
+}
+
+func (p *WebhookProjection) reduceWebhookChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.ChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Hd8wl", "seq", event.Sequence(), "expectedType", webhook.ChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Ks0dn", "reduce.wrong.event.type")
+	}
+	values := []handler.Column{
+		handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+		handler.NewCol(WebhookSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(WebhookNameCol, *e.Name))
+	}
+	if e.URL != nil {
+		values = append(values, handler.NewCol(WebhookURLCol, *e.URL))
+	}
+	if e.EventTypes != nil {
+		values = append(values, handler.NewCol(WebhookEventTypesCol, pq.StringArray(*e.EventTypes)))
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *WebhookProjection) reduceWebhookSigningKeyChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.SigningKeyChangedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Wb3ms", "seq", event.Sequence(), "expectedType", webhook.SigningKeyChangedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Tn2sk", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookSigningKeyCol, e.SigningKey),
+		},
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *WebhookProjection) reduceWebhookDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.DeactivatedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Gq9sm", "seq", event.Sequence(), "expectedType", webhook.DeactivatedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Ur7dk", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookStateCol, domain.WebhookStateInactive),
+		},
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *WebhookProjection) reduceWebhookReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.ReactivatedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Bc2lw", "seq", event.Sequence(), "expectedType", webhook.ReactivatedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Yq6mn", "reduce.wrong.event.type")
+	}
+	return crdb.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(WebhookChangeDateCol, e.CreationDate()),
+			handler.NewCol(WebhookSequenceCol, e.Sequence()),
+			handler.NewCol(WebhookStateCol, domain.WebhookStateActive),
+		},
+		[]handler.Condition{
+			handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *WebhookProjection) reduceWebhookRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*webhook.RemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-Lm4sq", "seq", event.Sequence(), "expectedType", webhook.RemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-Oe2nf", "reduce.wrong.event.type")
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(WebhookIDCol, e.Aggregate().ID),
+			},
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(WebhookFailedDeliveryWebhookIDCol, e.Aggregate().ID),
+			},
+			crdb.WithTableSuffix(webhookFailedDeliveriesTableSuffix),
+		),
+	), nil
+}
+
+func (p *WebhookProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		logging.LogWithFields("HANDL-uNInr", "seq", event.Sequence(), "expectedType", org.OrgRemovedEventType).Error("wrong event type")
+		return nil, errors.ThrowInvalidArgument(nil, "HANDL-qFnai", "reduce.wrong.event.type")
+	}
+	return crdb.NewMultiStatement(
+		e,
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(WebhookFailedDeliveryResourceOwnerCol, e.Aggregate().ID),
+			},
+			crdb.WithTableSuffix(webhookFailedDeliveriesTableSuffix),
+		),
+		crdb.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(WebhookResourceOwnerCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
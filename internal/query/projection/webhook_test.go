@@ -0,0 +1,275 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/webhook"
+)
+
+func TestWebhookProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceWebhookAdded",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.AddedEventType),
+					webhook.AggregateType,
+					[]byte(`{"name": "name", "url": "https://example.com/hook", "eventTypes": ["user.human.added"], "signingKey": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "a2V5"}}`),
+				), webhook.AddedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceWebhookAdded,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO zitadel.projections.webhooks (id, creation_date, change_date, resource_owner, sequence, state, name, url, event_types, signing_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								domain.WebhookStateActive,
+								"name",
+								"https://example.com/hook",
+								pq.StringArray{"user.human.added"},
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("key"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.ChangedEventType),
+					webhook.AggregateType,
+					[]byte(`{"url": "https://example.com/hook2", "eventTypes": ["user.locked"]}`),
+				), webhook.ChangedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceWebhookChanged,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.webhooks SET (change_date, sequence, url, event_types) = ($1, $2, $3, $4) WHERE (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"https://example.com/hook2",
+								pq.StringArray{"user.locked"},
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookSigningKeyChanged",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.SigningKeyChangedEventType),
+					webhook.AggregateType,
+					[]byte(`{"signingKey": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "a2V5"}}`),
+				), webhook.SigningKeyChangedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceWebhookSigningKeyChanged,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.webhooks SET (change_date, sequence, signing_key) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("key"),
+								},
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookDeactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.DeactivatedEventType),
+					webhook.AggregateType,
+					nil,
+				), webhook.DeactivatedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceWebhookDeactivated,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.webhooks SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.WebhookStateInactive,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookReactivated",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.ReactivatedEventType),
+					webhook.AggregateType,
+					nil,
+				), webhook.ReactivatedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceWebhookReactivated,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE zitadel.projections.webhooks SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.WebhookStateActive,
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceWebhookRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(webhook.RemovedEventType),
+					webhook.AggregateType,
+					nil,
+				), webhook.RemovedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceWebhookRemoved,
+			want: wantReduce{
+				projection:       WebhookTable,
+				aggregateType:    eventstore.AggregateType("webhook"),
+				sequence:         15,
+				previousSequence: 10,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.webhooks WHERE (id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.webhooks_failed_deliveries WHERE (webhook_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.OrgRemovedEventType",
+			args: args{
+				event: getEvent(testEvent(
+					repository.EventType(org.OrgRemovedEventType),
+					org.AggregateType,
+					[]byte(`{}`),
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&WebhookProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType:    org.AggregateType,
+				sequence:         15,
+				previousSequence: 10,
+				projection:       WebhookTable,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.webhooks_failed_deliveries WHERE (resource_owner = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM zitadel.projections.webhooks WHERE (resource_owner = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if _, ok := err.(errors.InvalidArgument); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, tt.want)
+		})
+	}
+}
@@ -16,12 +16,14 @@ import (
 	"github.com/caos/zitadel/internal/eventstore"
 	"github.com/caos/zitadel/internal/query/projection"
 	"github.com/caos/zitadel/internal/repository/action"
+	"github.com/caos/zitadel/internal/repository/deviceauth"
 	iam_repo "github.com/caos/zitadel/internal/repository/iam"
 	"github.com/caos/zitadel/internal/repository/keypair"
 	"github.com/caos/zitadel/internal/repository/org"
 	"github.com/caos/zitadel/internal/repository/project"
 	usr_repo "github.com/caos/zitadel/internal/repository/user"
 	"github.com/caos/zitadel/internal/repository/usergrant"
+	"github.com/caos/zitadel/internal/repository/webhook"
 )
 
 type Queries struct {
@@ -73,6 +75,8 @@ func StartQueries(ctx context.Context, es *eventstore.Eventstore, projections pr
 	action.RegisterEventMappers(repo.eventstore)
 	keypair.RegisterEventMappers(repo.eventstore)
 	usergrant.RegisterEventMappers(repo.eventstore)
+	deviceauth.RegisterEventMappers(repo.eventstore)
+	webhook.RegisterEventMappers(repo.eventstore)
 
 	err = projection.Start(ctx, sqlClient, es, projections, defaults, keyChan)
 	if err != nil {
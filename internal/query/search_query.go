@@ -3,6 +3,7 @@ package query
 import (
 	"errors"
 	"reflect"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
@@ -69,22 +70,22 @@ func (q *NotNullQuery) comp() sq.Sqlizer {
 	return sq.NotEq{q.Column.identifier(): nil}
 }
 
-type orQuery struct {
+type OrQuery struct {
 	queries []SearchQuery
 }
 
-func newOrQuery(queries ...SearchQuery) (*orQuery, error) {
+func NewOrQuery(queries ...SearchQuery) (*OrQuery, error) {
 	if len(queries) == 0 {
 		return nil, ErrMissingColumn
 	}
-	return &orQuery{queries: queries}, nil
+	return &OrQuery{queries: queries}, nil
 }
 
-func (q *orQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+func (q *OrQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
 	return query.Where(q.comp())
 }
 
-func (q *orQuery) comp() sq.Sqlizer {
+func (q *OrQuery) comp() sq.Sqlizer {
 	or := make(sq.Or, len(q.queries))
 	for i, query := range q.queries {
 		or[i] = query.comp()
@@ -92,6 +93,48 @@ func (q *orQuery) comp() sq.Sqlizer {
 	return or
 }
 
+type AndQuery struct {
+	queries []SearchQuery
+}
+
+func NewAndQuery(queries ...SearchQuery) (*AndQuery, error) {
+	if len(queries) == 0 {
+		return nil, ErrMissingColumn
+	}
+	return &AndQuery{queries: queries}, nil
+}
+
+func (q *AndQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *AndQuery) comp() sq.Sqlizer {
+	and := make(sq.And, len(q.queries))
+	for i, query := range q.queries {
+		and[i] = query.comp()
+	}
+	return and
+}
+
+type NotQuery struct {
+	query SearchQuery
+}
+
+func NewNotQuery(query SearchQuery) (*NotQuery, error) {
+	if query == nil {
+		return nil, ErrMissingColumn
+	}
+	return &NotQuery{query: query}, nil
+}
+
+func (q *NotQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *NotQuery) comp() sq.Sqlizer {
+	return &not{q.query.comp()}
+}
+
 type TextQuery struct {
 	Column  Column
 	Text    string
@@ -334,6 +377,58 @@ func (s *BoolQuery) comp() sq.Sqlizer {
 	return sq.Eq{s.Column.identifier(): s.Value}
 }
 
+type TimestampQuery struct {
+	Column    Column
+	Timestamp time.Time
+	Compare   TimestampComparison
+}
+
+func NewTimestampQuery(c Column, value time.Time, compare TimestampComparison) (*TimestampQuery, error) {
+	if compare < 0 || compare >= timestampCompareMax {
+		return nil, ErrInvalidCompare
+	}
+	if c.isZero() {
+		return nil, ErrMissingColumn
+	}
+	return &TimestampQuery{
+		Column:    c,
+		Timestamp: value,
+		Compare:   compare,
+	}, nil
+}
+
+func (q *TimestampQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (s *TimestampQuery) comp() sq.Sqlizer {
+	switch s.Compare {
+	case TimestampEquals:
+		return sq.Eq{s.Column.identifier(): s.Timestamp}
+	case TimestampGreater:
+		return sq.Gt{s.Column.identifier(): s.Timestamp}
+	case TimestampGreaterOrEquals:
+		return sq.GtOrEq{s.Column.identifier(): s.Timestamp}
+	case TimestampLess:
+		return sq.Lt{s.Column.identifier(): s.Timestamp}
+	case TimestampLessOrEquals:
+		return sq.LtOrEq{s.Column.identifier(): s.Timestamp}
+	}
+	return nil
+}
+
+type TimestampComparison int
+
+const (
+	TimestampEquals TimestampComparison = iota
+	TimestampGreater
+	TimestampGreaterOrEquals
+	TimestampLess
+	TimestampLessOrEquals
+
+	timestampCompareMax
+)
+
 var (
 	//countColumn represents the default counter for search responses
 	countColumn = Column{
@@ -402,3 +497,15 @@ type listContains struct {
 func (q *listContains) ToSql() (string, []interface{}, error) {
 	return q.col.identifier() + " @> ? ", q.args, nil
 }
+
+type not struct {
+	sq.Sqlizer
+}
+
+func (n *not) ToSql() (string, []interface{}, error) {
+	query, args, err := n.Sqlizer.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return "NOT (" + query + ")", args, nil
+}
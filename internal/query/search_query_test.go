@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/caos/zitadel/internal/domain"
@@ -687,6 +688,187 @@ func TestNumberQuery_comp(t *testing.T) {
 	}
 }
 
+func TestNewTimestampQuery(t *testing.T) {
+	now := time.Now()
+	type args struct {
+		column  Column
+		value   time.Time
+		compare TimestampComparison
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *TimestampQuery
+		wantErr func(error) bool
+	}{
+		{
+			name: "too low compare",
+			args: args{
+				column:  testCol,
+				value:   now,
+				compare: -1,
+			},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrInvalidCompare)
+			},
+		},
+		{
+			name: "too high compare",
+			args: args{
+				column:  testCol,
+				value:   now,
+				compare: timestampCompareMax,
+			},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrInvalidCompare)
+			},
+		},
+		{
+			name: "no column",
+			args: args{
+				column:  Column{},
+				value:   now,
+				compare: TimestampEquals,
+			},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrMissingColumn)
+			},
+		},
+		{
+			name: "correct",
+			args: args{
+				column:  testCol,
+				value:   now,
+				compare: TimestampGreater,
+			},
+			want: &TimestampQuery{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   TimestampGreater,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTimestampQuery(tt.args.column, tt.args.value, tt.args.compare)
+			if err != nil && tt.wantErr == nil {
+				t.Errorf("NewTimestampQuery() no error expected got %v", err)
+				return
+			} else if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("NewTimestampQuery() unexpeted error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTimestampQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampQuery_comp(t *testing.T) {
+	now := time.Now()
+	type fields struct {
+		Column    Column
+		Timestamp time.Time
+		Compare   TimestampComparison
+	}
+	type want struct {
+		query interface{}
+		isNil bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "equals",
+			fields: fields{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   TimestampEquals,
+			},
+			want: want{
+				query: sq.Eq{"test_table.test_col": now},
+			},
+		},
+		{
+			name: "greater",
+			fields: fields{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   TimestampGreater,
+			},
+			want: want{
+				query: sq.Gt{"test_table.test_col": now},
+			},
+		},
+		{
+			name: "greater or equals",
+			fields: fields{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   TimestampGreaterOrEquals,
+			},
+			want: want{
+				query: sq.GtOrEq{"test_table.test_col": now},
+			},
+		},
+		{
+			name: "less",
+			fields: fields{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   TimestampLess,
+			},
+			want: want{
+				query: sq.Lt{"test_table.test_col": now},
+			},
+		},
+		{
+			name: "less or equals",
+			fields: fields{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   TimestampLessOrEquals,
+			},
+			want: want{
+				query: sq.LtOrEq{"test_table.test_col": now},
+			},
+		},
+		{
+			name: "too high comparison",
+			fields: fields{
+				Column:    testCol,
+				Timestamp: now,
+				Compare:   timestampCompareMax,
+			},
+			want: want{
+				isNil: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &TimestampQuery{
+				Column:    tt.fields.Column,
+				Timestamp: tt.fields.Timestamp,
+				Compare:   tt.fields.Compare,
+			}
+			query := s.comp()
+			if query == nil && tt.want.isNil {
+				return
+			} else if tt.want.isNil && query != nil {
+				t.Error("query should not be nil")
+			}
+
+			if !reflect.DeepEqual(query, tt.want.query) {
+				t.Errorf("wrong query: want: %v, (%T), got: %v, (%T)", tt.want.query, tt.want.query, query, query)
+			}
+		})
+	}
+}
+
 func TestNumberComparisonFromMethod(t *testing.T) {
 	type args struct {
 		m domain.SearchMethod
@@ -747,3 +929,79 @@ func TestNumberComparisonFromMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestCombinedQuery_comp(t *testing.T) {
+	textQuery := &TextQuery{Column: testCol, Text: "Hurst", Compare: TextEquals}
+	numberQuery := &NumberQuery{Column: testCol, Number: 1, Compare: NumberEquals}
+	type want struct {
+		query string
+		args  []interface{}
+	}
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  want
+	}{
+		{
+			name:  "or",
+			query: &OrQuery{queries: []SearchQuery{textQuery, numberQuery}},
+			want: want{
+				query: "(test_table.test_col = ? OR test_table.test_col = ?)",
+				args:  []interface{}{"Hurst", 1},
+			},
+		},
+		{
+			name:  "and",
+			query: &AndQuery{queries: []SearchQuery{textQuery, numberQuery}},
+			want: want{
+				query: "(test_table.test_col = ? AND test_table.test_col = ?)",
+				args:  []interface{}{"Hurst", 1},
+			},
+		},
+		{
+			name:  "not",
+			query: &NotQuery{query: textQuery},
+			want: want{
+				query: "NOT (test_table.test_col = ?)",
+				args:  []interface{}{"Hurst"},
+			},
+		},
+		{
+			name: "nested",
+			query: &NotQuery{query: &OrQuery{queries: []SearchQuery{
+				textQuery,
+				&AndQuery{queries: []SearchQuery{textQuery, numberQuery}},
+			}}},
+			want: want{
+				query: "NOT ((test_table.test_col = ? OR (test_table.test_col = ? AND test_table.test_col = ?)))",
+				args:  []interface{}{"Hurst", "Hurst", 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args, err := tt.query.comp().ToSql()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query != tt.want.query {
+				t.Errorf("wrong query: want %q, got %q", tt.want.query, query)
+			}
+			if !reflect.DeepEqual(args, tt.want.args) {
+				t.Errorf("wrong args: want %v, got %v", tt.want.args, args)
+			}
+		})
+	}
+}
+
+func TestNewCombinedQuery(t *testing.T) {
+	if _, err := NewOrQuery(); !errors.Is(err, ErrMissingColumn) {
+		t.Errorf("or: expected %v, got %v", ErrMissingColumn, err)
+	}
+	if _, err := NewAndQuery(); !errors.Is(err, ErrMissingColumn) {
+		t.Errorf("and: expected %v, got %v", ErrMissingColumn, err)
+	}
+	if _, err := NewNotQuery(nil); !errors.Is(err, ErrMissingColumn) {
+		t.Errorf("not: expected %v, got %v", ErrMissingColumn, err)
+	}
+}
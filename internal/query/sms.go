@@ -0,0 +1,469 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+type SMSConfigs struct {
+	SearchResponse
+	Configs []*SMSConfig
+}
+
+type SMSConfig struct {
+	AggregateID   string
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.SMSConfigState
+	Sequence      uint64
+
+	TwilioConfig *Twilio
+	VonageConfig *Vonage
+	HTTPConfig   *HTTP
+}
+
+type Twilio struct {
+	SID          string
+	Token        *crypto.CryptoValue
+	SenderNumber string
+}
+
+type Vonage struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type HTTP struct {
+	Endpoint      string
+	Method        string
+	ContentType   string
+	BodyTemplate  string
+	SenderNumber  string
+	Authorization *crypto.CryptoValue
+}
+
+type SMSConfigsSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SMSConfigsSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+var (
+	smsConfigsTable = table{
+		name: projection.SMSConfigProjectionTable,
+	}
+	SMSConfigColumnID = Column{
+		name:  projection.SMSColumnID,
+		table: smsConfigsTable,
+	}
+	SMSConfigColumnAggregateID = Column{
+		name:  projection.SMSColumnAggregateID,
+		table: smsConfigsTable,
+	}
+	SMSConfigColumnCreationDate = Column{
+		name:  projection.SMSColumnCreationDate,
+		table: smsConfigsTable,
+	}
+	SMSConfigColumnChangeDate = Column{
+		name:  projection.SMSColumnChangeDate,
+		table: smsConfigsTable,
+	}
+	SMSConfigColumnResourceOwner = Column{
+		name:  projection.SMSColumnResourceOwner,
+		table: smsConfigsTable,
+	}
+	SMSConfigColumnState = Column{
+		name:  projection.SMSColumnState,
+		table: smsConfigsTable,
+	}
+	SMSConfigColumnSequence = Column{
+		name:  projection.SMSColumnSequence,
+		table: smsConfigsTable,
+	}
+)
+
+var (
+	smsTwilioConfigsTable = table{
+		name: projection.SMSTwilioTable,
+	}
+	SMSTwilioConfigColumnSMSID = Column{
+		name:  projection.SMSTwilioConfigColumnSMSID,
+		table: smsTwilioConfigsTable,
+	}
+	SMSTwilioConfigColumnSID = Column{
+		name:  projection.SMSTwilioConfigColumnSID,
+		table: smsTwilioConfigsTable,
+	}
+	SMSTwilioConfigColumnToken = Column{
+		name:  projection.SMSTwilioConfigColumnToken,
+		table: smsTwilioConfigsTable,
+	}
+	SMSTwilioConfigColumnSenderNumber = Column{
+		name:  projection.SMSTwilioConfigColumnSenderNumber,
+		table: smsTwilioConfigsTable,
+	}
+)
+
+var (
+	smsVonageConfigsTable = table{
+		name: projection.SMSVonageTable,
+	}
+	SMSVonageConfigColumnSMSID = Column{
+		name:  projection.SMSVonageConfigColumnSMSID,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnAPIKey = Column{
+		name:  projection.SMSVonageConfigColumnAPIKey,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnAPISecret = Column{
+		name:  projection.SMSVonageConfigColumnAPISecret,
+		table: smsVonageConfigsTable,
+	}
+	SMSVonageConfigColumnSenderNumber = Column{
+		name:  projection.SMSVonageConfigColumnSenderNumber,
+		table: smsVonageConfigsTable,
+	}
+)
+
+var (
+	smsHTTPConfigsTable = table{
+		name: projection.SMSHTTPTable,
+	}
+	SMSHTTPConfigColumnSMSID = Column{
+		name:  projection.SMSHTTPConfigColumnSMSID,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnEndpoint = Column{
+		name:  projection.SMSHTTPConfigColumnEndpoint,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnMethod = Column{
+		name:  projection.SMSHTTPConfigColumnMethod,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnContentType = Column{
+		name:  projection.SMSHTTPConfigColumnContentType,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnBodyTemplate = Column{
+		name:  projection.SMSHTTPConfigColumnBodyTemplate,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnSenderNumber = Column{
+		name:  projection.SMSHTTPConfigColumnSenderNumber,
+		table: smsHTTPConfigsTable,
+	}
+	SMSHTTPConfigColumnAuthorization = Column{
+		name:  projection.SMSHTTPConfigColumnAuthorization,
+		table: smsHTTPConfigsTable,
+	}
+)
+
+func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (*SMSConfig, error) {
+	query, scan := prepareSMSConfigQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			SMSConfigColumnID.identifier(): id,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-dn9JW", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+//SMSProviderConfigActive returns the sms provider of the resource owner which is currently used to send messages
+func (q *Queries) SMSProviderConfigActive(ctx context.Context, resourceOwner string) (*SMSConfig, error) {
+	query, scan := prepareSMSConfigQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			SMSConfigColumnResourceOwner.identifier(): resourceOwner,
+			SMSConfigColumnState.identifier():         domain.SMSConfigStateActive,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ac82n", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, stmt, args...)
+	return scan(row)
+}
+
+func (q *Queries) SearchSMSConfigs(ctx context.Context, queries *SMSConfigsSearchQueries) (*SMSConfigs, error) {
+	query, scan := prepareSMSConfigsQuery()
+	stmt, args, err := queries.toQuery(query).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-sn9Jf", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-aJnZL", "Errors.Internal")
+	}
+	configs, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	configs.LatestSequence, err = q.latestSequence(ctx, smsConfigsTable)
+	return configs, err
+}
+
+func NewSMSConfigResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(SMSConfigColumnResourceOwner, value, TextEquals)
+}
+
+func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, error)) {
+	return sq.Select(
+			SMSConfigColumnID.identifier(),
+			SMSConfigColumnAggregateID.identifier(),
+			SMSConfigColumnCreationDate.identifier(),
+			SMSConfigColumnChangeDate.identifier(),
+			SMSConfigColumnResourceOwner.identifier(),
+			SMSConfigColumnState.identifier(),
+			SMSConfigColumnSequence.identifier(),
+
+			SMSTwilioConfigColumnSMSID.identifier(),
+			SMSTwilioConfigColumnSID.identifier(),
+			SMSTwilioConfigColumnToken.identifier(),
+			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSVonageConfigColumnSMSID.identifier(),
+			SMSVonageConfigColumnAPIKey.identifier(),
+			SMSVonageConfigColumnAPISecret.identifier(),
+			SMSVonageConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnContentType.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+			SMSHTTPConfigColumnAuthorization.identifier(),
+		).From(smsConfigsTable.identifier()).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSVonageConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*SMSConfig, error) {
+			config := new(SMSConfig)
+
+			twilioConfig := sqlTwilioConfig{}
+			vonageConfig := sqlVonageConfig{}
+			httpConfig := sqlHTTPConfig{}
+
+			err := row.Scan(
+				&config.ID,
+				&config.AggregateID,
+				&config.CreationDate,
+				&config.ChangeDate,
+				&config.ResourceOwner,
+				&config.State,
+				&config.Sequence,
+
+				&twilioConfig.smsID,
+				&twilioConfig.sid,
+				&twilioConfig.token,
+				&twilioConfig.senderNumber,
+
+				&vonageConfig.smsID,
+				&vonageConfig.apiKey,
+				&vonageConfig.apiSecret,
+				&vonageConfig.senderNumber,
+
+				&httpConfig.smsID,
+				&httpConfig.endpoint,
+				&httpConfig.method,
+				&httpConfig.contentType,
+				&httpConfig.bodyTemplate,
+				&httpConfig.senderNumber,
+				&httpConfig.authorization,
+			)
+
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-fn99w", "Errors.SMSConfig.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-3n9Js", "Errors.Internal")
+			}
+
+			twilioConfig.set(config)
+			vonageConfig.set(config)
+			httpConfig.set(config)
+
+			return config, nil
+		}
+}
+
+func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, error)) {
+	return sq.Select(
+			SMSConfigColumnID.identifier(),
+			SMSConfigColumnAggregateID.identifier(),
+			SMSConfigColumnCreationDate.identifier(),
+			SMSConfigColumnChangeDate.identifier(),
+			SMSConfigColumnResourceOwner.identifier(),
+			SMSConfigColumnState.identifier(),
+			SMSConfigColumnSequence.identifier(),
+
+			SMSTwilioConfigColumnSMSID.identifier(),
+			SMSTwilioConfigColumnSID.identifier(),
+			SMSTwilioConfigColumnToken.identifier(),
+			SMSTwilioConfigColumnSenderNumber.identifier(),
+
+			SMSVonageConfigColumnSMSID.identifier(),
+			SMSVonageConfigColumnAPIKey.identifier(),
+			SMSVonageConfigColumnAPISecret.identifier(),
+			SMSVonageConfigColumnSenderNumber.identifier(),
+
+			SMSHTTPConfigColumnSMSID.identifier(),
+			SMSHTTPConfigColumnEndpoint.identifier(),
+			SMSHTTPConfigColumnMethod.identifier(),
+			SMSHTTPConfigColumnContentType.identifier(),
+			SMSHTTPConfigColumnBodyTemplate.identifier(),
+			SMSHTTPConfigColumnSenderNumber.identifier(),
+			SMSHTTPConfigColumnAuthorization.identifier(),
+
+			countColumn.identifier(),
+		).From(smsConfigsTable.identifier()).
+			LeftJoin(join(SMSTwilioConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSVonageConfigColumnSMSID, SMSConfigColumnID)).
+			LeftJoin(join(SMSHTTPConfigColumnSMSID, SMSConfigColumnID)).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Rows) (*SMSConfigs, error) {
+			configs := &SMSConfigs{Configs: []*SMSConfig{}}
+
+			for row.Next() {
+				config := new(SMSConfig)
+				twilioConfig := sqlTwilioConfig{}
+				vonageConfig := sqlVonageConfig{}
+				httpConfig := sqlHTTPConfig{}
+
+				err := row.Scan(
+					&config.ID,
+					&config.AggregateID,
+					&config.CreationDate,
+					&config.ChangeDate,
+					&config.ResourceOwner,
+					&config.State,
+					&config.Sequence,
+
+					&twilioConfig.smsID,
+					&twilioConfig.sid,
+					&twilioConfig.token,
+					&twilioConfig.senderNumber,
+
+					&vonageConfig.smsID,
+					&vonageConfig.apiKey,
+					&vonageConfig.apiSecret,
+					&vonageConfig.senderNumber,
+
+					&httpConfig.smsID,
+					&httpConfig.endpoint,
+					&httpConfig.method,
+					&httpConfig.contentType,
+					&httpConfig.bodyTemplate,
+					&httpConfig.senderNumber,
+					&httpConfig.authorization,
+
+					&configs.Count,
+				)
+
+				if err != nil {
+					return nil, errors.ThrowInternal(err, "QUERY-d9jJd", "Errors.Internal")
+				}
+
+				twilioConfig.set(config)
+				vonageConfig.set(config)
+				httpConfig.set(config)
+
+				configs.Configs = append(configs.Configs, config)
+			}
+
+			if err := row.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Lp9sm", "Errors.Query.CloseRows")
+			}
+
+			return configs, nil
+		}
+}
+
+type sqlTwilioConfig struct {
+	smsID        sql.NullString
+	sid          sql.NullString
+	token        *crypto.CryptoValue
+	senderNumber sql.NullString
+}
+
+func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.TwilioConfig = &Twilio{
+		SID:          c.sid.String,
+		Token:        c.token,
+		SenderNumber: c.senderNumber.String,
+	}
+}
+
+type sqlVonageConfig struct {
+	smsID        sql.NullString
+	apiKey       sql.NullString
+	apiSecret    *crypto.CryptoValue
+	senderNumber sql.NullString
+}
+
+func (c sqlVonageConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.VonageConfig = &Vonage{
+		APIKey:       c.apiKey.String,
+		APISecret:    c.apiSecret,
+		SenderNumber: c.senderNumber.String,
+	}
+}
+
+type sqlHTTPConfig struct {
+	smsID         sql.NullString
+	endpoint      sql.NullString
+	method        sql.NullString
+	contentType   sql.NullString
+	bodyTemplate  sql.NullString
+	senderNumber  sql.NullString
+	authorization *crypto.CryptoValue
+}
+
+func (c sqlHTTPConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.HTTPConfig = &HTTP{
+		Endpoint:      c.endpoint.String,
+		Method:        c.method.String,
+		ContentType:   c.contentType.String,
+		BodyTemplate:  c.bodyTemplate.String,
+		SenderNumber:  c.senderNumber.String,
+		Authorization: c.authorization,
+	}
+}
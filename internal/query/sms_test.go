@@ -0,0 +1,368 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+var (
+	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT zitadel.projections.sms_configs.id,` +
+		` zitadel.projections.sms_configs.aggregate_id,` +
+		` zitadel.projections.sms_configs.creation_date,` +
+		` zitadel.projections.sms_configs.change_date,` +
+		` zitadel.projections.sms_configs.resource_owner,` +
+		` zitadel.projections.sms_configs.state,` +
+		` zitadel.projections.sms_configs.sequence,` +
+		// twilio config
+		` zitadel.projections.sms_configs_twilio.sms_id,` +
+		` zitadel.projections.sms_configs_twilio.sid,` +
+		` zitadel.projections.sms_configs_twilio.token,` +
+		` zitadel.projections.sms_configs_twilio.sender_number,` +
+		// vonage config
+		` zitadel.projections.sms_configs_vonage.sms_id,` +
+		` zitadel.projections.sms_configs_vonage.api_key,` +
+		` zitadel.projections.sms_configs_vonage.api_secret,` +
+		` zitadel.projections.sms_configs_vonage.sender_number,` +
+		// http config
+		` zitadel.projections.sms_configs_http.sms_id,` +
+		` zitadel.projections.sms_configs_http.endpoint,` +
+		` zitadel.projections.sms_configs_http.method,` +
+		` zitadel.projections.sms_configs_http.content_type,` +
+		` zitadel.projections.sms_configs_http.body_template,` +
+		` zitadel.projections.sms_configs_http.sender_number,` +
+		` zitadel.projections.sms_configs_http.auth_header` +
+		` FROM zitadel.projections.sms_configs` +
+		` LEFT JOIN zitadel.projections.sms_configs_twilio ON zitadel.projections.sms_configs.id = zitadel.projections.sms_configs_twilio.sms_id` +
+		` LEFT JOIN zitadel.projections.sms_configs_vonage ON zitadel.projections.sms_configs.id = zitadel.projections.sms_configs_vonage.sms_id` +
+		` LEFT JOIN zitadel.projections.sms_configs_http ON zitadel.projections.sms_configs.id = zitadel.projections.sms_configs_http.sms_id`)
+	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT zitadel.projections.sms_configs.id,` +
+		` zitadel.projections.sms_configs.aggregate_id,` +
+		` zitadel.projections.sms_configs.creation_date,` +
+		` zitadel.projections.sms_configs.change_date,` +
+		` zitadel.projections.sms_configs.resource_owner,` +
+		` zitadel.projections.sms_configs.state,` +
+		` zitadel.projections.sms_configs.sequence,` +
+		// twilio config
+		` zitadel.projections.sms_configs_twilio.sms_id,` +
+		` zitadel.projections.sms_configs_twilio.sid,` +
+		` zitadel.projections.sms_configs_twilio.token,` +
+		` zitadel.projections.sms_configs_twilio.sender_number,` +
+		// vonage config
+		` zitadel.projections.sms_configs_vonage.sms_id,` +
+		` zitadel.projections.sms_configs_vonage.api_key,` +
+		` zitadel.projections.sms_configs_vonage.api_secret,` +
+		` zitadel.projections.sms_configs_vonage.sender_number,` +
+		// http config
+		` zitadel.projections.sms_configs_http.sms_id,` +
+		` zitadel.projections.sms_configs_http.endpoint,` +
+		` zitadel.projections.sms_configs_http.method,` +
+		` zitadel.projections.sms_configs_http.content_type,` +
+		` zitadel.projections.sms_configs_http.body_template,` +
+		` zitadel.projections.sms_configs_http.sender_number,` +
+		` zitadel.projections.sms_configs_http.auth_header,` +
+		` COUNT(*) OVER ()` +
+		` FROM zitadel.projections.sms_configs` +
+		` LEFT JOIN zitadel.projections.sms_configs_twilio ON zitadel.projections.sms_configs.id = zitadel.projections.sms_configs_twilio.sms_id` +
+		` LEFT JOIN zitadel.projections.sms_configs_vonage ON zitadel.projections.sms_configs.id = zitadel.projections.sms_configs_vonage.sms_id` +
+		` LEFT JOIN zitadel.projections.sms_configs_http ON zitadel.projections.sms_configs.id = zitadel.projections.sms_configs_http.sms_id`)
+
+	smsConfigCols = []string{
+		"id",
+		"aggregate_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"state",
+		"sequence",
+		// twilio config
+		"sms_id",
+		"sid",
+		"token",
+		"sender_number",
+		// vonage config
+		"sms_id",
+		"api_key",
+		"api_secret",
+		"sender_number",
+		// http config
+		"sms_id",
+		"endpoint",
+		"method",
+		"content_type",
+		"body_template",
+		"sender_number",
+		"auth_header",
+	}
+	smsConfigsCols = append(smsConfigCols, "count")
+)
+
+func Test_SMSConfigsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareSMSConfigsQuery no result",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &SMSConfigs{Configs: []*SMSConfig{}},
+		},
+		{
+			name:    "prepareSMSConfigsQuery twilio config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateInactive,
+							uint64(20211109),
+							// twilio config
+							"sms-id",
+							"sid",
+							[]byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"dG9rZW4="}`),
+							"sender-number",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateInactive,
+						Sequence:      20211109,
+						TwilioConfig: &Twilio{
+							SID: "sid",
+							Token: &crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("token"),
+							},
+							SenderNumber: "sender-number",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSMSConfigsQuery sql err",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedSMSConfigsQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareSMSConfigQuery no result",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SMSConfig)(nil),
+		},
+		{
+			name:    "prepareSMSConfigQuery found",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedSMSConfigQuery,
+					smsConfigCols,
+					[]driver.Value{
+						"sms-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.SMSConfigStateActive,
+						uint64(20211109),
+						// twilio config
+						"sms-id",
+						"sid",
+						[]byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"dG9rZW4="}`),
+						"sender-number",
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// http config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &SMSConfig{
+				ID:            "sms-id",
+				AggregateID:   "agg-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.SMSConfigStateActive,
+				Sequence:      20211109,
+				TwilioConfig: &Twilio{
+					SID: "sid",
+					Token: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("token"),
+					},
+					SenderNumber: "sender-number",
+				},
+			},
+		},
+		{
+			name:    "prepareSMSConfigQuery http config",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedSMSConfigQuery,
+					smsConfigCols,
+					[]driver.Value{
+						"sms-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.SMSConfigStateActive,
+						uint64(20211109),
+						// twilio config
+						nil,
+						nil,
+						nil,
+						nil,
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// http config
+						"sms-id",
+						"https://sms.example.com",
+						"POST",
+						"application/json",
+						`{"to": {{json .To}}}`,
+						"sender-number",
+						[]byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"YXV0aA=="}`),
+					},
+				),
+			},
+			object: &SMSConfig{
+				ID:            "sms-id",
+				AggregateID:   "agg-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.SMSConfigStateActive,
+				Sequence:      20211109,
+				HTTPConfig: &HTTP{
+					Endpoint:     "https://sms.example.com",
+					Method:       "POST",
+					ContentType:  "application/json",
+					BodyTemplate: `{"to": {{json .To}}}`,
+					SenderNumber: "sender-number",
+					Authorization: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("auth"),
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSMSConfigQuery sql err",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedSMSConfigQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -15,13 +15,14 @@ import (
 )
 
 type UserGrant struct {
-	ID           string
-	CreationDate time.Time
-	ChangeDate   time.Time
-	Sequence     uint64
-	Roles        []string
-	GrantID      string
-	State        domain.UserGrantState
+	ID             string
+	CreationDate   time.Time
+	ChangeDate     time.Time
+	Sequence       uint64
+	Roles          []string
+	GrantID        string
+	State          domain.UserGrantState
+	ExpirationDate time.Time
 
 	UserID            string
 	Username          string
@@ -75,6 +76,10 @@ func NewUserGrantResourceOwnerSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(UserGrantResourceOwner, id, TextEquals)
 }
 
+func NewUserGrantUserResourceOwnerSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(UserResourceOwnerCol, id, TextEquals)
+}
+
 func NewUserGrantGrantIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(UserGrantGrantID, id, TextEquals)
 }
@@ -128,7 +133,33 @@ func NewUserGrantWithGrantedQuery(owner string) (SearchQuery, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newOrQuery(orgQuery, projectQuery)
+	return NewOrQuery(orgQuery, projectQuery)
+}
+
+func NewUserGrantStateQuery(state domain.UserGrantState) (SearchQuery, error) {
+	return NewNumberQuery(UserGrantState, state, NumberEquals)
+}
+
+//NewUserGrantNotExpiredQuery returns the grants without expiration date or an expiration date after the given time
+func NewUserGrantNotExpiredQuery(now time.Time) (SearchQuery, error) {
+	notNullQuery, err := NewNotNullQuery(UserGrantExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+	withoutExpirationQuery, err := NewNotQuery(notNullQuery)
+	if err != nil {
+		return nil, err
+	}
+	notExpiredQuery, err := NewTimestampQuery(UserGrantExpirationDate, now, TimestampGreater)
+	if err != nil {
+		return nil, err
+	}
+	return NewOrQuery(withoutExpirationQuery, notExpiredQuery)
+}
+
+//NewUserGrantExpiredQuery returns the grants with an expiration date reached at the given time
+func NewUserGrantExpiredQuery(now time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(UserGrantExpirationDate, now, TimestampLessOrEquals)
 }
 
 func NewUserGrantContainsRolesSearchQuery(roles ...string) (SearchQuery, error) {
@@ -183,6 +214,10 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantExpirationDate = Column{
+		name:  projection.UserGrantExpirationDate,
+		table: userGrantTable,
+	}
 )
 
 func (q *Queries) UserGrant(ctx context.Context, queries ...SearchQuery) (*UserGrant, error) {
@@ -233,6 +268,7 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantExpirationDate.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -275,6 +311,8 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 				orgDomain sql.NullString
 
 				projectName sql.NullString
+
+				expirationDate sql.NullTime
 			)
 
 			err := row.Scan(
@@ -285,6 +323,7 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 				&g.GrantID,
 				&roles,
 				&g.State,
+				&expirationDate,
 
 				&g.UserID,
 				&username,
@@ -322,6 +361,7 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 			g.OrgName = orgName.String
 			g.OrgPrimaryDomain = orgDomain.String
 			g.ProjectName = projectName.String
+			g.ExpirationDate = expirationDate.Time
 
 			return g, nil
 		}
@@ -336,6 +376,7 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantExpirationDate.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -383,6 +424,8 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 					orgDomain sql.NullString
 
 					projectName sql.NullString
+
+					expirationDate sql.NullTime
 				)
 
 				err := rows.Scan(
@@ -393,6 +436,7 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 					&g.GrantID,
 					&roles,
 					&g.State,
+					&expirationDate,
 
 					&g.UserID,
 					&username,
@@ -429,6 +473,7 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 				g.OrgName = orgName.String
 				g.OrgPrimaryDomain = orgDomain.String
 				g.ProjectName = projectName.String
+				g.ExpirationDate = expirationDate.Time
 
 				userGrants = append(userGrants, g)
 			}
@@ -23,6 +23,7 @@ var (
 			", zitadel.projections.user_grants.grant_id" +
 			", zitadel.projections.user_grants.roles" +
 			", zitadel.projections.user_grants.state" +
+			", zitadel.projections.user_grants.expiration_date" +
 			", zitadel.projections.user_grants.user_id" +
 			", zitadel.projections.users.username" +
 			", zitadel.projections.users.type" +
@@ -50,6 +51,7 @@ var (
 		"grant_id",
 		"roles",
 		"state",
+		"expiration_date",
 		"user_id",
 		"username",
 		"type",
@@ -73,6 +75,7 @@ var (
 			", zitadel.projections.user_grants.grant_id" +
 			", zitadel.projections.user_grants.roles" +
 			", zitadel.projections.user_grants.state" +
+			", zitadel.projections.user_grants.expiration_date" +
 			", zitadel.projections.user_grants.user_id" +
 			", zitadel.projections.users.username" +
 			", zitadel.projections.users.type" +
@@ -143,6 +146,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						pq.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						testNow,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -168,6 +172,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 				Roles:             []string{"role-key"},
 				GrantID:           "grant-id",
 				State:             domain.UserGrantStateActive,
+				ExpirationDate:    testNow,
 				UserID:            "user-id",
 				Username:          "username",
 				UserType:          domain.UserTypeHuman,
@@ -199,6 +204,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						pq.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeMachine,
@@ -255,6 +261,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						pq.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -311,6 +318,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						pq.StringArray{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -397,6 +405,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							pq.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -462,6 +471,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							pq.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -527,6 +537,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							pq.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -592,6 +603,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							pq.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -657,6 +669,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							pq.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -680,6 +693,7 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							pq.StringArray{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -60,6 +60,14 @@ func NewMembershipUserIDQuery(userID string) (SearchQuery, error) {
 	return NewTextQuery(membershipUserID.setTable(membershipAlias), userID, TextEquals)
 }
 
+func NewMembershipUserIDsQuery(userIDs ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(userIDs))
+	for i, userID := range userIDs {
+		list[i] = userID
+	}
+	return NewListQuery(membershipUserID.setTable(membershipAlias), list, ListIn)
+}
+
 func NewMembershipResourceOwnerQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(membershipResourceOwner.setTable(membershipAlias), value, TextEquals)
 }
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+type UserPassword struct {
+	Secret         *crypto.CryptoValue
+	ChangeRequired bool
+}
+
+//UserPasswordsByOrg returns the password hashes of all humans of the organisation mapped by the user id
+//the hashes aren't part of any projection so they are reduced from the eventstore
+func (q *Queries) UserPasswordsByOrg(ctx context.Context, orgID string) (map[string]*UserPassword, error) {
+	events, err := q.eventstore.Filter(ctx, userPasswordsQuery(orgID))
+	if err != nil {
+		logging.Log("QUERY-Jf9s2").WithError(err).Warn("eventstore unavailable")
+		return nil, errors.ThrowInternal(err, "QUERY-Ks9dm", "Errors.Internal")
+	}
+	return reduceUserPasswords(events), nil
+}
+
+func userPasswordsQuery(orgID string) *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(orgID).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.HumanPasswordChangedType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.UserV1PasswordChangedType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
+
+func reduceUserPasswords(events []eventstore.Event) map[string]*UserPassword {
+	passwords := make(map[string]*UserPassword)
+	for _, event := range events {
+		userID := event.Aggregate().ID
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			if e.Secret != nil {
+				passwords[userID] = &UserPassword{Secret: e.Secret, ChangeRequired: e.ChangeRequired}
+			}
+		case *user.HumanRegisteredEvent:
+			if e.Secret != nil {
+				passwords[userID] = &UserPassword{Secret: e.Secret, ChangeRequired: e.ChangeRequired}
+			}
+		case *user.HumanPasswordChangedEvent:
+			passwords[userID] = &UserPassword{Secret: e.Secret, ChangeRequired: e.ChangeRequired}
+		case *user.UserRemovedEvent:
+			delete(passwords, userID)
+		}
+	}
+	return passwords
+}
@@ -0,0 +1,100 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+var (
+	userPasswordAgeTable = table{
+		name:  projection.UserPasswordAgeTable,
+		alias: "password_ages",
+	}
+	UserPasswordAgeColUserID = Column{
+		name:  projection.UserPasswordAgeUserIDCol,
+		table: userPasswordAgeTable,
+	}
+	UserPasswordAgeColResourceOwner = Column{
+		name:  projection.UserPasswordAgeResourceOwnerCol,
+		table: userPasswordAgeTable,
+	}
+	UserPasswordAgeColPasswordChanged = Column{
+		name:  projection.UserPasswordAgePasswordChangedCol,
+		table: userPasswordAgeTable,
+	}
+	UserPasswordAgeColExpiryNotified = Column{
+		name:  projection.UserPasswordAgeExpiryNotifiedCol,
+		table: userPasswordAgeTable,
+	}
+)
+
+type UserPasswordAge struct {
+	UserID          string
+	ResourceOwner   string
+	PasswordChanged time.Time
+}
+
+type UserPasswordAges struct {
+	Users []*UserPasswordAge
+}
+
+//PasswordExpiryNotificationCandidates returns the users of the organisation who weren't notified yet
+//and whose password was changed after changedAfter and before or at changedUntil
+func (q *Queries) PasswordExpiryNotificationCandidates(ctx context.Context, orgID string, changedAfter, changedUntil time.Time) (*UserPasswordAges, error) {
+	query, scan := prepareUserPasswordAgesQuery()
+	stmt, args, err := query.Where(sq.And{
+		sq.Eq{
+			UserPasswordAgeColResourceOwner.identifier():  orgID,
+			UserPasswordAgeColExpiryNotified.identifier(): false,
+		},
+		sq.Gt{UserPasswordAgeColPasswordChanged.identifier(): changedAfter},
+		sq.LtOrEq{UserPasswordAgeColPasswordChanged.identifier(): changedUntil},
+	}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pw8sq", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pw8qe", "Errors.Internal")
+	}
+	return scan(rows)
+}
+
+func prepareUserPasswordAgesQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserPasswordAges, error)) {
+	return sq.Select(
+			UserPasswordAgeColUserID.identifier(),
+			UserPasswordAgeColResourceOwner.identifier(),
+			UserPasswordAgeColPasswordChanged.identifier(),
+		).From(userPasswordAgeTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserPasswordAges, error) {
+			users := make([]*UserPasswordAge, 0)
+			for rows.Next() {
+				user := new(UserPasswordAge)
+				err := rows.Scan(
+					&user.UserID,
+					&user.ResourceOwner,
+					&user.PasswordChanged,
+				)
+				if err != nil {
+					return nil, err
+				}
+				users = append(users, user)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Pw8qc", "Errors.Query.CloseRows")
+			}
+
+			return &UserPasswordAges{
+				Users: users,
+			}, nil
+		}
+}
@@ -0,0 +1,111 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	userPasswordAgesQuery = regexp.QuoteMeta("SELECT" +
+		" password_ages.user_id" +
+		", password_ages.resource_owner" +
+		", password_ages.password_changed" +
+		" FROM zitadel.projections.user_password_ages as password_ages")
+	userPasswordAgesColumns = []string{
+		"user_id",
+		"resource_owner",
+		"password_changed",
+	}
+)
+
+func Test_UserPasswordAgePrepares(t *testing.T) {
+	changed := time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC)
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserPasswordAgesQuery no result",
+			prepare: prepareUserPasswordAgesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userPasswordAgesQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &UserPasswordAges{
+				Users: []*UserPasswordAge{},
+			},
+		},
+		{
+			name:    "prepareUserPasswordAgesQuery found",
+			prepare: prepareUserPasswordAgesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					userPasswordAgesQuery,
+					userPasswordAgesColumns,
+					[][]driver.Value{
+						{
+							"user-id-1",
+							"ro",
+							changed,
+						},
+						{
+							"user-id-2",
+							"ro",
+							changed,
+						},
+					},
+				),
+			},
+			object: &UserPasswordAges{
+				Users: []*UserPasswordAge{
+					{
+						UserID:          "user-id-1",
+						ResourceOwner:   "ro",
+						PasswordChanged: changed,
+					},
+					{
+						UserID:          "user-id-2",
+						ResourceOwner:   "ro",
+						PasswordChanged: changed,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserPasswordAgesQuery sql err",
+			prepare: prepareUserPasswordAgesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					userPasswordAgesQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
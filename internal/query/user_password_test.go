@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/repository/user"
+)
+
+func Test_reduceUserPasswords(t *testing.T) {
+	initial := &crypto.CryptoValue{CryptoType: crypto.TypeHash, Algorithm: "bcrypt", Crypted: []byte("initial")}
+	changed := &crypto.CryptoValue{CryptoType: crypto.TypeHash, Algorithm: "bcrypt", Crypted: []byte("changed")}
+	humanAdded := func(userID string, secret *crypto.CryptoValue) eventstore.Event {
+		event := user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate(userID, "org1").Aggregate,
+			userID,
+			"firstname",
+			"lastname",
+			"",
+			"",
+			language.English,
+			domain.GenderUnspecified,
+			"email@zitadel.ch",
+			true,
+		)
+		event.AddPasswordData(secret, true)
+		return event
+	}
+	tests := []struct {
+		name   string
+		events []eventstore.Event
+		want   map[string]*UserPassword
+	}{
+		{
+			name:   "no events",
+			events: []eventstore.Event{},
+			want:   map[string]*UserPassword{},
+		},
+		{
+			name: "user without password",
+			events: []eventstore.Event{
+				humanAdded("user1", nil),
+			},
+			want: map[string]*UserPassword{},
+		},
+		{
+			name: "password changed",
+			events: []eventstore.Event{
+				humanAdded("user1", initial),
+				humanAdded("user2", initial),
+				user.NewHumanPasswordChangedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate, changed, false, ""),
+			},
+			want: map[string]*UserPassword{
+				"user1": {Secret: changed, ChangeRequired: false},
+				"user2": {Secret: initial, ChangeRequired: true},
+			},
+		},
+		{
+			name: "user removed",
+			events: []eventstore.Event{
+				humanAdded("user1", initial),
+				user.NewUserRemovedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate, "user1", nil, true),
+			},
+			want: map[string]*UserPassword{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reduceUserPasswords(tt.events); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("reduceUserPasswords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
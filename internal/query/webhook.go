@@ -0,0 +1,413 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	errs "errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+var (
+	webhookTable = table{
+		name: projection.WebhookTable,
+	}
+	WebhookColumnID = Column{
+		name:  projection.WebhookIDCol,
+		table: webhookTable,
+	}
+	WebhookColumnCreationDate = Column{
+		name:  projection.WebhookCreationDateCol,
+		table: webhookTable,
+	}
+	WebhookColumnChangeDate = Column{
+		name:  projection.WebhookChangeDateCol,
+		table: webhookTable,
+	}
+	WebhookColumnResourceOwner = Column{
+		name:  projection.WebhookResourceOwnerCol,
+		table: webhookTable,
+	}
+	WebhookColumnSequence = Column{
+		name:  projection.WebhookSequenceCol,
+		table: webhookTable,
+	}
+	WebhookColumnState = Column{
+		name:  projection.WebhookStateCol,
+		table: webhookTable,
+	}
+	WebhookColumnName = Column{
+		name:  projection.WebhookNameCol,
+		table: webhookTable,
+	}
+	WebhookColumnURL = Column{
+		name:  projection.WebhookURLCol,
+		table: webhookTable,
+	}
+	WebhookColumnEventTypes = Column{
+		name:  projection.WebhookEventTypesCol,
+		table: webhookTable,
+	}
+	WebhookColumnSigningKey = Column{
+		name:  projection.WebhookSigningKeyCol,
+		table: webhookTable,
+	}
+)
+
+var (
+	webhookFailedDeliveriesTable = table{
+		name: projection.WebhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnWebhookID = Column{
+		name:  projection.WebhookFailedDeliveryWebhookIDCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnResourceOwner = Column{
+		name:  projection.WebhookFailedDeliveryResourceOwnerCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnFailedSequence = Column{
+		name:  projection.WebhookFailedDeliveryFailedSequenceCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnAggregateType = Column{
+		name:  projection.WebhookFailedDeliveryAggregateTypeCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnAggregateID = Column{
+		name:  projection.WebhookFailedDeliveryAggregateIDCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnEventType = Column{
+		name:  projection.WebhookFailedDeliveryEventTypeCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnFailureCount = Column{
+		name:  projection.WebhookFailedDeliveryFailureCountCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnError = Column{
+		name:  projection.WebhookFailedDeliveryErrorCol,
+		table: webhookFailedDeliveriesTable,
+	}
+	WebhookFailedDeliveryColumnLastFailed = Column{
+		name:  projection.WebhookFailedDeliveryLastFailedCol,
+		table: webhookFailedDeliveriesTable,
+	}
+)
+
+type Webhooks struct {
+	SearchResponse
+	Webhooks []*Webhook
+}
+
+type Webhook struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.WebhookState
+	Sequence      uint64
+
+	Name       string
+	URL        string
+	EventTypes []string
+	SigningKey *crypto.CryptoValue
+}
+
+type WebhookSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *WebhookSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+type WebhookFailedDeliveries struct {
+	SearchResponse
+	FailedDeliveries []*WebhookFailedDelivery
+}
+
+type WebhookFailedDelivery struct {
+	WebhookID      string
+	ResourceOwner  string
+	FailedSequence uint64
+	AggregateType  string
+	AggregateID    string
+	EventType      string
+	FailureCount   uint64
+	Error          string
+	LastFailed     time.Time
+}
+
+type WebhookFailedDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *WebhookFailedDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchWebhooks(ctx context.Context, queries *WebhookSearchQueries) (webhooks *Webhooks, err error) {
+	query, scan := prepareWebhooksQuery()
+	stmt, args, err := queries.toQuery(query).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Wq2nf", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Pw9sm", "Errors.Internal")
+	}
+	webhooks, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	webhooks.LatestSequence, err = q.latestSequence(ctx, webhookTable)
+	return webhooks, err
+}
+
+func (q *Queries) GetWebhookByID(ctx context.Context, id string, resourceOwner string) (*Webhook, error) {
+	stmt, scan := prepareWebhookQuery()
+	query, args, err := stmt.Where(
+		sq.Eq{
+			WebhookColumnID.identifier():            id,
+			WebhookColumnResourceOwner.identifier(): resourceOwner,
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ht8sl", "Errors.Query.SQLStatement")
+	}
+
+	row := q.client.QueryRowContext(ctx, query, args...)
+	return scan(row)
+}
+
+//ActiveWebhooksByEventType returns the active webhooks of the resource owners which subscribed to the event type
+func (q *Queries) ActiveWebhooksByEventType(ctx context.Context, eventType string, resourceOwners ...string) ([]*Webhook, error) {
+	eventTypeQuery, err := NewWebhookEventTypeSearchQuery(eventType)
+	if err != nil {
+		return nil, err
+	}
+	stmt, scan := prepareWebhooksQuery()
+	query, args, err := eventTypeQuery.toQuery(stmt).Where(
+		sq.Eq{
+			WebhookColumnResourceOwner.identifier(): resourceOwners,
+			WebhookColumnState.identifier():         domain.WebhookStateActive,
+		}).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Js92n", "Errors.Query.SQLStatement")
+	}
+
+	rows, err := q.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Rm3ls", "Errors.Internal")
+	}
+	webhooks, err := scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	return webhooks.Webhooks, nil
+}
+
+func (q *Queries) SearchWebhookFailedDeliveries(ctx context.Context, queries *WebhookFailedDeliverySearchQueries) (failedDeliveries *WebhookFailedDeliveries, err error) {
+	query, scan := prepareWebhookFailedDeliveriesQuery()
+	stmt, args, err := queries.toQuery(query).ToSql()
+	if err != nil {
+		return nil, errors.ThrowInvalidArgument(err, "QUERY-Fk2mw", "Errors.Query.InvalidRequest")
+	}
+
+	rows, err := q.client.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "QUERY-Ue8sk", "Errors.Internal")
+	}
+	failedDeliveries, err = scan(rows)
+	if err != nil {
+		return nil, err
+	}
+	failedDeliveries.LatestSequence, err = q.latestSequence(ctx, webhookTable)
+	return failedDeliveries, err
+}
+
+func NewWebhookResourceOwnerQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(WebhookColumnResourceOwner, id, TextEquals)
+}
+
+func NewWebhookNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(WebhookColumnName, value, method)
+}
+
+func NewWebhookStateSearchQuery(value domain.WebhookState) (SearchQuery, error) {
+	return NewNumberQuery(WebhookColumnState, int(value), NumberEquals)
+}
+
+func NewWebhookEventTypeSearchQuery(eventType string) (SearchQuery, error) {
+	return NewTextQuery(WebhookColumnEventTypes, eventType, TextListContains)
+}
+
+func NewWebhookFailedDeliveryWebhookIDQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(WebhookFailedDeliveryColumnWebhookID, id, TextEquals)
+}
+
+func NewWebhookFailedDeliveryResourceOwnerQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(WebhookFailedDeliveryColumnResourceOwner, id, TextEquals)
+}
+
+func prepareWebhooksQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Webhooks, error)) {
+	return sq.Select(
+			WebhookColumnID.identifier(),
+			WebhookColumnCreationDate.identifier(),
+			WebhookColumnChangeDate.identifier(),
+			WebhookColumnResourceOwner.identifier(),
+			WebhookColumnSequence.identifier(),
+			WebhookColumnState.identifier(),
+			WebhookColumnName.identifier(),
+			WebhookColumnURL.identifier(),
+			WebhookColumnEventTypes.identifier(),
+			WebhookColumnSigningKey.identifier(),
+			countColumn.identifier(),
+		).From(webhookTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Webhooks, error) {
+			webhooks := make([]*Webhook, 0)
+			var count uint64
+			for rows.Next() {
+				webhook := new(Webhook)
+				eventTypes := pq.StringArray{}
+				err := rows.Scan(
+					&webhook.ID,
+					&webhook.CreationDate,
+					&webhook.ChangeDate,
+					&webhook.ResourceOwner,
+					&webhook.Sequence,
+					&webhook.State,
+					&webhook.Name,
+					&webhook.URL,
+					&eventTypes,
+					&webhook.SigningKey,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				webhook.EventTypes = eventTypes
+				webhooks = append(webhooks, webhook)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Ob3ns", "Errors.Query.CloseRows")
+			}
+
+			return &Webhooks{
+				Webhooks: webhooks,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareWebhookQuery() (sq.SelectBuilder, func(row *sql.Row) (*Webhook, error)) {
+	return sq.Select(
+			WebhookColumnID.identifier(),
+			WebhookColumnCreationDate.identifier(),
+			WebhookColumnChangeDate.identifier(),
+			WebhookColumnResourceOwner.identifier(),
+			WebhookColumnSequence.identifier(),
+			WebhookColumnState.identifier(),
+			WebhookColumnName.identifier(),
+			WebhookColumnURL.identifier(),
+			WebhookColumnEventTypes.identifier(),
+			WebhookColumnSigningKey.identifier(),
+		).From(webhookTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Webhook, error) {
+			webhook := new(Webhook)
+			eventTypes := pq.StringArray{}
+			err := row.Scan(
+				&webhook.ID,
+				&webhook.CreationDate,
+				&webhook.ChangeDate,
+				&webhook.ResourceOwner,
+				&webhook.Sequence,
+				&webhook.State,
+				&webhook.Name,
+				&webhook.URL,
+				&eventTypes,
+				&webhook.SigningKey,
+			)
+			if err != nil {
+				if errs.Is(err, sql.ErrNoRows) {
+					return nil, errors.ThrowNotFound(err, "QUERY-Ze8sk", "Errors.Webhook.NotFound")
+				}
+				return nil, errors.ThrowInternal(err, "QUERY-Vb2nf", "Errors.Internal")
+			}
+			webhook.EventTypes = eventTypes
+			return webhook, nil
+		}
+}
+
+func prepareWebhookFailedDeliveriesQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*WebhookFailedDeliveries, error)) {
+	return sq.Select(
+			WebhookFailedDeliveryColumnWebhookID.identifier(),
+			WebhookFailedDeliveryColumnResourceOwner.identifier(),
+			WebhookFailedDeliveryColumnFailedSequence.identifier(),
+			WebhookFailedDeliveryColumnAggregateType.identifier(),
+			WebhookFailedDeliveryColumnAggregateID.identifier(),
+			WebhookFailedDeliveryColumnEventType.identifier(),
+			WebhookFailedDeliveryColumnFailureCount.identifier(),
+			WebhookFailedDeliveryColumnError.identifier(),
+			WebhookFailedDeliveryColumnLastFailed.identifier(),
+			countColumn.identifier(),
+		).From(webhookFailedDeliveriesTable.identifier()).PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*WebhookFailedDeliveries, error) {
+			failedDeliveries := make([]*WebhookFailedDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				failedDelivery := new(WebhookFailedDelivery)
+				errorMessage := sql.NullString{}
+				err := rows.Scan(
+					&failedDelivery.WebhookID,
+					&failedDelivery.ResourceOwner,
+					&failedDelivery.FailedSequence,
+					&failedDelivery.AggregateType,
+					&failedDelivery.AggregateID,
+					&failedDelivery.EventType,
+					&failedDelivery.FailureCount,
+					&errorMessage,
+					&failedDelivery.LastFailed,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				failedDelivery.Error = errorMessage.String
+				failedDeliveries = append(failedDeliveries, failedDelivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, errors.ThrowInternal(err, "QUERY-Cn3ms", "Errors.Query.CloseRows")
+			}
+
+			return &WebhookFailedDeliveries{
+				FailedDeliveries: failedDeliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,301 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/lib/pq"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	errs "github.com/caos/zitadel/internal/errors"
+)
+
+func Test_WebhookPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareWebhooksQuery no result",
+			prepare: prepareWebhooksQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.webhooks.id,`+
+						` zitadel.projections.webhooks.creation_date,`+
+						` zitadel.projections.webhooks.change_date,`+
+						` zitadel.projections.webhooks.resource_owner,`+
+						` zitadel.projections.webhooks.sequence,`+
+						` zitadel.projections.webhooks.state,`+
+						` zitadel.projections.webhooks.name,`+
+						` zitadel.projections.webhooks.url,`+
+						` zitadel.projections.webhooks.event_types,`+
+						` zitadel.projections.webhooks.signing_key,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.webhooks`),
+					nil,
+					nil,
+				),
+			},
+			object: &Webhooks{Webhooks: []*Webhook{}},
+		},
+		{
+			name:    "prepareWebhooksQuery one result",
+			prepare: prepareWebhooksQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.webhooks.id,`+
+						` zitadel.projections.webhooks.creation_date,`+
+						` zitadel.projections.webhooks.change_date,`+
+						` zitadel.projections.webhooks.resource_owner,`+
+						` zitadel.projections.webhooks.sequence,`+
+						` zitadel.projections.webhooks.state,`+
+						` zitadel.projections.webhooks.name,`+
+						` zitadel.projections.webhooks.url,`+
+						` zitadel.projections.webhooks.event_types,`+
+						` zitadel.projections.webhooks.signing_key,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.webhooks`),
+					[]string{
+						"id",
+						"creation_date",
+						"change_date",
+						"resource_owner",
+						"sequence",
+						"state",
+						"name",
+						"url",
+						"event_types",
+						"signing_key",
+						"count",
+					},
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							domain.WebhookStateActive,
+							"webhook-name",
+							"https://example.com/hook",
+							pq.StringArray{"user.human.added"},
+							[]byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"a2V5"}`),
+						},
+					},
+				),
+			},
+			object: &Webhooks{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Webhooks: []*Webhook{
+					{
+						ID:            "id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.WebhookStateActive,
+						Sequence:      20211109,
+						Name:          "webhook-name",
+						URL:           "https://example.com/hook",
+						EventTypes:    []string{"user.human.added"},
+						SigningKey: &crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("key"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareWebhooksQuery sql err",
+			prepare: prepareWebhooksQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(`SELECT zitadel.projections.webhooks.id,`+
+						` zitadel.projections.webhooks.creation_date,`+
+						` zitadel.projections.webhooks.change_date,`+
+						` zitadel.projections.webhooks.resource_owner,`+
+						` zitadel.projections.webhooks.sequence,`+
+						` zitadel.projections.webhooks.state,`+
+						` zitadel.projections.webhooks.name,`+
+						` zitadel.projections.webhooks.url,`+
+						` zitadel.projections.webhooks.event_types,`+
+						` zitadel.projections.webhooks.signing_key,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.webhooks`),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: nil,
+		},
+		{
+			name:    "prepareWebhookQuery no result",
+			prepare: prepareWebhookQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.webhooks.id,`+
+						` zitadel.projections.webhooks.creation_date,`+
+						` zitadel.projections.webhooks.change_date,`+
+						` zitadel.projections.webhooks.resource_owner,`+
+						` zitadel.projections.webhooks.sequence,`+
+						` zitadel.projections.webhooks.state,`+
+						` zitadel.projections.webhooks.name,`+
+						` zitadel.projections.webhooks.url,`+
+						` zitadel.projections.webhooks.event_types,`+
+						` zitadel.projections.webhooks.signing_key`+
+						` FROM zitadel.projections.webhooks`),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !errs.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Webhook)(nil),
+		},
+		{
+			name:    "prepareWebhookQuery found",
+			prepare: prepareWebhookQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(`SELECT zitadel.projections.webhooks.id,`+
+						` zitadel.projections.webhooks.creation_date,`+
+						` zitadel.projections.webhooks.change_date,`+
+						` zitadel.projections.webhooks.resource_owner,`+
+						` zitadel.projections.webhooks.sequence,`+
+						` zitadel.projections.webhooks.state,`+
+						` zitadel.projections.webhooks.name,`+
+						` zitadel.projections.webhooks.url,`+
+						` zitadel.projections.webhooks.event_types,`+
+						` zitadel.projections.webhooks.signing_key`+
+						` FROM zitadel.projections.webhooks`),
+					[]string{
+						"id",
+						"creation_date",
+						"change_date",
+						"resource_owner",
+						"sequence",
+						"state",
+						"name",
+						"url",
+						"event_types",
+						"signing_key",
+					},
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						domain.WebhookStateInactive,
+						"webhook-name",
+						"https://example.com/hook",
+						pq.StringArray{"user.human.added", "user.locked"},
+						nil,
+					},
+				),
+			},
+			object: &Webhook{
+				ID:            "id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.WebhookStateInactive,
+				Sequence:      20211109,
+				Name:          "webhook-name",
+				URL:           "https://example.com/hook",
+				EventTypes:    []string{"user.human.added", "user.locked"},
+			},
+		},
+		{
+			name:    "prepareWebhookFailedDeliveriesQuery one result",
+			prepare: prepareWebhookFailedDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(`SELECT zitadel.projections.webhooks_failed_deliveries.webhook_id,`+
+						` zitadel.projections.webhooks_failed_deliveries.resource_owner,`+
+						` zitadel.projections.webhooks_failed_deliveries.failed_sequence,`+
+						` zitadel.projections.webhooks_failed_deliveries.aggregate_type,`+
+						` zitadel.projections.webhooks_failed_deliveries.aggregate_id,`+
+						` zitadel.projections.webhooks_failed_deliveries.event_type,`+
+						` zitadel.projections.webhooks_failed_deliveries.failure_count,`+
+						` zitadel.projections.webhooks_failed_deliveries.error,`+
+						` zitadel.projections.webhooks_failed_deliveries.last_failed,`+
+						` COUNT(*) OVER ()`+
+						` FROM zitadel.projections.webhooks_failed_deliveries`),
+					[]string{
+						"webhook_id",
+						"resource_owner",
+						"failed_sequence",
+						"aggregate_type",
+						"aggregate_id",
+						"event_type",
+						"failure_count",
+						"error",
+						"last_failed",
+						"count",
+					},
+					[][]driver.Value{
+						{
+							"webhook-id",
+							"ro",
+							uint64(20211109),
+							"user",
+							"user-id",
+							"user.locked",
+							uint64(5),
+							"unexpected status code 500",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &WebhookFailedDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				FailedDeliveries: []*WebhookFailedDelivery{
+					{
+						WebhookID:      "webhook-id",
+						ResourceOwner:  "ro",
+						FailedSequence: 20211109,
+						AggregateType:  "user",
+						AggregateID:    "user-id",
+						EventType:      "user.locked",
+						FailureCount:   5,
+						Error:          "unexpected status code 500",
+						LastFailed:     testNow,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -7,5 +7,8 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(ChangedEventType, ChangedEventMapper).
 		RegisterFilterEventMapper(DeactivatedEventType, DeactivatedEventMapper).
 		RegisterFilterEventMapper(ReactivatedEventType, ReactivatedEventMapper).
-		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper)
+		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper).
+		RegisterFilterEventMapper(LibraryAddedEventType, LibraryAddedEventMapper).
+		RegisterFilterEventMapper(LibraryChangedEventType, LibraryChangedEventMapper).
+		RegisterFilterEventMapper(LibraryRemovedEventType, LibraryRemovedEventMapper)
 }
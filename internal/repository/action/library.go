@@ -0,0 +1,173 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	LibraryAggregateType    = "action_library"
+	LibraryAggregateVersion = "v1"
+
+	UniqueLibraryNameType   = "action_library_names"
+	libraryEventTypePrefix  = eventstore.EventType("action_library.")
+	LibraryAddedEventType   = libraryEventTypePrefix + "added"
+	LibraryChangedEventType = libraryEventTypePrefix + "changed"
+	LibraryRemovedEventType = libraryEventTypePrefix + "removed"
+)
+
+func NewLibraryAggregate(id, resourceOwner string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          LibraryAggregateType,
+			Version:       LibraryAggregateVersion,
+			ID:            id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func NewAddLibraryNameUniqueConstraint(libraryName, resourceOwner string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueLibraryNameType,
+		libraryName+":"+resourceOwner,
+		"Errors.ActionLibrary.AlreadyExists")
+}
+
+func NewRemoveLibraryNameUniqueConstraint(libraryName, resourceOwner string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueLibraryNameType,
+		libraryName+":"+resourceOwner)
+}
+
+//LibraryAddedEvent adds the first version of the library
+type LibraryAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name   string `json:"name"`
+	Script string `json:"script"`
+}
+
+func (e *LibraryAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LibraryAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddLibraryNameUniqueConstraint(e.Name, e.Aggregate().ResourceOwner)}
+}
+
+func NewLibraryAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	script string,
+) *LibraryAddedEvent {
+	return &LibraryAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LibraryAddedEventType,
+		),
+		Name:   name,
+		Script: script,
+	}
+}
+
+func LibraryAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LibraryAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ACTION-Lb2md", "unable to unmarshal action library added")
+	}
+
+	return e, nil
+}
+
+//LibraryChangedEvent adds a new version of the library
+//the previous versions stay available for the actions which require them
+type LibraryChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Script  string `json:"script"`
+	Version uint64 `json:"version"`
+}
+
+func (e *LibraryChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LibraryChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewLibraryChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	script string,
+	version uint64,
+) *LibraryChangedEvent {
+	return &LibraryChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LibraryChangedEventType,
+		),
+		Script:  script,
+		Version: version,
+	}
+}
+
+func LibraryChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LibraryChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ACTION-Lb8sw", "unable to unmarshal action library changed")
+	}
+
+	return e, nil
+}
+
+type LibraryRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	name string
+}
+
+func (e *LibraryRemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *LibraryRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveLibraryNameUniqueConstraint(e.name, e.Aggregate().ResourceOwner)}
+}
+
+func NewLibraryRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name string,
+) *LibraryRemovedEvent {
+	return &LibraryRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LibraryRemovedEventType,
+		),
+		name: name,
+	}
+}
+
+func LibraryRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &LibraryRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package deviceauth
+
+import (
+	"github.com/caos/zitadel/internal/eventstore"
+)
+
+const (
+	AggregateType    = "device_auth"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+func NewAggregate(id, resourceOwner string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
@@ -0,0 +1,220 @@
+package deviceauth
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	UniqueUserCodeType = "device_auth_user_codes"
+	eventTypePrefix    = eventstore.EventType("device.authorization.")
+	AddedEventType     = eventTypePrefix + "added"
+	ApprovedEventType  = eventTypePrefix + "approved"
+	DeniedEventType    = eventTypePrefix + "denied"
+	RemovedEventType   = eventTypePrefix + "removed"
+)
+
+func NewAddUserCodeUniqueConstraint(userCode string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueUserCodeType,
+		userCode,
+		"Errors.DeviceAuth.AlreadyExists")
+}
+
+func NewRemoveUserCodeUniqueConstraint(userCode string) *eventstore.EventUniqueConstraint {
+	return eventstore.NewRemoveEventUniqueConstraint(
+		UniqueUserCodeType,
+		userCode)
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string    `json:"clientId"`
+	DeviceCode string    `json:"deviceCode"`
+	UserCode   string    `json:"userCode"`
+	Expires    time.Time `json:"expires"`
+	Scopes     []string  `json:"scopes,omitempty"`
+}
+
+func (e *AddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewAddUserCodeUniqueConstraint(e.UserCode)}
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	deviceCode,
+	userCode string,
+	expires time.Time,
+	scopes []string,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedEventType,
+		),
+		ClientID:   clientID,
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		Expires:    expires,
+		Scopes:     scopes,
+	}
+}
+
+func AddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "DEVAUTH-3m0Fs", "unable to unmarshal device authorization")
+	}
+
+	return e, nil
+}
+
+type ApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Subject   string    `json:"subject"`
+	UserOrgID string    `json:"userOrgId"`
+	Audience  []string  `json:"audience,omitempty"`
+	AMR       []string  `json:"amr,omitempty"`
+	AuthTime  time.Time `json:"authTime"`
+
+	userCode string
+}
+
+func (e *ApprovedEvent) Data() interface{} {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveUserCodeUniqueConstraint(e.userCode)}
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userCode,
+	subject,
+	userOrgID string,
+	audience,
+	amr []string,
+	authTime time.Time,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApprovedEventType,
+		),
+		Subject:   subject,
+		UserOrgID: userOrgID,
+		Audience:  audience,
+		AMR:       amr,
+		AuthTime:  authTime,
+		userCode:  userCode,
+	}
+}
+
+func ApprovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ApprovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "DEVAUTH-Gm9sf", "unable to unmarshal device authorization approved")
+	}
+
+	return e, nil
+}
+
+type DeniedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	userCode string
+}
+
+func (e *DeniedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *DeniedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return []*eventstore.EventUniqueConstraint{NewRemoveUserCodeUniqueConstraint(e.userCode)}
+}
+
+func NewDeniedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userCode string,
+) *DeniedEvent {
+	return &DeniedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeniedEventType,
+		),
+		userCode: userCode,
+	}
+}
+
+func DeniedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &DeniedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	userCode string
+}
+
+func (e *RemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	if e.userCode == "" {
+		return nil
+	}
+	return []*eventstore.EventUniqueConstraint{NewRemoveUserCodeUniqueConstraint(e.userCode)}
+}
+
+// NewRemovedEvent removes the device authorization after the token has been issued (or it expired);
+// the user code is only passed if it's still reserved (authorization neither approved nor denied)
+func NewRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userCode string,
+) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedEventType,
+		),
+		userCode: userCode,
+	}
+}
+
+func RemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,12 @@
+package deviceauth
+
+import (
+	"github.com/caos/zitadel/internal/eventstore"
+)
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AddedEventType, AddedEventMapper).
+		RegisterFilterEventMapper(ApprovedEventType, ApprovedEventMapper).
+		RegisterFilterEventMapper(DeniedEventType, DeniedEventMapper).
+		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper)
+}
@@ -0,0 +1,58 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	ActionsHTTPConfigSetEventType = iamEventTypePrefix + "actions.http.config.set"
+)
+
+type ActionsHTTPConfigSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	AllowList       []string `json:"allowList,omitempty"`
+	MaxResponseSize int64    `json:"maxResponseSize,omitempty"`
+}
+
+func NewActionsHTTPConfigSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	allowList []string,
+	maxResponseSize int64,
+) *ActionsHTTPConfigSetEvent {
+	return &ActionsHTTPConfigSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ActionsHTTPConfigSetEventType,
+		),
+		AllowList:       allowList,
+		MaxResponseSize: maxResponseSize,
+	}
+}
+
+func (e *ActionsHTTPConfigSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *ActionsHTTPConfigSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func ActionsHTTPConfigSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ActionsHTTPConfigSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Ah8sj", "unable to unmarshal actions http config set")
+	}
+
+	return e, nil
+}
@@ -30,6 +30,8 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(OrgIAMPolicyChangedEventType, OrgIAMPolicyChangedEventMapper).
 		RegisterFilterEventMapper(PasswordAgePolicyAddedEventType, PasswordAgePolicyAddedEventMapper).
 		RegisterFilterEventMapper(PasswordAgePolicyChangedEventType, PasswordAgePolicyChangedEventMapper).
+		RegisterFilterEventMapper(PasswordHistoryPolicyAddedEventType, PasswordHistoryPolicyAddedEventMapper).
+		RegisterFilterEventMapper(PasswordHistoryPolicyChangedEventType, PasswordHistoryPolicyChangedEventMapper).
 		RegisterFilterEventMapper(PasswordComplexityPolicyAddedEventType, PasswordComplexityPolicyAddedEventMapper).
 		RegisterFilterEventMapper(PasswordComplexityPolicyChangedEventType, PasswordComplexityPolicyChangedEventMapper).
 		RegisterFilterEventMapper(LockoutPolicyAddedEventType, LockoutPolicyAddedEventMapper).
@@ -49,6 +51,10 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(IDPOIDCConfigChangedEventType, IDPOIDCConfigChangedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigAddedEventType, IDPJWTConfigAddedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigChangedEventType, IDPJWTConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigAddedEventType, IDPSAMLConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigChangedEventType, IDPSAMLConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigAddedEventType, IDPLDAPConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigChangedEventType, IDPLDAPConfigChangedEventMapper).
 		RegisterFilterEventMapper(LoginPolicyIDPProviderAddedEventType, IdentityProviderAddedEventMapper).
 		RegisterFilterEventMapper(LoginPolicyIDPProviderRemovedEventType, IdentityProviderRemovedEventMapper).
 		RegisterFilterEventMapper(LoginPolicyIDPProviderCascadeRemovedEventType, IdentityProviderCascadeRemovedEventMapper).
@@ -63,5 +69,21 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(CustomTextSetEventType, CustomTextSetEventMapper).
 		RegisterFilterEventMapper(CustomTextRemovedEventType, CustomTextRemovedEventMapper).
 		RegisterFilterEventMapper(CustomTextTemplateRemovedEventType, CustomTextTemplateRemovedEventMapper).
-		RegisterFilterEventMapper(FeaturesSetEventType, FeaturesSetEventMapper)
+		RegisterFilterEventMapper(FeaturesSetEventType, FeaturesSetEventMapper).
+		RegisterFilterEventMapper(SMTPConfigAddedEventType, SMTPConfigAddedEventMapper).
+		RegisterFilterEventMapper(SMTPConfigChangedEventType, SMTPConfigChangedEventMapper).
+		RegisterFilterEventMapper(SMTPConfigPasswordChangedEventType, SMTPConfigPasswordChangedEventMapper).
+		RegisterFilterEventMapper(ActionsHTTPConfigSetEventType, ActionsHTTPConfigSetEventMapper).
+		RegisterFilterEventMapper(SMSConfigTwilioAddedEventType, SMSConfigTwilioAddedEventMapper).
+		RegisterFilterEventMapper(SMSConfigTwilioChangedEventType, SMSConfigTwilioChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigTwilioTokenChangedEventType, SMSConfigTwilioTokenChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigVonageAddedEventType, SMSConfigVonageAddedEventMapper).
+		RegisterFilterEventMapper(SMSConfigVonageChangedEventType, SMSConfigVonageChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigVonageSecretChangedEventType, SMSConfigVonageSecretChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigHTTPAddedEventType, SMSConfigHTTPAddedEventMapper).
+		RegisterFilterEventMapper(SMSConfigHTTPChangedEventType, SMSConfigHTTPChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigHTTPAuthorizationChangedEventType, SMSConfigHTTPAuthorizationChangedEventMapper).
+		RegisterFilterEventMapper(SMSConfigActivatedEventType, SMSConfigActivatedEventMapper).
+		RegisterFilterEventMapper(SMSConfigDeactivatedEventType, SMSConfigDeactivatedEventMapper).
+		RegisterFilterEventMapper(SMSConfigRemovedEventType, SMSConfigRemovedEventMapper)
 }
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -35,6 +36,7 @@ func NewMemberAddedEvent(
 				MemberAddedEventType,
 			),
 			userID,
+			time.Time{},
 			roles...,
 		),
 	}
@@ -25,7 +25,8 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasLowercase,
 	hasUppercase,
 	hasNumber,
-	hasSymbol bool,
+	hasSymbol,
+	checkBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
 		PasswordComplexityPolicyAddedEvent: *policy.NewPasswordComplexityPolicyAddedEvent(
@@ -37,7 +38,8 @@ func NewPasswordComplexityPolicyAddedEvent(
 			hasLowercase,
 			hasUppercase,
 			hasNumber,
-			hasSymbol),
+			hasSymbol,
+			checkBreached),
 	}
 }
 
@@ -0,0 +1,74 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/policy"
+)
+
+var (
+	PasswordHistoryPolicyAddedEventType   = iamEventTypePrefix + policy.PasswordHistoryPolicyAddedEventType
+	PasswordHistoryPolicyChangedEventType = iamEventTypePrefix + policy.PasswordHistoryPolicyChangedEventType
+)
+
+type PasswordHistoryPolicyAddedEvent struct {
+	policy.PasswordHistoryPolicyAddedEvent
+}
+
+func NewPasswordHistoryPolicyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	historyCount uint64,
+) *PasswordHistoryPolicyAddedEvent {
+	return &PasswordHistoryPolicyAddedEvent{
+		PasswordHistoryPolicyAddedEvent: *policy.NewPasswordHistoryPolicyAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				PasswordHistoryPolicyAddedEventType),
+			historyCount),
+	}
+}
+
+func PasswordHistoryPolicyAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := policy.PasswordHistoryPolicyAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PasswordHistoryPolicyAddedEvent{PasswordHistoryPolicyAddedEvent: *e.(*policy.PasswordHistoryPolicyAddedEvent)}, nil
+}
+
+type PasswordHistoryPolicyChangedEvent struct {
+	policy.PasswordHistoryPolicyChangedEvent
+}
+
+func NewPasswordHistoryPolicyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.PasswordHistoryPolicyChanges,
+) (*PasswordHistoryPolicyChangedEvent, error) {
+	changedEvent, err := policy.NewPasswordHistoryPolicyChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PasswordHistoryPolicyChangedEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &PasswordHistoryPolicyChangedEvent{PasswordHistoryPolicyChangedEvent: *changedEvent}, nil
+}
+
+func PasswordHistoryPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := policy.PasswordHistoryPolicyChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PasswordHistoryPolicyChangedEvent{PasswordHistoryPolicyChangedEvent: *e.(*policy.PasswordHistoryPolicyChangedEvent)}, nil
+}
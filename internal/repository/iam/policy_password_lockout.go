@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -21,8 +22,12 @@ type LockoutPolicyAddedEvent struct {
 func NewLockoutPolicyAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	maxAttempts uint64,
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
 	showLockoutFailure bool,
+	lockoutDuration,
+	backoffDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 	return &LockoutPolicyAddedEvent{
 		LockoutPolicyAddedEvent: *policy.NewLockoutPolicyAddedEvent(
@@ -31,7 +36,11 @@ func NewLockoutPolicyAddedEvent(
 				aggregate,
 				LockoutPolicyAddedEventType),
 			maxAttempts,
-			showLockoutFailure),
+			maxOTPAttempts,
+			maxU2FAttempts,
+			showLockoutFailure,
+			lockoutDuration,
+			backoffDelay),
 	}
 }
 
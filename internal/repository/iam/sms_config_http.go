@@ -0,0 +1,207 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	smsConfigHTTPPrefix                        = "http."
+	SMSConfigHTTPAddedEventType                = iamEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType              = iamEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigHTTPAuthorizationChangedEventType = iamEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "authorization.changed"
+)
+
+type SMSConfigHTTPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID            string              `json:"id,omitempty"`
+	Endpoint      string              `json:"endpoint,omitempty"`
+	Method        string              `json:"method,omitempty"`
+	ContentType   string              `json:"contentType,omitempty"`
+	BodyTemplate  string              `json:"bodyTemplate,omitempty"`
+	SenderNumber  string              `json:"senderNumber,omitempty"`
+	Authorization *crypto.CryptoValue `json:"authorization,omitempty"`
+}
+
+func NewSMSConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	endpoint,
+	method,
+	contentType,
+	bodyTemplate,
+	senderNumber string,
+	authorization *crypto.CryptoValue,
+) *SMSConfigHTTPAddedEvent {
+	return &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAddedEventType,
+		),
+		ID:            id,
+		Endpoint:      endpoint,
+		Method:        method,
+		ContentType:   contentType,
+		BodyTemplate:  bodyTemplate,
+		SenderNumber:  senderNumber,
+		Authorization: authorization,
+	}
+}
+
+func (e *SMSConfigHTTPAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigHTTPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Ht82m", "unable to unmarshal sms config http added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigHTTPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	Endpoint     *string `json:"endpoint,omitempty"`
+	Method       *string `json:"method,omitempty"`
+	ContentType  *string `json:"contentType,omitempty"`
+	BodyTemplate *string `json:"bodyTemplate,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigHTTPChanges,
+) (*SMSConfigHTTPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IAM-Hs92k", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigHTTPChanges func(event *SMSConfigHTTPChangedEvent)
+
+func ChangeSMSConfigHTTPEndpoint(endpoint string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeSMSConfigHTTPMethod(method string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.Method = &method
+	}
+}
+
+func ChangeSMSConfigHTTPContentType(contentType string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.ContentType = &contentType
+	}
+}
+
+func ChangeSMSConfigHTTPBodyTemplate(bodyTemplate string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.BodyTemplate = &bodyTemplate
+	}
+}
+
+func ChangeSMSConfigHTTPSenderNumber(senderNumber string) func(event *SMSConfigHTTPChangedEvent) {
+	return func(e *SMSConfigHTTPChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigHTTPChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigHTTPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Hc83n", "unable to unmarshal sms config http changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+type SMSConfigHTTPAuthorizationChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID            string              `json:"id,omitempty"`
+	Authorization *crypto.CryptoValue `json:"authorization,omitempty"`
+}
+
+func NewSMSConfigHTTPAuthorizationChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	authorization *crypto.CryptoValue,
+) *SMSConfigHTTPAuthorizationChangedEvent {
+	return &SMSConfigHTTPAuthorizationChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPAuthorizationChangedEventType,
+		),
+		ID:            id,
+		Authorization: authorization,
+	}
+}
+
+func (e *SMSConfigHTTPAuthorizationChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPAuthorizationChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigHTTPAuthorizationChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigAuthorizationChanged := &SMSConfigHTTPAuthorizationChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigAuthorizationChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Ha93m", "unable to unmarshal sms config http authorization changed")
+	}
+
+	return smsConfigAuthorizationChanged, nil
+}
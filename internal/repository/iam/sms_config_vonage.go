@@ -0,0 +1,177 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	smsConfigVonagePrefix                 = "vonage."
+	SMSConfigVonageAddedEventType         = iamEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "added"
+	SMSConfigVonageChangedEventType       = iamEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "changed"
+	SMSConfigVonageSecretChangedEventType = iamEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "secret.changed"
+)
+
+type SMSConfigVonageAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	APIKey       string              `json:"apiKey,omitempty"`
+	APISecret    *crypto.CryptoValue `json:"apiSecret,omitempty"`
+	SenderNumber string              `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVonageAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	apiKey,
+	senderNumber string,
+	apiSecret *crypto.CryptoValue,
+) *SMSConfigVonageAddedEvent {
+	return &SMSConfigVonageAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageAddedEventType,
+		),
+		ID:           id,
+		APIKey:       apiKey,
+		APISecret:    apiSecret,
+		SenderNumber: senderNumber,
+	}
+}
+
+func (e *SMSConfigVonageAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigVonageAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigAdded := &SMSConfigVonageAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Vn82k", "unable to unmarshal sms config vonage added")
+	}
+
+	return smsConfigAdded, nil
+}
+
+type SMSConfigVonageChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	APIKey       *string `json:"apiKey,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVonageChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigVonageChanges,
+) (*SMSConfigVonageChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IAM-Vn9sk", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigVonageChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigVonageChanges func(event *SMSConfigVonageChangedEvent)
+
+func ChangeSMSConfigVonageAPIKey(apiKey string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.APIKey = &apiKey
+	}
+}
+
+func ChangeSMSConfigVonageSenderNumber(senderNumber string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigVonageChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigVonageChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigChanged := &SMSConfigVonageChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Vk29s", "unable to unmarshal sms config vonage changed")
+	}
+
+	return smsConfigChanged, nil
+}
+
+type SMSConfigVonageSecretChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID        string              `json:"id,omitempty"`
+	APISecret *crypto.CryptoValue `json:"apiSecret,omitempty"`
+}
+
+func NewSMSConfigVonageSecretChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	apiSecret *crypto.CryptoValue,
+) *SMSConfigVonageSecretChangedEvent {
+	return &SMSConfigVonageSecretChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageSecretChangedEventType,
+		),
+		ID:        id,
+		APISecret: apiSecret,
+	}
+}
+
+func (e *SMSConfigVonageSecretChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageSecretChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSConfigVonageSecretChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	smsConfigSecretChanged := &SMSConfigVonageSecretChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, smsConfigSecretChanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "IAM-Vs02m", "unable to unmarshal sms config vonage secret changed")
+	}
+
+	return smsConfigSecretChanged, nil
+}
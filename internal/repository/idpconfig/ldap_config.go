@@ -0,0 +1,233 @@
+package idpconfig
+
+import (
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	LDAPConfigAddedEventType   eventstore.EventType = "ldap.config.added"
+	LDAPConfigChangedEventType eventstore.EventType = "ldap.config.changed"
+)
+
+type LDAPConfigAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID     string              `json:"idpConfigId"`
+	URL             string              `json:"url"`
+	StartTLS        bool                `json:"startTls,omitempty"`
+	BindDN          string              `json:"bindDn,omitempty"`
+	BindPassword    *crypto.CryptoValue `json:"bindPassword,omitempty"`
+	BaseDN          string              `json:"baseDn"`
+	UserObjectClass string              `json:"userObjectClass,omitempty"`
+
+	IDAttribute          string `json:"idAttribute"`
+	UsernameAttribute    string `json:"usernameAttribute"`
+	DisplayNameAttribute string `json:"displayNameAttribute,omitempty"`
+	FirstNameAttribute   string `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute    string `json:"lastNameAttribute,omitempty"`
+	EmailAttribute       string `json:"emailAttribute,omitempty"`
+	PhoneAttribute       string `json:"phoneAttribute,omitempty"`
+}
+
+func (e *LDAPConfigAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LDAPConfigAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewLDAPConfigAddedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID,
+	url string,
+	startTLS bool,
+	bindDN string,
+	bindPassword *crypto.CryptoValue,
+	baseDN,
+	userObjectClass,
+	idAttribute,
+	usernameAttribute,
+	displayNameAttribute,
+	firstNameAttribute,
+	lastNameAttribute,
+	emailAttribute,
+	phoneAttribute string,
+) *LDAPConfigAddedEvent {
+	return &LDAPConfigAddedEvent{
+		BaseEvent:            *base,
+		IDPConfigID:          idpConfigID,
+		URL:                  url,
+		StartTLS:             startTLS,
+		BindDN:               bindDN,
+		BindPassword:         bindPassword,
+		BaseDN:               baseDN,
+		UserObjectClass:      userObjectClass,
+		IDAttribute:          idAttribute,
+		UsernameAttribute:    usernameAttribute,
+		DisplayNameAttribute: displayNameAttribute,
+		FirstNameAttribute:   firstNameAttribute,
+		LastNameAttribute:    lastNameAttribute,
+		EmailAttribute:       emailAttribute,
+		PhoneAttribute:       phoneAttribute,
+	}
+}
+
+func LDAPConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LDAPConfigAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LDAP-Hs92m", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type LDAPConfigChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+
+	URL             *string             `json:"url,omitempty"`
+	StartTLS        *bool               `json:"startTls,omitempty"`
+	BindDN          *string             `json:"bindDn,omitempty"`
+	BindPassword    *crypto.CryptoValue `json:"bindPassword,omitempty"`
+	BaseDN          *string             `json:"baseDn,omitempty"`
+	UserObjectClass *string             `json:"userObjectClass,omitempty"`
+
+	IDAttribute          *string `json:"idAttribute,omitempty"`
+	UsernameAttribute    *string `json:"usernameAttribute,omitempty"`
+	DisplayNameAttribute *string `json:"displayNameAttribute,omitempty"`
+	FirstNameAttribute   *string `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute    *string `json:"lastNameAttribute,omitempty"`
+	EmailAttribute       *string `json:"emailAttribute,omitempty"`
+	PhoneAttribute       *string `json:"phoneAttribute,omitempty"`
+}
+
+func (e *LDAPConfigChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *LDAPConfigChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewLDAPConfigChangedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID string,
+	changes []LDAPConfigChanges,
+) (*LDAPConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IDPCONFIG-Lw9sk", "Errors.NoChangesFound")
+	}
+	changeEvent := &LDAPConfigChangedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type LDAPConfigChanges func(*LDAPConfigChangedEvent)
+
+func ChangeLDAPURL(url string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.URL = &url
+	}
+}
+
+func ChangeLDAPStartTLS(startTLS bool) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.StartTLS = &startTLS
+	}
+}
+
+func ChangeLDAPBindDN(bindDN string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.BindDN = &bindDN
+	}
+}
+
+func ChangeLDAPBindPassword(bindPassword *crypto.CryptoValue) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.BindPassword = bindPassword
+	}
+}
+
+func ChangeLDAPBaseDN(baseDN string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.BaseDN = &baseDN
+	}
+}
+
+func ChangeLDAPUserObjectClass(userObjectClass string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.UserObjectClass = &userObjectClass
+	}
+}
+
+func ChangeLDAPIDAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.IDAttribute = &attribute
+	}
+}
+
+func ChangeLDAPUsernameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.UsernameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPDisplayNameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.DisplayNameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPFirstNameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.FirstNameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPLastNameAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.LastNameAttribute = &attribute
+	}
+}
+
+func ChangeLDAPEmailAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.EmailAttribute = &attribute
+	}
+}
+
+func ChangeLDAPPhoneAttribute(attribute string) func(*LDAPConfigChangedEvent) {
+	return func(e *LDAPConfigChangedEvent) {
+		e.PhoneAttribute = &attribute
+	}
+}
+
+func LDAPConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &LDAPConfigChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "LDAP-Vm2sl", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,220 @@
+package idpconfig
+
+import (
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	SAMLConfigAddedEventType   eventstore.EventType = "saml.config.added"
+	SAMLConfigChangedEventType eventstore.EventType = "saml.config.changed"
+)
+
+type SAMLConfigAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID       string                  `json:"idpConfigId"`
+	MetadataURL       string                  `json:"metadataUrl,omitempty"`
+	Metadata          []byte                  `json:"metadata,omitempty"`
+	Key               *crypto.CryptoValue     `json:"key,omitempty"`
+	Certificate       []byte                  `json:"certificate,omitempty"`
+	Binding           domain.SAMLBinding      `json:"binding,omitempty"`
+	WithSignedRequest bool                    `json:"withSignedRequest,omitempty"`
+	NameIDFormat      domain.SAMLNameIDFormat `json:"nameIdFormat,omitempty"`
+
+	UsernameAttribute    string `json:"usernameAttribute,omitempty"`
+	DisplayNameAttribute string `json:"displayNameAttribute,omitempty"`
+	FirstNameAttribute   string `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute    string `json:"lastNameAttribute,omitempty"`
+	EmailAttribute       string `json:"emailAttribute,omitempty"`
+	PhoneAttribute       string `json:"phoneAttribute,omitempty"`
+}
+
+func (e *SAMLConfigAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SAMLConfigAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewSAMLConfigAddedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID,
+	metadataURL string,
+	metadata []byte,
+	key *crypto.CryptoValue,
+	certificate []byte,
+	binding domain.SAMLBinding,
+	withSignedRequest bool,
+	nameIDFormat domain.SAMLNameIDFormat,
+	usernameAttribute,
+	displayNameAttribute,
+	firstNameAttribute,
+	lastNameAttribute,
+	emailAttribute,
+	phoneAttribute string,
+) *SAMLConfigAddedEvent {
+	return &SAMLConfigAddedEvent{
+		BaseEvent:            *base,
+		IDPConfigID:          idpConfigID,
+		MetadataURL:          metadataURL,
+		Metadata:             metadata,
+		Key:                  key,
+		Certificate:          certificate,
+		Binding:              binding,
+		WithSignedRequest:    withSignedRequest,
+		NameIDFormat:         nameIDFormat,
+		UsernameAttribute:    usernameAttribute,
+		DisplayNameAttribute: displayNameAttribute,
+		FirstNameAttribute:   firstNameAttribute,
+		LastNameAttribute:    lastNameAttribute,
+		EmailAttribute:       emailAttribute,
+		PhoneAttribute:       phoneAttribute,
+	}
+}
+
+func SAMLConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SAMLConfigAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "SAML-3n8fs", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type SAMLConfigChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	IDPConfigID string `json:"idpConfigId"`
+
+	MetadataURL       *string                  `json:"metadataUrl,omitempty"`
+	Metadata          []byte                   `json:"metadata,omitempty"`
+	Binding           *domain.SAMLBinding      `json:"binding,omitempty"`
+	WithSignedRequest *bool                    `json:"withSignedRequest,omitempty"`
+	NameIDFormat      *domain.SAMLNameIDFormat `json:"nameIdFormat,omitempty"`
+
+	UsernameAttribute    *string `json:"usernameAttribute,omitempty"`
+	DisplayNameAttribute *string `json:"displayNameAttribute,omitempty"`
+	FirstNameAttribute   *string `json:"firstNameAttribute,omitempty"`
+	LastNameAttribute    *string `json:"lastNameAttribute,omitempty"`
+	EmailAttribute       *string `json:"emailAttribute,omitempty"`
+	PhoneAttribute       *string `json:"phoneAttribute,omitempty"`
+}
+
+func (e *SAMLConfigChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SAMLConfigChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewSAMLConfigChangedEvent(
+	base *eventstore.BaseEvent,
+	idpConfigID string,
+	changes []SAMLConfigChanges,
+) (*SAMLConfigChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "IDPCONFIG-s8Gd2", "Errors.NoChangesFound")
+	}
+	changeEvent := &SAMLConfigChangedEvent{
+		BaseEvent:   *base,
+		IDPConfigID: idpConfigID,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SAMLConfigChanges func(*SAMLConfigChangedEvent)
+
+func ChangeSAMLMetadataURL(metadataURL string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.MetadataURL = &metadataURL
+	}
+}
+
+func ChangeSAMLMetadata(metadata []byte) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.Metadata = metadata
+	}
+}
+
+func ChangeSAMLBinding(binding domain.SAMLBinding) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.Binding = &binding
+	}
+}
+
+func ChangeSAMLWithSignedRequest(withSignedRequest bool) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.WithSignedRequest = &withSignedRequest
+	}
+}
+
+func ChangeSAMLNameIDFormat(nameIDFormat domain.SAMLNameIDFormat) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.NameIDFormat = &nameIDFormat
+	}
+}
+
+func ChangeSAMLUsernameAttribute(attribute string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.UsernameAttribute = &attribute
+	}
+}
+
+func ChangeSAMLDisplayNameAttribute(attribute string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.DisplayNameAttribute = &attribute
+	}
+}
+
+func ChangeSAMLFirstNameAttribute(attribute string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.FirstNameAttribute = &attribute
+	}
+}
+
+func ChangeSAMLLastNameAttribute(attribute string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.LastNameAttribute = &attribute
+	}
+}
+
+func ChangeSAMLEmailAttribute(attribute string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.EmailAttribute = &attribute
+	}
+}
+
+func ChangeSAMLPhoneAttribute(attribute string) func(*SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.PhoneAttribute = &attribute
+	}
+}
+
+func SAMLConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SAMLConfigChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "SAML-Fb3sz", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
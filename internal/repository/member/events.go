@@ -3,6 +3,7 @@ package member
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/caos/zitadel/internal/errors"
 	"github.com/caos/zitadel/internal/eventstore"
@@ -34,8 +35,9 @@ func NewRemoveMemberUniqueConstraint(aggregateID, userID string) *eventstore.Eve
 type MemberAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Roles  []string `json:"roles"`
-	UserID string   `json:"userId"`
+	Roles          []string  `json:"roles"`
+	UserID         string    `json:"userId"`
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
 }
 
 func (e *MemberAddedEvent) Data() interface{} {
@@ -49,13 +51,15 @@ func (e *MemberAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstrai
 func NewMemberAddedEvent(
 	base *eventstore.BaseEvent,
 	userID string,
+	expirationDate time.Time,
 	roles ...string,
 ) *MemberAddedEvent {
 
 	return &MemberAddedEvent{
-		BaseEvent: *base,
-		Roles:     roles,
-		UserID:    userID,
+		BaseEvent:      *base,
+		Roles:          roles,
+		UserID:         userID,
+		ExpirationDate: expirationDate,
 	}
 }
 
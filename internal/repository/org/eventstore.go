@@ -50,6 +50,9 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(PasswordAgePolicyAddedEventType, PasswordAgePolicyAddedEventMapper).
 		RegisterFilterEventMapper(PasswordAgePolicyChangedEventType, PasswordAgePolicyChangedEventMapper).
 		RegisterFilterEventMapper(PasswordAgePolicyRemovedEventType, PasswordAgePolicyRemovedEventMapper).
+		RegisterFilterEventMapper(PasswordHistoryPolicyAddedEventType, PasswordHistoryPolicyAddedEventMapper).
+		RegisterFilterEventMapper(PasswordHistoryPolicyChangedEventType, PasswordHistoryPolicyChangedEventMapper).
+		RegisterFilterEventMapper(PasswordHistoryPolicyRemovedEventType, PasswordHistoryPolicyRemovedEventMapper).
 		RegisterFilterEventMapper(PasswordComplexityPolicyAddedEventType, PasswordComplexityPolicyAddedEventMapper).
 		RegisterFilterEventMapper(PasswordComplexityPolicyChangedEventType, PasswordComplexityPolicyChangedEventMapper).
 		RegisterFilterEventMapper(PasswordComplexityPolicyRemovedEventType, PasswordComplexityPolicyRemovedEventMapper).
@@ -77,9 +80,15 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(IDPOIDCConfigChangedEventType, IDPOIDCConfigChangedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigAddedEventType, IDPJWTConfigAddedEventMapper).
 		RegisterFilterEventMapper(IDPJWTConfigChangedEventType, IDPJWTConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigAddedEventType, IDPSAMLConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPSAMLConfigChangedEventType, IDPSAMLConfigChangedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigAddedEventType, IDPLDAPConfigAddedEventMapper).
+		RegisterFilterEventMapper(IDPLDAPConfigChangedEventType, IDPLDAPConfigChangedEventMapper).
 		RegisterFilterEventMapper(FeaturesSetEventType, FeaturesSetEventMapper).
 		RegisterFilterEventMapper(FeaturesRemovedEventType, FeaturesRemovedEventMapper).
 		RegisterFilterEventMapper(TriggerActionsSetEventType, TriggerActionsSetEventMapper).
 		RegisterFilterEventMapper(TriggerActionsCascadeRemovedEventType, TriggerActionsCascadeRemovedEventMapper).
-		RegisterFilterEventMapper(FlowClearedEventType, FlowClearedEventMapper)
+		RegisterFilterEventMapper(FlowClearedEventType, FlowClearedEventMapper).
+		RegisterFilterEventMapper(SMSProvidersSetEventType, SMSProvidersSetEventMapper).
+		RegisterFilterEventMapper(SMSProvidersResetEventType, SMSProvidersResetEventMapper)
 }
@@ -0,0 +1,105 @@
+package org
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/idpconfig"
+)
+
+const (
+	IDPLDAPConfigAddedEventType   eventstore.EventType = "org.idp." + idpconfig.LDAPConfigAddedEventType
+	IDPLDAPConfigChangedEventType eventstore.EventType = "org.idp." + idpconfig.LDAPConfigChangedEventType
+)
+
+type IDPLDAPConfigAddedEvent struct {
+	idpconfig.LDAPConfigAddedEvent
+}
+
+func NewIDPLDAPConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID,
+	url string,
+	startTLS bool,
+	bindDN string,
+	bindPassword *crypto.CryptoValue,
+	baseDN,
+	userObjectClass,
+	idAttribute,
+	usernameAttribute,
+	displayNameAttribute,
+	firstNameAttribute,
+	lastNameAttribute,
+	emailAttribute,
+	phoneAttribute string,
+) *IDPLDAPConfigAddedEvent {
+	return &IDPLDAPConfigAddedEvent{
+		LDAPConfigAddedEvent: *idpconfig.NewLDAPConfigAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPLDAPConfigAddedEventType,
+			),
+			idpConfigID,
+			url,
+			startTLS,
+			bindDN,
+			bindPassword,
+			baseDN,
+			userObjectClass,
+			idAttribute,
+			usernameAttribute,
+			displayNameAttribute,
+			firstNameAttribute,
+			lastNameAttribute,
+			emailAttribute,
+			phoneAttribute,
+		),
+	}
+}
+
+func IDPLDAPConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.LDAPConfigAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPLDAPConfigAddedEvent{LDAPConfigAddedEvent: *e.(*idpconfig.LDAPConfigAddedEvent)}, nil
+}
+
+type IDPLDAPConfigChangedEvent struct {
+	idpconfig.LDAPConfigChangedEvent
+}
+
+func NewIDPLDAPConfigChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	changes []idpconfig.LDAPConfigChanges,
+) (*IDPLDAPConfigChangedEvent, error) {
+	changeEvent, err := idpconfig.NewLDAPConfigChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			IDPLDAPConfigChangedEventType),
+		idpConfigID,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &IDPLDAPConfigChangedEvent{LDAPConfigChangedEvent: *changeEvent}, nil
+}
+
+func IDPLDAPConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.LDAPConfigChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPLDAPConfigChangedEvent{LDAPConfigChangedEvent: *e.(*idpconfig.LDAPConfigChangedEvent)}, nil
+}
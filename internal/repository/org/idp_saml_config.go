@@ -0,0 +1,106 @@
+package org
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/eventstore"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/repository/idpconfig"
+)
+
+const (
+	IDPSAMLConfigAddedEventType   eventstore.EventType = "org.idp." + idpconfig.SAMLConfigAddedEventType
+	IDPSAMLConfigChangedEventType eventstore.EventType = "org.idp." + idpconfig.SAMLConfigChangedEventType
+)
+
+type IDPSAMLConfigAddedEvent struct {
+	idpconfig.SAMLConfigAddedEvent
+}
+
+func NewIDPSAMLConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID,
+	metadataURL string,
+	metadata []byte,
+	key *crypto.CryptoValue,
+	certificate []byte,
+	binding domain.SAMLBinding,
+	withSignedRequest bool,
+	nameIDFormat domain.SAMLNameIDFormat,
+	usernameAttribute,
+	displayNameAttribute,
+	firstNameAttribute,
+	lastNameAttribute,
+	emailAttribute,
+	phoneAttribute string,
+) *IDPSAMLConfigAddedEvent {
+	return &IDPSAMLConfigAddedEvent{
+		SAMLConfigAddedEvent: *idpconfig.NewSAMLConfigAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPSAMLConfigAddedEventType,
+			),
+			idpConfigID,
+			metadataURL,
+			metadata,
+			key,
+			certificate,
+			binding,
+			withSignedRequest,
+			nameIDFormat,
+			usernameAttribute,
+			displayNameAttribute,
+			firstNameAttribute,
+			lastNameAttribute,
+			emailAttribute,
+			phoneAttribute,
+		),
+	}
+}
+
+func IDPSAMLConfigAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.SAMLConfigAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPSAMLConfigAddedEvent{SAMLConfigAddedEvent: *e.(*idpconfig.SAMLConfigAddedEvent)}, nil
+}
+
+type IDPSAMLConfigChangedEvent struct {
+	idpconfig.SAMLConfigChangedEvent
+}
+
+func NewIDPSAMLConfigChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	changes []idpconfig.SAMLConfigChanges,
+) (*IDPSAMLConfigChangedEvent, error) {
+	changeEvent, err := idpconfig.NewSAMLConfigChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			IDPSAMLConfigChangedEventType),
+		idpConfigID,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &IDPSAMLConfigChangedEvent{SAMLConfigChangedEvent: *changeEvent}, nil
+}
+
+func IDPSAMLConfigChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e, err := idpconfig.SAMLConfigChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPSAMLConfigChangedEvent{SAMLConfigChangedEvent: *e.(*idpconfig.SAMLConfigChangedEvent)}, nil
+}
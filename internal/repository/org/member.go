@@ -2,6 +2,7 @@ package org
 
 import (
 	"context"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -24,6 +25,7 @@ func NewMemberAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	userID string,
+	expirationDate time.Time,
 	roles ...string,
 ) *MemberAddedEvent {
 	return &MemberAddedEvent{
@@ -34,6 +36,7 @@ func NewMemberAddedEvent(
 				MemberAddedEventType,
 			),
 			userID,
+			expirationDate,
 			roles...,
 		),
 	}
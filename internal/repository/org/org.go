@@ -169,6 +169,7 @@ func OrgReactivatedEventMapper(event *repository.Event) (eventstore.Event, error
 type OrgRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	name                 string
+	domains              []string
 }
 
 func (e *OrgRemovedEvent) Data() interface{} {
@@ -176,17 +177,22 @@ func (e *OrgRemovedEvent) Data() interface{} {
 }
 
 func (e *OrgRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
-	return []*eventstore.EventUniqueConstraint{NewRemoveOrgNameUniqueConstraint(e.name)}
+	constraints := []*eventstore.EventUniqueConstraint{NewRemoveOrgNameUniqueConstraint(e.name)}
+	for _, domain := range e.domains {
+		constraints = append(constraints, NewRemoveOrgDomainUniqueConstraint(domain))
+	}
+	return constraints
 }
 
-func NewOrgRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, name string) *OrgRemovedEvent {
+func NewOrgRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, name string, domains []string) *OrgRemovedEvent {
 	return &OrgRemovedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
 			ctx,
 			aggregate,
 			OrgRemovedEventType,
 		),
-		name: name,
+		name:    name,
+		domains: domains,
 	}
 }
 
@@ -26,7 +26,8 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasLowercase,
 	hasUppercase,
 	hasNumber,
-	hasSymbol bool,
+	hasSymbol,
+	checkBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
 		PasswordComplexityPolicyAddedEvent: *policy.NewPasswordComplexityPolicyAddedEvent(
@@ -38,7 +39,8 @@ func NewPasswordComplexityPolicyAddedEvent(
 			hasLowercase,
 			hasUppercase,
 			hasNumber,
-			hasSymbol),
+			hasSymbol,
+			checkBreached),
 	}
 }
 
@@ -0,0 +1,90 @@
+package org
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	smsProvidersPrefix         = orgEventTypePrefix + "sms.providers."
+	SMSProvidersSetEventType   = smsProvidersPrefix + "set"
+	SMSProvidersResetEventType = smsProvidersPrefix + "reset"
+)
+
+//SMSProvidersSetEvent selects the sms providers of the iam used for the org
+//the providers are used in the given order, the next one is only used if sending failed
+type SMSProvidersSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProviderIDs []string `json:"providerIds,omitempty"`
+}
+
+func NewSMSProvidersSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	providerIDs []string,
+) *SMSProvidersSetEvent {
+	return &SMSProvidersSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSProvidersSetEventType,
+		),
+		ProviderIDs: providerIDs,
+	}
+}
+
+func (e *SMSProvidersSetEvent) Data() interface{} {
+	return e
+}
+
+func (e *SMSProvidersSetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSProvidersSetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SMSProvidersSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "ORG-Sp82n", "unable to unmarshal sms providers set")
+	}
+
+	return e, nil
+}
+
+type SMSProvidersResetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func NewSMSProvidersResetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *SMSProvidersResetEvent {
+	return &SMSProvidersResetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSProvidersResetEventType,
+		),
+	}
+}
+
+func (e *SMSProvidersResetEvent) Data() interface{} {
+	return nil
+}
+
+func (e *SMSProvidersResetEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func SMSProvidersResetEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &SMSProvidersResetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package policy
+
+import (
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	PasswordHistoryPolicyAddedEventType   = "policy.password.history.added"
+	PasswordHistoryPolicyChangedEventType = "policy.password.history.changed"
+	PasswordHistoryPolicyRemovedEventType = "policy.password.history.removed"
+)
+
+type PasswordHistoryPolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	HistoryCount uint64 `json:"historyCount,omitempty"`
+}
+
+func (e *PasswordHistoryPolicyAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *PasswordHistoryPolicyAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewPasswordHistoryPolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	historyCount uint64,
+) *PasswordHistoryPolicyAddedEvent {
+
+	return &PasswordHistoryPolicyAddedEvent{
+		BaseEvent:    *base,
+		HistoryCount: historyCount,
+	}
+}
+
+func PasswordHistoryPolicyAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &PasswordHistoryPolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "POLIC-Hs9fg", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type PasswordHistoryPolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	HistoryCount *uint64 `json:"historyCount,omitempty"`
+}
+
+func (e *PasswordHistoryPolicyChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *PasswordHistoryPolicyChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewPasswordHistoryPolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []PasswordHistoryPolicyChanges,
+) (*PasswordHistoryPolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "POLICY-Wm3sv", "Errors.NoChangesFound")
+	}
+	changeEvent := &PasswordHistoryPolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type PasswordHistoryPolicyChanges func(*PasswordHistoryPolicyChangedEvent)
+
+func ChangeHistoryCount(historyCount uint64) func(*PasswordHistoryPolicyChangedEvent) {
+	return func(e *PasswordHistoryPolicyChangedEvent) {
+		e.HistoryCount = &historyCount
+	}
+}
+
+func PasswordHistoryPolicyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &PasswordHistoryPolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "POLIC-Ghw2d", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type PasswordHistoryPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PasswordHistoryPolicyRemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *PasswordHistoryPolicyRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewPasswordHistoryPolicyRemovedEvent(base *eventstore.BaseEvent) *PasswordHistoryPolicyRemovedEvent {
+	return &PasswordHistoryPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func PasswordHistoryPolicyRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &PasswordHistoryPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -2,6 +2,7 @@ package policy
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -18,8 +19,12 @@ const (
 type LockoutPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MaxPasswordAttempts uint64 `json:"maxPasswordAttempts,omitempty"`
-	ShowLockOutFailures bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      uint64        `json:"maxOtpAttempts,omitempty"`
+	MaxU2FAttempts      uint64        `json:"maxU2fAttempts,omitempty"`
+	ShowLockOutFailures bool          `json:"showLockOutFailures,omitempty"`
+	LockoutDuration     time.Duration `json:"lockoutDuration,omitempty"`
+	BackoffDelay        time.Duration `json:"backoffDelay,omitempty"`
 }
 
 func (e *LockoutPolicyAddedEvent) Data() interface{} {
@@ -32,14 +37,22 @@ func (e *LockoutPolicyAddedEvent) UniqueConstraints() []*eventstore.EventUniqueC
 
 func NewLockoutPolicyAddedEvent(
 	base *eventstore.BaseEvent,
-	maxAttempts uint64,
+	maxAttempts,
+	maxOTPAttempts,
+	maxU2FAttempts uint64,
 	showLockOutFailures bool,
+	lockoutDuration,
+	backoffDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 
 	return &LockoutPolicyAddedEvent{
 		BaseEvent:           *base,
 		MaxPasswordAttempts: maxAttempts,
+		MaxOTPAttempts:      maxOTPAttempts,
+		MaxU2FAttempts:      maxU2FAttempts,
 		ShowLockOutFailures: showLockOutFailures,
+		LockoutDuration:     lockoutDuration,
+		BackoffDelay:        backoffDelay,
 	}
 }
 
@@ -59,8 +72,12 @@ func LockoutPolicyAddedEventMapper(event *repository.Event) (eventstore.Event, e
 type LockoutPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MaxPasswordAttempts *uint64 `json:"maxPasswordAttempts,omitempty"`
-	ShowLockOutFailures *bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts *uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      *uint64        `json:"maxOtpAttempts,omitempty"`
+	MaxU2FAttempts      *uint64        `json:"maxU2fAttempts,omitempty"`
+	ShowLockOutFailures *bool          `json:"showLockOutFailures,omitempty"`
+	LockoutDuration     *time.Duration `json:"lockoutDuration,omitempty"`
+	BackoffDelay        *time.Duration `json:"backoffDelay,omitempty"`
 }
 
 func (e *LockoutPolicyChangedEvent) Data() interface{} {
@@ -95,6 +112,30 @@ func ChangeMaxAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
 	}
 }
 
+func ChangeMaxOTPAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxOTPAttempts = &maxAttempts
+	}
+}
+
+func ChangeMaxU2FAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxU2FAttempts = &maxAttempts
+	}
+}
+
+func ChangeLockoutDuration(lockoutDuration time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.LockoutDuration = &lockoutDuration
+	}
+}
+
+func ChangeBackoffDelay(backoffDelay time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.BackoffDelay = &backoffDelay
+	}
+}
+
 func ChangeShowLockOutFailures(showLockOutFailures bool) func(*LockoutPolicyChangedEvent) {
 	return func(e *LockoutPolicyChangedEvent) {
 		e.ShowLockOutFailures = &showLockOutFailures
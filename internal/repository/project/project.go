@@ -37,11 +37,13 @@ func NewRemoveProjectNameUniqueConstraint(projectName, resourceOwner string) *ev
 type ProjectAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name                   string                        `json:"name,omitempty"`
-	ProjectRoleAssertion   bool                          `json:"projectRoleAssertion,omitempty"`
-	ProjectRoleCheck       bool                          `json:"projectRoleCheck,omitempty"`
-	HasProjectCheck        bool                          `json:"hasProjectCheck,omitempty"`
-	PrivateLabelingSetting domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
+	Name                       string                        `json:"name,omitempty"`
+	ProjectRoleAssertion       bool                          `json:"projectRoleAssertion,omitempty"`
+	ProjectRoleCheck           bool                          `json:"projectRoleCheck,omitempty"`
+	HasProjectCheck            bool                          `json:"hasProjectCheck,omitempty"`
+	PrivateLabelingSetting     domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
+	TokenExchangeDelegation    bool                          `json:"tokenExchangeDelegation,omitempty"`
+	TokenExchangeImpersonation bool                          `json:"tokenExchangeImpersonation,omitempty"`
 }
 
 func (e *ProjectAddedEvent) Data() interface{} {
@@ -60,6 +62,8 @@ func NewProjectAddedEvent(
 	projectRoleCheck,
 	hasProjectCheck bool,
 	privateLabelingSetting domain.PrivateLabelingSetting,
+	tokenExchangeDelegation,
+	tokenExchangeImpersonation bool,
 ) *ProjectAddedEvent {
 	return &ProjectAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -67,11 +71,13 @@ func NewProjectAddedEvent(
 			aggregate,
 			ProjectAddedType,
 		),
-		Name:                   name,
-		ProjectRoleAssertion:   projectRoleAssertion,
-		ProjectRoleCheck:       projectRoleCheck,
-		HasProjectCheck:        hasProjectCheck,
-		PrivateLabelingSetting: privateLabelingSetting,
+		Name:                       name,
+		ProjectRoleAssertion:       projectRoleAssertion,
+		ProjectRoleCheck:           projectRoleCheck,
+		HasProjectCheck:            hasProjectCheck,
+		PrivateLabelingSetting:     privateLabelingSetting,
+		TokenExchangeDelegation:    tokenExchangeDelegation,
+		TokenExchangeImpersonation: tokenExchangeImpersonation,
 	}
 }
 
@@ -91,12 +97,14 @@ func ProjectAddedEventMapper(event *repository.Event) (eventstore.Event, error)
 type ProjectChangeEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name                   *string                        `json:"name,omitempty"`
-	ProjectRoleAssertion   *bool                          `json:"projectRoleAssertion,omitempty"`
-	ProjectRoleCheck       *bool                          `json:"projectRoleCheck,omitempty"`
-	HasProjectCheck        *bool                          `json:"hasProjectCheck,omitempty"`
-	PrivateLabelingSetting *domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
-	oldName                string
+	Name                       *string                        `json:"name,omitempty"`
+	ProjectRoleAssertion       *bool                          `json:"projectRoleAssertion,omitempty"`
+	ProjectRoleCheck           *bool                          `json:"projectRoleCheck,omitempty"`
+	HasProjectCheck            *bool                          `json:"hasProjectCheck,omitempty"`
+	PrivateLabelingSetting     *domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
+	TokenExchangeDelegation    *bool                          `json:"tokenExchangeDelegation,omitempty"`
+	TokenExchangeImpersonation *bool                          `json:"tokenExchangeImpersonation,omitempty"`
+	oldName                    string
 }
 
 func (e *ProjectChangeEvent) Data() interface{} {
@@ -168,6 +176,18 @@ func ChangePrivateLabelingSetting(ChangePrivateLabelingSetting domain.PrivateLab
 	}
 }
 
+func ChangeTokenExchangeDelegation(tokenExchangeDelegation bool) func(event *ProjectChangeEvent) {
+	return func(e *ProjectChangeEvent) {
+		e.TokenExchangeDelegation = &tokenExchangeDelegation
+	}
+}
+
+func ChangeTokenExchangeImpersonation(tokenExchangeImpersonation bool) func(event *ProjectChangeEvent) {
+	return func(e *ProjectChangeEvent) {
+		e.TokenExchangeImpersonation = &tokenExchangeImpersonation
+	}
+}
+
 func ProjectChangeEventMapper(event *repository.Event) (eventstore.Event, error) {
 	e := &ProjectChangeEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -241,6 +261,8 @@ type ProjectRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
 	Name string
+
+	entityIDUniqueContraints []*eventstore.EventUniqueConstraint
 }
 
 func (e *ProjectRemovedEvent) Data() interface{} {
@@ -248,13 +270,18 @@ func (e *ProjectRemovedEvent) Data() interface{} {
 }
 
 func (e *ProjectRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
-	return []*eventstore.EventUniqueConstraint{NewRemoveProjectNameUniqueConstraint(e.Name, e.Aggregate().ResourceOwner)}
+	constraints := []*eventstore.EventUniqueConstraint{NewRemoveProjectNameUniqueConstraint(e.Name, e.Aggregate().ResourceOwner)}
+	if e.entityIDUniqueContraints != nil {
+		constraints = append(constraints, e.entityIDUniqueContraints...)
+	}
+	return constraints
 }
 
 func NewProjectRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	name string,
+	entityIDUniqueContraints []*eventstore.EventUniqueConstraint,
 ) *ProjectRemovedEvent {
 	return &ProjectRemovedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -262,7 +289,8 @@ func NewProjectRemovedEvent(
 			aggregate,
 			ProjectRemovedType,
 		),
-		Name: name,
+		Name:                     name,
+		entityIDUniqueContraints: entityIDUniqueContraints,
 	}
 }
 
@@ -43,6 +43,7 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(UserRemovedType, UserRemovedEventMapper).
 		RegisterFilterEventMapper(UserTokenAddedType, UserTokenAddedEventMapper).
 		RegisterFilterEventMapper(UserTokenRemovedType, UserTokenRemovedEventMapper).
+		RegisterFilterEventMapper(UserTokenExchangedType, UserTokenExchangedEventMapper).
 		RegisterFilterEventMapper(UserDomainClaimedType, DomainClaimedEventMapper).
 		RegisterFilterEventMapper(UserDomainClaimedSentType, DomainClaimedSentEventMapper).
 		RegisterFilterEventMapper(UserUserNameChangedType, UsernameChangedEventMapper).
@@ -61,6 +62,8 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(HumanPasswordCodeSentType, HumanPasswordCodeSentEventMapper).
 		RegisterFilterEventMapper(HumanPasswordCheckSucceededType, HumanPasswordCheckSucceededEventMapper).
 		RegisterFilterEventMapper(HumanPasswordCheckFailedType, HumanPasswordCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanPasswordExpiryNotificationAddedType, HumanPasswordExpiryNotificationAddedEventMapper).
+		RegisterFilterEventMapper(HumanPasswordExpiryNotificationSentType, HumanPasswordExpiryNotificationSentEventMapper).
 		RegisterFilterEventMapper(UserIDPLinkAddedType, UserIDPLinkAddedEventMapper).
 		RegisterFilterEventMapper(UserIDPLinkRemovedType, UserIDPLinkRemovedEventMapper).
 		RegisterFilterEventMapper(UserIDPLinkCascadeRemovedType, UserIDPLinkCascadeRemovedEventMapper).
@@ -86,6 +89,24 @@ func RegisterEventMappers(es *eventstore.Eventstore) {
 		RegisterFilterEventMapper(HumanMFAOTPRemovedType, HumanOTPRemovedEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPCheckSucceededType, HumanOTPCheckSucceededEventMapper).
 		RegisterFilterEventMapper(HumanMFAOTPCheckFailedType, HumanOTPCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPSMSAddedType, HumanOTPSMSAddedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPSMSRemovedType, HumanOTPSMSRemovedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPSMSCodeAddedType, HumanOTPSMSCodeAddedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPSMSCodeSentType, HumanOTPSMSCodeSentEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPSMSCheckSucceededType, HumanOTPSMSCheckSucceededEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPSMSCheckFailedType, HumanOTPSMSCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPEmailAddedType, HumanOTPEmailAddedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPEmailRemovedType, HumanOTPEmailRemovedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPEmailCodeAddedType, HumanOTPEmailCodeAddedEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPEmailCodeSentType, HumanOTPEmailCodeSentEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPEmailCheckSucceededType, HumanOTPEmailCheckSucceededEventMapper).
+		RegisterFilterEventMapper(HumanMFAOTPEmailCheckFailedType, HumanOTPEmailCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanMFARecoveryCodesAddedType, HumanRecoveryCodesAddedEventMapper).
+		RegisterFilterEventMapper(HumanMFARecoveryCodesRemovedType, HumanRecoveryCodesRemovedEventMapper).
+		RegisterFilterEventMapper(HumanMFARecoveryCodeCheckSucceededType, HumanRecoveryCodeCheckSucceededEventMapper).
+		RegisterFilterEventMapper(HumanMFARecoveryCodeCheckFailedType, HumanRecoveryCodeCheckFailedEventMapper).
+		RegisterFilterEventMapper(HumanMFARecoveryCodesLowNotificationAddedType, HumanRecoveryCodesLowNotificationAddedEventMapper).
+		RegisterFilterEventMapper(HumanMFARecoveryCodesLowNotificationSentType, HumanRecoveryCodesLowNotificationSentEventMapper).
 		RegisterFilterEventMapper(HumanU2FTokenAddedType, HumanU2FAddedEventMapper).
 		RegisterFilterEventMapper(HumanU2FTokenVerifiedType, HumanU2FVerifiedEventMapper).
 		RegisterFilterEventMapper(HumanU2FTokenSignCountChangedType, HumanU2FSignCountChangedEventMapper).
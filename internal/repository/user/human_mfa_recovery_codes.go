@@ -0,0 +1,242 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	recoveryCodesEventPrefix                      = mfaEventPrefix + "recoverycodes."
+	HumanMFARecoveryCodesAddedType                = recoveryCodesEventPrefix + "added"
+	HumanMFARecoveryCodesRemovedType              = recoveryCodesEventPrefix + "removed"
+	HumanMFARecoveryCodeCheckSucceededType        = recoveryCodesEventPrefix + "check.succeeded"
+	HumanMFARecoveryCodeCheckFailedType           = recoveryCodesEventPrefix + "check.failed"
+	HumanMFARecoveryCodesLowNotificationAddedType = recoveryCodesEventPrefix + "low.notification.added"
+	HumanMFARecoveryCodesLowNotificationSentType  = recoveryCodesEventPrefix + "low.notification.sent"
+)
+
+type HumanRecoveryCodesAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Codes []*crypto.CryptoValue `json:"codes,omitempty"`
+}
+
+func (e *HumanRecoveryCodesAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodesAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodesAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	codes []*crypto.CryptoValue,
+) *HumanRecoveryCodesAddedEvent {
+	return &HumanRecoveryCodesAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFARecoveryCodesAddedType,
+		),
+		Codes: codes,
+	}
+}
+
+func HumanRecoveryCodesAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	codesAdded := &HumanRecoveryCodesAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, codesAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc8aD", "unable to unmarshal human recovery codes added")
+	}
+	return codesAdded, nil
+}
+
+type HumanRecoveryCodesRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryCodesRemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryCodesRemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodesRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *HumanRecoveryCodesRemovedEvent {
+	return &HumanRecoveryCodesRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFARecoveryCodesRemovedType,
+		),
+	}
+}
+
+func HumanRecoveryCodesRemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanRecoveryCodesRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type HumanRecoveryCodeCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CodeIndex int `json:"codeIndex"`
+	*AuthRequestInfo
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodeCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	codeIndex int,
+	info *AuthRequestInfo,
+) *HumanRecoveryCodeCheckSucceededEvent {
+	return &HumanRecoveryCodeCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFARecoveryCodeCheckSucceededType,
+		),
+		CodeIndex:       codeIndex,
+		AuthRequestInfo: info,
+	}
+}
+
+func HumanRecoveryCodeCheckSucceededEventMapper(event *repository.Event) (eventstore.Event, error) {
+	checkSucceeded := &HumanRecoveryCodeCheckSucceededEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, checkSucceeded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc8sU", "unable to unmarshal human recovery code check succeeded")
+	}
+	return checkSucceeded, nil
+}
+
+type HumanRecoveryCodeCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodeCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanRecoveryCodeCheckFailedEvent {
+	return &HumanRecoveryCodeCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFARecoveryCodeCheckFailedType,
+		),
+		AuthRequestInfo: info,
+	}
+}
+
+func HumanRecoveryCodeCheckFailedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	checkFailed := &HumanRecoveryCodeCheckFailedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, checkFailed)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc8fE", "unable to unmarshal human recovery code check failed")
+	}
+	return checkFailed, nil
+}
+
+type HumanRecoveryCodesLowNotificationAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Remaining int `json:"remaining"`
+}
+
+func (e *HumanRecoveryCodesLowNotificationAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodesLowNotificationAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodesLowNotificationAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	remaining int,
+) *HumanRecoveryCodesLowNotificationAddedEvent {
+	return &HumanRecoveryCodesLowNotificationAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFARecoveryCodesLowNotificationAddedType,
+		),
+		Remaining: remaining,
+	}
+}
+
+func HumanRecoveryCodesLowNotificationAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	notificationAdded := &HumanRecoveryCodesLowNotificationAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, notificationAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Rc8nA", "unable to unmarshal human recovery codes low notification added")
+	}
+	return notificationAdded, nil
+}
+
+type HumanRecoveryCodesLowNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryCodesLowNotificationSentEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryCodesLowNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanRecoveryCodesLowNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanRecoveryCodesLowNotificationSentEvent {
+	return &HumanRecoveryCodesLowNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMFARecoveryCodesLowNotificationSentType,
+		),
+	}
+}
+
+func HumanRecoveryCodesLowNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanRecoveryCodesLowNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
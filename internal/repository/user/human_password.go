@@ -20,6 +20,9 @@ const (
 	HumanPasswordCodeSentType       = passwordEventPrefix + "code.sent"
 	HumanPasswordCheckSucceededType = passwordEventPrefix + "check.succeeded"
 	HumanPasswordCheckFailedType    = passwordEventPrefix + "check.failed"
+
+	HumanPasswordExpiryNotificationAddedType = passwordEventPrefix + "expiry.notification.added"
+	HumanPasswordExpiryNotificationSentType  = passwordEventPrefix + "expiry.notification.sent"
 )
 
 type HumanPasswordChangedEvent struct {
@@ -28,6 +31,8 @@ type HumanPasswordChangedEvent struct {
 	Secret         *crypto.CryptoValue `json:"secret,omitempty"`
 	ChangeRequired bool                `json:"changeRequired"`
 	UserAgentID    string              `json:"userAgentID,omitempty"`
+	//Rehashed is set if the unchanged password was hashed with the current algorithm
+	Rehashed bool `json:"rehashed,omitempty"`
 }
 
 func (e *HumanPasswordChangedEvent) Data() interface{} {
@@ -57,6 +62,18 @@ func NewHumanPasswordChangedEvent(
 	}
 }
 
+func NewHumanPasswordRehashedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	secret *crypto.CryptoValue,
+	changeRequired bool,
+	userAgentID string,
+) *HumanPasswordChangedEvent {
+	event := NewHumanPasswordChangedEvent(ctx, aggregate, secret, changeRequired, userAgentID)
+	event.Rehashed = true
+	return event
+}
+
 func HumanPasswordChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
 	humanAdded := &HumanPasswordChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -223,3 +240,72 @@ func HumanPasswordCheckFailedEventMapper(event *repository.Event) (eventstore.Ev
 
 	return humanAdded, nil
 }
+
+type HumanPasswordExpiryNotificationAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ExpiryDate time.Time `json:"expiryDate"`
+}
+
+func (e *HumanPasswordExpiryNotificationAddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *HumanPasswordExpiryNotificationAddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordExpiryNotificationAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	expiryDate time.Time,
+) *HumanPasswordExpiryNotificationAddedEvent {
+	return &HumanPasswordExpiryNotificationAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordExpiryNotificationAddedType,
+		),
+		ExpiryDate: expiryDate,
+	}
+}
+
+func HumanPasswordExpiryNotificationAddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	notificationAdded := &HumanPasswordExpiryNotificationAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, notificationAdded)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-3nFs0", "unable to unmarshal human password expiry notification added")
+	}
+
+	return notificationAdded, nil
+}
+
+type HumanPasswordExpiryNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) Data() interface{} {
+	return nil
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordExpiryNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanPasswordExpiryNotificationSentEvent {
+	return &HumanPasswordExpiryNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordExpiryNotificationSentType,
+		),
+	}
+}
+
+func HumanPasswordExpiryNotificationSentEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &HumanPasswordExpiryNotificationSentEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -22,6 +22,7 @@ const (
 	UserRemovedType           = userEventTypePrefix + "removed"
 	UserTokenAddedType        = userEventTypePrefix + "token.added"
 	UserTokenRemovedType      = userEventTypePrefix + "token.removed"
+	UserTokenExchangedType    = userEventTypePrefix + "token.exchanged"
 	UserDomainClaimedType     = userEventTypePrefix + "domain.claimed"
 	UserDomainClaimedSentType = userEventTypePrefix + "domain.claimed.sent"
 	UserUserNameChangedType   = userEventTypePrefix + "username.changed"
@@ -50,10 +51,15 @@ func NewRemoveUsernameUniqueConstraint(userName, resourceOwner string, userLogin
 
 type UserLockedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	LockedUntil time.Time `json:"lockedUntil,omitempty"`
 }
 
 func (e *UserLockedEvent) Data() interface{} {
-	return nil
+	if e.LockedUntil.IsZero() {
+		return nil
+	}
+	return e
 }
 
 func (e *UserLockedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
@@ -70,10 +76,26 @@ func NewUserLockedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *U
 	}
 }
 
+//NewUserLockedUntilEvent locks the user until the given time
+//afterwards the user is unlocked on the next attempt
+func NewUserLockedUntilEvent(ctx context.Context, aggregate *eventstore.Aggregate, lockedUntil time.Time) *UserLockedEvent {
+	event := NewUserLockedEvent(ctx, aggregate)
+	event.LockedUntil = lockedUntil
+	return event
+}
+
 func UserLockedEventMapper(event *repository.Event) (eventstore.Event, error) {
-	return &UserLockedEvent{
+	lockedEvent := &UserLockedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	if len(event.Data) == 0 {
+		return lockedEvent, nil
+	}
+	err := json.Unmarshal(event.Data, lockedEvent)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-8dsG2", "unable to unmarshal user locked")
+	}
+	return lockedEvent, nil
 }
 
 type UserUnlockedEvent struct {
@@ -219,6 +241,8 @@ type UserTokenAddedEvent struct {
 	Scopes            []string  `json:"scopes"`
 	Expiration        time.Time `json:"expiration"`
 	PreferredLanguage string    `json:"preferredLanguage"`
+	ActorSubject      string    `json:"actorSubject,omitempty"`
+	ActorClientID     string    `json:"actorClientId,omitempty"`
 }
 
 func (e *UserTokenAddedEvent) Data() interface{} {
@@ -311,6 +335,67 @@ func UserTokenRemovedEventMapper(event *repository.Event) (eventstore.Event, err
 	return tokenRemoved, nil
 }
 
+// UserTokenExchangedEvent records the issuance of a token by the token exchange grant,
+// the token itself is added by the UserTokenAddedEvent
+type UserTokenExchangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TokenID        string                   `json:"tokenId"`
+	SubjectTokenID string                   `json:"subjectTokenId"`
+	ApplicationID  string                   `json:"applicationId"`
+	ExchangeType   domain.TokenExchangeType `json:"exchangeType"`
+	ActorSubject   string                   `json:"actorSubject,omitempty"`
+	Audience       []string                 `json:"audience"`
+	Scopes         []string                 `json:"scopes"`
+}
+
+func (e *UserTokenExchangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *UserTokenExchangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewUserTokenExchangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID,
+	subjectTokenID,
+	applicationID string,
+	exchangeType domain.TokenExchangeType,
+	actorSubject string,
+	audience,
+	scopes []string,
+) *UserTokenExchangedEvent {
+	return &UserTokenExchangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserTokenExchangedType,
+		),
+		TokenID:        tokenID,
+		SubjectTokenID: subjectTokenID,
+		ApplicationID:  applicationID,
+		ExchangeType:   exchangeType,
+		ActorSubject:   actorSubject,
+		Audience:       audience,
+		Scopes:         scopes,
+	}
+}
+
+func UserTokenExchangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	tokenExchanged := &UserTokenExchangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := json.Unmarshal(event.Data, tokenExchanged)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "USER-Wm0gs", "unable to unmarshal token exchanged")
+	}
+
+	return tokenExchanged, nil
+}
+
 type DomainClaimedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
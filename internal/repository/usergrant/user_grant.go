@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/caos/zitadel/internal/eventstore"
 
@@ -39,10 +40,11 @@ func NewRemoveUserGrantUniqueConstraint(resourceOwner, userID, projectID, projec
 type UserGrantAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	UserID         string   `json:"userId,omitempty"`
-	ProjectID      string   `json:"projectId,omitempty"`
-	ProjectGrantID string   `json:"grantId,omitempty"`
-	RoleKeys       []string `json:"roleKeys,omitempty"`
+	UserID         string    `json:"userId,omitempty"`
+	ProjectID      string    `json:"projectId,omitempty"`
+	ProjectGrantID string    `json:"grantId,omitempty"`
+	RoleKeys       []string  `json:"roleKeys,omitempty"`
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
 }
 
 func (e *UserGrantAddedEvent) Data() interface{} {
@@ -59,7 +61,8 @@ func NewUserGrantAddedEvent(
 	userID,
 	projectID,
 	projectGrantID string,
-	roleKeys []string) *UserGrantAddedEvent {
+	roleKeys []string,
+	expirationDate time.Time) *UserGrantAddedEvent {
 	return &UserGrantAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
 			ctx,
@@ -70,6 +73,7 @@ func NewUserGrantAddedEvent(
 		ProjectID:      projectID,
 		ProjectGrantID: projectGrantID,
 		RoleKeys:       roleKeys,
+		ExpirationDate: expirationDate,
 	}
 }
 
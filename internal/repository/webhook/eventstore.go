@@ -0,0 +1,12 @@
+package webhook
+
+import "github.com/caos/zitadel/internal/eventstore"
+
+func RegisterEventMappers(es *eventstore.Eventstore) {
+	es.RegisterFilterEventMapper(AddedEventType, AddedEventMapper).
+		RegisterFilterEventMapper(ChangedEventType, ChangedEventMapper).
+		RegisterFilterEventMapper(SigningKeyChangedEventType, SigningKeyChangedEventMapper).
+		RegisterFilterEventMapper(DeactivatedEventType, DeactivatedEventMapper).
+		RegisterFilterEventMapper(ReactivatedEventType, ReactivatedEventMapper).
+		RegisterFilterEventMapper(RemovedEventType, RemovedEventMapper)
+}
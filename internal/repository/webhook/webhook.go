@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+)
+
+const (
+	eventTypePrefix            = eventstore.EventType("webhook.")
+	AddedEventType             = eventTypePrefix + "added"
+	ChangedEventType           = eventTypePrefix + "changed"
+	SigningKeyChangedEventType = eventTypePrefix + "signing.key.changed"
+	DeactivatedEventType       = eventTypePrefix + "deactivated"
+	ReactivatedEventType       = eventTypePrefix + "reactivated"
+	RemovedEventType           = eventTypePrefix + "removed"
+)
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name       string              `json:"name"`
+	URL        string              `json:"url"`
+	EventTypes []string            `json:"eventTypes"`
+	SigningKey *crypto.CryptoValue `json:"signingKey"`
+}
+
+func (e *AddedEvent) Data() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	url string,
+	eventTypes []string,
+	signingKey *crypto.CryptoValue,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedEventType,
+		),
+		Name:       name,
+		URL:        url,
+		EventTypes: eventTypes,
+		SigningKey: signingKey,
+	}
+}
+
+func AddedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &AddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-4Hs9f", "unable to unmarshal webhook added")
+	}
+
+	return e, nil
+}
+
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name       *string   `json:"name,omitempty"`
+	URL        *string   `json:"url,omitempty"`
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+}
+
+func (e *ChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []WebhookChanges,
+) (*ChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, errors.ThrowPreconditionFailed(nil, "WEBHO-Mw2nf", "Errors.NoChangesFound")
+	}
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type WebhookChanges func(event *ChangedEvent)
+
+func ChangeName(name string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeURL(url string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.URL = &url
+	}
+}
+
+func ChangeEventTypes(eventTypes []string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.EventTypes = &eventTypes
+	}
+}
+
+func ChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &ChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Ka93m", "unable to unmarshal webhook changed")
+	}
+
+	return e, nil
+}
+
+type SigningKeyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	SigningKey *crypto.CryptoValue `json:"signingKey"`
+}
+
+func (e *SigningKeyChangedEvent) Data() interface{} {
+	return e
+}
+
+func (e *SigningKeyChangedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewSigningKeyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	signingKey *crypto.CryptoValue,
+) *SigningKeyChangedEvent {
+	return &SigningKeyChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SigningKeyChangedEventType,
+		),
+		SigningKey: signingKey,
+	}
+}
+
+func SigningKeyChangedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	e := &SigningKeyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := json.Unmarshal(event.Data, e)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-s0Fmw", "unable to unmarshal webhook signing key changed")
+	}
+
+	return e, nil
+}
+
+type DeactivatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DeactivatedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *DeactivatedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewDeactivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *DeactivatedEvent {
+	return &DeactivatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeactivatedEventType,
+		),
+	}
+}
+
+func DeactivatedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &DeactivatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type ReactivatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ReactivatedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *ReactivatedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewReactivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ReactivatedEvent {
+	return &ReactivatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ReactivatedEventType,
+		),
+	}
+}
+
+func ReactivatedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &ReactivatedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) Data() interface{} {
+	return nil
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.EventUniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedEventType,
+		),
+	}
+}
+
+func RemovedEventMapper(event *repository.Event) (eventstore.Event, error) {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -43,6 +43,11 @@ func (l *Login) renderChangePassword(w http.ResponseWriter, r *http.Request, aut
 		baseData:    l.getBaseData(r, authReq, "Change Password", errID, errMessage),
 		profileData: l.getProfileData(authReq),
 	}
+	for _, step := range authReq.PossibleSteps {
+		if changePassword, ok := step.(*domain.ChangePasswordStep); ok {
+			data.PasswordExpired = changePassword.Expired
+		}
+	}
 	policy, description, _ := l.getPasswordComplexityPolicy(r, authReq, authReq.UserOrgID)
 	if policy != nil {
 		data.PasswordPolicyDescription = description
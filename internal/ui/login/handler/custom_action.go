@@ -29,7 +29,7 @@ func (l *Login) customExternalUserMapping(ctx context.Context, user *domain.Exte
 	actionCtx := (&actions.Context{}).SetToken(tokens)
 	api := (&actions.API{}).SetExternalUser(user).SetMetadata(&user.Metadatas)
 	for _, a := range triggerActions {
-		err = actions.Run(actionCtx, api, a.Script, a.Name, a.Timeout, a.AllowedToFail)
+		err = actions.RunAction(ctx, l.actionsDeps, actionCtx, api, a, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostAuthentication)
 		if err != nil {
 			return nil, err
 		}
@@ -45,7 +45,7 @@ func (l *Login) customExternalUserToLoginUserMapping(user *domain.Human, tokens
 	actionCtx := (&actions.Context{}).SetToken(tokens)
 	api := (&actions.API{}).SetHuman(user).SetMetadata(&metadata)
 	for _, a := range triggerActions {
-		err = actions.Run(actionCtx, api, a.Script, a.Name, a.Timeout, a.AllowedToFail)
+		err = actions.RunAction(context.TODO(), l.actionsDeps, actionCtx, api, a, domain.FlowTypeExternalAuthentication, domain.TriggerTypePreCreation)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -62,7 +62,7 @@ func (l *Login) customGrants(userID string, tokens *oidc.Tokens, req *domain.Aut
 	actionUserGrants := make([]actions.UserGrant, 0)
 	api := (&actions.API{}).SetUserGrants(&actionUserGrants)
 	for _, a := range triggerActions {
-		err = actions.Run(actionCtx, api, a.Script, a.Name, a.Timeout, a.AllowedToFail)
+		err = actions.RunAction(context.TODO(), l.actionsDeps, actionCtx, api, a, domain.FlowTypeExternalAuthentication, domain.TriggerTypePostCreation)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/caos/logging"
+
+	http_mw "github.com/caos/zitadel/internal/api/http/middleware"
+	z_oidc "github.com/caos/zitadel/internal/api/oidc"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errs "github.com/caos/zitadel/internal/errors"
+)
+
+const (
+	queryUserCode = "user_code"
+
+	tmplDeviceUserCode = "deviceusercode"
+	tmplDeviceAction   = "deviceaction"
+	tmplDeviceDone     = "devicedone"
+)
+
+type deviceUserCodeFormData struct {
+	UserCode string `schema:"user_code"`
+}
+
+type deviceUserCodeData struct {
+	baseData
+	UserCode string
+}
+
+type deviceActionFormData struct {
+	Allow bool `schema:"allow"`
+}
+
+type deviceActionData struct {
+	userData
+	AppName string
+	Scopes  []string
+}
+
+type deviceDoneData struct {
+	baseData
+	Approved bool
+}
+
+func (l *Login) handleDeviceUserCode(w http.ResponseWriter, r *http.Request) {
+	l.renderDeviceUserCode(w, r, r.FormValue(queryUserCode), nil)
+}
+
+// handleDeviceUserCodeCheck starts the login of the user for the device authorization of the entered user code
+func (l *Login) handleDeviceUserCodeCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(deviceUserCodeFormData)
+	err := l.getParseData(r, data)
+	if err != nil {
+		l.renderDeviceUserCode(w, r, "", err)
+		return
+	}
+	userCode := z_oidc.NormalizeUserCode(data.UserCode)
+	deviceAuth, err := l.query.DeviceAuthByUserCode(r.Context(), userCode)
+	if err != nil {
+		l.renderDeviceUserCode(w, r, data.UserCode, caos_errs.ThrowNotFound(err, "LOGIN-3m9fs", "Errors.DeviceAuth.NotFound"))
+		return
+	}
+	if deviceAuth.State != domain.DeviceAuthStateInitiated {
+		l.renderDeviceUserCode(w, r, data.UserCode, caos_errs.ThrowNotFound(nil, "LOGIN-Wm2ds", "Errors.DeviceAuth.NotFound"))
+		return
+	}
+	if deviceAuth.IsExpired() {
+		l.renderDeviceUserCode(w, r, data.UserCode, caos_errs.ThrowPreconditionFailed(nil, "LOGIN-Qm0fs", "Errors.DeviceAuth.Expired"))
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	authReq, err := l.authRepo.CreateAuthRequest(r.Context(), &domain.AuthRequest{
+		CreationDate:  time.Now(),
+		AgentID:       userAgentID,
+		BrowserInfo:   domain.BrowserInfoFromRequest(r),
+		ApplicationID: deviceAuth.ClientID,
+		Request: &domain.AuthRequestDevice{
+			ID:       deviceAuth.ID,
+			UserCode: deviceAuth.UserCode,
+			Scopes:   deviceAuth.Scopes,
+		},
+	})
+	if err != nil {
+		l.renderDeviceUserCode(w, r, data.UserCode, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+// handleDeviceAction is the callback of the login for device authorizations,
+// the (logged in) user has to allow or deny the access of the device
+func (l *Login) handleDeviceAction(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getDeviceAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderDeviceAction(w, r, authReq)
+}
+
+func (l *Login) handleDeviceActionCheck(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.getDeviceAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	data := new(deviceActionFormData)
+	if err = l.getParseData(r, data); err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	deviceReq := authReq.Request.(*domain.AuthRequestDevice)
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	if data.Allow {
+		amr := (&z_oidc.AuthRequest{AuthRequest: authReq}).GetAMR()
+		_, err = l.command.ApproveDeviceAuth(ctx, deviceReq.ID, authReq.ApplicationResourceOwner, authReq.UserID, authReq.UserOrgID, authReq.Audience, amr, authReq.AuthTime)
+	} else {
+		_, err = l.command.DenyDeviceAuth(ctx, deviceReq.ID, authReq.ApplicationResourceOwner)
+	}
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	err = l.authRepo.DeleteAuthRequest(r.Context(), authReq.ID)
+	logging.LogWithFields("LOGIN-Pq2fs", "authRequestID", authReq.ID).OnError(err).Warn("unable to delete auth request")
+	l.renderDeviceDone(w, r, authReq, data.Allow)
+}
+
+func (l *Login) getDeviceAuthRequest(r *http.Request) (*domain.AuthRequest, error) {
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	authReq, err := l.authRepo.AuthRequestByIDCheckLoggedIn(r.Context(), r.FormValue(queryAuthRequestID), userAgentID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := authReq.Request.(*domain.AuthRequestDevice); !ok {
+		return authReq, caos_errs.ThrowInvalidArgument(nil, "LOGIN-2n0fs", "Errors.AuthRequest.RequestTypeNotSupported")
+	}
+	return authReq, nil
+}
+
+func (l *Login) renderDeviceUserCode(w http.ResponseWriter, r *http.Request, userCode string, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := deviceUserCodeData{
+		baseData: l.getBaseData(r, nil, "Device Authorization", errID, errMessage),
+		UserCode: userCode,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(nil), l.renderer.Templates[tmplDeviceUserCode], data, nil)
+}
+
+func (l *Login) renderDeviceAction(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
+	data := deviceActionData{
+		userData: l.getUserData(r, authReq, "Device Authorization", "", ""),
+		Scopes:   authReq.Request.(*domain.AuthRequestDevice).Scopes,
+	}
+	app, err := l.query.AppByOIDCClientID(r.Context(), authReq.ApplicationID)
+	if err == nil {
+		data.AppName = app.Name
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplDeviceAction], data, nil)
+}
+
+func (l *Login) renderDeviceDone(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, approved bool) {
+	data := deviceDoneData{
+		baseData: l.getBaseData(r, authReq, "Device Authorization", "", ""),
+		Approved: approved,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplDeviceDone], data, nil)
+}
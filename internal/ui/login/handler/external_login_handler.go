@@ -92,6 +92,14 @@ func (l *Login) handleIDP(w http.ResponseWriter, r *http.Request, authReq *domai
 		l.renderLogin(w, r, authReq, err)
 		return
 	}
+	if idpConfig.IsSAML {
+		l.handleSAMLAuthorize(w, r, authReq, idpConfig)
+		return
+	}
+	if idpConfig.IsLDAP {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, "", nil)
+		return
+	}
 	if !idpConfig.IsOIDC {
 		l.handleJWTAuthorize(w, r, authReq, idpConfig)
 		return
@@ -192,6 +200,10 @@ func (l *Login) getRPConfig(idpConfig *iam_model.IDPConfigView, callbackEndpoint
 
 func (l *Login) handleExternalUserAuthenticated(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, userAgentID string, tokens *oidc.Tokens) {
 	externalUser := l.mapTokenToLoginUser(tokens, idpConfig)
+	l.handleExternalUser(w, r, authReq, idpConfig, userAgentID, externalUser, tokens)
+}
+
+func (l *Login) handleExternalUser(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, userAgentID string, externalUser *domain.ExternalUser, tokens *oidc.Tokens) {
 	externalUser, err := l.customExternalUserMapping(r.Context(), externalUser, tokens, authReq, idpConfig)
 	if err != nil {
 		l.renderError(w, r, authReq, err)
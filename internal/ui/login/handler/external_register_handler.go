@@ -72,6 +72,14 @@ func (l *Login) handleExternalRegister(w http.ResponseWriter, r *http.Request) {
 		l.renderLogin(w, r, authReq, err)
 		return
 	}
+	if idpConfig.IsSAML {
+		l.handleSAMLAuthorize(w, r, authReq, idpConfig)
+		return
+	}
+	if idpConfig.IsLDAP {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, "", nil)
+		return
+	}
 	if !idpConfig.IsOIDC {
 		l.handleJWTAuthorize(w, r, authReq, idpConfig)
 		return
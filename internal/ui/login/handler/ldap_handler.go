@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"net/http"
+
+	http_mw "github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errors "github.com/caos/zitadel/internal/errors"
+	iam_model "github.com/caos/zitadel/internal/iam/model"
+	"github.com/caos/zitadel/internal/ldap"
+)
+
+const (
+	tmplLDAPLogin = "ldaplogin"
+)
+
+type ldapLoginFormData struct {
+	Username string `schema:"username"`
+	Password string `schema:"password"`
+}
+
+type ldapLoginData struct {
+	baseData
+	IDPName  string
+	Username string
+}
+
+// renderLDAPLogin shows the username / password form of the selected ldap identity provider,
+// the credentials are checked by ZITADEL directly against the ldap server
+func (l *Login) renderLDAPLogin(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView, username string, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := ldapLoginData{
+		baseData: l.getBaseData(r, authReq, "LDAP Login", errID, errMessage),
+		IDPName:  idpConfig.Name,
+		Username: username,
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplLDAPLogin], data, nil)
+}
+
+func (l *Login) handleLDAPLoginCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(ldapLoginFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq == nil {
+		http.Redirect(w, r, l.zitadelURL, http.StatusFound)
+		return
+	}
+	idpConfig, err := l.getIDPConfigByID(r, authReq.SelectedIDPConfigID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !idpConfig.IsLDAP {
+		l.renderError(w, r, authReq, caos_errors.ThrowPreconditionFailed(nil, "LOGIN-Vn2sk", "Errors.ExternalIDP.IDPTypeNotImplemented"))
+		return
+	}
+	config, err := l.ldapConfig(idpConfig)
+	if err != nil {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, data.Username, err)
+		return
+	}
+	lockoutPolicy, err := l.ldapLockoutPolicy(r, authReq)
+	if err != nil {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, data.Username, err)
+		return
+	}
+	if err = l.ldapLimiter.Check(idpConfig.IDPConfigID, data.Username, lockoutPolicy); err != nil {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, data.Username, err)
+		return
+	}
+	user, err := ldap.Authenticate(config, data.Username, data.Password)
+	if caos_errors.IsUnauthenticated(err) {
+		l.ldapLimiter.Failed(idpConfig.IDPConfigID, data.Username, lockoutPolicy)
+	}
+	if err != nil {
+		l.renderLDAPLogin(w, r, authReq, idpConfig, data.Username, err)
+		return
+	}
+	l.ldapLimiter.Succeeded(idpConfig.IDPConfigID, data.Username)
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	l.handleExternalUser(w, r, authReq, idpConfig, userAgentID, mapLDAPUserToExternalUser(user, idpConfig, data.Username), nil)
+}
+
+func (l *Login) ldapConfig(idpConfig *iam_model.IDPConfigView) (*ldap.Config, error) {
+	var bindPassword string
+	if idpConfig.LDAPBindPassword != nil {
+		var err error
+		bindPassword, err = crypto.DecryptString(idpConfig.LDAPBindPassword, l.IDPConfigAesCrypto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ldap.Config{
+		URL:                  idpConfig.LDAPURL,
+		StartTLS:             idpConfig.LDAPStartTLS,
+		BindDN:               idpConfig.LDAPBindDN,
+		BindPassword:         bindPassword,
+		BaseDN:               idpConfig.LDAPBaseDN,
+		UserObjectClass:      idpConfig.LDAPUserObjectClass,
+		IDAttribute:          idpConfig.LDAPIDAttribute,
+		UsernameAttribute:    idpConfig.LDAPUsernameAttribute,
+		DisplayNameAttribute: idpConfig.LDAPDisplayNameAttribute,
+		FirstNameAttribute:   idpConfig.LDAPFirstNameAttribute,
+		LastNameAttribute:    idpConfig.LDAPLastNameAttribute,
+		EmailAttribute:       idpConfig.LDAPEmailAttribute,
+		PhoneAttribute:       idpConfig.LDAPPhoneAttribute,
+	}, nil
+}
+
+//ldapLockoutPolicy returns the lockout policy of the requested organisation or the default policy
+func (l *Login) ldapLockoutPolicy(r *http.Request, authReq *domain.AuthRequest) (*domain.LockoutPolicy, error) {
+	policy, err := l.query.LockoutPolicyByOrg(r.Context(), authReq.RequestedOrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.LockoutPolicy{
+		MaxPasswordAttempts: policy.MaxPasswordAttempts,
+		LockoutDuration:     policy.LockoutDuration,
+		BackoffDelay:        policy.BackoffDelay,
+	}, nil
+}
+
+func mapLDAPUserToExternalUser(user *ldap.User, idpConfig *iam_model.IDPConfigView, username string) *domain.ExternalUser {
+	externalUser := &domain.ExternalUser{
+		IDPConfigID:       idpConfig.IDPConfigID,
+		ExternalUserID:    user.ID,
+		PreferredUsername: user.Username,
+		DisplayName:       user.DisplayName,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Email:             user.Email,
+		Phone:             user.Phone,
+	}
+	if externalUser.PreferredUsername == "" {
+		externalUser.PreferredUsername = username
+	}
+	if externalUser.DisplayName == "" {
+		externalUser.DisplayName = externalUser.PreferredUsername
+	}
+	return externalUser
+}
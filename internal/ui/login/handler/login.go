@@ -4,12 +4,14 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/caos/logging"
 	"github.com/gorilla/csrf"
 	"github.com/rakyll/statik/fs"
 	"golang.org/x/text/language"
 
+	"github.com/caos/zitadel/internal/actions"
 	"github.com/caos/zitadel/internal/api/authz"
 	http_utils "github.com/caos/zitadel/internal/api/http"
 	"github.com/caos/zitadel/internal/api/http/middleware"
@@ -23,6 +25,7 @@ import (
 	"github.com/caos/zitadel/internal/domain"
 	"github.com/caos/zitadel/internal/form"
 	"github.com/caos/zitadel/internal/id"
+	"github.com/caos/zitadel/internal/ldap"
 	"github.com/caos/zitadel/internal/query"
 	"github.com/caos/zitadel/internal/static"
 	_ "github.com/caos/zitadel/internal/ui/login/statik"
@@ -41,13 +44,18 @@ type Login struct {
 	baseURL             string
 	zitadelURL          string
 	oidcAuthCallbackURL string
+	samlAuthCallbackURL string
+	samlMetadata        *samlMetadataCache
+	ldapLimiter         *ldap.Limiter
 	IDPConfigAesCrypto  crypto.EncryptionAlgorithm
 	iamDomain           string
+	actionsDeps         actions.Dependencies
 }
 
 type Config struct {
 	BaseURL               string
 	OidcAuthCallbackURL   string
+	SamlAuthCallbackURL   string
 	ZitadelURL            string
 	LanguageCookieName    string
 	DefaultLanguage       language.Tag
@@ -68,13 +76,16 @@ const (
 	handlerPrefix = "/login"
 )
 
-func CreateLogin(config Config, command *command.Commands, query *query.Queries, authRepo *eventsourcing.EsRepository, staticStorage static.Storage, systemDefaults systemdefaults.SystemDefaults, localDevMode bool) (*Login, string) {
+func CreateLogin(config Config, command *command.Commands, query *query.Queries, authRepo *eventsourcing.EsRepository, staticStorage static.Storage, systemDefaults systemdefaults.SystemDefaults, actionsDeps actions.Dependencies, localDevMode bool) (*Login, string) {
 	aesCrypto, err := crypto.NewAESCrypto(systemDefaults.IDPConfigVerificationKey)
 	if err != nil {
 		logging.Log("HANDL-s90ew").WithError(err).Debug("error create new aes crypto")
 	}
 	login := &Login{
 		oidcAuthCallbackURL: config.OidcAuthCallbackURL,
+		samlAuthCallbackURL: config.SamlAuthCallbackURL,
+		samlMetadata:        newSAMLMetadataCache(),
+		ldapLimiter:         ldap.NewLimiter(),
 		baseURL:             config.BaseURL,
 		zitadelURL:          config.ZitadelURL,
 		command:             command,
@@ -83,6 +94,7 @@ func CreateLogin(config Config, command *command.Commands, query *query.Queries,
 		authRepo:            authRepo,
 		IDPConfigAesCrypto:  aesCrypto,
 		iamDomain:           systemDefaults.Domain,
+		actionsDeps:         actionsDeps,
 	}
 	prefix := ""
 	if localDevMode {
@@ -101,7 +113,7 @@ func CreateLogin(config Config, command *command.Commands, query *query.Queries,
 	security := middleware.SecurityHeaders(csp(), login.cspErrorHandler)
 	userAgentCookie, err := middleware.NewUserAgentHandler(config.UserAgentCookieConfig, id.SonyFlakeGenerator, localDevMode)
 	logging.Log("CONFI-Dvwf2").OnError(err).Panic("unable to create userAgentInterceptor")
-	login.router = CreateRouter(login, statikFS, csrf, cache, security, userAgentCookie, middleware.TelemetryHandler(EndpointResources))
+	login.router = CreateRouter(login, statikFS, samlACSInterceptor(csrf), cache, security, skipForSAMLACS(userAgentCookie), middleware.TelemetryHandler(EndpointResources))
 	login.renderer = CreateRenderer(prefix, statikFS, staticStorage, config.LanguageCookieName, config.DefaultLanguage)
 	login.parser = form.NewParser()
 	return login, handlerPrefix
@@ -129,6 +141,39 @@ func csrfInterceptor(config CSRF, errorHandler http.Handler) (func(http.Handler)
 	), nil
 }
 
+// samlACSInterceptor disables the csrf check for the SAML assertion consumer service,
+// which receives cross-site POST requests from the identity provider
+func samlACSInterceptor(csrfInterceptor func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		protected := csrfInterceptor(handler)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isSAMLACSRequest(r) {
+				r = csrf.UnsafeSkipCheck(r)
+			}
+			protected.ServeHTTP(w, r)
+		})
+	}
+}
+
+// skipForSAMLACS bypasses the interceptor for the SAML assertion consumer service,
+// cookies are not sent on the cross-site POST and must not be overwritten
+func skipForSAMLACS(interceptor func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		intercepted := interceptor(handler)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isSAMLACSRequest(r) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			intercepted.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isSAMLACSRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, EndpointSAMLACS)
+}
+
 func (l *Login) Handler() http.Handler {
 	return l.router
 }
@@ -43,12 +43,26 @@ func (l *Login) renderSuccessAndCallback(w http.ResponseWriter, r *http.Request,
 		userData: l.getUserData(r, authReq, "Login Successful", errID, errMessage),
 	}
 	if authReq != nil {
-		data.RedirectURI = l.oidcAuthCallbackURL
+		data.RedirectURI = l.authCallbackURL(authReq)
 	}
 	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplLoginSuccess], data, nil)
 }
 
 func (l *Login) redirectToCallback(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) {
-	callback := l.oidcAuthCallbackURL + authReq.ID
+	callback := l.authCallbackURL(authReq) + authReq.ID
 	http.Redirect(w, r, callback, http.StatusFound)
 }
+
+func (l *Login) authCallbackURL(authReq *domain.AuthRequest) string {
+	if authReq.Request == nil {
+		return l.oidcAuthCallbackURL
+	}
+	switch authReq.Request.Type() {
+	case domain.AuthRequestTypeSAML:
+		return l.samlAuthCallbackURL
+	case domain.AuthRequestTypeDevice:
+		return l.renderer.pathPrefix + EndpointDeviceAuthAction + "?" + queryAuthRequestID + "="
+	default:
+		return l.oidcAuthCallbackURL
+	}
+}
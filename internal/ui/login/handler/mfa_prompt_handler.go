@@ -85,6 +85,10 @@ func (l *Login) handleMFACreation(w http.ResponseWriter, r *http.Request, authRe
 	case domain.MFATypeU2F:
 		l.renderRegisterU2F(w, r, authReq, nil)
 		return
+	case domain.MFATypeOTPEmail,
+		domain.MFATypeOTPSMS:
+		l.handleOTPCodeCreation(w, r, authReq, data)
+		return
 	}
 	l.renderError(w, r, authReq, caos_errs.ThrowPreconditionFailed(nil, "APP-Or3HO", "Errors.User.MFA.NoProviders"))
 }
@@ -102,3 +106,19 @@ func (l *Login) handleOTPCreation(w http.ResponseWriter, r *http.Request, authRe
 	}
 	l.renderMFAInitVerify(w, r, authReq, data, nil)
 }
+
+func (l *Login) handleOTPCodeCreation(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, data *mfaVerifyData) {
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	var err error
+	switch data.MFAType {
+	case domain.MFATypeOTPEmail:
+		_, err = l.command.AddHumanOTPEmail(ctx, authReq.UserID, authReq.UserOrgID)
+	case domain.MFATypeOTPSMS:
+		_, err = l.command.AddHumanOTPSMS(ctx, authReq.UserID, authReq.UserOrgID)
+	}
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderMFAInitDone(w, r, authReq, &mfaDoneData{MFAType: data.MFAType})
+}
@@ -7,13 +7,16 @@ import (
 )
 
 const (
-	tmplMFAVerify = "mfaverify"
+	tmplMFAVerify             = "mfaverify"
+	tmplMFAVerifyCode         = "mfaverifycode"
+	tmplMFAVerifyRecoveryCode = "mfaverifyrecoverycode"
 )
 
 type mfaVerifyFormData struct {
 	MFAType          domain.MFAType `schema:"mfaType"`
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"provider"`
+	Resend           bool           `schema:"resend"`
 }
 
 func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
@@ -28,17 +31,40 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	if data.Resend {
+		l.renderMFAVerifySelected(w, r, authReq, step, data.MFAType, nil)
+		return
+	}
 	if data.Code == "" {
 		l.renderMFAVerifySelected(w, r, authReq, step, data.SelectedProvider, nil)
 		return
 	}
-	if data.MFAType == domain.MFATypeOTP {
-		userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	switch data.MFAType {
+	case domain.MFATypeOTP:
 		err = l.authRepo.VerifyMFAOTP(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Code, userAgentID, domain.BrowserInfoFromRequest(r))
 		if err != nil {
 			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeOTP, err)
 			return
 		}
+	case domain.MFATypeOTPEmail:
+		err = l.authRepo.VerifyMFAOTPEmail(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Code, userAgentID, domain.BrowserInfoFromRequest(r))
+		if err != nil {
+			l.renderMFAVerifyCode(w, r, authReq, step, domain.MFATypeOTPEmail, err)
+			return
+		}
+	case domain.MFATypeOTPSMS:
+		err = l.authRepo.VerifyMFAOTPSMS(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Code, userAgentID, domain.BrowserInfoFromRequest(r))
+		if err != nil {
+			l.renderMFAVerifyCode(w, r, authReq, step, domain.MFATypeOTPSMS, err)
+			return
+		}
+	case domain.MFATypeRecoveryCode:
+		err = l.authRepo.VerifyMFARecoveryCode(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Code, userAgentID, domain.BrowserInfoFromRequest(r))
+		if err != nil {
+			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeRecoveryCode, err)
+			return
+		}
 	}
 	l.renderNextStep(w, r, authReq)
 }
@@ -69,6 +95,18 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	case domain.MFATypeOTP:
 		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, domain.MFATypeOTP)
 		data.SelectedMFAProvider = domain.MFATypeOTP
+	case domain.MFATypeOTPEmail,
+		domain.MFATypeOTPSMS:
+		if err == nil {
+			err = l.sendMFAOTPCode(r, authReq, selectedProvider)
+		}
+		l.renderMFAVerifyCode(w, r, authReq, verificationStep, selectedProvider, err)
+		return
+	case domain.MFATypeRecoveryCode:
+		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, domain.MFATypeRecoveryCode)
+		data.SelectedMFAProvider = domain.MFATypeRecoveryCode
+		l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplMFAVerifyRecoveryCode], data, nil)
+		return
 	default:
 		l.renderError(w, r, authReq, err)
 		return
@@ -76,6 +114,27 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplMFAVerify], data, nil)
 }
 
+//sendMFAOTPCode requests a new one-time code, which will be sent to the user by email or sms
+func (l *Login) sendMFAOTPCode(r *http.Request, authReq *domain.AuthRequest, mfaType domain.MFAType) error {
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	ctx := setContext(r.Context(), authReq.UserOrgID)
+	if mfaType == domain.MFATypeOTPEmail {
+		return l.authRepo.SendMFAOTPEmail(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, userAgentID)
+	}
+	return l.authRepo.SendMFAOTPSMS(ctx, authReq.ID, authReq.UserID, authReq.UserOrgID, userAgentID)
+}
+
+func (l *Login) renderMFAVerifyCode(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, verificationStep *domain.MFAVerificationStep, selectedProvider domain.MFAType, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := l.getUserData(r, authReq, "MFA Verify", errID, errMessage)
+	data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, selectedProvider)
+	data.SelectedMFAProvider = selectedProvider
+	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplMFAVerifyCode], data, nil)
+}
+
 func removeSelectedProviderFromList(providers []domain.MFAType, selected domain.MFAType) []domain.MFAType {
 	for i := len(providers) - 1; i >= 0; i-- {
 		if providers[i] == selected {
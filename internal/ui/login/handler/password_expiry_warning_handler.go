@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	http_mw "github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/domain"
+)
+
+const (
+	tmplPasswordExpiryWarning = "passwordexpirywarning"
+)
+
+type passwordExpiryWarningData struct {
+	userData
+	RemainingDays int
+}
+
+type passwordExpiryWarningFormData struct {
+	Skip bool `schema:"skip"`
+}
+
+func (l *Login) handlePasswordExpiryWarning(w http.ResponseWriter, r *http.Request) {
+	data := new(passwordExpiryWarningFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !data.Skip {
+		l.renderChangePassword(w, r, authReq, nil)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	err = l.authRepo.SkipPasswordExpiryWarning(r.Context(), authReq.ID, userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.handleLogin(w, r)
+}
+
+func (l *Login) renderPasswordExpiryWarning(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.PasswordExpiryWarningStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	data := passwordExpiryWarningData{
+		userData:      l.getUserData(r, authReq, "Password Expiry Warning", errID, errMessage),
+		RemainingDays: int(math.Ceil(time.Until(step.ExpiryDate).Hours() / 24)),
+	}
+	l.renderer.RenderTemplate(w, r, l.getTranslator(authReq), l.renderer.Templates[tmplPasswordExpiryWarning], data, nil)
+}
@@ -45,6 +45,8 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		tmplPasswordlessRegistrationDone: "passwordless_registration_done.html",
 		tmplPasswordlessPrompt:           "passwordless_prompt.html",
 		tmplMFAVerify:                    "mfa_verify_otp.html",
+		tmplMFAVerifyCode:                "mfa_verify_otp_code.html",
+		tmplMFAVerifyRecoveryCode:        "mfa_verify_recovery_code.html",
 		tmplMFAPrompt:                    "mfa_prompt.html",
 		tmplMFAInitVerify:                "mfa_init_otp.html",
 		tmplMFAU2FInit:                   "mfa_init_u2f.html",
@@ -69,6 +71,11 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		tmplLinkUsersDone:                "link_users_done.html",
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
+		tmplPasswordExpiryWarning:        "password_expiry_warning.html",
+		tmplDeviceUserCode:               "device_usercode.html",
+		tmplDeviceAction:                 "device_action.html",
+		tmplDeviceDone:                   "device_done.html",
+		tmplLDAPLogin:                    "ldap_login.html",
 	}
 	funcs := map[string]interface{}{
 		"resourceUrl": func(file string) string {
@@ -172,6 +179,9 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"changePasswordUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointChangePassword)
 		},
+		"passwordExpiryWarningUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointPasswordExpiryWarning)
+		},
 		"registerOptionUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointRegisterOption)
 		},
@@ -190,6 +200,15 @@ func CreateRenderer(pathPrefix string, staticDir http.FileSystem, staticStorage
 		"externalNotFoundOptionUrl": func(action string) string {
 			return path.Join(r.pathPrefix, EndpointExternalNotFoundOption+"?"+action+"=true")
 		},
+		"deviceAuthUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointDeviceAuth)
+		},
+		"deviceAuthActionUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointDeviceAuthAction)
+		},
+		"ldapLoginUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointLDAPLogin)
+		},
 		"selectedLanguage": func(l string) bool {
 			return false
 		},
@@ -283,6 +302,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.redirectToLoginSuccess(w, r, authReq.ID)
 	case *domain.ChangePasswordStep:
 		l.renderChangePassword(w, r, authReq, err)
+	case *domain.PasswordExpiryWarningStep:
+		l.renderPasswordExpiryWarning(w, r, authReq, step, err)
 	case *domain.VerifyEMailStep:
 		l.renderMailVerification(w, r, authReq, "", err)
 	case *domain.MFAPromptStep:
@@ -553,6 +574,7 @@ type profileData struct {
 type passwordData struct {
 	baseData
 	profileData
+	PasswordExpired           bool
 	PasswordPolicyDescription string
 	MinLength                 uint64
 	HasUppercase              string
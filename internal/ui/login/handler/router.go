@@ -15,6 +15,9 @@ const (
 	EndpointExternalLoginCallback    = "/login/externalidp/callback"
 	EndpointJWTAuthorize             = "/login/jwt/authorize"
 	EndpointJWTCallback              = "/login/jwt/callback"
+	EndpointSAMLACS                  = "/login/externalidp/saml/acs"
+	EndpointSAMLMetadata             = "/login/externalidp/saml/metadata"
+	EndpointLDAPLogin                = "/login/externalidp/ldap"
 	EndpointPasswordlessLogin        = "/login/passwordless"
 	EndpointPasswordlessRegistration = "/login/passwordless/init"
 	EndpointPasswordlessPrompt       = "/login/passwordless/prompt"
@@ -25,6 +28,7 @@ const (
 	EndpointInitPassword             = "/password/init"
 	EndpointChangePassword           = "/password/change"
 	EndpointPasswordReset            = "/password/reset"
+	EndpointPasswordExpiryWarning    = "/password/expiry"
 	EndpointInitUser                 = "/user/init"
 	EndpointMFAVerify                = "/mfa/verify"
 	EndpointMFAPrompt                = "/mfa/prompt"
@@ -41,6 +45,8 @@ const (
 	EndpointLogoutDone               = "/logout/done"
 	EndpointLoginSuccess             = "/login/success"
 	EndpointExternalNotFoundOption   = "/externaluser/option"
+	EndpointDeviceAuth               = "/device"
+	EndpointDeviceAuthAction         = "/device/action"
 
 	EndpointResources        = "/resources"
 	EndpointDynamicResources = "/resources/dynamic"
@@ -57,6 +63,9 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointExternalLoginCallback, login.handleExternalLoginCallback).Methods(http.MethodGet)
 	router.HandleFunc(EndpointJWTAuthorize, login.handleJWTRequest).Methods(http.MethodGet)
 	router.HandleFunc(EndpointJWTCallback, login.handleJWTCallback).Methods(http.MethodGet)
+	router.HandleFunc(EndpointSAMLACS, login.handleSAMLACS).Methods(http.MethodPost)
+	router.HandleFunc(EndpointSAMLMetadata, login.handleSAMLMetadata).Methods(http.MethodGet)
+	router.HandleFunc(EndpointLDAPLogin, login.handleLDAPLoginCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointPasswordlessLogin, login.handlePasswordlessVerification).Methods(http.MethodPost)
 	router.HandleFunc(EndpointPasswordlessRegistration, login.handlePasswordlessRegistration).Methods(http.MethodGet)
 	router.HandleFunc(EndpointPasswordlessRegistration, login.handlePasswordlessRegistrationCheck).Methods(http.MethodPost)
@@ -80,6 +89,7 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerification).Methods(http.MethodGet)
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerificationCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointChangePassword, login.handleChangePassword).Methods(http.MethodPost)
+	router.HandleFunc(EndpointPasswordExpiryWarning, login.handlePasswordExpiryWarning).Methods(http.MethodPost)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOption).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOptionCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointExternalNotFoundOption, login.handleExternalNotFoundOptionCheck).Methods(http.MethodPost)
@@ -94,5 +104,9 @@ func CreateRouter(login *Login, staticDir http.FileSystem, interceptors ...mux.M
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrg).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrgCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointLoginSuccess, login.handleLoginSuccess).Methods(http.MethodGet)
+	router.HandleFunc(EndpointDeviceAuth, login.handleDeviceUserCode).Methods(http.MethodGet)
+	router.HandleFunc(EndpointDeviceAuth, login.handleDeviceUserCodeCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointDeviceAuthAction, login.handleDeviceAction).Methods(http.MethodGet)
+	router.HandleFunc(EndpointDeviceAuthAction, login.handleDeviceActionCheck).Methods(http.MethodPost)
 	return router
 }
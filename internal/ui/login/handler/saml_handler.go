@@ -0,0 +1,409 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/caos/logging"
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	http_mw "github.com/caos/zitadel/internal/api/http/middleware"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	caos_errors "github.com/caos/zitadel/internal/errors"
+	iam_model "github.com/caos/zitadel/internal/iam/model"
+)
+
+const (
+	samlRelayStateSeparator = "."
+	samlRequestIDPrefix     = "id-"
+	samlMetadataTimeout     = 10 * time.Second
+	samlMetadataMaxAge      = 1 * time.Hour
+)
+
+var samlPostFormTemplate = template.Must(template.New("saml-post-form").Parse(`<!DOCTYPE html>
+<html><body>
+<form method="post" action="{{.URL}}" id="SAMLRequestForm">
+<input type="hidden" name="SAMLRequest" value="{{.SAMLRequest}}" />
+<input type="hidden" name="RelayState" value="{{.RelayState}}" />
+<noscript><input type="submit" value="Submit" /></noscript>
+</form>
+<script nonce="{{.Nonce}}">document.getElementById('SAMLRequestForm').submit();</script>
+</body></html>`))
+
+type samlACSData struct {
+	SAMLResponse string `schema:"SAMLResponse"`
+	RelayState   string `schema:"RelayState"`
+}
+
+type samlMetadataData struct {
+	IDPConfigID string `schema:"idpConfigID"`
+}
+
+func (l *Login) handleSAMLAuthorize(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, idpConfig *iam_model.IDPConfigView) {
+	userAgentID, ok := http_mw.UserAgentIDFromCtx(r.Context())
+	if !ok {
+		l.renderLogin(w, r, authReq, caos_errors.ThrowPreconditionFailed(nil, "LOGIN-Kd93f", "Errors.AuthRequest.UserAgentNotFound"))
+		return
+	}
+	sp, err := l.getSAMLServiceProvider(r.Context(), idpConfig)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	relayState, err := l.samlRelayState(authReq.ID, userAgentID)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	binding := saml.HTTPRedirectBinding
+	if idpConfig.SAMLBinding == domain.SAMLBindingPost {
+		binding = saml.HTTPPostBinding
+	}
+	bindingLocation := sp.GetSSOBindingLocation(binding)
+	if bindingLocation == "" {
+		l.renderLogin(w, r, authReq, caos_errors.ThrowPreconditionFailed(nil, "LOGIN-Pw8sl", "Errors.IdentityProvider.InvalidConfig"))
+		return
+	}
+	samlRequest, err := sp.MakeAuthenticationRequest(bindingLocation, binding, saml.HTTPPostBinding)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	samlRequest.ID = samlRequestIDPrefix + authReq.ID
+	if binding == saml.HTTPPostBinding && sp.SignatureMethod != "" {
+		samlRequest.Signature = nil
+		if err = sp.SignAuthnRequest(samlRequest); err != nil {
+			l.renderLogin(w, r, authReq, err)
+			return
+		}
+	}
+	if binding == saml.HTTPRedirectBinding {
+		redirect, err := samlRequest.Redirect(relayState, sp)
+		if err != nil {
+			l.renderLogin(w, r, authReq, err)
+			return
+		}
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+		return
+	}
+	l.renderSAMLPostForm(w, r, authReq, samlRequest, relayState)
+}
+
+func (l *Login) renderSAMLPostForm(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, samlRequest *saml.AuthnRequest, relayState string) {
+	doc := etree.NewDocument()
+	doc.SetRoot(samlRequest.Element())
+	request, err := doc.WriteToBytes()
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	data := struct {
+		URL         string
+		SAMLRequest string
+		RelayState  string
+		Nonce       string
+	}{
+		URL:         samlRequest.Destination,
+		SAMLRequest: base64.StdEncoding.EncodeToString(request),
+		RelayState:  relayState,
+		Nonce:       http_mw.GetNonce(r),
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = samlPostFormTemplate.Execute(w, data)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+	}
+}
+
+func (l *Login) handleSAMLACS(w http.ResponseWriter, r *http.Request) {
+	data := new(samlACSData)
+	err := l.getParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	authReqID, userAgentID, err := l.parseSAMLRelayState(data.RelayState)
+	if err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	r = r.WithContext(http_mw.UserAgentIDToCtx(r.Context(), userAgentID))
+	authReq, err := l.authRepo.AuthRequestByID(r.Context(), authReqID, userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	idpConfig, err := l.authRepo.GetIDPConfigByID(r.Context(), authReq.SelectedIDPConfigID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if !idpConfig.IsSAML {
+		l.renderError(w, r, authReq, caos_errors.ThrowPreconditionFailed(nil, "LOGIN-3Jf8s", "Errors.ExternalIDP.IDPTypeNotImplemented"))
+		return
+	}
+	sp, err := l.getSAMLServiceProvider(r.Context(), idpConfig)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	assertion, err := sp.ParseResponse(r, []string{samlRequestIDPrefix + authReq.ID})
+	if err != nil {
+		l.renderLogin(w, r, authReq, caos_errors.ThrowUnauthenticated(err, "LOGIN-9Gk2s", "Errors.ExternalIDP.SAMLAssertionInvalid"))
+		return
+	}
+	externalUser, err := mapSAMLAssertionToExternalUser(assertion, idpConfig)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	l.handleExternalUser(w, r, authReq, idpConfig, userAgentID, externalUser, nil)
+}
+
+func (l *Login) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	data := new(samlMetadataData)
+	err := l.getParseData(r, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	idpConfig, err := l.authRepo.GetIDPConfigByID(r.Context(), data.IDPConfigID)
+	if err != nil || !idpConfig.IsSAML {
+		http.Error(w, "idp not found", http.StatusNotFound)
+		return
+	}
+	sp, err := l.samlServiceProvider(idpConfig)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, err = w.Write(metadata)
+	logging.Log("LOGIN-Sm3k8").OnError(err).Debug("unable to write metadata")
+}
+
+func (l *Login) getSAMLServiceProvider(ctx context.Context, idpConfig *iam_model.IDPConfigView) (*saml.ServiceProvider, error) {
+	sp, err := l.samlServiceProvider(idpConfig)
+	if err != nil {
+		return nil, err
+	}
+	sp.IDPMetadata, err = l.samlMetadata.idpMetadata(ctx, idpConfig)
+	if err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+func (l *Login) samlServiceProvider(idpConfig *iam_model.IDPConfigView) (*saml.ServiceProvider, error) {
+	keyBytes, err := crypto.Decrypt(idpConfig.SAMLKey, l.IDPConfigAesCrypto)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.BytesToPrivateKey(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := crypto.BytesToCertificate(idpConfig.SAMLCertificate)
+	if err != nil {
+		return nil, err
+	}
+	metadataURL, err := url.Parse(l.samlEntityID(idpConfig.IDPConfigID))
+	if err != nil {
+		return nil, err
+	}
+	acsURL, err := url.Parse(l.baseURL + EndpointSAMLACS)
+	if err != nil {
+		return nil, err
+	}
+	sp := &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		Key:               key,
+		Certificate:       certificate,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		AuthnNameIDFormat: samlNameIDFormat(idpConfig.SAMLNameIDFormat),
+	}
+	if idpConfig.SAMLWithSignedRequest {
+		sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+	}
+	return sp, nil
+}
+
+func (l *Login) samlEntityID(idpConfigID string) string {
+	return l.baseURL + EndpointSAMLMetadata + "?" + queryIDPConfigID + "=" + url.QueryEscape(idpConfigID)
+}
+
+//samlMetadataCache caches the metadata fetched from the metadata url of the identity providers,
+//so they are not requested on every authorize and assertion consumer service request
+type samlMetadataCache struct {
+	client  *http.Client
+	maxAge  time.Duration
+	mu      sync.RWMutex
+	entries map[string]*samlMetadataEntry
+}
+
+type samlMetadataEntry struct {
+	metadata *saml.EntityDescriptor
+	expires  time.Time
+}
+
+func newSAMLMetadataCache() *samlMetadataCache {
+	return &samlMetadataCache{
+		client:  &http.Client{Timeout: samlMetadataTimeout},
+		maxAge:  samlMetadataMaxAge,
+		entries: make(map[string]*samlMetadataEntry),
+	}
+}
+
+func (c *samlMetadataCache) idpMetadata(ctx context.Context, idpConfig *iam_model.IDPConfigView) (*saml.EntityDescriptor, error) {
+	if len(idpConfig.SAMLMetadata) > 0 {
+		metadata, err := samlsp.ParseMetadata(idpConfig.SAMLMetadata)
+		if err != nil {
+			return nil, caos_errors.ThrowPreconditionFailed(err, "LOGIN-Ld82s", "Errors.ExternalIDP.SAMLMetadataInvalid")
+		}
+		return metadata, nil
+	}
+	if metadata := c.get(idpConfig.SAMLMetadataURL); metadata != nil {
+		return metadata, nil
+	}
+	metadataURL, err := url.Parse(idpConfig.SAMLMetadataURL)
+	if err != nil {
+		return nil, caos_errors.ThrowPreconditionFailed(err, "LOGIN-M2js9", "Errors.ExternalIDP.SAMLMetadataInvalid")
+	}
+	metadata, err := samlsp.FetchMetadata(ctx, c.client, *metadataURL)
+	if err != nil {
+		return nil, caos_errors.ThrowPreconditionFailed(err, "LOGIN-Qs8f2", "Errors.ExternalIDP.SAMLMetadataInvalid")
+	}
+	c.set(idpConfig.SAMLMetadataURL, metadata)
+	return metadata, nil
+}
+
+func (c *samlMetadataCache) get(metadataURL string) *saml.EntityDescriptor {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[metadataURL]
+	if !ok || time.Now().After(entry.expires) {
+		return nil
+	}
+	return entry.metadata
+}
+
+//set caches the metadata until it's no longer valid, but at most for the max age of the cache
+func (c *samlMetadataCache) set(metadataURL string, metadata *saml.EntityDescriptor) {
+	expires := time.Now().Add(c.maxAge)
+	if !metadata.ValidUntil.IsZero() && metadata.ValidUntil.Before(expires) {
+		expires = metadata.ValidUntil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if time.Now().After(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[metadataURL] = &samlMetadataEntry{
+		metadata: metadata,
+		expires:  expires,
+	}
+}
+
+func (l *Login) samlRelayState(authReqID, userAgentID string) (string, error) {
+	encryptedUserAgentID, err := l.IDPConfigAesCrypto.Encrypt([]byte(userAgentID))
+	if err != nil {
+		return "", err
+	}
+	return authReqID + samlRelayStateSeparator + base64.RawURLEncoding.EncodeToString(encryptedUserAgentID), nil
+}
+
+func (l *Login) parseSAMLRelayState(relayState string) (authReqID, userAgentID string, err error) {
+	parts := strings.SplitN(relayState, samlRelayStateSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", caos_errors.ThrowInvalidArgument(nil, "LOGIN-Wk3d9", "Errors.AuthRequest.MissingParameters")
+	}
+	encryptedUserAgentID, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", "", caos_errors.ThrowInvalidArgument(err, "LOGIN-Ml2fs", "Errors.AuthRequest.MissingParameters")
+	}
+	userAgentID, err = l.IDPConfigAesCrypto.DecryptString(encryptedUserAgentID, l.IDPConfigAesCrypto.EncryptionKeyID())
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], userAgentID, nil
+}
+
+func samlNameIDFormat(format domain.SAMLNameIDFormat) saml.NameIDFormat {
+	switch format {
+	case domain.SAMLNameIDFormatEmailAddress:
+		return saml.EmailAddressNameIDFormat
+	case domain.SAMLNameIDFormatPersistent:
+		return saml.PersistentNameIDFormat
+	case domain.SAMLNameIDFormatTransient:
+		return saml.TransientNameIDFormat
+	default:
+		return saml.UnspecifiedNameIDFormat
+	}
+}
+
+func mapSAMLAssertionToExternalUser(assertion *saml.Assertion, idpConfig *iam_model.IDPConfigView) (*domain.ExternalUser, error) {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return nil, caos_errors.ThrowInvalidArgument(nil, "LOGIN-Ns82j", "Errors.ExternalIDP.SAMLAssertionInvalid")
+	}
+	nameID := assertion.Subject.NameID.Value
+	attributes := samlAttributes(assertion)
+	externalUser := &domain.ExternalUser{
+		IDPConfigID:       idpConfig.IDPConfigID,
+		ExternalUserID:    nameID,
+		PreferredUsername: attributeOrDefault(attributes, idpConfig.SAMLUsernameAttribute, nameID),
+		FirstName:         attributes[idpConfig.SAMLFirstNameAttribute],
+		LastName:          attributes[idpConfig.SAMLLastNameAttribute],
+		Email:             attributes[idpConfig.SAMLEmailAttribute],
+		Phone:             attributes[idpConfig.SAMLPhoneAttribute],
+	}
+	if externalUser.Email == "" && assertion.Subject.NameID.Format == string(saml.EmailAddressNameIDFormat) {
+		externalUser.Email = nameID
+	}
+	externalUser.DisplayName = attributeOrDefault(attributes, idpConfig.SAMLDisplayNameAttribute, externalUser.PreferredUsername)
+	return externalUser, nil
+}
+
+func samlAttributes(assertion *saml.Assertion) map[string]string {
+	attributes := make(map[string]string)
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if len(attribute.Values) == 0 {
+				continue
+			}
+			value := attribute.Values[0].Value
+			if _, ok := attributes[attribute.Name]; !ok && attribute.Name != "" {
+				attributes[attribute.Name] = value
+			}
+			if _, ok := attributes[attribute.FriendlyName]; !ok && attribute.FriendlyName != "" {
+				attributes[attribute.FriendlyName] = value
+			}
+		}
+	}
+	return attributes
+}
+
+func attributeOrDefault(attributes map[string]string, name, defaultValue string) string {
+	if value := attributes[name]; name != "" && value != "" {
+		return value
+	}
+	return defaultValue
+}
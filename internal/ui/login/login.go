@@ -1,6 +1,7 @@
 package login
 
 import (
+	"github.com/caos/zitadel/internal/actions"
 	"github.com/caos/zitadel/internal/auth/repository/eventsourcing"
 	"github.com/caos/zitadel/internal/command"
 	"github.com/caos/zitadel/internal/config/systemdefaults"
@@ -13,6 +14,6 @@ type Config struct {
 	Handler handler.Config
 }
 
-func Start(config Config, command *command.Commands, query *query.Queries, authRepo *eventsourcing.EsRepository, staticStorage static.Storage, systemdefaults systemdefaults.SystemDefaults, localDevMode bool) (*handler.Login, string) {
-	return handler.CreateLogin(config.Handler, command, query, authRepo, staticStorage, systemdefaults, localDevMode)
+func Start(config Config, command *command.Commands, query *query.Queries, authRepo *eventsourcing.EsRepository, staticStorage static.Storage, systemdefaults systemdefaults.SystemDefaults, actionsDeps actions.Dependencies, localDevMode bool) (*handler.Login, string) {
+	return handler.CreateLogin(config.Handler, command, query, authRepo, staticStorage, systemdefaults, actionsDeps, localDevMode)
 }
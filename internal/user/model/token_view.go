@@ -22,6 +22,8 @@ type TokenView struct {
 	PreferredLanguage string
 	RefreshTokenID    string
 	IsPAT             bool
+	ActorSubject      string
+	ActorClientID     string
 }
 
 type TokenSearchRequest struct {
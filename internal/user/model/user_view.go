@@ -56,6 +56,9 @@ type HumanView struct {
 	Region                   string
 	StreetAddress            string
 	OTPState                 MFAState
+	OTPSMSAdded              bool
+	OTPEmailAdded            bool
+	RecoveryCodesRemaining   int32
 	U2FTokens                []*WebAuthNView
 	PasswordlessTokens       []*WebAuthNView
 	MFAMaxSetUp              req_model.MFALevel
@@ -167,10 +170,17 @@ func (u *UserView) MFATypesSetupPossible(level domain.MFALevel, policy *domain.L
 					}
 				case domain.SecondFactorTypeU2F:
 					types = append(types, domain.MFATypeU2F)
+				case domain.SecondFactorTypeOTPEmail:
+					if !u.OTPEmailAdded && u.IsEmailVerified {
+						types = append(types, domain.MFATypeOTPEmail)
+					}
+				case domain.SecondFactorTypeOTPSMS:
+					if !u.OTPSMSAdded && u.IsPhoneVerified {
+						types = append(types, domain.MFATypeOTPSMS)
+					}
 				}
 			}
 		}
-		//PLANNED: add sms
 	}
 	return types
 }
@@ -194,10 +204,21 @@ func (u *UserView) MFATypesAllowed(level domain.MFALevel, policy *domain.LoginPo
 					if u.IsU2FReady() {
 						types = append(types, domain.MFATypeU2F)
 					}
+				case domain.SecondFactorTypeOTPEmail:
+					if u.OTPEmailAdded && u.IsEmailVerified {
+						types = append(types, domain.MFATypeOTPEmail)
+					}
+				case domain.SecondFactorTypeOTPSMS:
+					if u.OTPSMSAdded && u.IsPhoneVerified {
+						types = append(types, domain.MFATypeOTPSMS)
+					}
 				}
 			}
 		}
-		//PLANNED: add sms
+		//recovery codes are only a fallback for the second factors allowed by the policy
+		if len(types) > 0 && u.RecoveryCodesRemaining > 0 {
+			types = append(types, domain.MFATypeRecoveryCode)
+		}
 	}
 	return types, required
 }
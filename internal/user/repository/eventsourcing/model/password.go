@@ -29,6 +29,7 @@ type PasswordCode struct {
 type PasswordChange struct {
 	Password
 	UserAgentID string `json:"userAgentID,omitempty"`
+	Rehashed    bool   `json:"rehashed,omitempty"`
 }
 
 func PasswordFromModel(password *model.Password) *Password {
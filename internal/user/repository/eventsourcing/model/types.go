@@ -125,6 +125,21 @@ const (
 	HumanMFAOTPCheckFailed    models.EventType = "user.human.mfa.otp.check.failed"
 	HumanMFAInitSkipped       models.EventType = "user.human.mfa.init.skipped"
 
+	HumanMFAOTPSMSAdded          models.EventType = "user.human.mfa.otp.sms.added"
+	HumanMFAOTPSMSRemoved        models.EventType = "user.human.mfa.otp.sms.removed"
+	HumanMFAOTPSMSCheckSucceeded models.EventType = "user.human.mfa.otp.sms.check.succeeded"
+	HumanMFAOTPSMSCheckFailed    models.EventType = "user.human.mfa.otp.sms.check.failed"
+
+	HumanMFAOTPEmailAdded          models.EventType = "user.human.mfa.otp.email.added"
+	HumanMFAOTPEmailRemoved        models.EventType = "user.human.mfa.otp.email.removed"
+	HumanMFAOTPEmailCheckSucceeded models.EventType = "user.human.mfa.otp.email.check.succeeded"
+	HumanMFAOTPEmailCheckFailed    models.EventType = "user.human.mfa.otp.email.check.failed"
+
+	HumanMFARecoveryCodesAdded         models.EventType = "user.human.mfa.recoverycodes.added"
+	HumanMFARecoveryCodesRemoved       models.EventType = "user.human.mfa.recoverycodes.removed"
+	HumanMFARecoveryCodeCheckSucceeded models.EventType = "user.human.mfa.recoverycodes.check.succeeded"
+	HumanMFARecoveryCodeCheckFailed    models.EventType = "user.human.mfa.recoverycodes.check.failed"
+
 	HumanMFAU2FTokenAdded            models.EventType = "user.human.mfa.u2f.token.added"
 	HumanMFAU2FTokenVerified         models.EventType = "user.human.mfa.u2f.token.verified"
 	HumanMFAU2FTokenSignCountChanged models.EventType = "user.human.mfa.u2f.token.signcount.changed"
@@ -40,6 +40,8 @@ type TokenView struct {
 	PreferredLanguage string         `json:"preferredLanguage" gorm:"column:preferred_language"`
 	RefreshTokenID    string         `json:"refreshTokenID,omitempty" gorm:"refresh_token_id"`
 	IsPAT             bool           `json:"-" gorm:"is_pat"`
+	ActorSubject      string         `json:"actorSubject,omitempty" gorm:"column:actor_subject"`
+	ActorClientID     string         `json:"actorClientId,omitempty" gorm:"column:actor_client_id"`
 	Deactivated       bool           `json:"-" gorm:"-"`
 }
 
@@ -59,6 +61,8 @@ func TokenViewToModel(token *TokenView) *usr_model.TokenView {
 		PreferredLanguage: token.PreferredLanguage,
 		RefreshTokenID:    token.RefreshTokenID,
 		IsPAT:             token.IsPAT,
+		ActorSubject:      token.ActorSubject,
+		ActorClientID:     token.ActorClientID,
 	}
 }
 
@@ -87,6 +87,9 @@ type HumanView struct {
 	Region                   string         `json:"region" gorm:"column:region"`
 	StreetAddress            string         `json:"streetAddress" gorm:"column:street_address"`
 	OTPState                 int32          `json:"-" gorm:"column:otp_state"`
+	OTPSMSAdded              bool           `json:"-" gorm:"column:otp_sms_added"`
+	OTPEmailAdded            bool           `json:"-" gorm:"column:otp_email_added"`
+	RecoveryCodesRemaining   int32          `json:"-" gorm:"column:recovery_codes_remaining"`
 	U2FTokens                WebAuthNTokens `json:"-" gorm:"column:u2f_tokens"`
 	MFAMaxSetUp              int32          `json:"-" gorm:"column:mfa_max_set_up"`
 	MFAInitSkipped           time.Time      `json:"-" gorm:"column:mfa_init_skipped"`
@@ -177,6 +180,9 @@ func UserToModel(user *UserView, prefixAvatarURL string) *model.UserView {
 			Region:                   user.Region,
 			StreetAddress:            user.StreetAddress,
 			OTPState:                 model.MFAState(user.OTPState),
+			OTPSMSAdded:              user.OTPSMSAdded,
+			OTPEmailAdded:            user.OTPEmailAdded,
+			RecoveryCodesRemaining:   user.RecoveryCodesRemaining,
 			MFAMaxSetUp:              req_model.MFALevel(user.MFAMaxSetUp),
 			MFAInitSkipped:           user.MFAInitSkipped,
 			InitRequired:             user.InitRequired,
@@ -309,6 +315,7 @@ func (u *UserView) AppendEvent(event *models.Event) (err error) {
 		es_model.HumanPhoneRemoved:
 		u.Phone = ""
 		u.IsPhoneVerified = false
+		u.OTPSMSAdded = false
 	case es_model.UserDeactivated:
 		u.State = int32(model.UserStateInactive)
 	case es_model.UserReactivated,
@@ -326,6 +333,24 @@ func (u *UserView) AppendEvent(event *models.Event) (err error) {
 	case es_model.MFAOTPRemoved,
 		es_model.HumanMFAOTPRemoved:
 		u.OTPState = int32(model.MFAStateUnspecified)
+	case es_model.HumanMFAOTPSMSAdded:
+		u.OTPSMSAdded = true
+		u.MFAInitSkipped = time.Time{}
+	case es_model.HumanMFAOTPSMSRemoved:
+		u.OTPSMSAdded = false
+	case es_model.HumanMFAOTPEmailAdded:
+		u.OTPEmailAdded = true
+		u.MFAInitSkipped = time.Time{}
+	case es_model.HumanMFAOTPEmailRemoved:
+		u.OTPEmailAdded = false
+	case es_model.HumanMFARecoveryCodesAdded:
+		err = u.setRecoveryCodes(event)
+	case es_model.HumanMFARecoveryCodesRemoved:
+		u.RecoveryCodesRemaining = 0
+	case es_model.HumanMFARecoveryCodeCheckSucceeded:
+		if u.RecoveryCodesRemaining > 0 {
+			u.RecoveryCodesRemaining--
+		}
 	case es_model.HumanMFAU2FTokenAdded:
 		err = u.addU2FToken(event)
 	case es_model.HumanMFAU2FTokenVerified:
@@ -374,7 +399,7 @@ func (u *UserView) setData(event *models.Event) error {
 }
 
 func (u *UserView) setPasswordData(event *models.Event) error {
-	password := new(es_model.Password)
+	password := new(es_model.PasswordChange)
 	if err := json.Unmarshal(event.Data, password); err != nil {
 		logging.Log("MODEL-sdw4r").WithError(err).Error("could not unmarshal event data")
 		return caos_errs.ThrowInternal(nil, "MODEL-6jhsw", "could not unmarshal data")
@@ -382,6 +407,9 @@ func (u *UserView) setPasswordData(event *models.Event) error {
 	u.PasswordSet = password.Secret != nil
 	u.PasswordInitRequired = !u.PasswordSet
 	u.PasswordChangeRequired = password.ChangeRequired
+	if password.Rehashed {
+		return nil
+	}
 	u.PasswordChanged = event.CreationDate
 	return nil
 }
@@ -433,6 +461,18 @@ func (u *UserView) removePasswordlessToken(event *models.Event) error {
 	return nil
 }
 
+func (u *UserView) setRecoveryCodes(event *models.Event) error {
+	codes := new(struct {
+		Codes []json.RawMessage `json:"codes"`
+	})
+	if err := json.Unmarshal(event.Data, codes); err != nil {
+		logging.Log("MODEL-Rc8vU").WithError(err).Error("could not unmarshal event data")
+		return caos_errs.ThrowInternal(nil, "MODEL-Rc8vE", "could not unmarshal data")
+	}
+	u.RecoveryCodesRemaining = int32(len(codes.Codes))
+	return nil
+}
+
 func (u *UserView) addU2FToken(event *models.Event) error {
 	token, err := webAuthNViewFromEvent(event)
 	if err != nil {
@@ -523,6 +563,10 @@ func (u *UserView) ComputeMFAMaxSetUp() {
 		u.MFAMaxSetUp = int32(req_model.MFALevelSecondFactor)
 		return
 	}
+	if (u.OTPSMSAdded && u.IsPhoneVerified) || (u.OTPEmailAdded && u.IsEmailVerified) {
+		u.MFAMaxSetUp = int32(req_model.MFALevelSecondFactor)
+		return
+	}
 	u.MFAMaxSetUp = int32(req_model.MFALevelNotSetUp)
 }
 
@@ -121,7 +121,7 @@ func (v *UserSessionView) AppendEvent(event *models.Event) error {
 		if err != nil {
 			return err
 		}
-		if v.UserAgentID != data.UserAgentID {
+		if v.UserAgentID != data.UserAgentID && !data.Rehashed {
 			v.PasswordVerification = time.Time{}
 		}
 	case es_model.HumanMFAOTPVerified:
@@ -136,10 +136,22 @@ func (v *UserSessionView) AppendEvent(event *models.Event) error {
 	case es_model.MFAOTPCheckSucceeded,
 		es_model.HumanMFAOTPCheckSucceeded:
 		v.setSecondFactorVerification(event.CreationDate, req_model.MFATypeOTP)
+	case es_model.HumanMFAOTPSMSCheckSucceeded:
+		v.setSecondFactorVerification(event.CreationDate, req_model.MFATypeOTPSMS)
+	case es_model.HumanMFAOTPEmailCheckSucceeded:
+		v.setSecondFactorVerification(event.CreationDate, req_model.MFATypeOTPEmail)
+	case es_model.HumanMFARecoveryCodeCheckSucceeded:
+		v.setSecondFactorVerification(event.CreationDate, req_model.MFATypeRecoveryCode)
 	case es_model.MFAOTPCheckFailed,
 		es_model.MFAOTPRemoved,
 		es_model.HumanMFAOTPCheckFailed,
 		es_model.HumanMFAOTPRemoved,
+		es_model.HumanMFAOTPSMSCheckFailed,
+		es_model.HumanMFAOTPSMSRemoved,
+		es_model.HumanMFAOTPEmailCheckFailed,
+		es_model.HumanMFAOTPEmailRemoved,
+		es_model.HumanMFARecoveryCodeCheckFailed,
+		es_model.HumanMFARecoveryCodesRemoved,
 		es_model.HumanMFAU2FTokenCheckFailed,
 		es_model.HumanMFAU2FTokenRemoved:
 		v.SecondFactorVerification = time.Time{}
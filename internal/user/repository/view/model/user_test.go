@@ -363,6 +363,30 @@ func TestUserAppendEvent(t *testing.T) {
 			},
 			result: &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", UserName: "UserName", HumanView: &HumanView{FirstName: "FirstName", LastName: "LastName", Email: "Email", Phone: "Phone", Country: "Country", MFAInitSkipped: time.Now().UTC()}, State: int32(model.UserStateActive)},
 		},
+		{
+			name: "append recovery codes added event",
+			args: args{
+				event: &es_models.Event{AggregateID: "AggregateID", Sequence: 1, Type: es_model.HumanMFARecoveryCodesAdded, ResourceOwner: "GrantedOrgID", Data: []byte(`{"codes":[{},{},{}]}`)},
+				user:  &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", State: int32(model.UserStateActive), HumanView: &HumanView{FirstName: "FirstName"}},
+			},
+			result: &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", State: int32(model.UserStateActive), HumanView: &HumanView{FirstName: "FirstName", RecoveryCodesRemaining: 3}},
+		},
+		{
+			name: "append recovery code check succeeded event",
+			args: args{
+				event: &es_models.Event{AggregateID: "AggregateID", Sequence: 1, Type: es_model.HumanMFARecoveryCodeCheckSucceeded, ResourceOwner: "GrantedOrgID", Data: []byte(`{"codeIndex":1}`)},
+				user:  &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", State: int32(model.UserStateActive), HumanView: &HumanView{FirstName: "FirstName", RecoveryCodesRemaining: 3}},
+			},
+			result: &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", State: int32(model.UserStateActive), HumanView: &HumanView{FirstName: "FirstName", RecoveryCodesRemaining: 2}},
+		},
+		{
+			name: "append recovery codes removed event",
+			args: args{
+				event: &es_models.Event{AggregateID: "AggregateID", Sequence: 1, Type: es_model.HumanMFARecoveryCodesRemoved, ResourceOwner: "GrantedOrgID"},
+				user:  &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", State: int32(model.UserStateActive), HumanView: &HumanView{FirstName: "FirstName", RecoveryCodesRemaining: 2}},
+			},
+			result: &UserView{ID: "AggregateID", ResourceOwner: "GrantedOrgID", State: int32(model.UserStateActive), HumanView: &HumanView{FirstName: "FirstName"}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -401,6 +425,9 @@ func TestUserAppendEvent(t *testing.T) {
 				if human.OTPState != tt.result.OTPState {
 					t.Errorf("got wrong result OTPState: expected: %v, actual: %v ", tt.result.OTPState, human.OTPState)
 				}
+				if human.RecoveryCodesRemaining != tt.result.RecoveryCodesRemaining {
+					t.Errorf("got wrong result RecoveryCodesRemaining: expected: %v, actual: %v ", tt.result.RecoveryCodesRemaining, human.RecoveryCodesRemaining)
+				}
 				if human.MFAInitSkipped.Round(1*time.Second) != tt.result.MFAInitSkipped.Round(1*time.Second) {
 					t.Errorf("got wrong result MFAInitSkipped: expected: %v, actual: %v ", tt.result.MFAInitSkipped.Round(1*time.Second), human.MFAInitSkipped.Round(1*time.Second))
 				}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/repository/iam"
+	"github.com/caos/zitadel/internal/repository/org"
+	"github.com/caos/zitadel/internal/repository/project"
+	"github.com/caos/zitadel/internal/repository/user"
+	"github.com/caos/zitadel/internal/repository/usergrant"
+)
+
+const (
+	//DeliveryProjection is the name of the projection and of the table of the pending deliveries
+	DeliveryProjection = "zitadel.projections.webhook_deliveries"
+
+	deliveryWebhookIDCol     = "webhook_id"
+	deliveryResourceOwnerCol = "resource_owner"
+	deliveryEventSequenceCol = "event_sequence"
+	deliveryAggregateTypeCol = "aggregate_type"
+	deliveryAggregateIDCol   = "aggregate_id"
+	deliveryEventTypeCol     = "event_type"
+	deliveryPayloadCol       = "payload"
+	deliveryCreationDateCol  = "creation_date"
+	deliveryAttemptsCol      = "attempts"
+	deliveryNextAttemptCol   = "next_attempt"
+	deliveryErrorCol         = "error"
+)
+
+type webhookQueries interface {
+	ActiveWebhooksByEventType(ctx context.Context, eventType string, resourceOwners ...string) ([]*query.Webhook, error)
+}
+
+//DeliveryHandler records a pending delivery for each webhook target which subscribed to the events of the aggregates
+//the deliveries are sent by the sender outside of the transaction of the projection
+type DeliveryHandler struct {
+	crdb.StatementHandler
+
+	ctx     context.Context
+	queries webhookQueries
+}
+
+func NewDeliveryHandler(
+	ctx context.Context,
+	handlerConfig crdb.StatementHandlerConfig,
+	queries webhookQueries,
+) *DeliveryHandler {
+	h := &DeliveryHandler{
+		ctx:     ctx,
+		queries: queries,
+	}
+	handlerConfig.ProjectionName = DeliveryProjection
+	handlerConfig.Reducers = h.reducers()
+	h.StatementHandler = crdb.NewStatementHandler(ctx, handlerConfig)
+	return h
+}
+
+func (h *DeliveryHandler) reducers() []handler.AggregateReducer {
+	aggregates := []eventstore.AggregateType{
+		user.AggregateType,
+		usergrant.AggregateType,
+		org.AggregateType,
+		project.AggregateType,
+		iam.AggregateType,
+	}
+	reducers := make([]handler.AggregateReducer, len(aggregates))
+	for i, aggregate := range aggregates {
+		reducers[i] = handler.AggregateReducer{
+			Aggregate: aggregate,
+			Reduce:    h.reduceEvent,
+		}
+	}
+	return reducers
+}
+
+//reduceEvent records a pending delivery for all active targets of the organisation and the IAM which subscribed to the event type
+//failed lookups return an error so the event is reduced again
+func (h *DeliveryHandler) reduceEvent(event eventstore.Event) (*handler.Statement, error) {
+	resourceOwners := []string{domain.IAMID}
+	if resourceOwner := event.Aggregate().ResourceOwner; resourceOwner != "" && resourceOwner != domain.IAMID {
+		resourceOwners = append(resourceOwners, resourceOwner)
+	}
+	webhooks, err := h.queries.ActiveWebhooksByEventType(h.ctx, string(event.Type()), resourceOwners...)
+	if err != nil {
+		return nil, err
+	}
+	if len(webhooks) == 0 {
+		return crdb.NewNoOpStatement(event), nil
+	}
+	body, err := json.Marshal(newPayload(event))
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Sk2nd", "unable to marshal payload")
+	}
+	deliveries := make([]func(eventstore.Event) crdb.Exec, len(webhooks))
+	for i, webhook := range webhooks {
+		deliveries[i] = crdb.AddUpsertStatement(
+			[]handler.Column{
+				handler.NewCol(deliveryWebhookIDCol, webhook.ID),
+				handler.NewCol(deliveryResourceOwnerCol, webhook.ResourceOwner),
+				handler.NewCol(deliveryEventSequenceCol, event.Sequence()),
+				handler.NewCol(deliveryAggregateTypeCol, event.Aggregate().Type),
+				handler.NewCol(deliveryAggregateIDCol, event.Aggregate().ID),
+				handler.NewCol(deliveryEventTypeCol, event.Type()),
+				handler.NewCol(deliveryPayloadCol, body),
+				handler.NewCol(deliveryCreationDateCol, event.CreationDate()),
+				handler.NewCol(deliveryNextAttemptCol, event.CreationDate()),
+			},
+		)
+	}
+	return crdb.NewMultiStatement(event, deliveries...), nil
+}
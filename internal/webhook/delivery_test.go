@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/repository"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type testQueries struct {
+	webhooks       []*query.Webhook
+	err            error
+	resourceOwners []string
+}
+
+func (q *testQueries) ActiveWebhooksByEventType(_ context.Context, _ string, resourceOwners ...string) ([]*query.Webhook, error) {
+	q.resourceOwners = resourceOwners
+	return q.webhooks, q.err
+}
+
+type testExecuter struct {
+	stmts []string
+	args  [][]interface{}
+}
+
+func (ex *testExecuter) Exec(stmt string, args ...interface{}) (sql.Result, error) {
+	ex.stmts = append(ex.stmts, stmt)
+	ex.args = append(ex.args, args)
+	return nil, nil
+}
+
+func testEvent(data []byte) eventstore.Event {
+	return eventstore.BaseEventFromRepo(&repository.Event{
+		Sequence:      15,
+		AggregateType: "user",
+		AggregateID:   "user-id",
+		ResourceOwner: sql.NullString{String: "org-id", Valid: true},
+		Type:          "user.locked",
+		EditorUser:    "editor-id",
+		Data:          data,
+	})
+}
+
+func testWebhook(id string) *query.Webhook {
+	return &query.Webhook{
+		ID:            id,
+		ResourceOwner: "org-id",
+		URL:           "https://example.com/hook",
+		SigningKey: &crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("key"),
+		},
+	}
+}
+
+func TestDeliveryHandler_reduceEvent(t *testing.T) {
+	type res struct {
+		deliveredWebhooks []string
+		err               bool
+	}
+	tests := []struct {
+		name    string
+		queries *testQueries
+		res     res
+	}{
+		{
+			name:    "lookup failed, error",
+			queries: &testQueries{err: sql.ErrConnDone},
+			res: res{
+				err: true,
+			},
+		},
+		{
+			name:    "no webhooks",
+			queries: &testQueries{},
+			res:     res{},
+		},
+		{
+			name: "deliveries recorded",
+			queries: &testQueries{
+				webhooks: []*query.Webhook{testWebhook("webhook1"), testWebhook("webhook2")},
+			},
+			res: res{
+				deliveredWebhooks: []string{"webhook1", "webhook2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &DeliveryHandler{
+				ctx:     context.Background(),
+				queries: tt.queries,
+			}
+			stmt, err := h.reduceEvent(testEvent([]byte(`{}`)))
+			if (err != nil) != tt.res.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.queries.resourceOwners, []string{domain.IAMID, "org-id"}) {
+				t.Errorf("wrong resource owners: %v", tt.queries.resourceOwners)
+			}
+			if tt.res.err {
+				return
+			}
+			if stmt.Sequence != 15 {
+				t.Errorf("wrong sequence: want 15, got %d", stmt.Sequence)
+			}
+			if len(tt.res.deliveredWebhooks) == 0 {
+				if stmt.Execute != nil {
+					t.Error("expected no op statement")
+				}
+				return
+			}
+			ex := new(testExecuter)
+			if err = stmt.Execute(ex, DeliveryProjection); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(ex.stmts) != len(tt.res.deliveredWebhooks) {
+				t.Fatalf("wrong number of recorded deliveries: want %d, got %d", len(tt.res.deliveredWebhooks), len(ex.stmts))
+			}
+			for i, webhookID := range tt.res.deliveredWebhooks {
+				if ex.stmts[i] != "UPSERT INTO "+DeliveryProjection+" (webhook_id, resource_owner, event_sequence, aggregate_type, aggregate_id, event_type, payload, creation_date, next_attempt) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)" {
+					t.Errorf("wrong statement: %s", ex.stmts[i])
+				}
+				if ex.args[i][0] != webhookID {
+					t.Errorf("wrong webhook: want %s, got %v", webhookID, ex.args[i][0])
+				}
+				if ex.args[i][2] != uint64(15) {
+					t.Errorf("wrong sequence: want 15, got %v", ex.args[i][2])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/query"
+)
+
+type payload struct {
+	EventType     string          `json:"eventType"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateID"`
+	ResourceOwner string          `json:"resourceOwner"`
+	Sequence      uint64          `json:"sequence"`
+	CreationDate  time.Time       `json:"creationDate"`
+	EditorUser    string          `json:"editorUser"`
+	Data          json.RawMessage `json:"data,omitempty"`
+}
+
+func newPayload(event eventstore.Event) *payload {
+	return &payload{
+		EventType:     string(event.Type()),
+		AggregateType: string(event.Aggregate().Type),
+		AggregateID:   event.Aggregate().ID,
+		ResourceOwner: event.Aggregate().ResourceOwner,
+		Sequence:      event.Sequence(),
+		CreationDate:  event.CreationDate(),
+		EditorUser:    event.EditorUser(),
+		Data:          query.EventPayload(event.DataAsBytes()),
+	}
+}
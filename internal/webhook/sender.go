@@ -0,0 +1,341 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/caos/logging"
+
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/domain"
+	"github.com/caos/zitadel/internal/errors"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+const (
+	SignatureHeader = "ZITADEL-Signature"
+	EventTypeHeader = "ZITADEL-Event-Type"
+
+	senderLockName = "webhook_sender"
+
+	dueDeliveriesStmt = "SELECT d.webhook_id, d.resource_owner, d.event_sequence, d.aggregate_type, d.aggregate_id, d.event_type, d.payload, d.attempts, w.url, w.signing_key" +
+		" FROM " + DeliveryProjection + " AS d" +
+		" LEFT JOIN " + projection.WebhookTable + " AS w ON d.webhook_id = w.id AND w.state = $1" +
+		" WHERE d.next_attempt <= $2" +
+		" ORDER BY d.next_attempt" +
+		" LIMIT $3"
+	removeDeliveryStmt = "DELETE FROM " + DeliveryProjection + " WHERE webhook_id = $1 AND event_sequence = $2"
+	retryDeliveryStmt  = "UPDATE " + DeliveryProjection + " SET attempts = $1, next_attempt = $2, error = $3 WHERE webhook_id = $4 AND event_sequence = $5"
+
+	setFailedDeliveryStmt = "UPSERT INTO " + projection.WebhookFailedDeliveriesTable +
+		" (webhook_id, resource_owner, failed_sequence, aggregate_type, aggregate_id, event_type, failure_count, error, last_failed)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)"
+
+	defaultSendInterval = time.Second
+	defaultBulkLimit    = 100
+	defaultMaxAttempts  = 3
+)
+
+//Sender sends the pending deliveries to the webhook targets
+//failed attempts are retried with an exponential backoff, the delivery is recorded as failed after the last attempt
+//only one instance sends the deliveries at the same time
+type Sender struct {
+	client         *sql.DB
+	httpClient     *http.Client
+	keyAlg         crypto.EncryptionAlgorithm
+	locker         crdb.Locker
+	interval       time.Duration
+	bulkLimit      uint64
+	maxAttempts    uint
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+type pendingDelivery struct {
+	webhookID     string
+	resourceOwner string
+	sequence      uint64
+	aggregateType string
+	aggregateID   string
+	eventType     string
+	payload       []byte
+	attempts      uint
+	url           sql.NullString
+	signingKey    *crypto.CryptoValue
+}
+
+func NewSender(client *sql.DB, config Config, keyAlg crypto.EncryptionAlgorithm) *Sender {
+	s := &Sender{
+		client:         client,
+		httpClient:     newHTTPClient(config.Timeout.Duration),
+		keyAlg:         keyAlg,
+		locker:         crdb.NewLocker(client, projection.LocksTable, senderLockName),
+		interval:       config.SendInterval.Duration,
+		bulkLimit:      config.BulkLimit,
+		maxAttempts:    config.MaxAttempts,
+		initialBackoff: config.InitialBackoff.Duration,
+		maxBackoff:     config.MaxBackoff.Duration,
+	}
+	if s.interval <= 0 {
+		s.interval = defaultSendInterval
+	}
+	if s.bulkLimit == 0 {
+		s.bulkLimit = defaultBulkLimit
+	}
+	if s.maxAttempts == 0 {
+		s.maxAttempts = defaultMaxAttempts
+	}
+	return s
+}
+
+//newHTTPClient returns a client which only connects to allowed addresses
+//the address is checked after the dns resolution, so host names resolving to internal addresses are rejected as well
+//proxies are not used because they would connect on behalf of the client
+func newHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: checkDialAddress,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+func checkDialAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !domain.IsAllowedWebhookIP(net.ParseIP(host)) {
+		return errors.ThrowPermissionDenied(nil, "WEBHO-Dl8ip", "webhook target address not allowed")
+	}
+	return nil
+}
+
+func (s *Sender) Start(ctx context.Context) {
+	go s.run(ctx)
+}
+
+func (s *Sender) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.lockAndSend(ctx, now)
+		}
+	}
+}
+
+//lockAndSend sends the due deliveries if no other instance holds the lock
+func (s *Sender) lockAndSend(ctx context.Context, now time.Time) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := s.locker.Lock(ctx, s.interval)
+	if err, ok := <-errs; err != nil || !ok {
+		if !errors.IsErrorAlreadyExists(err) {
+			logging.Log("WEBHO-Sd8le").OnError(err).Warn("initial lock failed")
+		}
+		return
+	}
+	go cancelOnErr(ctx, errs, cancel)
+
+	s.send(ctx, now)
+
+	err := s.locker.Unlock()
+	logging.Log("WEBHO-Sd8ul").OnError(err).Warn("unable to unlock")
+}
+
+func cancelOnErr(ctx context.Context, errs <-chan error, cancel func()) {
+	for {
+		select {
+		case err := <-errs:
+			if err != nil {
+				logging.Log("WEBHO-Sd8cl").WithError(err).Warn("webhook deliveries canceled")
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			cancel()
+			return
+		}
+	}
+}
+
+func (s *Sender) send(ctx context.Context, now time.Time) {
+	deliveries, err := s.dueDeliveries(ctx, now)
+	if err != nil {
+		logging.Log("WEBHO-Dq8se").WithError(err).Warn("unable to search due deliveries")
+		return
+	}
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		err = s.deliver(ctx, delivery, now)
+		logging.LogWithFields("WEBHO-Dq8ue", "webhook", delivery.webhookID, "seq", delivery.sequence).OnError(err).Warn("unable to update delivery")
+	}
+}
+
+func (s *Sender) dueDeliveries(ctx context.Context, now time.Time) ([]*pendingDelivery, error) {
+	rows, err := s.client.QueryContext(ctx, dueDeliveriesStmt, domain.WebhookStateActive, now, s.bulkLimit)
+	if err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Dq3ls", "Errors.Internal")
+	}
+	defer rows.Close()
+	deliveries := make([]*pendingDelivery, 0)
+	for rows.Next() {
+		delivery := &pendingDelivery{
+			signingKey: new(crypto.CryptoValue),
+		}
+		err = rows.Scan(
+			&delivery.webhookID,
+			&delivery.resourceOwner,
+			&delivery.sequence,
+			&delivery.aggregateType,
+			&delivery.aggregateID,
+			&delivery.eventType,
+			&delivery.payload,
+			&delivery.attempts,
+			&delivery.url,
+			delivery.signingKey,
+		)
+		if err != nil {
+			return nil, errors.ThrowInternal(err, "WEBHO-Dq9sc", "Errors.Internal")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.ThrowInternal(err, "WEBHO-Dq9rc", "Errors.Internal")
+	}
+	return deliveries, nil
+}
+
+//deliver makes one attempt to send the delivery
+//deliveries of removed or deactivated webhooks are dropped
+func (s *Sender) deliver(ctx context.Context, delivery *pendingDelivery, now time.Time) error {
+	if !delivery.url.Valid {
+		return s.removeDelivery(ctx, s.client, delivery)
+	}
+	err := s.post(ctx, delivery)
+	if err == nil {
+		return s.removeDelivery(ctx, s.client, delivery)
+	}
+	attempts := delivery.attempts + 1
+	if attempts < s.maxAttempts {
+		_, err = s.client.ExecContext(ctx, retryDeliveryStmt, attempts, now.Add(s.backoff(attempts)), err.Error(), delivery.webhookID, delivery.sequence)
+		if err != nil {
+			return errors.ThrowInternal(err, "WEBHO-Rt8ud", "unable to schedule retry of delivery")
+		}
+		return nil
+	}
+	logging.LogWithFields("WEBHO-Lq93m", "webhook", delivery.webhookID, "seq", delivery.sequence, "attempts", attempts).WithError(err).Warn("webhook delivery failed")
+	return s.setFailedDelivery(ctx, delivery, attempts, err, now)
+}
+
+//setFailedDelivery records the failed delivery and removes it from the pending deliveries
+func (s *Sender) setFailedDelivery(ctx context.Context, delivery *pendingDelivery, attempts uint, deliveryErr error, now time.Time) error {
+	tx, err := s.client.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.ThrowInternal(err, "WEBHO-Fd8tx", "unable to begin transaction")
+	}
+	_, err = tx.ExecContext(ctx, setFailedDeliveryStmt,
+		delivery.webhookID,
+		delivery.resourceOwner,
+		delivery.sequence,
+		delivery.aggregateType,
+		delivery.aggregateID,
+		delivery.eventType,
+		attempts,
+		deliveryErr.Error(),
+		now,
+	)
+	if err != nil {
+		tx.Rollback()
+		return errors.ThrowInternal(err, "WEBHO-Oe8sn", "unable to record failed delivery")
+	}
+	if err = s.removeDelivery(ctx, tx, delivery); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.ThrowInternal(err, "WEBHO-Fd8cm", "unable to commit failed delivery")
+	}
+	return nil
+}
+
+type contextExecuter interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func (s *Sender) removeDelivery(ctx context.Context, ex contextExecuter, delivery *pendingDelivery) error {
+	if _, err := ex.ExecContext(ctx, removeDeliveryStmt, delivery.webhookID, delivery.sequence); err != nil {
+		return errors.ThrowInternal(err, "WEBHO-Rm8de", "unable to remove delivery")
+	}
+	return nil
+}
+
+//backoff returns the wait time after the given number of failed attempts
+func (s *Sender) backoff(attempts uint) time.Duration {
+	backoff := s.initialBackoff
+	for i := uint(1); i < attempts; i++ {
+		backoff *= 2
+		if s.maxBackoff > 0 && backoff > s.maxBackoff {
+			return s.maxBackoff
+		}
+	}
+	return backoff
+}
+
+func (s *Sender) post(ctx context.Context, delivery *pendingDelivery) error {
+	signingKey, err := crypto.Decrypt(delivery.signingKey, s.keyAlg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.url.String, bytes.NewReader(delivery.payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, delivery.eventType)
+	req.Header.Set(SignatureHeader, Signature(signingKey, time.Now(), delivery.payload))
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+//Signature computes the value of the signature header
+//the receiver is able to verify the body by computing the HMAC-SHA256 of "t.body" with the signing key of the webhook
+func Signature(signingKey []byte, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(t + "."))
+	mac.Write(body)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
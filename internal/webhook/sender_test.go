@@ -0,0 +1,247 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+
+	"github.com/caos/zitadel/internal/crypto"
+)
+
+func testDelivery(url string, attempts uint) *pendingDelivery {
+	return &pendingDelivery{
+		webhookID:     "webhook1",
+		resourceOwner: "org-id",
+		sequence:      15,
+		aggregateType: "user",
+		aggregateID:   "user-id",
+		eventType:     "user.locked",
+		payload:       []byte(`{"eventType":"user.locked"}`),
+		attempts:      attempts,
+		url:           sql.NullString{String: url, Valid: url != ""},
+		signingKey: &crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("key"),
+		},
+	}
+}
+
+func TestSender_deliver(t *testing.T) {
+	now := time.Now()
+	type res struct {
+		requests int
+		err      bool
+	}
+	tests := []struct {
+		name     string
+		status   int
+		removed  bool
+		attempts uint
+		expect   func(mock sqlmock.Sqlmock)
+		res      res
+	}{
+		{
+			name:    "webhook removed, dropped",
+			removed: true,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(removeDeliveryStmt)).
+					WithArgs("webhook1", 15).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			res: res{},
+		},
+		{
+			name:   "delivered, removed",
+			status: http.StatusNoContent,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(removeDeliveryStmt)).
+					WithArgs("webhook1", 15).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			res: res{
+				requests: 1,
+			},
+		},
+		{
+			name:     "target failed, retry scheduled",
+			status:   http.StatusInternalServerError,
+			attempts: 1,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(retryDeliveryStmt)).
+					WithArgs(2, now.Add(2*time.Second), "unexpected status code 500", "webhook1", 15).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			res: res{
+				requests: 1,
+			},
+		},
+		{
+			name:     "last attempt failed, recorded",
+			status:   http.StatusInternalServerError,
+			attempts: 2,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(setFailedDeliveryStmt)).
+					WithArgs("webhook1", "org-id", 15, "user", "user-id", "user.locked", 3, "unexpected status code 500", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(removeDeliveryStmt)).
+					WithArgs("webhook1", 15).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			res: res{
+				requests: 1,
+			},
+		},
+		{
+			name:     "record failed, rollback",
+			status:   http.StatusInternalServerError,
+			attempts: 2,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(setFailedDeliveryStmt)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			res: res{
+				requests: 1,
+				err:      true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				body, _ := ioutil.ReadAll(r.Body)
+				if r.Header.Get(EventTypeHeader) != "user.locked" {
+					t.Errorf("wrong event type header: %s", r.Header.Get(EventTypeHeader))
+				}
+				if !verify(r.Header.Get(SignatureHeader), []byte("key"), body) {
+					t.Errorf("invalid signature: %s", r.Header.Get(SignatureHeader))
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("unable to create mock db: %v", err)
+			}
+			tt.expect(mock)
+
+			s := &Sender{
+				client:         db,
+				httpClient:     server.Client(),
+				keyAlg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				maxAttempts:    3,
+				initialBackoff: time.Second,
+				maxBackoff:     10 * time.Second,
+			}
+			url := server.URL
+			if tt.removed {
+				url = ""
+			}
+			err = s.deliver(context.Background(), testDelivery(url, tt.attempts), now)
+			if (err != nil) != tt.res.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests != tt.res.requests {
+				t.Errorf("wrong number of requests: want %d, got %d", tt.res.requests, requests)
+			}
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, err := newHTTPClient(time.Second).Post(server.URL, "application/json", nil)
+	if err == nil {
+		t.Error("expected connection to loopback address to be rejected")
+	}
+	if requests != 0 {
+		t.Errorf("wrong number of requests: want 0, got %d", requests)
+	}
+}
+
+func TestSender_backoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts uint
+		want     time.Duration
+	}{
+		{
+			name:     "first attempt",
+			attempts: 1,
+			want:     time.Second,
+		},
+		{
+			name:     "doubled",
+			attempts: 3,
+			want:     4 * time.Second,
+		},
+		{
+			name:     "limited",
+			attempts: 5,
+			want:     10 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Sender{
+				initialBackoff: time.Second,
+				maxBackoff:     10 * time.Second,
+			}
+			if got := s.backoff(tt.attempts); got != tt.want {
+				t.Errorf("backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignature(t *testing.T) {
+	timestamp := time.Unix(1636000000, 0)
+	signature := Signature([]byte("key"), timestamp, []byte(`{"eventType":"user.locked"}`))
+	if !strings.HasPrefix(signature, "t=1636000000,v1=") {
+		t.Errorf("wrong signature format: %s", signature)
+	}
+	if !verify(signature, []byte("key"), []byte(`{"eventType":"user.locked"}`)) {
+		t.Error("signature not verifiable")
+	}
+	if verify(signature, []byte("other"), []byte(`{"eventType":"user.locked"}`)) {
+		t.Error("signature verifiable with wrong key")
+	}
+}
+
+func verify(signature string, key, body []byte) bool {
+	parts := strings.Split(signature, ",")
+	if len(parts) != 2 {
+		return false
+	}
+	seconds, err := strconv.ParseInt(strings.TrimPrefix(parts[0], "t="), 10, 64)
+	if err != nil {
+		return false
+	}
+	return Signature(key, time.Unix(seconds, 0), body) == signature
+}
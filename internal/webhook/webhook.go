@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/caos/zitadel/internal/config/systemdefaults"
+	"github.com/caos/zitadel/internal/config/types"
+	"github.com/caos/zitadel/internal/crypto"
+	"github.com/caos/zitadel/internal/eventstore"
+	"github.com/caos/zitadel/internal/eventstore/handler"
+	"github.com/caos/zitadel/internal/eventstore/handler/crdb"
+	"github.com/caos/zitadel/internal/query"
+	"github.com/caos/zitadel/internal/query/projection"
+)
+
+type Config struct {
+	//Timeout of a single delivery request
+	Timeout types.Duration
+	//SendInterval between two checks for due deliveries
+	SendInterval types.Duration
+	//BulkLimit of the deliveries sent per check
+	BulkLimit uint64
+	//MaxAttempts of a delivery to one target before it's recorded as failed
+	MaxAttempts uint
+	//InitialBackoff is the wait time after the first failed attempt, it's doubled after each further attempt
+	InitialBackoff types.Duration
+	//MaxBackoff limits the wait time between two attempts
+	MaxBackoff types.Duration
+}
+
+//Start starts the delivery of the events to the webhook targets
+//the pending deliveries are recorded like a projection with its own current sequences
+//and sent by the sender outside of the transaction of the projection
+func Start(ctx context.Context, config Config, projections projection.Config, defaults systemdefaults.SystemDefaults, es *eventstore.Eventstore, queries *query.Queries) error {
+	sqlClient, err := projections.CRDB.Start()
+	if err != nil {
+		return err
+	}
+	keyAlg, err := crypto.NewAESCrypto(defaults.WebhookVerificationKey)
+	if err != nil {
+		return err
+	}
+	NewDeliveryHandler(
+		ctx,
+		crdb.StatementHandlerConfig{
+			ProjectionHandlerConfig: handler.ProjectionHandlerConfig{
+				HandlerConfig: handler.HandlerConfig{
+					Eventstore: es,
+				},
+				RequeueEvery:     projections.RequeueEvery.Duration,
+				RetryFailedAfter: projections.RetryFailedAfter.Duration,
+			},
+			Client:            sqlClient,
+			SequenceTable:     projection.CurrentSeqTable,
+			LockTable:         projection.LocksTable,
+			FailedEventsTable: projection.FailedEventsTable,
+			MaxFailureCount:   projections.MaxFailureCount,
+			BulkLimit:         projections.BulkLimit,
+		},
+		queries,
+	)
+	NewSender(sqlClient, config, keyAlg).Start(ctx)
+	return nil
+}
@@ -0,0 +1,34 @@
+CREATE TABLE zitadel.projections.idps_saml_config (
+    idp_id TEXT REFERENCES zitadel.projections.idps (id) ON DELETE CASCADE,
+
+    metadata_url TEXT,
+    metadata BYTES,
+    key JSONB,
+    certificate BYTES,
+    binding SMALLINT,
+    with_signed_request BOOLEAN,
+    name_id_format SMALLINT,
+    username_attribute TEXT,
+    display_name_attribute TEXT,
+    first_name_attribute TEXT,
+    last_name_attribute TEXT,
+    email_attribute TEXT,
+    phone_attribute TEXT,
+
+    PRIMARY KEY (idp_id)
+);
+
+ALTER TABLE auth.idp_configs ADD COLUMN is_saml BOOLEAN;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_metadata_url TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_metadata BYTES;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_key JSONB;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_certificate BYTES;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_binding SMALLINT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_with_signed_request BOOLEAN;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_name_id_format SMALLINT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_username_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_display_name_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_first_name_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_last_name_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_email_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN saml_phone_attribute TEXT;
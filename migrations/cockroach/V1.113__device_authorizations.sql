@@ -0,0 +1,22 @@
+CREATE TABLE zitadel.projections.device_authorizations (
+    id STRING
+    , creation_date TIMESTAMPTZ NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    , resource_owner STRING NOT NULL
+    , sequence INT8 NOT NULL
+    , state INT2 NOT NULL
+    , client_id STRING NOT NULL
+    , device_code STRING NOT NULL
+    , user_code STRING NOT NULL
+    , expires TIMESTAMPTZ NOT NULL
+    , scopes STRING[]
+    , subject STRING
+    , user_org_id STRING
+    , audience STRING[]
+    , amr STRING[]
+    , auth_time TIMESTAMPTZ
+
+    , PRIMARY KEY (id)
+    , UNIQUE INDEX idx_device_code (device_code)
+    , INDEX idx_user_code (user_code)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE zitadel.projections.projects ADD COLUMN token_exchange_delegation BOOLEAN;
+ALTER TABLE zitadel.projections.projects ADD COLUMN token_exchange_impersonation BOOLEAN;
+
+ALTER TABLE auth.tokens ADD COLUMN actor_subject TEXT;
+ALTER TABLE auth.tokens ADD COLUMN actor_client_id TEXT;
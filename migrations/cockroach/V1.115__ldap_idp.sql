@@ -0,0 +1,34 @@
+CREATE TABLE zitadel.projections.idps_ldap_config (
+    idp_id TEXT REFERENCES zitadel.projections.idps (id) ON DELETE CASCADE,
+
+    url TEXT,
+    start_tls BOOLEAN,
+    bind_dn TEXT,
+    bind_password JSONB,
+    base_dn TEXT,
+    user_object_class TEXT,
+    id_attribute TEXT,
+    username_attribute TEXT,
+    display_name_attribute TEXT,
+    first_name_attribute TEXT,
+    last_name_attribute TEXT,
+    email_attribute TEXT,
+    phone_attribute TEXT,
+
+    PRIMARY KEY (idp_id)
+);
+
+ALTER TABLE auth.idp_configs ADD COLUMN is_ldap BOOLEAN;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_url TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_start_tls BOOLEAN;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_bind_dn TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_bind_password JSONB;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_base_dn TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_user_object_class TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_id_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_username_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_display_name_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_first_name_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_last_name_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_email_attribute TEXT;
+ALTER TABLE auth.idp_configs ADD COLUMN ldap_phone_attribute TEXT;
@@ -0,0 +1,37 @@
+CREATE TABLE zitadel.projections.webhooks (
+    id STRING
+    , creation_date TIMESTAMPTZ NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    , resource_owner STRING NOT NULL
+    , sequence INT8 NOT NULL
+    , state INT2 NOT NULL
+    , name STRING NOT NULL
+    , url STRING NOT NULL
+    , event_types STRING[]
+    , signing_key JSONB
+
+    , PRIMARY KEY (id)
+    , INDEX idx_ro (resource_owner)
+);
+
+CREATE TABLE zitadel.projections.webhooks_failed_deliveries (
+    webhook_id STRING
+    , resource_owner STRING NOT NULL
+    , failed_sequence INT8
+    , aggregate_type STRING NOT NULL
+    , aggregate_id STRING NOT NULL
+    , event_type STRING NOT NULL
+    , failure_count INT2 NOT NULL
+    , error STRING
+    , last_failed TIMESTAMPTZ NOT NULL
+
+    , PRIMARY KEY (webhook_id, failed_sequence)
+    , INDEX idx_ro (resource_owner)
+);
+
+-- deliveries start at the current position of the eventstore instead of replaying the history
+INSERT INTO zitadel.projections.current_sequences (projection_name, aggregate_type, current_sequence, timestamp)
+    SELECT 'zitadel.projections.webhook_deliveries', aggregate_type, MAX(event_sequence), now()
+    FROM eventstore.events
+    WHERE aggregate_type IN ('user', 'usergrant', 'org', 'project', 'iam')
+    GROUP BY aggregate_type;
@@ -0,0 +1,29 @@
+CREATE TABLE zitadel.projections.sms_configs_vonage (
+    sms_id STRING NOT NULL REFERENCES zitadel.projections.sms_configs (id) ON DELETE CASCADE
+    , api_key STRING NOT NULL
+    , sender_number STRING NOT NULL
+    , api_secret JSONB
+
+    , PRIMARY KEY (sms_id)
+);
+
+CREATE TABLE zitadel.projections.sms_configs_http (
+    sms_id STRING NOT NULL REFERENCES zitadel.projections.sms_configs (id) ON DELETE CASCADE
+    , endpoint STRING NOT NULL
+    , method STRING NOT NULL DEFAULT ''
+    , content_type STRING NOT NULL DEFAULT ''
+    , body_template STRING NOT NULL
+    , sender_number STRING NOT NULL DEFAULT ''
+    , auth_header JSONB
+
+    , PRIMARY KEY (sms_id)
+);
+
+CREATE TABLE zitadel.projections.org_sms_providers (
+    org_id STRING NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    , sequence INT8 NOT NULL
+    , provider_ids STRING[]
+
+    , PRIMARY KEY (org_id)
+);
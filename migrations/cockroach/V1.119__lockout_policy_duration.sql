@@ -0,0 +1,4 @@
+ALTER TABLE zitadel.projections.lockout_policies ADD COLUMN max_otp_attempts INT8 NOT NULL DEFAULT 0;
+ALTER TABLE zitadel.projections.lockout_policies ADD COLUMN max_u2f_attempts INT8 NOT NULL DEFAULT 0;
+ALTER TABLE zitadel.projections.lockout_policies ADD COLUMN lockout_duration INT8 NOT NULL DEFAULT 0;
+ALTER TABLE zitadel.projections.lockout_policies ADD COLUMN backoff_delay INT8 NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+CREATE TABLE zitadel.projections.password_history_policies (
+    id STRING NOT NULL,
+    creation_date TIMESTAMPTZ NULL,
+    change_date TIMESTAMPTZ NULL,
+    sequence INT8 NULL,
+    state INT2 NULL,
+    resource_owner TEXT,
+
+    is_default BOOLEAN,
+    history_count INT8 NULL,
+
+    PRIMARY KEY (id)
+);
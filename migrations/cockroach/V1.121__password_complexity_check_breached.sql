@@ -0,0 +1 @@
+ALTER TABLE zitadel.projections.password_complexity_policies ADD COLUMN check_breached BOOLEAN NOT NULL DEFAULT false;
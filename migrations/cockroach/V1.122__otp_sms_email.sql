@@ -0,0 +1,8 @@
+ALTER TABLE adminapi.users ADD COLUMN otp_sms_added BOOLEAN;
+ALTER TABLE adminapi.users ADD COLUMN otp_email_added BOOLEAN;
+
+ALTER TABLE auth.users ADD COLUMN otp_sms_added BOOLEAN;
+ALTER TABLE auth.users ADD COLUMN otp_email_added BOOLEAN;
+
+ALTER TABLE management.users ADD COLUMN otp_sms_added BOOLEAN;
+ALTER TABLE management.users ADD COLUMN otp_email_added BOOLEAN;
@@ -0,0 +1,5 @@
+ALTER TABLE adminapi.users ADD COLUMN recovery_codes_remaining SMALLINT;
+
+ALTER TABLE auth.users ADD COLUMN recovery_codes_remaining SMALLINT;
+
+ALTER TABLE management.users ADD COLUMN recovery_codes_remaining SMALLINT;
@@ -0,0 +1,9 @@
+ALTER TABLE zitadel.projections.user_grants ADD COLUMN expiration_date TIMESTAMPTZ;
+
+ALTER TABLE zitadel.projections.iam_members ADD COLUMN expiration_date TIMESTAMPTZ;
+
+ALTER TABLE zitadel.projections.org_members ADD COLUMN expiration_date TIMESTAMPTZ;
+
+ALTER TABLE zitadel.projections.project_members ADD COLUMN expiration_date TIMESTAMPTZ;
+
+ALTER TABLE zitadel.projections.project_grant_members ADD COLUMN expiration_date TIMESTAMPTZ;
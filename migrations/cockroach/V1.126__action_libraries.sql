@@ -0,0 +1,25 @@
+CREATE TABLE zitadel.projections.action_libraries (
+    id STRING
+    , creation_date TIMESTAMPTZ
+    , change_date TIMESTAMPTZ
+    , resource_owner STRING NOT NULL
+    , state INT2
+    , sequence INT8
+
+    , name STRING NOT NULL
+    , version INT8 NOT NULL
+    , script STRING
+
+    , PRIMARY KEY (id)
+    , INDEX idx_ro_name (resource_owner, name)
+);
+
+CREATE TABLE zitadel.projections.action_libraries_versions (
+    library_id STRING REFERENCES zitadel.projections.action_libraries (id) ON DELETE CASCADE
+    , version INT8 NOT NULL
+    , script STRING
+    , creation_date TIMESTAMPTZ
+    , resource_owner STRING NOT NULL
+
+    , PRIMARY KEY (library_id, version)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE zitadel.projections.user_password_ages (
+    user_id STRING
+    , resource_owner STRING NOT NULL
+    , change_date TIMESTAMPTZ
+    , sequence INT8
+
+    , password_changed TIMESTAMPTZ NOT NULL
+    , expiry_notified BOOLEAN NOT NULL DEFAULT false
+
+    , PRIMARY KEY (user_id)
+    , INDEX idx_ro_changed (resource_owner, password_changed)
+);
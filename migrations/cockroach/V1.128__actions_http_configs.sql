@@ -0,0 +1,10 @@
+CREATE TABLE zitadel.projections.actions_http_configs (
+    aggregate_id STRING NOT NULL
+    , change_date TIMESTAMPTZ NOT NULL
+    , resource_owner STRING NOT NULL
+    , sequence INT8 NOT NULL
+    , allow_list STRING[]
+    , max_response_size INT8 NOT NULL DEFAULT 0
+
+    , PRIMARY KEY (aggregate_id)
+);
@@ -0,0 +1,23 @@
+CREATE TABLE zitadel.action_executions (
+    id UUID DEFAULT gen_random_uuid()
+    , action_id STRING NOT NULL
+    , action_name STRING NOT NULL
+    , resource_owner STRING NOT NULL
+    , flow_type INT2 NOT NULL
+    , trigger_type INT2 NOT NULL
+    , creation_date TIMESTAMPTZ NOT NULL
+    , duration INT8 NOT NULL
+    , outcome INT2 NOT NULL
+    , error STRING
+    , logs STRING[]
+
+    , PRIMARY KEY (id)
+    , INDEX idx_action (resource_owner, action_id, creation_date DESC)
+    , INDEX idx_creation_date (creation_date)
+);
+
+GRANT SELECT, INSERT, DELETE ON TABLE zitadel.action_executions TO queries;
+
+INSERT INTO zitadel.action_executions SELECT * FROM zitadel.projections.action_executions;
+
+DROP TABLE zitadel.projections.action_executions;
@@ -0,0 +1,17 @@
+-- the pending deliveries are sent by a separate worker, they are removed after the delivery or after the last failed attempt
+CREATE TABLE zitadel.projections.webhook_deliveries (
+    webhook_id STRING
+    , resource_owner STRING NOT NULL
+    , event_sequence INT8
+    , aggregate_type STRING NOT NULL
+    , aggregate_id STRING NOT NULL
+    , event_type STRING NOT NULL
+    , payload BYTES NOT NULL
+    , creation_date TIMESTAMPTZ NOT NULL
+    , attempts INT2 NOT NULL DEFAULT 0
+    , next_attempt TIMESTAMPTZ NOT NULL
+    , error STRING
+
+    , PRIMARY KEY (webhook_id, event_sequence)
+    , INDEX idx_next_attempt (next_attempt)
+);
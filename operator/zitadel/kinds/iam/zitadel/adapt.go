@@ -358,6 +358,15 @@ func AdaptFunc(
 				if desiredKind.Spec.Configuration.Secrets.IDPConfigVerificationID == "" {
 					desiredKind.Spec.Configuration.Secrets.IDPConfigVerificationID = "idpconfigverificationkey_1"
 				}
+				if desiredKind.Spec.Configuration.Secrets.WebhookVerificationID == "" {
+					desiredKind.Spec.Configuration.Secrets.WebhookVerificationID = "webhookverificationkey_1"
+				}
+				if desiredKind.Spec.Configuration.Secrets.RecoveryCodeID == "" {
+					desiredKind.Spec.Configuration.Secrets.RecoveryCodeID = "recoverycodekey_1"
+				}
+				if desiredKind.Spec.Configuration.Secrets.NotificationProviderID == "" {
+					desiredKind.Spec.Configuration.Secrets.NotificationProviderID = "notificationproviderkey_1"
+				}
 				if desiredKind.Spec.Configuration.Secrets.OIDCKeysID == "" {
 					desiredKind.Spec.Configuration.Secrets.OIDCKeysID = "oidckey_1"
 				}
@@ -392,6 +401,15 @@ func AdaptFunc(
 				if _, ok := keys[desiredKind.Spec.Configuration.Secrets.IDPConfigVerificationID]; !ok {
 					keys[desiredKind.Spec.Configuration.Secrets.IDPConfigVerificationID] = helper.RandStringBytes(32)
 				}
+				if _, ok := keys[desiredKind.Spec.Configuration.Secrets.WebhookVerificationID]; !ok {
+					keys[desiredKind.Spec.Configuration.Secrets.WebhookVerificationID] = helper.RandStringBytes(32)
+				}
+				if _, ok := keys[desiredKind.Spec.Configuration.Secrets.RecoveryCodeID]; !ok {
+					keys[desiredKind.Spec.Configuration.Secrets.RecoveryCodeID] = helper.RandStringBytes(32)
+				}
+				if _, ok := keys[desiredKind.Spec.Configuration.Secrets.NotificationProviderID]; !ok {
+					keys[desiredKind.Spec.Configuration.Secrets.NotificationProviderID] = helper.RandStringBytes(32)
+				}
 				if _, ok := keys[desiredKind.Spec.Configuration.Secrets.OIDCKeysID]; !ok {
 					keys[desiredKind.Spec.Configuration.Secrets.OIDCKeysID] = helper.RandStringBytes(32)
 				}
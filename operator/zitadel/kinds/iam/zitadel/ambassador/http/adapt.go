@@ -18,6 +18,7 @@ const (
 	AuthRName      = "auth-rest-v1"
 	AuthorizeName  = "authorize-v1"
 	EndsessionName = "endsession-v1"
+	SAMLName       = "saml-v2"
 	IssuerName     = "issuer-v1"
 	OpenAPIName    = "openapi"
 )
@@ -70,6 +71,11 @@ func AdaptFunc(
 		return nil, nil, err
 	}
 
+	destroySAML, err := mapping.AdaptFuncToDestroy(namespace, SAMLName)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	destroyIssuer, err := mapping.AdaptFuncToDestroy(namespace, IssuerName)
 	if err != nil {
 		return nil, nil, err
@@ -88,6 +94,7 @@ func AdaptFunc(
 		operator.ResourceDestroyToZitadelDestroy(destroyAuthR),
 		operator.ResourceDestroyToZitadelDestroy(destroyAuthorize),
 		operator.ResourceDestroyToZitadelDestroy(destroyEndsession),
+		operator.ResourceDestroyToZitadelDestroy(destroySAML),
 		operator.ResourceDestroyToZitadelDestroy(destroyIssuer),
 		operator.ResourceDestroyToZitadelDestroy(destroySwagger),
 	}
@@ -223,6 +230,22 @@ func AdaptFunc(
 				return nil, err
 			}
 
+			querySAML, err := mapping.AdaptFuncToEnsure(
+				namespace,
+				labels.MustForName(componentLabels, SAMLName),
+				false,
+				accountsDomain,
+				"/saml/v2/",
+				"",
+				httpUrl,
+				30000,
+				30000,
+				cors,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 			queryIssuer, err := mapping.AdaptFuncToEnsure(
 				namespace,
 				labels.MustForName(componentLabels, IssuerName),
@@ -263,6 +286,7 @@ func AdaptFunc(
 				operator.ResourceQueryToZitadelQuery(queryAuthR),
 				operator.ResourceQueryToZitadelQuery(queryAuthorize),
 				operator.ResourceQueryToZitadelQuery(queryEndsession),
+				operator.ResourceQueryToZitadelQuery(querySAML),
 				operator.ResourceQueryToZitadelQuery(queryIssuer),
 				operator.ResourceQueryToZitadelQuery(queryOpenAPI),
 			}
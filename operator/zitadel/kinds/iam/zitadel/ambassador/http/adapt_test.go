@@ -89,6 +89,30 @@ func TestHttp_Adapt(t *testing.T) {
 	SetReturnResourceVersion(k8sClient, group, version, kind, namespace, EndsessionName, "")
 	k8sClient.EXPECT().ApplyNamespacedCRDResource(group, version, kind, namespace, EndsessionName, endsession).MinTimes(1).MaxTimes(1)
 
+	samlName := labels.MustForName(componentLabels, SAMLName)
+	saml := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": group + "/" + version,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"labels":    labels.MustK8sMap(samlName),
+				"name":      samlName.Name(),
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"connect_timeout_ms": 30000,
+				"host":               ".",
+				"prefix":             "/saml/v2/",
+				"rewrite":            "",
+				"service":            url,
+				"timeout_ms":         30000,
+				"cors":               cors,
+			},
+		},
+	}
+	SetReturnResourceVersion(k8sClient, group, version, kind, namespace, SAMLName, "")
+	k8sClient.EXPECT().ApplyNamespacedCRDResource(group, version, kind, namespace, SAMLName, saml).MinTimes(1).MaxTimes(1)
+
 	uploadName := labels.MustForName(componentLabels, Upload)
 	upload := &unstructured.Unstructured{
 		Object: map[string]interface{}{
@@ -345,6 +369,30 @@ func TestHttp_Adapt2(t *testing.T) {
 	SetReturnResourceVersion(k8sClient, group, version, kind, namespace, EndsessionName, "")
 	k8sClient.EXPECT().ApplyNamespacedCRDResource(group, version, kind, namespace, EndsessionName, endsession).MinTimes(1).MaxTimes(1)
 
+	samlName := labels.MustForName(componentLabels, SAMLName)
+	saml := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": group + "/" + version,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"labels":    labels.MustK8sMap(samlName),
+				"name":      samlName.Name(),
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"connect_timeout_ms": 30000,
+				"host":               "accounts.domain",
+				"prefix":             "/saml/v2/",
+				"rewrite":            "",
+				"service":            url,
+				"timeout_ms":         30000,
+				"cors":               cors,
+			},
+		},
+	}
+	SetReturnResourceVersion(k8sClient, group, version, kind, namespace, SAMLName, "")
+	k8sClient.EXPECT().ApplyNamespacedCRDResource(group, version, kind, namespace, SAMLName, saml).MinTimes(1).MaxTimes(1)
+
 	uploadName := labels.MustForName(componentLabels, Upload)
 	upload := &unstructured.Unstructured{
 		Object: map[string]interface{}{
@@ -102,6 +102,9 @@ type Secrets struct {
 	CSRFID                  string           `yaml:"csrfID,omitempty"`
 	DomainVerificationID    string           `yaml:"domainVerificationID,omitempty"`
 	IDPConfigVerificationID string           `yaml:"idpConfigVerificationID,omitempty"`
+	WebhookVerificationID   string           `yaml:"webhookVerificationID,omitempty"`
+	RecoveryCodeID          string           `yaml:"recoveryCodeID,omitempty"`
+	NotificationProviderID  string           `yaml:"notificationProviderID,omitempty"`
 }
 
 type Notifications struct {
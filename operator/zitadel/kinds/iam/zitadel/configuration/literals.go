@@ -64,6 +64,9 @@ func literalsConfigMap(
 			literalsConfigMap["ZITADEL_CSRF_KEY"] = desired.Secrets.CSRFID
 			literalsConfigMap["ZITADEL_DOMAIN_VERIFICATION_KEY"] = desired.Secrets.DomainVerificationID
 			literalsConfigMap["ZITADEL_IDP_CONFIG_VERIFICATION_KEY"] = desired.Secrets.IDPConfigVerificationID
+			literalsConfigMap["ZITADEL_WEBHOOK_VERIFICATION_KEY"] = desired.Secrets.WebhookVerificationID
+			literalsConfigMap["ZITADEL_RECOVERY_CODE_KEY"] = desired.Secrets.RecoveryCodeID
+			literalsConfigMap["ZITADEL_NOTIFICATION_PROVIDER_KEY"] = desired.Secrets.NotificationProviderID
 		}
 		if desired.Notifications != nil {
 			literalsConfigMap["TWILIO_SENDER_NAME"] = desired.Notifications.Twilio.SenderName
@@ -90,6 +93,7 @@ func literalsConfigMap(
 			issuer := "https://" + desired.DNS.Subdomains.Issuer + "." + defaultDomain
 			oauth := "https://" + desired.DNS.Subdomains.API + "." + defaultDomain + "/oauth/v2"
 			authorize := "https://" + desired.DNS.Subdomains.Accounts + "." + defaultDomain + "/oauth/v2"
+			saml := "https://" + desired.DNS.Subdomains.Accounts + "." + defaultDomain + "/saml/v2"
 			console := "https://" + desired.DNS.Subdomains.Console + "." + defaultDomain
 			apiDomain := "https://" + desired.DNS.Subdomains.API + "." + defaultDomain
 
@@ -97,6 +101,7 @@ func literalsConfigMap(
 			literalsConfigMap["ZITADEL_ACCOUNTS"] = accounts
 			literalsConfigMap["ZITADEL_OAUTH"] = oauth
 			literalsConfigMap["ZITADEL_AUTHORIZE"] = authorize
+			literalsConfigMap["ZITADEL_SAML"] = saml
 			literalsConfigMap["ZITADEL_CONSOLE"] = console
 			literalsConfigMap["ZITADEL_ACCOUNTS_DOMAIN"] = accountsDomain
 			literalsConfigMap["ZITADEL_COOKIE_DOMAIN"] = accountsDomain
@@ -30,6 +30,9 @@ var (
 			CSRFID:                  "",
 			DomainVerificationID:    "",
 			IDPConfigVerificationID: "",
+			WebhookVerificationID:   "",
+			RecoveryCodeID:          "",
+			NotificationProviderID:  "",
 		},
 		Notifications: &Notifications{
 			GoogleChatURL: &secret.Secret{Value: ""},
@@ -104,6 +107,9 @@ var (
 			CSRFID:                  "csrfid",
 			DomainVerificationID:    "domainid",
 			IDPConfigVerificationID: "idpid",
+			WebhookVerificationID:   "webhookid",
+			RecoveryCodeID:          "recoverycodeid",
+			NotificationProviderID:  "notificationproviderid",
 		},
 		Notifications: &Notifications{
 			GoogleChatURL: &secret.Secret{Value: "chat"},
@@ -178,6 +184,9 @@ var (
 			CSRFID:                  "csrfid",
 			DomainVerificationID:    "domainid",
 			IDPConfigVerificationID: "idpid",
+			WebhookVerificationID:   "webhookid",
+			RecoveryCodeID:          "recoverycodeid",
+			NotificationProviderID:  "notificationproviderid",
 		},
 		Notifications: &Notifications{
 			ExistingGoogleChatURL: &secret.Existing{"chat", "chat", "chat"},
@@ -272,6 +281,9 @@ func TestConfiguration_LiteralsConfigMap(t *testing.T) {
 		"ZITADEL_EVENTSTORE_HOST":             "test",
 		"CR_ADMINAPI_CERT":                    "test/client.adminapi.crt",
 		"ZITADEL_IDP_CONFIG_VERIFICATION_KEY": "",
+		"ZITADEL_WEBHOOK_VERIFICATION_KEY":    "",
+		"ZITADEL_RECOVERY_CODE_KEY":           "",
+		"ZITADEL_NOTIFICATION_PROVIDER_KEY":   "",
 		"ZITADEL_ACCOUNTS":                    "https://.",
 		"ZITADEL_OAUTH":                       "https://./oauth/v2",
 		"ZITADEL_EVENTSTORE_PORT":             "test",
@@ -310,6 +322,7 @@ func TestConfiguration_LiteralsConfigMap(t *testing.T) {
 		"CR_MANAGEMENT_KEY":                   "test/client.management.key",
 		"ZITADEL_TRACING_TYPE":                "",
 		"ZITADEL_AUTHORIZE":                   "https://./oauth/v2",
+		"ZITADEL_SAML":                        "https://./saml/v2",
 		"ZITADEL_ASSET_STORAGE_TYPE":          "",
 		"ZITADEL_ASSET_STORAGE_ENDPOINT":      "",
 		"ZITADEL_ASSET_STORAGE_SSL":           "false",
@@ -377,6 +390,7 @@ func TestConfiguration_LiteralsConfigMapFull(t *testing.T) {
 		"ZITADEL_ACCOUNTS":                    "https://accounts.domain",
 		"ZITADEL_ACCOUNTS_DOMAIN":             "accounts.domain",
 		"ZITADEL_AUTHORIZE":                   "https://accounts.domain/oauth/v2",
+		"ZITADEL_SAML":                        "https://accounts.domain/saml/v2",
 		"ZITADEL_CONSOLE":                     "https://console.domain",
 		"ZITADEL_API_DOMAIN":                  "https://api.domain",
 		"ZITADEL_COOKIE_DOMAIN":               "accounts.domain",
@@ -387,6 +401,9 @@ func TestConfiguration_LiteralsConfigMapFull(t *testing.T) {
 		"ZITADEL_EVENTSTORE_HOST":             "test",
 		"ZITADEL_EVENTSTORE_PORT":             "test",
 		"ZITADEL_IDP_CONFIG_VERIFICATION_KEY": "idpid",
+		"ZITADEL_WEBHOOK_VERIFICATION_KEY":    "webhookid",
+		"ZITADEL_RECOVERY_CODE_KEY":           "recoverycodeid",
+		"ZITADEL_NOTIFICATION_PROVIDER_KEY":   "notificationproviderid",
 		"ZITADEL_ISSUER":                      "https://issuer.domain",
 		"ZITADEL_KEY_PATH":                    "test/test",
 		"ZITADEL_LOG_LEVEL":                   "debug",